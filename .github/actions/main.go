@@ -54,4 +54,4 @@ func main() {
 	}
 
 	fmt.Printf("Successfully converted test file %s to Terratest file %s\n", testFile, terratestFileName)
-}
\ No newline at end of file
+}
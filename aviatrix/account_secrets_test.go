@@ -0,0 +1,133 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func TestAccountSecretsWriteOnly(t *testing.T) {
+	raw := map[string]interface{}{
+		"account_name":       "aws",
+		"cloud_type":         1,
+		"aws_account_number": "123456789012",
+		"aws_iam":            false,
+		"aws_access_key":     "AKIA",
+		"aws_secret_key":     "secret",
+	}
+	diff, err := resourceAviatrixAccount().SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("SimpleDiff() error = %v", err)
+	}
+	if attr := diff.Attributes["aws_secret_key"]; attr != nil && attr.New != "" {
+		t.Errorf("aws_secret_key is planned as %#v, want it not to be stored", attr)
+	}
+
+	d := resourceAviatrixAccount().Data(&terraform.InstanceState{
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"aws_secret_key":       cty.StringVal("secret"),
+			"awsgov_secret_key":    cty.NullVal(cty.String),
+			"edge_zededa_password": cty.StringVal("password"),
+		}),
+	})
+	if got := getWriteOnlyString(d, "aws_secret_key"); got != "secret" {
+		t.Errorf("getWriteOnlyString(aws_secret_key) = %q, want %q", got, "secret")
+	}
+	if got := getWriteOnlyString(d, "edge_zededa_password"); got != "password" {
+		t.Errorf("getWriteOnlyString(edge_zededa_password) = %q, want %q", got, "password")
+	}
+	for _, attribute := range []string{"awsgov_secret_key", "alicloud_secret_key"} {
+		if got := getWriteOnlyString(d, attribute); got != "" {
+			t.Errorf("getWriteOnlyString(%s) = %q, want empty", attribute, got)
+		}
+	}
+}
+
+func TestAccountSecretsRotation(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "edge",
+		Attributes: map[string]string{
+			"id":                           "edge",
+			"account_name":                 "edge",
+			"cloud_type":                   "65536",
+			"edge_zededa_username":         "admin",
+			"edge_zededa_password_version": "1",
+		},
+	}
+	config := func(password string, version int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"account_name":                 "edge",
+			"cloud_type":                   65536,
+			"edge_zededa_username":         "admin",
+			"edge_zededa_password":         password,
+			"edge_zededa_password_version": version,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		config     *terraform.ResourceConfig
+		wantUpdate bool
+	}{
+		// Only a changed version sends the password to the controller
+		{"unchanged", config("password", 1), false},
+		{"rotated without its version", config("rotated", 1), false},
+		{"rotated with its version", config("rotated", 2), true},
+	}
+	for _, tt := range tests {
+		diff, err := resourceAviatrixAccount().SimpleDiff(context.Background(), state, tt.config, nil)
+		if err != nil {
+			t.Fatalf("%s: SimpleDiff() error = %v", tt.name, err)
+		}
+		if attr := diff.Attributes["edge_zededa_password"]; attr != nil {
+			t.Errorf("%s: edge_zededa_password diff = %#v, want no diff", tt.name, attr)
+		}
+		if update := diff.Attributes["edge_zededa_password_version"] != nil; update != tt.wantUpdate {
+			t.Errorf("%s: password update planned = %t, want %t", tt.name, update, tt.wantUpdate)
+		}
+	}
+}
+
+func TestAccountSecretsDroppedOnRead(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetAccountFunc: func(account *goaviatrix.Account) (*goaviatrix.Account, error) {
+			return &goaviatrix.Account{
+				AccountName:     "edge",
+				CloudType:       goaviatrix.EDGECSP,
+				EdgeCSPUsername: "admin",
+			}, nil
+		},
+	}
+
+	// Earlier provider versions stored the secrets or their hash
+	d := schema.TestResourceDataRaw(t, resourceAviatrixAccount().Schema, map[string]interface{}{
+		"account_name":         "edge",
+		"edge_zededa_username": "admin",
+	})
+	d.SetId("edge")
+	for _, attribute := range accountSecrets {
+		if err := d.Set(attribute, "sha256:0123"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if diags := resourceAviatrixAccountRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read error = %v", diags)
+	}
+	for _, attribute := range accountSecrets {
+		if got := d.Get(attribute).(string); got != "" {
+			t.Errorf("%s = %q after read, want empty", attribute, got)
+		}
+	}
+	if got := d.Get("edge_zededa_username").(string); got != "admin" {
+		t.Errorf("edge_zededa_username = %q, want %q", got, "admin")
+	}
+	if got := d.Get("edge_csp_username").(string); got != "" {
+		t.Errorf("edge_csp_username = %q, want empty", got)
+	}
+}
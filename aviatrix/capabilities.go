@@ -0,0 +1,111 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// controllerVersionDiagnostics checks the controller version against supportedVersions. Controllers
+// older than every supported version are rejected. Newer controllers are only warned about, as the
+// features that depend on the controller version are checked per attribute at plan time.
+func controllerVersionDiagnostics(ctx context.Context, client goaviatrix.ControllerClient, supportedVersions []string) diag.Diagnostics {
+	capabilities, err := client.Capabilities(ctx)
+	if err != nil {
+		return diag.Errorf("controller version validation failed: %s", err)
+	}
+	current := fmt.Sprintf("%d.%d", capabilities.Version.Major, capabilities.Version.Minor)
+
+	older := true
+	for _, version := range supportedVersions {
+		cmp, err := goaviatrix.CompareSoftwareVersions(current, version)
+		if err != nil {
+			return diag.Errorf("controller version validation failed: %s", err)
+		}
+		if cmp == 0 {
+			return nil
+		}
+		if cmp > 0 {
+			older = false
+		}
+	}
+
+	if older {
+		return diag.Errorf("controller version validation failed: current Terraform branch does not support controller version: UserConnect-%s. "+
+			"Please go to 'https://registry.terraform.io/providers/AviatrixSystems/aviatrix/latest/docs/guides/release-compatibility' for version construct instructions", current)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Controller version %s is newer than the versions supported by this provider", current),
+		Detail: "Features added to the controller after this provider release cannot be managed. " +
+			"Please go to 'https://registry.terraform.io/providers/AviatrixSystems/aviatrix/latest/docs/guides/release-compatibility' to find a provider release supporting it.",
+	}}
+}
+
+// requireControllerFeatures returns a CustomizeDiffFunc that fails the plan when an attribute is
+// set in the configuration but the controller does not support the feature it requires. The
+// controller version is only requested when one of the attributes is set.
+func requireControllerFeatures(attributes map[string]goaviatrix.Feature) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(goaviatrix.ControllerClient)
+		if !ok {
+			return nil
+		}
+
+		var set []string
+		for attribute := range attributes {
+			if attributeConfigured(d, attribute) && (d.Id() == "" || d.HasChange(attribute)) {
+				set = append(set, attribute)
+			}
+		}
+		if len(set) == 0 {
+			return nil
+		}
+		sort.Strings(set)
+
+		capabilities, err := client.Capabilities(ctx)
+		if err != nil {
+			return err
+		}
+		for _, attribute := range set {
+			if err := capabilities.Require(attributes[attribute], attribute); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// attributeConfigured reports whether the top level attribute is set in the configuration, as
+// opposed to being unset or set by its default.
+func attributeConfigured(d *schema.ResourceDiff, attribute string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(attribute) {
+		return false
+	}
+	return !config.GetAttr(attribute).IsNull()
+}
+
+// requireControllerFeature returns a CustomizeDiffFunc that fails the plan of a new resource when
+// the controller does not support the feature the resource requires.
+func requireControllerFeature(resource string, feature goaviatrix.Feature) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" {
+			return nil
+		}
+		client, ok := meta.(goaviatrix.ControllerClient)
+		if !ok {
+			return nil
+		}
+
+		capabilities, err := client.Capabilities(ctx)
+		if err != nil {
+			return err
+		}
+		return capabilities.Require(feature, resource)
+	}
+}
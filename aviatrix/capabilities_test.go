@@ -0,0 +1,97 @@
+package aviatrix
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+// plannedConfig returns the empty prior state and the configuration of a new r, with the raw
+// configuration set in the prior state as Terraform's plan sets it, so that attributeConfigured
+// can tell the attributes that are set.
+func plannedConfig(t *testing.T, r *schema.Resource, config string) (*terraform.InstanceState, *terraform.ResourceConfig) {
+	t.Helper()
+	block := r.CoreConfigSchema()
+	val, err := ctyjson.Unmarshal([]byte(config), block.ImpliedType())
+	if err != nil {
+		t.Fatalf("invalid configuration: %v", err)
+	}
+	return &terraform.InstanceState{RawConfig: val}, terraform.NewResourceConfigShimmed(val, block)
+}
+
+func TestRequireControllerFeatures(t *testing.T) {
+	edgeConn := `{
+		"site_id": "site",
+		"connection_name": "conn",
+		"gw_name": "edge",
+		"bgp_local_as_num": "65001",
+		"bgp_remote_as_num": "65002",
+		"local_lan_ip": "10.230.3.23",
+		"remote_lan_ip": "10.230.3.100"
+	}`
+	tests := []struct {
+		name     string
+		version  string
+		resource *schema.Resource
+		config   string
+		wantErr  string
+	}{
+		{
+			"resource of a newer controller",
+			"UserConnect-7.1.1000",
+			resourceAviatrixEdgePlatform(),
+			`{"account_name": "edge", "gw_name": "edge", "site_id": "site"}`,
+			"aviatrix_edge_platform requires controller >= 7.1.1794, the controller version is 7.1.1000",
+		},
+		{
+			"resource of a supported controller",
+			"UserConnect-7.1.2131",
+			resourceAviatrixEdgePlatform(),
+			`{"account_name": "edge", "gw_name": "edge", "site_id": "site"}`,
+			"",
+		},
+		{
+			"attribute of a newer controller",
+			"UserConnect-7.1.1000",
+			resourceAviatrixEdgeSpokeExternalDeviceConn(),
+			edgeConn[:len(edgeConn)-1] + `, "manual_bgp_advertised_cidrs": ["10.0.0.0/16"]}`,
+			"manual_bgp_advertised_cidrs requires controller >= 7.1.1794, the controller version is 7.1.1000",
+		},
+		{
+			"attribute not set",
+			"UserConnect-7.1.1000",
+			resourceAviatrixEdgeSpokeExternalDeviceConn(),
+			edgeConn,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := fakecontroller.New(fakecontroller.WithVersion(tt.version))
+			defer server.Close()
+			client, err := server.NewClient()
+			if err != nil {
+				t.Fatalf("could not login to the fake controller: %v", err)
+			}
+
+			state, config := plannedConfig(t, tt.resource, tt.config)
+			_, err = tt.resource.Diff(context.Background(), state, config, client)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("plan error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("plan error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
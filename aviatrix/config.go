@@ -1,14 +1,16 @@
 package aviatrix
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
+	"sync"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Config contains the configuration for the Aviatrix provider
@@ -19,7 +21,54 @@ type Config struct {
 	ControllerIP string
 	VerifyCert   bool
 	PathToCACert string
+	// CACertPEM is a PEM encoded CA certificate trusted in addition to PathToCACert
+	CACertPEM string
+	// PathToClientCert and PathToClientKey are the PEM encoded client certificate and key for mutual TLS
+	PathToClientCert string
+	PathToClientKey  string
+	// TLSMinVersion is the minimum TLS version, e.g. "1.2". Default: the Go default.
+	TLSMinVersion string
+	// TLSServerName overrides the server name used to verify the controller certificate
+	TLSServerName string
+
 	IgnoreTags   *goaviatrix.IgnoreTagsConfig
+	DefaultTags  *goaviatrix.DefaultTagsConfig
+	RetryPolicy  *goaviatrix.RetryPolicy
+	SessionCache *goaviatrix.SessionCache
+	// ReadOnly rejects the requests that may change the controller
+	ReadOnly bool
+
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+
+	WireLog *WireLogConfig
+}
+
+// WireLogConfig contains the settings of the redacting wire logger
+type WireLogConfig struct {
+	TranscriptPath string
+	RedactKeys     []string
+}
+
+var (
+	wireLoggersMu sync.Mutex
+	// wireLoggers are the wire loggers with a transcript of the configured provider instances
+	wireLoggers []*goaviatrix.WireLogger
+)
+
+// CloseWireLogs closes the wire log transcripts opened by the configured provider instances. It is
+// called when the provider plugin shuts down.
+func CloseWireLogs() error {
+	wireLoggersMu.Lock()
+	defer wireLoggersMu.Unlock()
+	var firstErr error
+	for _, w := range wireLoggers {
+		if err := w.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	wireLoggers = nil
+	return firstErr
 }
 
 // Client gets the Aviatrix client to access the Controller
@@ -32,29 +81,113 @@ type Config struct {
 //	the aviatrix client (from goaviatrix)
 //	error (if any)
 func (c *Config) Client() (*goaviatrix.Client, error) {
+	return c.ClientContext(context.Background())
+}
+
+// ClientContext gets the Aviatrix client to access the Controller. The client logs with the
+// provider logger carried by ctx.
+func (c *Config) ClientContext(ctx context.Context) (*goaviatrix.Client, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
 	tr := &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: !c.VerifyCert,
-		},
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
 	}
 
-	if c.VerifyCert && c.PathToCACert != "" {
-		caCert, err := ioutil.ReadFile(c.PathToCACert)
+	var transport http.RoundTripper = tr
+	if c.WireLog != nil {
+		wireLogger, err := goaviatrix.NewWireLogger(tr, c.WireLog.TranscriptPath, c.WireLog.RedactKeys)
 		if err != nil {
-			return nil, fmt.Errorf(err.Error())
+			return nil, fmt.Errorf("could not open wire log transcript: %v", err)
 		}
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
-		tr.TLSClientConfig.RootCAs = caCertPool
+		if c.WireLog.TranscriptPath != "" {
+			wireLoggersMu.Lock()
+			wireLoggers = append(wireLoggers, wireLogger)
+			wireLoggersMu.Unlock()
+		}
+		transport = wireLogger
 	}
 
-	client, err := goaviatrix.NewClient(c.Username, c.Password, c.ControllerIP, &http.Client{Transport: tr}, c.IgnoreTags)
+	opts := []goaviatrix.ClientOption{goaviatrix.WithLogContext(ctx)}
+	if c.RetryPolicy != nil {
+		opts = append(opts, goaviatrix.WithRetryPolicy(c.RetryPolicy))
+	}
+	if c.MaxConcurrentRequests > 0 || c.RequestsPerSecond > 0 {
+		opts = append(opts, goaviatrix.WithRateLimit(c.MaxConcurrentRequests, c.RequestsPerSecond))
+	}
+	if c.SessionCache != nil {
+		opts = append(opts, goaviatrix.WithSessionCache(c.SessionCache))
+	}
+	if c.ReadOnly {
+		opts = append(opts, goaviatrix.WithReadOnly())
+	}
+	if c.DefaultTags != nil {
+		opts = append(opts, goaviatrix.WithDefaultTags(c.DefaultTags))
+	}
 
-	log.Printf("[INFO] Aviatrix Client configured for use")
+	client, err := goaviatrix.NewClient(c.Username, c.Password, c.ControllerIP, &http.Client{Transport: transport}, c.IgnoreTags, opts...)
 
 	if client == nil || err != nil {
-		log.Printf("[ERROR] unable to create client: %s", err)
+		tflog.Error(ctx, "Unable to create Aviatrix client", map[string]interface{}{"error": fmt.Sprint(err)})
+	} else {
+		tflog.Info(ctx, "Aviatrix client configured for use")
 	}
 	return client, err
 }
+
+// tlsMinVersions are the supported values of TLSMinVersion
+var tlsMinVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsConfig returns the TLS settings of the connections to the controller. The CA certificates
+// are only used when the controller certificate is verified.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: !c.VerifyCert,
+		ServerName:         c.TLSServerName,
+	}
+
+	if c.TLSMinVersion != "" {
+		version, ok := tlsMinVersions[c.TLSMinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported minimum TLS version %q", c.TLSMinVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if c.VerifyCert && (c.PathToCACert != "" || c.CACertPEM != "") {
+		caCertPool := x509.NewCertPool()
+		if c.PathToCACert != "" {
+			caCert, err := ioutil.ReadFile(c.PathToCACert)
+			if err != nil {
+				return nil, fmt.Errorf("could not read the CA certificate: %w", err)
+			}
+			if !caCertPool.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no PEM encoded certificate found in %s", c.PathToCACert)
+			}
+		}
+		if c.CACertPEM != "" && !caCertPool.AppendCertsFromPEM([]byte(c.CACertPEM)) {
+			return nil, fmt.Errorf("no PEM encoded certificate found in ca_certificate_pem")
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	if c.PathToClientCert != "" || c.PathToClientKey != "" {
+		if c.PathToClientCert == "" || c.PathToClientKey == "" {
+			return nil, fmt.Errorf("both the client certificate and the client key must be set for mutual TLS")
+		}
+		cert, err := tls.LoadX509KeyPair(c.PathToClientCert, c.PathToClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load the client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
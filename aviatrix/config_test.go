@@ -0,0 +1,155 @@
+package aviatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key to dir.
+func writeTestCertificate(t *testing.T, dir string) (certPath, keyPath string, certPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	certPath = filepath.Join(dir, "client.crt")
+	keyPath = filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certPath, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certPath, keyPath, certPEM
+}
+
+func TestConfigTLS(t *testing.T) {
+	certPath, keyPath, certPEM := writeTestCertificate(t, t.TempDir())
+
+	config := &Config{
+		VerifyCert:       true,
+		CACertPEM:        string(certPEM),
+		PathToClientCert: certPath,
+		PathToClientKey:  keyPath,
+		TLSMinVersion:    "1.3",
+		TLSServerName:    "controller.internal",
+	}
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		t.Fatalf("tlsConfig() error = %v", err)
+	}
+	if tlsConfig.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify = true, want false")
+	}
+	if tlsConfig.RootCAs == nil {
+		t.Error("RootCAs not set")
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Errorf("got %d client certificates, want 1", len(tlsConfig.Certificates))
+	}
+	if tlsConfig.MinVersion != tls.VersionTLS13 {
+		t.Errorf("MinVersion = %x, want %x", tlsConfig.MinVersion, tls.VersionTLS13)
+	}
+	if tlsConfig.ServerName != "controller.internal" {
+		t.Errorf("ServerName = %q, want %q", tlsConfig.ServerName, "controller.internal")
+	}
+	if diags := tlsDiagnostics(config); len(diags) != 0 {
+		t.Errorf("tlsDiagnostics() = %v, want no diagnostics", diags)
+	}
+
+	invalid := []*Config{
+		{VerifyCert: true, CACertPEM: "not a certificate"},
+		{PathToClientCert: certPath},
+		{PathToClientCert: certPath, PathToClientKey: certPath},
+		{TLSMinVersion: "2.0"},
+	}
+	for _, config := range invalid {
+		if _, err := config.tlsConfig(); err == nil {
+			t.Errorf("tlsConfig() with %+v succeeded", config)
+		}
+	}
+
+	if diags := tlsDiagnostics(&Config{}); len(diags) != 1 {
+		t.Errorf("tlsDiagnostics() without verification = %v, want a warning", diags)
+	}
+}
+
+func TestConfigTLSWithoutVerification(t *testing.T) {
+	certPath, keyPath, _ := writeTestCertificate(t, t.TempDir())
+
+	var serverName string
+	var peerCertificates int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		peerCertificates = len(r.TLS.PeerCertificates)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName = hello.ServerName
+			return nil, nil
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	config := &Config{
+		VerifyCert:       false,
+		PathToCACert:     filepath.Join(t.TempDir(), "missing.crt"),
+		PathToClientCert: certPath,
+		PathToClientKey:  keyPath,
+		TLSServerName:    "controller.internal",
+	}
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		t.Fatalf("tlsConfig() error = %v", err)
+	}
+	if !tlsConfig.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify = false, want true")
+	}
+	if tlsConfig.RootCAs != nil {
+		t.Error("RootCAs set without verification")
+	}
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request error = %v", err)
+	}
+	resp.Body.Close()
+	if serverName != "controller.internal" {
+		t.Errorf("server name sent = %q, want %q", serverName, "controller.internal")
+	}
+	if peerCertificates != 1 {
+		t.Errorf("got %d client certificates, want 1", peerCertificates)
+	}
+}
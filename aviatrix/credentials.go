@@ -0,0 +1,207 @@
+package aviatrix
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultCredentialsFile is the shared credentials file, relative to the home directory
+const defaultCredentialsFile = ".aviatrix/credentials"
+
+// credentials are the controller credentials of a provider, a profile or a credential process
+type credentials struct {
+	ControllerIP string `json:"controller_ip"`
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+
+	// CredentialProcess is only set in profiles
+	CredentialProcess string `json:"-"`
+}
+
+// merge sets the credentials that are not set yet from other.
+func (c *credentials) merge(other *credentials) {
+	if c.ControllerIP == "" {
+		c.ControllerIP = other.ControllerIP
+	}
+	if c.Username == "" {
+		c.Username = other.Username
+	}
+	if c.Password == "" {
+		c.Password = other.Password
+	}
+}
+
+func (c *credentials) complete() bool {
+	return c.ControllerIP != "" && c.Username != "" && c.Password != ""
+}
+
+// resolveProviderCredentials returns the controller credentials of the provider. Arguments set in
+// the provider block or the AVIATRIX_* environment variables take precedence, then the output of
+// credential_process, then the profile of the shared credentials file.
+func resolveProviderCredentials(ctx context.Context, d *schema.ResourceData) (*credentials, error) {
+	creds := &credentials{
+		ControllerIP: d.Get("controller_ip").(string),
+		Username:     d.Get("username").(string),
+		Password:     d.Get("password").(string),
+	}
+
+	var profile *credentials
+	if name := d.Get("profile").(string); name != "" {
+		path, err := credentialsFilePath(d.Get("shared_credentials_file").(string))
+		if err != nil {
+			return nil, err
+		}
+		if profile, err = loadCredentialsProfile(path, name); err != nil {
+			return nil, err
+		}
+	}
+
+	process := d.Get("credential_process").(string)
+	if process == "" && profile != nil {
+		process = profile.CredentialProcess
+	}
+	if process != "" && !creds.complete() {
+		output, err := runCredentialProcess(ctx, process)
+		if err != nil {
+			return nil, err
+		}
+		creds.merge(output)
+	}
+	if profile != nil {
+		creds.merge(profile)
+	}
+
+	var missing []string
+	if creds.ControllerIP == "" {
+		missing = append(missing, "controller_ip")
+	}
+	if creds.Username == "" {
+		missing = append(missing, "username")
+	}
+	if creds.Password == "" {
+		missing = append(missing, "password")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%s must be set in the provider block, with the AVIATRIX_* environment variables, a credentials profile or credential_process", strings.Join(missing, ", "))
+	}
+	return creds, nil
+}
+
+// credentialsFilePath returns the path of the shared credentials file, ~/.aviatrix/credentials by default.
+func credentialsFilePath(path string) (string, error) {
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("could not find the shared credentials file: %v", err)
+		}
+		return filepath.Join(home, defaultCredentialsFile), nil
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("could not expand %s: %v", path, err)
+		}
+		return filepath.Join(home, path[1:]), nil
+	}
+	return path, nil
+}
+
+// loadCredentialsProfile reads the profile name from the shared credentials file at path. The file
+// has one section per profile:
+//
+//	[prod]
+//	controller_ip = 1.2.3.4
+//	username      = admin
+//	password      = password
+//
+//	[dev]
+//	controller_ip      = 5.6.7.8
+//	credential_process = vault-aviatrix-credentials dev
+func loadCredentialsProfile(path, name string) (*credentials, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the shared credentials file: %v", err)
+	}
+	defer f.Close()
+
+	var profile *credentials
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == name {
+				profile = &credentials{}
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid line %d in the shared credentials file %s", lineNumber, path)
+		}
+		if section != name {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "controller_ip":
+			profile.ControllerIP = value
+		case "username":
+			profile.Username = value
+		case "password":
+			profile.Password = value
+		case "credential_process":
+			profile.CredentialProcess = value
+		default:
+			return nil, fmt.Errorf("unknown key %q in profile %q of the shared credentials file %s", strings.TrimSpace(key), name, path)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read the shared credentials file: %v", err)
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("profile %q not found in the shared credentials file %s", name, path)
+	}
+	return profile, nil
+}
+
+// runCredentialProcess runs command with the shell and decodes the credentials it writes to
+// stdout as a JSON object with the controller_ip, username and password keys.
+func runCredentialProcess(ctx context.Context, command string) (*credentials, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	tflog.Debug(ctx, "Running credential process")
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential_process failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	creds := &credentials{}
+	if err := json.Unmarshal(stdout.Bytes(), creds); err != nil {
+		// The output is not included as it may contain the password
+		return nil, fmt.Errorf("could not decode the output of credential_process as JSON: %v", err)
+	}
+	return creds, nil
+}
@@ -0,0 +1,112 @@
+package aviatrix
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testCredentialsFile = `
+# Shared credentials
+[prod]
+controller_ip = 1.2.3.4
+username      = admin
+password      = prod-password
+
+[dev]
+controller_ip      = 5.6.7.8
+credential_process = echo '{"username":"process-user","password":"process-password"}'
+`
+
+func TestResolveProviderCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process tests use sh")
+	}
+	for _, env := range []string{"AVIATRIX_CONTROLLER_IP", "AVIATRIX_USERNAME", "AVIATRIX_PASSWORD", "AVIATRIX_PROFILE", "AVIATRIX_SHARED_CREDENTIALS_FILE"} {
+		t.Setenv(env, "")
+	}
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(testCredentialsFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		want    credentials
+		wantErr string
+	}{
+		{
+			"inline",
+			map[string]interface{}{"controller_ip": "9.9.9.9", "username": "user", "password": "password"},
+			credentials{ControllerIP: "9.9.9.9", Username: "user", Password: "password"},
+			"",
+		},
+		{
+			"profile",
+			map[string]interface{}{"profile": "prod", "shared_credentials_file": path},
+			credentials{ControllerIP: "1.2.3.4", Username: "admin", Password: "prod-password"},
+			"",
+		},
+		{
+			"inline overrides profile",
+			map[string]interface{}{"profile": "prod", "shared_credentials_file": path, "username": "other"},
+			credentials{ControllerIP: "1.2.3.4", Username: "other", Password: "prod-password"},
+			"",
+		},
+		{
+			"profile credential process",
+			map[string]interface{}{"profile": "dev", "shared_credentials_file": path},
+			credentials{ControllerIP: "5.6.7.8", Username: "process-user", Password: "process-password"},
+			"",
+		},
+		{
+			"credential process",
+			map[string]interface{}{"controller_ip": "9.9.9.9", "credential_process": `echo '{"username":"u","password":"p"}'`},
+			credentials{ControllerIP: "9.9.9.9", Username: "u", Password: "p"},
+			"",
+		},
+		{
+			"failed credential process",
+			map[string]interface{}{"controller_ip": "9.9.9.9", "credential_process": "echo denied >&2; exit 1"},
+			credentials{},
+			"denied",
+		},
+		{
+			"unknown profile",
+			map[string]interface{}{"profile": "missing", "shared_credentials_file": path},
+			credentials{},
+			`profile "missing" not found`,
+		},
+		{
+			"missing credentials",
+			map[string]interface{}{"controller_ip": "9.9.9.9"},
+			credentials{},
+			"username, password must be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+			got, err := resolveProviderCredentials(context.Background(), d)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveProviderCredentials() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveProviderCredentials() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("resolveProviderCredentials() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
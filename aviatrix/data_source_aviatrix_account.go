@@ -1,16 +1,16 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixAccountRead,
+		ReadContext: dataSourceAviatrixAccountRead,
 
 		Schema: map[string]*schema.Schema{
 			"account_name": {
@@ -192,14 +192,14 @@ func dataSourceAviatrixAccount() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	account := &goaviatrix.Account{
 		AccountName: d.Get("account_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account: %#v", account)
+	logInfo(ctx, "Looking for Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
 	acc, err := client.GetAccount(account)
 	if err != nil {
@@ -207,7 +207,7 @@ func dataSourceAviatrixAccountRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("aviatrix Account: %s", err)
+		return diag.Errorf("aviatrix Account: %s", err)
 	}
 
 	d.Set("account_name", acc.AccountName)
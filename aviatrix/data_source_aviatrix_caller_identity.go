@@ -1,16 +1,17 @@
 package aviatrix
 
 import (
-	"log"
+	"context"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixCallerIdentity() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixCallerIdentityRead,
+		ReadContext: dataSourceAviatrixCallerIdentityRead,
 
 		Schema: map[string]*schema.Schema{
 			"cid": {
@@ -22,12 +23,12 @@ func dataSourceAviatrixCallerIdentity() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixCallerIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ControllerClient)
 
-	log.Printf("[DEBUG] CID is '%s'", client.CID)
+	logDebug(ctx, "Reading caller identity")
 
 	d.SetId(time.Now().UTC().String())
-	d.Set("cid", client.CID)
+	d.Set("cid", client.CurrentCID())
 	return nil
 }
@@ -90,7 +90,7 @@ func dataSourceAviatrixFireNetFirewallManager() *schema.Resource {
 }
 
 func dataSourceAviatrixFireNetFirewallManagerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewallManager := &goaviatrix.FirewallManager{
 		VpcID:         d.Get("vpc_id").(string),
@@ -112,7 +112,7 @@ func dataSourceAviatrixFireNetFirewallManagerRead(ctx context.Context, d *schema
 
 	if firewallManager.VendorType == "Palo Alto Networks Panorama" && (firewallManager.PublicIP == "" ||
 		firewallManager.Username == "" || firewallManager.Password == "" || firewallManager.Template == "" || firewallManager.TemplateStack == "") {
-		return diag.Errorf("'public_ip', 'username', 'password', 'template' and 'template_stack' are required for vendor type 'Palo Alto Networks Panorama'")
+		return attributeErrorf("public_ip", "'public_ip', 'username', 'password', 'template' and 'template_stack' are required for vendor type 'Palo Alto Networks Panorama'")
 	}
 
 	numberOfRetries := d.Get("number_of_retries").(int)
@@ -1,16 +1,17 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixFireNetVendorIntegration() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixFireNetVendorIntegrationRead,
+		ReadContext: dataSourceAviatrixFireNetVendorIntegrationRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -94,8 +95,8 @@ func dataSourceAviatrixFireNetVendorIntegration() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixFireNetVendorIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixFireNetVendorIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewallInstance := &goaviatrix.FirewallInstance{
 		InstanceID: d.Get("instance_id").(string),
@@ -107,7 +108,7 @@ func dataSourceAviatrixFireNetVendorIntegrationRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Firewall Instance: %s", err)
+		return diag.Errorf("couldn't find Firewall Instance: %s", err)
 	}
 	if fI != nil {
 		if goaviatrix.VendorToCloudType(fI.CloudVendor) == goaviatrix.GCP {
@@ -138,7 +139,7 @@ func dataSourceAviatrixFireNetVendorIntegrationRead(d *schema.ResourceData, meta
 	}
 
 	if vendorInfo.Save && vendorInfo.Synchronize {
-		return fmt.Errorf("can't do 'save' and 'synchronize' at the same time for vendor integration")
+		return diag.Errorf("can't do 'save' and 'synchronize' at the same time for vendor integration")
 	}
 
 	numberOfRetries := d.Get("number_of_retries").(int)
@@ -147,27 +148,27 @@ func dataSourceAviatrixFireNetVendorIntegrationRead(d *schema.ResourceData, meta
 	if vendorInfo.Save {
 		if vendorInfo.VendorType == "Fortinet FortiGate" {
 			if vendorInfo.ApiToken == "" {
-				return fmt.Errorf("'api_token' is required for vendor type 'Fortinet FortiGate'")
+				return attributeErrorf("api_token", "'api_token' is required for vendor type 'Fortinet FortiGate'")
 			}
 		} else if vendorInfo.VendorType == "Check Point Cloud Guard" {
 			if vendorInfo.PrivateKeyFile != "" {
 				if vendorInfo.Password != "" {
-					return fmt.Errorf("'password' should be empty when using 'private_key_file' for vendor type 'Check Point Cloud Guard'")
+					return attributeErrorf("password", "'password' should be empty when using 'private_key_file' for vendor type 'Check Point Cloud Guard'")
 				}
 			} else {
 				if vendorInfo.Username == "" || vendorInfo.Password == "" {
-					return fmt.Errorf("'username' and 'password' are required when not using 'private_key_file' for vendor type 'Check Point Cloud Guard'")
+					return attributeErrorf("username", "'username' and 'password' are required when not using 'private_key_file' for vendor type 'Check Point Cloud Guard'")
 				}
 			}
 		} else {
 			if vendorInfo.Username == "" || vendorInfo.Password == "" {
-				return fmt.Errorf("'username' and 'password' are required for vendor type 'Generic', 'Palo Alto Networks VM-Series', 'Palo Alto Networks Panorama' and 'Aviatrix FQDN Gateway'")
+				return attributeErrorf("username", "'username' and 'password' are required for vendor type 'Generic', 'Palo Alto Networks VM-Series', 'Palo Alto Networks Panorama' and 'Aviatrix FQDN Gateway'")
 			}
 			if vendorInfo.ApiToken != "" {
-				return fmt.Errorf("'api_token' is valid only for vendor type 'Fortinet FortiGate'")
+				return attributeErrorf("api_token", "'api_token' is valid only for vendor type 'Fortinet FortiGate'")
 			}
 			if vendorInfo.PrivateKeyFile != "" {
-				return fmt.Errorf("'private_key_file' is valid only for vendor type 'Check Point Cloud Guard'")
+				return attributeErrorf("private_key_file", "'private_key_file' is valid only for vendor type 'Check Point Cloud Guard'")
 			}
 		}
 
@@ -185,7 +186,7 @@ func dataSourceAviatrixFireNetVendorIntegrationRead(d *schema.ResourceData, meta
 				time.Sleep(time.Duration(retryInterval) * time.Second)
 			} else {
 				d.SetId("")
-				return fmt.Errorf("failed to 'save' FireNet Firewall Vendor Info: %s", err)
+				return diag.Errorf("failed to 'save' FireNet Firewall Vendor Info: %s", err)
 			}
 		}
 	}
@@ -201,7 +202,7 @@ func dataSourceAviatrixFireNetVendorIntegrationRead(d *schema.ResourceData, meta
 				time.Sleep(time.Duration(retryInterval) * time.Second)
 			} else {
 				d.SetId("")
-				return fmt.Errorf("failed to 'synchronize' FireNet Firewall Vendor Info: %s", err)
+				return diag.Errorf("failed to 'synchronize' FireNet Firewall Vendor Info: %s", err)
 			}
 		}
 	}
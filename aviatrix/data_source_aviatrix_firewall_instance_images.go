@@ -1,16 +1,17 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"sort"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixFirewallInstanceImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixFirewallInstanceImagesRead,
+		ReadContext: dataSourceAviatrixFirewallInstanceImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -48,14 +49,14 @@ func dataSourceAviatrixFirewallInstanceImages() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixFirewallInstanceImagesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixFirewallInstanceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	vpcId := d.Get("vpc_id").(string)
 
 	firewallInstanceImages, err := client.GetFirewallInstanceImages(vpcId)
 	if err != nil {
-		return fmt.Errorf("couldn't get firewall instance images: %s", err)
+		return diag.Errorf("couldn't get firewall instance images: %s", err)
 	}
 
 	var images []map[string]interface{}
@@ -64,7 +65,7 @@ func dataSourceAviatrixFirewallInstanceImagesRead(d *schema.ResourceData, meta i
 		fI["firewall_image"] = image.Image
 		versionList := image.Version
 		sort.Slice(versionList, func(i, j int) bool {
-			return sortVersion(versionList, i, j, image.Image)
+			return sortVersion(ctx, versionList, i, j, image.Image)
 		})
 		fI["firewall_image_version"] = versionList
 		sizeList := image.Size
@@ -76,7 +77,7 @@ func dataSourceAviatrixFirewallInstanceImagesRead(d *schema.ResourceData, meta i
 	}
 
 	if err = d.Set("firewall_images", images); err != nil {
-		return fmt.Errorf("couldn't set firewall_images: %s", err)
+		return diag.Errorf("couldn't set firewall_images: %s", err)
 	}
 
 	d.SetId(vpcId)
@@ -1,18 +1,19 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixGatewayRead,
+		ReadContext: dataSourceAviatrixGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -434,8 +435,9 @@ func dataSourceAviatrixGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	gateway := &goaviatrix.Gateway{
 		GwName: d.Get("gw_name").(string),
@@ -451,7 +453,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Gateway: %s", err)
 	}
 	if gw != nil {
 		d.Set("cloud_type", gw.CloudType)
@@ -514,7 +516,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 		gwDetail, err := client.GetGatewayDetail(gateway)
 		if err != nil {
-			return fmt.Errorf("couldn't get Detail info for VPN gateway: %s due to: %s", gateway.GwName, err)
+			return diag.Errorf("couldn't get Detail info for VPN gateway: %s due to: %s", gateway.GwName, err)
 		}
 		if gw.VpnStatus != "" {
 			if gw.VpnStatus == "disabled" {
@@ -651,7 +653,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		} else {
 			d.Set("enable_public_subnet_filtering", true)
 			if err := d.Set("public_subnet_filtering_route_tables", gw.PsfDetails.RouteTableList); err != nil {
-				return fmt.Errorf("could not set public_subnet_filtering_route_tables into state: %v", err)
+				return diag.Errorf("could not set public_subnet_filtering_route_tables into state: %v", err)
 			}
 			d.Set("public_subnet_filtering_guard_duty_enforced", gw.PsfDetails.GuardDutyEnforced == "yes")
 			d.Set("subnet", gw.PsfDetails.GwSubnetCidr)
@@ -659,11 +661,11 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			if gw.HaGw.GwSize == "" {
 				err := d.Set("public_subnet_filtering_ha_route_tables", nil)
 				if err != nil {
-					return fmt.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
+					return diag.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
 				}
 			} else {
 				if err := d.Set("public_subnet_filtering_ha_route_tables", gw.PsfDetails.HaRouteTableList); err != nil {
-					return fmt.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
+					return diag.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
 				}
 				d.Set("peering_ha_subnet", gw.PsfDetails.HaGwSubnetCidr)
 				d.Set("peering_ha_zone", gw.PsfDetails.HaGwSubnetAz)
@@ -689,7 +691,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 				if len(azureEip) == 3 {
 					d.Set("peering_ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName)
+					diags = append(diags, attributeWarning("peering_ha_azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName), nil))
 				}
 			}
 			if !gw.IsPsfGateway {
@@ -731,11 +733,11 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 			_, err := client.GetTags(tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for gateway %s: %v", tags.ResourceName, err)
+				diags = append(diags, attributeWarning("tags", fmt.Sprintf("could not get tags for gateway %s", tags.ResourceName), err))
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for gateway %s: %v", tags.ResourceName, err)
+					diags = append(diags, attributeWarning("tags", "could not set tags into state", err))
 				}
 			}
 		}
@@ -766,13 +768,13 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 
 		if gw.IdleTimeout != "NA" {
 			idleTimeout, err := strconv.Atoi(gw.IdleTimeout)
 			if err != nil {
-				return fmt.Errorf("couldn't get idle timeout for the gateway %s: %v", gw.GwName, err)
+				return diag.Errorf("couldn't get idle timeout for the gateway %s: %v", gw.GwName, err)
 			}
 			d.Set("idle_timeout", idleTimeout)
 		} else {
@@ -782,7 +784,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		if gw.RenegotiationInterval != "NA" {
 			renegotiationInterval, err := strconv.Atoi(gw.RenegotiationInterval)
 			if err != nil {
-				return fmt.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gw.GwName, err)
+				return diag.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gw.GwName, err)
 			}
 			d.Set("renegotiation_interval", renegotiationInterval)
 		} else {
@@ -812,11 +814,11 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Gateway %s", gw.GwName)
+				diags = append(diags, attributeWarning("azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Gateway %s", gw.GwName), nil))
 			}
 		}
 	}
 
 	d.SetId(gateway.GwName)
-	return nil
+	return diags
 }
@@ -34,7 +34,7 @@ func dataSourceAviatrixGatewayImage() *schema.Resource {
 }
 
 func dataSourceAviatrixGatewayImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	cloudType := d.Get("cloud_type").(int)
 	softwareVersion := d.Get("software_version").(string)
@@ -89,7 +89,7 @@ func dataSourceAviatrixNetworkDomains() *schema.Resource {
 }
 
 func dataSourceAviatrixNetworkDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	domainList, err := client.GetAllNetworkDomains(ctx)
 	if err != nil {
@@ -117,6 +117,6 @@ func dataSourceAviatrixNetworkDomainsRead(ctx context.Context, d *schema.Resourc
 	if err = d.Set("network_domains", result); err != nil {
 		return diag.Errorf("couldn't set network_domains: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
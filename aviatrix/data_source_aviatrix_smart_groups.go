@@ -112,7 +112,7 @@ func dataSourceAviatrixSmartGroups() *schema.Resource {
 }
 
 func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	smartGroups, err := client.GetSmartGroups(ctx)
 	if err != nil {
@@ -159,6 +159,6 @@ func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("couldn't set smart_groups: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
@@ -1,17 +1,18 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixSpokeGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixSpokeGatewayRead,
+		ReadContext: dataSourceAviatrixSpokeGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -387,8 +388,9 @@ func dataSourceAviatrixSpokeGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixSpokeGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	gateway := &goaviatrix.Gateway{
 		GwName: d.Get("gw_name").(string),
@@ -404,7 +406,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix spoke gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix spoke gateway: %s", err)
 	}
 	if gw != nil {
 		d.Set("cloud_type", gw.CloudType)
@@ -560,7 +562,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 				if len(azureEip) == 3 {
 					d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+					diags = append(diags, attributeWarning("ha_azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName), nil))
 				}
 			}
 		}
@@ -574,11 +576,11 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 
 			_, err := client.GetTags(tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for spoke gateway %s: %v", tags.ResourceName, err)
+				diags = append(diags, attributeWarning("tags", fmt.Sprintf("could not get tags for spoke gateway %s", tags.ResourceName), err))
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for spoke gateway %s: %v", tags.ResourceName, err)
+					diags = append(diags, attributeWarning("tags", "could not set tags into state", err))
 				}
 			}
 		}
@@ -594,11 +596,11 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 		if gw.EnableLearnedCidrsApproval {
 			spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: gw.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get advanced config for spoke gateway: %v", err)
+				return diag.Errorf("could not get advanced config for spoke gateway: %v", err)
 			}
 
 			if err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-				return fmt.Errorf("could not set approved_learned_cidrs into state: %v", err)
+				return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 			}
 		} else {
 			d.Set("approved_learned_cidrs", nil)
@@ -615,12 +617,12 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 		}
 		err = d.Set("prepend_as_path", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 
 		if gw.EnableBgp {
@@ -643,11 +645,11 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName)
+				diags = append(diags, attributeWarning("azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName), nil))
 			}
 		}
 	}
 
 	d.SetId(gateway.GwName)
-	return nil
+	return diags
 }
@@ -1,15 +1,16 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixSpokeGatewayInspectionSubnets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixSpokeGatewayInspectionSubnetsRead,
+		ReadContext: dataSourceAviatrixSpokeGatewayInspectionSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -29,13 +30,13 @@ func dataSourceAviatrixSpokeGatewayInspectionSubnets() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixSpokeGatewayInspectionSubnetsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixSpokeGatewayInspectionSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SpokeClient)
 
 	gwName := d.Get("gw_name").(string)
 	subnetsForInspection, err := client.GetSubnetsForInspection(gwName)
 	if err != nil {
-		return fmt.Errorf("couldn't get subnets for inspection for gateway %s: %s", gwName, err)
+		return diag.Errorf("couldn't get subnets for inspection for gateway %s: %s", gwName, err)
 	}
 	d.Set("subnets_for_inspection", subnetsForInspection)
 
@@ -307,7 +307,7 @@ func dataSourceAviatrixSpokeGateways() *schema.Resource {
 }
 
 func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.GatewayClient)
 
 	SpokeGatewayList, err := client.GetSpokeGatewayList(ctx)
 	if err != nil {
@@ -487,7 +487,7 @@ func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.Resource
 	if err = d.Set("gateway_list", result); err != nil {
 		return diag.Errorf("couldn't set gateway_list: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 
 }
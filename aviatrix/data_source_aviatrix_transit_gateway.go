@@ -1,18 +1,19 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixTransitGatewayRead,
+		ReadContext: dataSourceAviatrixTransitGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -504,8 +505,9 @@ func dataSourceAviatrixTransitGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	gateway := &goaviatrix.Gateway{
 		GwName: d.Get("gw_name").(string),
@@ -517,7 +519,7 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
 	}
 	if gw != nil {
 		d.Set("cloud_type", gw.CloudType)
@@ -630,7 +632,7 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 
 		gwDetail, err := client.GetGatewayDetail(gw)
 		if err != nil {
-			return fmt.Errorf("couldn't get Aviatrix Transit Gateway: %s", err)
+			return diag.Errorf("couldn't get Aviatrix Transit Gateway: %s", err)
 		}
 
 		d.Set("enable_firenet", gwDetail.EnableFireNet)
@@ -675,11 +677,11 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 
 			_, err := client.GetTags(tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for transit gateway %s: %v", tags.ResourceName, err)
+				diags = append(diags, attributeWarning("tags", fmt.Sprintf("could not get tags for transit gateway %s", tags.ResourceName), err))
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for transit gateway %s: %v", tags.ResourceName, err)
+					diags = append(diags, attributeWarning("tags", "could not set tags into state", err))
 				}
 			}
 		}
@@ -732,13 +734,13 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 				if len(azureEip) == 3 {
 					d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+					diags = append(diags, attributeWarning("ha_azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName), nil))
 				}
 			}
 
 			lanCidr, err := client.GetTransitGatewayLanCidr(gw.HaGw.GwName)
 			if err != nil && err != goaviatrix.ErrNotFound {
-				log.Printf("[WARN] Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err)
+				diags = append(diags, attributeWarning("ha_lan_interface_cidr", fmt.Sprintf("could not get lan cidr for HA transit gateway %s", gw.HaGw.GwName), err))
 			}
 			d.Set("ha_lan_interface_cidr", lanCidr)
 		}
@@ -765,11 +767,11 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 		if gw.EnableLearnedCidrsApproval {
 			transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: gw.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+				return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 			}
 
 			if err = d.Set("approved_learned_cidrs", transitAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-				return fmt.Errorf("could not set approved_learned_cidrs into state: %v", err)
+				return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 			}
 		} else {
 			d.Set("approved_learned_cidrs", nil)
@@ -783,12 +785,12 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 		}
 		err = d.Set("prepend_as_path", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 
 		d.Set("enable_bgp_over_lan", goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes) && gw.EnableBgpOverLan)
@@ -802,7 +804,7 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 					interfaces = append(interfaces, interfaceDict)
 				}
 				if err = d.Set("bgp_lan_interfaces", interfaces); err != nil {
-					return fmt.Errorf("could not set bgp_lan_interfaces into state: %v", err)
+					return diag.Errorf("could not set bgp_lan_interfaces into state: %v", err)
 				}
 			}
 
@@ -815,20 +817,20 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 					haInterfaces = append(haInterfaces, interfaceDict)
 				}
 				if err = d.Set("ha_bgp_lan_interfaces", haInterfaces); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
+					return diag.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
 				}
 			}
 
 			bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
 			}
 			if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.BgpLanIpList); err != nil {
-				return fmt.Errorf("could not set bgp_lan_ip_list into state: %v", err)
+				return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 			}
 			if len(bgpLanIpInfo.HaBgpLanIpList) != 0 {
 				if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.HaBgpLanIpList); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_ip_list into tate: %v", err)
+					return diag.Errorf("could not set ha_bgp_lan_ip_list into tate: %v", err)
 				}
 			} else {
 				d.Set("ha_bgp_lan_ip_list", nil)
@@ -836,14 +838,14 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 		} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && gw.EnableBgpOverLan {
 			bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
 			}
 			if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-				return fmt.Errorf("could not set bgp_lan_ip_list into state: %v", err)
+				return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 			}
 			if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 				if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
+					return diag.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
 				}
 			} else {
 				d.Set("ha_bgp_lan_ip_list", nil)
@@ -863,17 +865,17 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				diags = append(diags, attributeWarning("azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName), nil))
 			}
 		}
 
 		lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
 		if err != nil && err != goaviatrix.ErrNotFound {
-			log.Printf("[WARN] Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err)
+			diags = append(diags, attributeWarning("lan_interface_cidr", fmt.Sprintf("could not get lan cidr for transit gateway %s", gw.GwName), err))
 		}
 		d.Set("lan_interface_cidr", lanCidr)
 	}
 
 	d.SetId(gateway.GwName)
-	return nil
+	return diags
 }
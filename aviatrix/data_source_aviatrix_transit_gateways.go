@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -365,7 +364,7 @@ func dataSourceAviatrixTransitGateways() *schema.Resource {
 }
 
 func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.GatewayClient)
 
 	TransitGatewayList, err := client.GetTransitGatewayList(ctx)
 	if err != nil {
@@ -544,7 +543,7 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 			if len(azureEip) == 3 {
 				transitGateway["azure_eip_name_resource_group"] = fmt.Sprintf("%s:%s", azureEip[0], azureEip[1])
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				logWarn(ctx, "Could not get Azure EIP name and resource group for the transit gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 
@@ -554,7 +553,7 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 	if err = d.Set("gateway_list", result); err != nil {
 		return diag.Errorf("couldn't set gateway_list: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 
 }
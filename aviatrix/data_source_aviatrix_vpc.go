@@ -1,10 +1,10 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -13,7 +13,7 @@ import (
 
 func dataSourceAviatrixVpc() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixVpcRead,
+		ReadContext: dataSourceAviatrixVpcRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -183,8 +183,9 @@ func dataSourceAviatrixVpc() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	vpc := &goaviatrix.Vpc{
 		Name: d.Get("name").(string),
@@ -196,7 +197,7 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find VPC: %s", err)
+		return diag.Errorf("couldn't find VPC: %s", err)
 	}
 
 	d.Set("cloud_type", vC.CloudType)
@@ -236,7 +237,7 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		acc, err := client.GetAccount(account)
 		if err != nil {
 			if err != goaviatrix.ErrNotFound {
-				return fmt.Errorf("aviatrix Account: %s", err)
+				return diag.Errorf("aviatrix Account: %s", err)
 			}
 		}
 
@@ -267,7 +268,7 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		subnetList = append(subnetList, sub)
 	}
 	if err := d.Set("subnets", subnetList); err != nil {
-		log.Printf("[WARN] Error setting subnets for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("subnets", "could not set subnets into state", err))
 	}
 
 	var privateSubnetList []map[string]string
@@ -289,10 +290,10 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		publicSubnetList = append(publicSubnetList, sub)
 	}
 	if err := d.Set("private_subnets", privateSubnetList); err != nil {
-		log.Printf("[WARN] Error setting 'private_subnets' for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("private_subnets", "could not set private_subnets into state", err))
 	}
 	if err := d.Set("public_subnets", publicSubnetList); err != nil {
-		log.Printf("[WARN] Error setting 'public_subnets' for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("public_subnets", "could not set public_subnets into state", err))
 	}
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
@@ -307,35 +308,35 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("could not get vpc route table ids: %v", err)
+			return diag.Errorf("could not get vpc route table ids: %v", err)
 		}
 
 		if err := d.Set("route_tables", rtbs); err != nil {
-			log.Printf("[WARN] Error setting route tables for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("route_tables", "could not set route_tables into state", err))
 		}
 	}
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		availabilityDomains, err := client.ListOciVpcAvailabilityDomains(vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI availability domains: %v", err)
+			return diag.Errorf("could not get OCI availability domains: %v", err)
 		}
 		d.Set("availability_domains", availabilityDomains)
 
 		faultDomains, err := client.ListOciVpcFaultDomains(vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI fault domains: %v", err)
+			return diag.Errorf("could not get OCI fault domains: %v", err)
 		}
 		d.Set("fault_domains", faultDomains)
 	}
 
 	d.SetId(vC.Name)
-	return nil
+	return diags
 }
 
 // To find all the private route tables we will remove the public route tables
 // from the list of all route tables.
-func getPrivateRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]string, error) {
+func getPrivateRouteTables(vpc *goaviatrix.Vpc, client goaviatrix.NetworkingClient) ([]string, error) {
 	all, err := getAllRouteTables(vpc, client)
 	if err != nil {
 		return nil, err
@@ -355,7 +356,7 @@ func getPrivateRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]st
 	return rtbs, nil
 }
 
-func getPublicRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]string, error) {
+func getPublicRouteTables(vpc *goaviatrix.Vpc, client goaviatrix.NetworkingClient) ([]string, error) {
 	vpc.PublicRoutesOnly = true
 	rtbs, err := client.GetVpcRouteTableIDs(vpc)
 	if err != nil {
@@ -364,7 +365,7 @@ func getPublicRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]str
 	return rtbs, nil
 }
 
-func getAllRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]string, error) {
+func getAllRouteTables(vpc *goaviatrix.Vpc, client goaviatrix.NetworkingClient) ([]string, error) {
 	vpc.PublicRoutesOnly = false
 	rtbs, err := client.GetVpcRouteTableIDs(vpc)
 	if err != nil {
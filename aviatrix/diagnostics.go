@@ -0,0 +1,32 @@
+package aviatrix
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// attributeErrorf returns an error diagnostic reported against the top level attribute, so that
+// Terraform points at the attribute in the configuration.
+func attributeErrorf(attribute string, format string, a ...interface{}) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf(format, a...),
+		AttributePath: cty.GetAttrPath(attribute),
+	}}
+}
+
+// attributeWarning returns a warning diagnostic reported against the top level attribute, for
+// conditions that do not fail the operation but leave the attribute out of date in the state.
+func attributeWarning(attribute string, summary string, err error) diag.Diagnostic {
+	warning := diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		AttributePath: cty.GetAttrPath(attribute),
+	}
+	if err != nil {
+		warning.Detail = err.Error()
+	}
+	return warning
+}
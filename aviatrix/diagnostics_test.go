@@ -0,0 +1,30 @@
+package aviatrix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAttributeDiagnostics(t *testing.T) {
+	diags := attributeErrorf("ha_gw_size", "%q is required", "ha_gw_size")
+	if len(diags) != 1 || diags[0].Severity != diag.Error || diags[0].Summary != `"ha_gw_size" is required` {
+		t.Fatalf("attributeErrorf() = %#v", diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("ha_gw_size")) {
+		t.Errorf("attributeErrorf() path = %#v", diags[0].AttributePath)
+	}
+
+	warning := attributeWarning("tags", "could not set tags into state", errors.New("invalid value"))
+	if warning.Severity != diag.Warning || warning.Detail != "invalid value" {
+		t.Errorf("attributeWarning() = %#v", warning)
+	}
+	if !warning.AttributePath.Equals(cty.GetAttrPath("tags")) {
+		t.Errorf("attributeWarning() path = %#v", warning.AttributePath)
+	}
+	if warning := attributeWarning("tags", "summary", nil); warning.Detail != "" {
+		t.Errorf("attributeWarning() without error detail = %q", warning.Detail)
+	}
+}
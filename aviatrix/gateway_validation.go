@@ -0,0 +1,232 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// gatewayDiffRule is a plan time check of a combination of gateway attributes. The check only runs
+// when every attribute it reads is known, and when the resource is created or one of them changes.
+type gatewayDiffRule struct {
+	attributes []string
+	check      func(d *schema.ResourceDiff) error
+}
+
+// validateGatewayDiff returns a CustomizeDiffFunc that fails the plan on the first rule violated.
+// The error is a cty.PathError, so that Terraform reports it against the offending attribute. It
+// must not be wrapped by customdiff.All, which would drop the attribute path.
+func validateGatewayDiff(rules []gatewayDiffRule) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, rule := range rules {
+			if !newValuesKnown(d, rule.attributes) || (d.Id() != "" && !d.HasChanges(rule.attributes...)) {
+				continue
+			}
+			if err := rule.check(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func newValuesKnown(d *schema.ResourceDiff, attributes []string) bool {
+	for _, attribute := range attributes {
+		if !d.NewValueKnown(attribute) {
+			return false
+		}
+	}
+	return true
+}
+
+func pathErrorf(attribute string, format string, a ...interface{}) error {
+	return cty.GetAttrPath(attribute).NewErrorf(format, a...)
+}
+
+func attributeSet(d *schema.ResourceDiff, attribute string) bool {
+	_, ok := d.GetOk(attribute)
+	return ok
+}
+
+// cloudTypeRule rejects attribute when it is set for a cloud_type outside of cloudTypes.
+func cloudTypeRule(attribute string, cloudTypes int, clouds string) gatewayDiffRule {
+	return gatewayDiffRule{
+		attributes: []string{attribute, "cloud_type"},
+		check: func(d *schema.ResourceDiff) error {
+			if attributeSet(d, attribute) && !goaviatrix.IsCloudType(d.Get("cloud_type").(int), cloudTypes) {
+				return pathErrorf(attribute, "%q is only supported for %s", attribute, clouds)
+			}
+			return nil
+		},
+	}
+}
+
+// requiredWithRule requires every one of required to be set when attribute is set.
+func requiredWithRule(attribute string, required ...string) gatewayDiffRule {
+	return gatewayDiffRule{
+		attributes: append([]string{attribute}, required...),
+		check: func(d *schema.ResourceDiff) error {
+			if !attributeSet(d, attribute) {
+				return nil
+			}
+			for _, r := range required {
+				if !attributeSet(d, r) {
+					return pathErrorf(r, "%q is required when %q is set", r, attribute)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// onlyWithRule rejects attribute when none of with is set.
+func onlyWithRule(attribute string, with ...string) gatewayDiffRule {
+	return gatewayDiffRule{
+		attributes: append([]string{attribute}, with...),
+		check: func(d *schema.ResourceDiff) error {
+			if !attributeSet(d, attribute) {
+				return nil
+			}
+			for _, w := range with {
+				if attributeSet(d, w) {
+					return nil
+				}
+			}
+			if len(with) == 1 {
+				return pathErrorf(attribute, "%q is only valid when %q is set", attribute, with[0])
+			}
+			return pathErrorf(attribute, "%q is only valid when one of %q is set", attribute, with)
+		},
+	}
+}
+
+// conflictsRule rejects attribute when other is set as well. Unlike ConflictsWith in the schema, it
+// treats false booleans and empty strings as unset.
+func conflictsRule(attribute string, other string) gatewayDiffRule {
+	return gatewayDiffRule{
+		attributes: []string{attribute, other},
+		check: func(d *schema.ResourceDiff) error {
+			if attributeSet(d, attribute) && attributeSet(d, other) {
+				return pathErrorf(attribute, "%q can't be set when %q is set", attribute, other)
+			}
+			return nil
+		},
+	}
+}
+
+// haGatewayRules are the rules shared by the HA gateway of the transit and spoke gateways.
+func haGatewayRules() []gatewayDiffRule {
+	return []gatewayDiffRule{
+		requiredWithRule("ha_subnet", "ha_gw_size"),
+		onlyWithRule("ha_gw_size", "ha_subnet", "ha_zone"),
+		onlyWithRule("enable_active_standby", "ha_subnet", "ha_zone"),
+		onlyWithRule("enable_active_standby_preemptive", "enable_active_standby"),
+	}
+}
+
+// privateOobRules are the rules of the private OOB attributes shared by the transit and spoke gateways.
+func privateOobRules() []gatewayDiffRule {
+	return []gatewayDiffRule{
+		cloudTypeRule("enable_private_oob", goaviatrix.AWSRelatedCloudTypes, "AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)"),
+		requiredWithRule("enable_private_oob", "oob_management_subnet", "oob_availability_zone"),
+		onlyWithRule("oob_management_subnet", "enable_private_oob"),
+		onlyWithRule("oob_availability_zone", "enable_private_oob"),
+		{
+			attributes: []string{"enable_private_oob", "ha_subnet", "ha_oob_management_subnet", "ha_oob_availability_zone"},
+			check: func(d *schema.ResourceDiff) error {
+				if !d.Get("enable_private_oob").(bool) || d.Get("ha_subnet").(string) == "" {
+					return nil
+				}
+				for _, attribute := range []string{"ha_oob_management_subnet", "ha_oob_availability_zone"} {
+					if !attributeSet(d, attribute) {
+						return pathErrorf(attribute, "%q is required when \"enable_private_oob\" is true and \"ha_subnet\" is set", attribute)
+					}
+				}
+				return nil
+			},
+		},
+		onlyWithRule("ha_oob_management_subnet", "ha_subnet"),
+		onlyWithRule("ha_oob_availability_zone", "ha_subnet"),
+	}
+}
+
+// commonGatewayRules are the cloud type rules shared by the transit, spoke and standalone gateways.
+func commonGatewayRules() []gatewayDiffRule {
+	return []gatewayDiffRule{
+		cloudTypeRule("enable_encrypt_volume", goaviatrix.AWSRelatedCloudTypes, "AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)"),
+		onlyWithRule("customer_managed_keys", "enable_encrypt_volume"),
+		cloudTypeRule("enable_monitor_gateway_subnets", goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina, "AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)"),
+		onlyWithRule("monitor_exclude_list", "enable_monitor_gateway_subnets"),
+		cloudTypeRule("enable_vpc_dns_server", goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes,
+			"AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)"),
+		cloudTypeRule("enable_spot_instance", goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes, "AWS and Azure related cloud types"),
+		{
+			// delete_spot is ignored unless the gateway is a spot instance
+			attributes: []string{"delete_spot", "enable_spot_instance", "cloud_type"},
+			check: func(d *schema.ResourceDiff) error {
+				if d.Get("enable_spot_instance").(bool) && d.Get("delete_spot").(bool) &&
+					!goaviatrix.IsCloudType(d.Get("cloud_type").(int), goaviatrix.AzureArmRelatedCloudTypes) {
+					return pathErrorf("delete_spot", "%q is only supported for Azure (8), AzureGov (32) or AzureChina (2048)", "delete_spot")
+				}
+				return nil
+			},
+		},
+		cloudTypeRule("rx_queue_size", goaviatrix.AWSRelatedCloudTypes, "AWS related cloud types"),
+	}
+}
+
+var transitGatewayDiffRules = concatGatewayDiffRules(
+	commonGatewayRules(),
+	haGatewayRules(),
+	privateOobRules(),
+	[]gatewayDiffRule{
+		conflictsRule("enable_transit_firenet", "enable_firenet"),
+		onlyWithRule("enable_gateway_load_balancer", "enable_firenet", "enable_transit_firenet"),
+		cloudTypeRule("enable_gateway_load_balancer", goaviatrix.AWS, "AWS (1)"),
+		onlyWithRule("enable_egress_transit_firenet", "enable_transit_firenet"),
+		conflictsRule("enable_egress_transit_firenet", "connected_transit"),
+		cloudTypeRule("enable_bgp_over_lan", goaviatrix.GCP|goaviatrix.AzureArmRelatedCloudTypes, "GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)"),
+		onlyWithRule("bgp_lan_interfaces_count", "enable_bgp_over_lan"),
+		cloudTypeRule("bgp_lan_interfaces_count", goaviatrix.AzureArmRelatedCloudTypes, "Azure (8), AzureGov (32) or AzureChina (2048)"),
+		cloudTypeRule("enable_hybrid_connection", goaviatrix.AWSRelatedCloudTypes, "AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)"),
+	},
+)
+
+var spokeGatewayDiffRules = concatGatewayDiffRules(
+	commonGatewayRules(),
+	haGatewayRules(),
+	privateOobRules(),
+	[]gatewayDiffRule{
+		onlyWithRule("enable_active_standby", "enable_bgp"),
+		cloudTypeRule("enable_bgp", goaviatrix.AWS|goaviatrix.Azure, "AWS (1) and Azure (8)"),
+		onlyWithRule("enable_bgp_over_lan", "enable_bgp"),
+		cloudTypeRule("enable_bgp_over_lan", goaviatrix.AzureArmRelatedCloudTypes, "Azure (8), AzureGov (32) or AzureChina (2048)"),
+		onlyWithRule("bgp_lan_interfaces_count", "enable_bgp_over_lan"),
+		cloudTypeRule("enable_private_vpc_default_route", goaviatrix.AWSRelatedCloudTypes, "AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)"),
+		cloudTypeRule("enable_skip_public_route_table_update", goaviatrix.AWSRelatedCloudTypes, "AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)"),
+		cloudTypeRule("enable_global_vpc", goaviatrix.GCPRelatedCloudTypes, "GCP (4)"),
+	},
+)
+
+var gatewayDiffRules = concatGatewayDiffRules(
+	commonGatewayRules(),
+	[]gatewayDiffRule{
+		requiredWithRule("peering_ha_subnet", "peering_ha_gw_size"),
+		onlyWithRule("peering_ha_gw_size", "peering_ha_subnet", "peering_ha_zone"),
+		onlyWithRule("enable_elb", "vpn_access"),
+		cloudTypeRule("enable_designated_gateway", goaviatrix.AWSRelatedCloudTypes, "AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)"),
+		conflictsRule("enable_designated_gateway", "peering_ha_subnet"),
+		conflictsRule("enable_designated_gateway", "peering_ha_zone"),
+	},
+)
+
+func concatGatewayDiffRules(rules ...[]gatewayDiffRule) []gatewayDiffRule {
+	var all []gatewayDiffRule
+	for _, r := range rules {
+		all = append(all, r...)
+	}
+	return all
+}
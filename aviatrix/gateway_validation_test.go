@@ -0,0 +1,126 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidateGatewayDiff(t *testing.T) {
+	transit := map[string]interface{}{
+		"cloud_type":   1,
+		"account_name": "aws",
+		"gw_name":      "transit",
+		"vpc_id":       "vpc-1234",
+		"vpc_reg":      "us-east-1",
+		"gw_size":      "c5.xlarge",
+		"subnet":       "10.0.0.0/26",
+	}
+	with := func(base map[string]interface{}, attributes map[string]interface{}) map[string]interface{} {
+		raw := make(map[string]interface{})
+		for k, v := range base {
+			raw[k] = v
+		}
+		for k, v := range attributes {
+			raw[k] = v
+		}
+		return raw
+	}
+
+	tests := []struct {
+		name      string
+		resource  *schema.Resource
+		raw       map[string]interface{}
+		attribute string
+	}{
+		{
+			"valid transit",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"ha_subnet": "10.0.0.64/26", "ha_gw_size": "c5.xlarge", "enable_transit_firenet": true, "enable_gateway_load_balancer": true}),
+			"",
+		},
+		{
+			"gateway load balancer outside of AWS",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"cloud_type": 8, "enable_transit_firenet": true, "enable_gateway_load_balancer": true}),
+			"enable_gateway_load_balancer",
+		},
+		{
+			"gateway load balancer without firenet",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"enable_gateway_load_balancer": true}),
+			"enable_gateway_load_balancer",
+		},
+		{
+			"ha_subnet without ha_gw_size",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"ha_subnet": "10.0.0.64/26"}),
+			"ha_gw_size",
+		},
+		{
+			"ha_gw_size unknown",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"ha_subnet": "10.0.0.64/26", "ha_gw_size": "74D93920-ED26-11E3-AC10-0800200C9A66"}),
+			"",
+		},
+		{
+			"bgp over lan on AWS",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"enable_bgp_over_lan": true}),
+			"enable_bgp_over_lan",
+		},
+		{
+			"private oob without oob subnet",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"enable_private_oob": true, "oob_availability_zone": "us-east-1a"}),
+			"oob_management_subnet",
+		},
+		{
+			"spoke bgp over lan without bgp",
+			resourceAviatrixSpokeGateway(),
+			with(transit, map[string]interface{}{"cloud_type": 8, "enable_bgp_over_lan": true}),
+			"enable_bgp_over_lan",
+		},
+		{
+			"delete_spot without spot instance on AWS",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"delete_spot": true}),
+			"",
+		},
+		{
+			"delete_spot of a spot instance on AWS",
+			resourceAviatrixTransitGateway(),
+			with(transit, map[string]interface{}{"enable_spot_instance": true, "delete_spot": true}),
+			"delete_spot",
+		},
+		{
+			"designated gateway with peering HA",
+			resourceAviatrixGateway(),
+			with(transit, map[string]interface{}{"enable_designated_gateway": true, "peering_ha_subnet": "10.0.0.64/26", "peering_ha_gw_size": "t3.small"}),
+			"enable_designated_gateway",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := tt.resource.SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.raw), nil)
+		if tt.attribute == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.name, err)
+			}
+			continue
+		}
+
+		var pathErr cty.PathError
+		if !errors.As(err, &pathErr) {
+			t.Errorf("%s: expected a cty.PathError, got %v", tt.name, err)
+			continue
+		}
+		if want := cty.GetAttrPath(tt.attribute); !pathErr.Path.Equals(want) {
+			t.Errorf("%s: error path = %#v, want %q", tt.name, pathErr.Path, tt.attribute)
+		}
+	}
+}
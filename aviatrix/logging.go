@@ -0,0 +1,75 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceLogSubsystem is the terraform-plugin-log subsystem resources and data sources log to.
+// Its level can be set with TF_LOG_PROVIDER_AVIATRIX_RESOURCE.
+const resourceLogSubsystem = "aviatrix_resource"
+
+// crudContextFunc is the signature shared by the context-aware CRUD functions of a resource.
+type crudContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// withResourceLogging wraps the context-aware CRUD functions of r so that they log to the
+// resource log subsystem with the resource type, the CRUD action and, for resources that have
+// one, the gateway name as fields. The CRUD action is logged as crud_action, since action is the
+// controller API action logged by the client. The controller client includes these fields in its own logs.
+func withResourceLogging(name string, r *schema.Resource) {
+	_, hasGwName := r.Schema["gw_name"]
+	wrap := func(action string, f crudContextFunc) crudContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(resourceLogContext(ctx, d, name, action, hasGwName), d, meta)
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = schema.CreateContextFunc(wrap("create", crudContextFunc(r.CreateContext)))
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = schema.ReadContextFunc(wrap("read", crudContextFunc(r.ReadContext)))
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = schema.UpdateContextFunc(wrap("update", crudContextFunc(r.UpdateContext)))
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = schema.DeleteContextFunc(wrap("delete", crudContextFunc(r.DeleteContext)))
+	}
+}
+
+// resourceLogContext returns ctx with the resource log subsystem and the resource, crud_action and
+// gateway name fields.
+func resourceLogContext(ctx context.Context, d *schema.ResourceData, name, action string, hasGwName bool) context.Context {
+	ctx = tflog.SetField(ctx, "resource", name)
+	ctx = tflog.SetField(ctx, "crud_action", action)
+	if hasGwName {
+		if gwName, ok := d.Get("gw_name").(string); ok && gwName != "" {
+			ctx = tflog.SetField(ctx, "gw_name", gwName)
+		}
+	}
+	return tflog.NewSubsystem(ctx, resourceLogSubsystem, tflog.WithRootFields())
+}
+
+func logTrace(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemTrace(ctx, resourceLogSubsystem, msg, fields...)
+}
+
+func logDebug(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemDebug(ctx, resourceLogSubsystem, msg, fields...)
+}
+
+func logInfo(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemInfo(ctx, resourceLogSubsystem, msg, fields...)
+}
+
+func logWarn(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemWarn(ctx, resourceLogSubsystem, msg, fields...)
+}
+
+func logError(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemError(ctx, resourceLogSubsystem, msg, fields...)
+}
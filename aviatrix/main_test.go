@@ -0,0 +1,79 @@
+package aviatrix
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+// TestMain runs the tests against an in-process fake controller when AVIATRIX_FAKE_CONTROLLER is
+// set, so that the acceptance tests can run with TF_ACC=1 and no network.
+func TestMain(m *testing.M) {
+	if os.Getenv("AVIATRIX_FAKE_CONTROLLER") == "" {
+		code := m.Run()
+		closeWireLogs()
+		os.Exit(code)
+	}
+
+	server := fakecontroller.New()
+	for key, value := range server.Env() {
+		if err := os.Setenv(key, value); err != nil {
+			log.Fatalf("[ERROR] could not set %s: %v", key, err)
+		}
+	}
+	for key, value := range fakeControllerTestEnv {
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			log.Fatalf("[ERROR] could not set %s: %v", key, err)
+		}
+	}
+	log.Printf("[INFO] Running the tests against the fake controller at %s", server.Address())
+	code := m.Run()
+	closeWireLogs()
+	server.Close()
+	os.Exit(code)
+}
+
+// fakeControllerTestEnv are the environment variables read by the acceptance tests that run
+// against the fake controller, unless they are already set. The fake controller only implements
+// AWS accounts and gateways, the tests of the other clouds are skipped.
+var fakeControllerTestEnv = map[string]string{
+	"AWS_ACCOUNT_NUMBER": "123456789012",
+	"AWS_ACCESS_KEY":     "AKIAFAKECONTROLLER",
+	"AWS_SECRET_KEY":     "fake-controller-secret-key",
+	"AWS_REGION":         "us-east-1",
+	"AWS_VPC_ID":         "vpc-0fa4e5c5e7a0c0001",
+	"AWS_SUBNET":         "10.1.0.0/24",
+	"AWS_VPC_ID4":        "vpc-0fa4e5c5e7a0c0004",
+	"AWS_SUBNET4":        "10.4.0.0/24",
+	"AWS_GW_SIZE":        "t2.micro",
+
+	"SKIP_ACCOUNT_AWS":           "no",
+	"SKIP_ACCOUNT_GCP":           "yes",
+	"SKIP_ACCOUNT_AZURE":         "yes",
+	"SKIP_ACCOUNT_OCI":           "yes",
+	"SKIP_ACCOUNT_AWSGOV":        "yes",
+	"SKIP_ACCOUNT_AZUREGOV":      "yes",
+	"SKIP_ACCOUNT_AWSCHINA_IAM":  "yes",
+	"SKIP_ACCOUNT_AWSCHINA":      "yes",
+	"SKIP_ACCOUNT_AZURECHINA":    "yes",
+	"SKIP_ACCOUNT_AWSTS":         "yes",
+	"SKIP_ACCOUNT_AWSS":          "yes",
+	"SKIP_TRANSIT_GATEWAY_AZURE": "yes",
+	"SKIP_TRANSIT_GATEWAY_GCP":   "yes",
+	"SKIP_TRANSIT_GATEWAY_OCI":   "yes",
+	"SKIP_SPOKE_GATEWAY_AZURE":   "yes",
+	"SKIP_SPOKE_GATEWAY_GCP":     "yes",
+	"SKIP_SPOKE_GATEWAY_OCI":     "yes",
+}
+
+// closeWireLogs closes the wire log transcripts opened by the providers configured by the tests.
+func closeWireLogs() {
+	if err := CloseWireLogs(); err != nil {
+		log.Printf("[WARN] Could not close the wire log transcript: %v", err)
+	}
+}
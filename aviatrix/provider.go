@@ -1,34 +1,54 @@
 package aviatrix
 
 import (
-	"errors"
+	"context"
 	"os"
+	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
-	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var supportedVersions = []string{"7.1"}
 
 // Provider returns a schema.Provider for Aviatrix.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"controller_ip": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: envDefaultFunc("AVIATRIX_CONTROLLER_IP"),
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: envDefaultFunc("AVIATRIX_USERNAME"),
 			},
 			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: envDefaultFunc("AVIATRIX_PASSWORD"),
 			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefaultFunc("AVIATRIX_PROFILE"),
+				Description: "Name of the profile of the shared credentials file to read the controller credentials from.",
+			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefaultFunc("AVIATRIX_SHARED_CREDENTIALS_FILE"),
+				Description: "Path of the shared credentials file. Default: ~/.aviatrix/credentials.",
+			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command that writes the controller credentials to stdout as JSON.",
+			},
 			"skip_version_validation": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -43,6 +63,54 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ca_certificate_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate to verify the controller certificate with.",
+			},
+			"path_to_client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"path_to_client_key"},
+				Description:  "Path of the PEM encoded client certificate for mutual TLS.",
+			},
+			"path_to_client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"path_to_client_certificate"},
+				Description:  "Path of the PEM encoded private key of the client certificate.",
+			},
+			"tls_min_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+				Description:  "Minimum TLS version of the connections to the controller.",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server name used to verify the controller certificate, e.g. when the controller is accessed through a reverse proxy.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reject every request that may change the controller before it is sent.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of concurrent requests sent to the controller. 0 means unlimited.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of requests per second sent to the controller. 0 means unlimited.",
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -67,6 +135,110 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with the tags added to every taggable resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags added to every taggable resource. The resource tags win on conflict.",
+						},
+					},
+				},
+			},
+			"wire_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block to log every request sent to the controller with secrets redacted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transcript_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of a file the sanitized transcript of every request is appended to, as JSON lines.",
+						},
+						"redact_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Additional parameter names to redact. A parameter is redacted if its name contains any of these values.",
+						},
+					},
+				},
+			},
+			"retry_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to retry failed requests to the controller.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total number of attempts for a single request, including the first one.",
+						},
+						"initial_backoff_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      500,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Delay in milliseconds before the first retry. It doubles after every retry.",
+						},
+						"max_backoff_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30000,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum delay in milliseconds between two attempts.",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+							Description: "HTTP status codes that are retried. Default: 429, 502, 503 and 504.",
+						},
+						"retryable_reasons": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Substrings of the controller error reason that are retried.",
+						},
+					},
+				},
+			},
+			"session_cache": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block to share controller sessions between provider instances instead of logging in with each of them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of a file sessions are saved to and reused from by later runs. It is only readable by its owner.",
+						},
+						"ttl_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of minutes a session is reused after login.",
+						},
+					},
+				},
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -218,8 +390,17 @@ func Provider() *schema.Provider {
 			"aviatrix_firewall":                             dataSourceAviatrixFirewall(),
 			"aviatrix_firewall_instance_images":             dataSourceAviatrixFirewallInstanceImages(),
 		},
-		ConfigureFunc: aviatrixConfigure,
+		ConfigureContextFunc: aviatrixConfigure,
 	}
+
+	for name, r := range p.ResourcesMap {
+		withResourceLogging(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		withResourceLogging(name, r)
+	}
+
+	return p
 }
 
 func envDefaultFunc(k string) schema.SchemaDefaultFunc {
@@ -232,45 +413,89 @@ func envDefaultFunc(k string) schema.SchemaDefaultFunc {
 	}
 }
 
-func aviatrixConfigure(d *schema.ResourceData) (interface{}, error) {
-	config := Config{
-		ControllerIP: d.Get("controller_ip").(string),
-		Username:     d.Get("username").(string),
-		Password:     d.Get("password").(string),
-		VerifyCert:   d.Get("verify_ssl_certificate").(bool),
-		PathToCACert: d.Get("path_to_ca_certificate").(string),
-		IgnoreTags:   expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+func aviatrixConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client, diags := newProviderClient(ctx, d)
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	skipVersionValidation := d.Get("skip_version_validation").(bool)
 	if skipVersionValidation {
-		return config.Client()
+		return client, diags
 	}
 
-	client, err := config.Client()
-	if err != nil {
-		return nil, err
+	diags = append(diags, controllerVersionDiagnostics(ctx, client, supportedVersions)...)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	err = client.ControllerVersionValidation(supportedVersions)
-	if err != nil {
-		return nil, errors.New("controller version validation failed: " + err.Error())
-	}
+	return client, diags
+}
 
-	return client, nil
+func aviatrixConfigureWithoutVersionValidation(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client, diags := newProviderClient(ctx, d)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return client, diags
 }
 
-func aviatrixConfigureWithoutVersionValidation(d *schema.ResourceData) (interface{}, error) {
+// newProviderClient builds the Config from the provider arguments and returns the client of the
+// controller, along with the TLS warnings.
+func newProviderClient(ctx context.Context, d *schema.ResourceData) (*goaviatrix.Client, diag.Diagnostics) {
+	creds, err := resolveProviderCredentials(ctx, d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	config := Config{
-		ControllerIP: d.Get("controller_ip").(string),
-		Username:     d.Get("username").(string),
-		Password:     d.Get("password").(string),
+		ControllerIP: creds.ControllerIP,
+		Username:     creds.Username,
+		Password:     creds.Password,
 		VerifyCert:   d.Get("verify_ssl_certificate").(bool),
 		PathToCACert: d.Get("path_to_ca_certificate").(string),
+		CACertPEM:    d.Get("ca_certificate_pem").(string),
 		IgnoreTags:   expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		DefaultTags:  expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		RetryPolicy:  expandProviderRetryPolicy(d.Get("retry_policy").([]interface{})),
+		WireLog:      expandProviderWireLog(d.Get("wire_log").([]interface{})),
+		SessionCache: expandProviderSessionCache(d.Get("session_cache").([]interface{})),
+		ReadOnly:     d.Get("read_only").(bool),
+
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:     d.Get("requests_per_second").(float64),
+
+		PathToClientCert: d.Get("path_to_client_certificate").(string),
+		PathToClientKey:  d.Get("path_to_client_key").(string),
+		TLSMinVersion:    d.Get("tls_min_version").(string),
+		TLSServerName:    d.Get("tls_server_name").(string),
+	}
+	diags := tlsDiagnostics(&config)
+
+	client, err := config.ClientContext(ctx)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return client, diags
+}
+
+// tlsDiagnostics warns when the controller certificate is not verified.
+func tlsDiagnostics(config *Config) diag.Diagnostics {
+	if config.VerifyCert {
+		return nil
 	}
 
-	return config.Client()
+	detail := "The SSL certificate of the controller is not verified, so the connection to the controller, " +
+		"including the credentials, can be intercepted. Set verify_ssl_certificate to true, with " +
+		"path_to_ca_certificate or ca_certificate_pem if the controller uses a self-signed certificate."
+	if config.PathToCACert != "" || config.CACertPEM != "" {
+		detail += " The CA certificate is ignored while verify_ssl_certificate is false."
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Controller SSL certificate verification is disabled",
+		Detail:   detail,
+	}}
 }
 
 func expandProviderIgnoreTags(l []interface{}) *goaviatrix.IgnoreTagsConfig {
@@ -291,3 +516,100 @@ func expandProviderIgnoreTags(l []interface{}) *goaviatrix.IgnoreTagsConfig {
 
 	return ignoreConfig
 }
+
+func expandProviderDefaultTags(l []interface{}) *goaviatrix.DefaultTagsConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &goaviatrix.DefaultTagsConfig{Tags: make(goaviatrix.KeyValueTags)}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		for key, value := range v {
+			defaultConfig.Tags[key] = value.(string)
+		}
+	}
+
+	return defaultConfig
+}
+
+func expandProviderRetryPolicy(l []interface{}) *goaviatrix.RetryPolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	retryPolicy := goaviatrix.DefaultRetryPolicy()
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["max_attempts"].(int); ok {
+		retryPolicy.MaxAttempts = v
+	}
+
+	if v, ok := m["initial_backoff_ms"].(int); ok {
+		retryPolicy.InitialBackoff = time.Duration(v) * time.Millisecond
+	}
+
+	if v, ok := m["max_backoff_ms"].(int); ok {
+		retryPolicy.MaxBackoff = time.Duration(v) * time.Millisecond
+	}
+
+	if v, ok := m["retryable_status_codes"].(*schema.Set); ok && v.Len() > 0 {
+		retryPolicy.RetryableStatusCodes = nil
+		for _, code := range v.List() {
+			retryPolicy.RetryableStatusCodes = append(retryPolicy.RetryableStatusCodes, code.(int))
+		}
+	}
+
+	if v, ok := m["retryable_reasons"].(*schema.Set); ok {
+		retryPolicy.RetryableReasons = goaviatrix.ExpandStringList(v.List())
+	}
+
+	return retryPolicy
+}
+
+func expandProviderWireLog(l []interface{}) *WireLogConfig {
+	if len(l) == 0 {
+		return nil
+	}
+
+	// An empty block enables the wire log without a transcript
+	wireLog := &WireLogConfig{}
+	if l[0] == nil {
+		return wireLog
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["transcript_path"].(string); ok {
+		wireLog.TranscriptPath = v
+	}
+
+	if v, ok := m["redact_keys"].(*schema.Set); ok {
+		wireLog.RedactKeys = goaviatrix.ExpandStringList(v.List())
+	}
+
+	return wireLog
+}
+
+func expandProviderSessionCache(l []interface{}) *goaviatrix.SessionCache {
+	if len(l) == 0 {
+		return nil
+	}
+
+	// An empty block only shares sessions within the process
+	sessionCache := &goaviatrix.SessionCache{}
+	if l[0] == nil {
+		return sessionCache
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["path"].(string); ok {
+		sessionCache.Path = v
+	}
+
+	if v, ok := m["ttl_minutes"].(int); ok {
+		sessionCache.TTL = time.Duration(v) * time.Minute
+	}
+
+	return sessionCache
+}
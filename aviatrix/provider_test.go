@@ -9,8 +9,8 @@ import (
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -26,7 +26,7 @@ func init() {
 	}
 
 	testAccProviderVersionValidation = Provider()
-	testAccProviderVersionValidation.ConfigureFunc = aviatrixConfigureWithoutVersionValidation
+	testAccProviderVersionValidation.ConfigureContextFunc = aviatrixConfigureWithoutVersionValidation
 	testAccProvidersVersionValidation = map[string]*schema.Provider{
 		"aviatrix": testAccProviderVersionValidation,
 	}
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -19,7 +18,7 @@ func resourceAviatrixAccount() *schema.Resource {
 		UpdateWithoutTimeout: resourceAviatrixAccountUpdate,
 		DeleteWithoutTimeout: resourceAviatrixAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,10 +62,16 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "AWS Access Key.",
 			},
 			"aws_secret_key": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "AWS Secret Key. It is sent to the controller but not stored in the state.",
+			},
+			"aws_secret_key_version": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Sensitive:   true,
-				Description: "AWS Secret Key.",
+				Description: "Version of 'aws_secret_key'. Change it to send a rotated 'aws_secret_key' to the controller.",
 			},
 			"awsgov_account_number": {
 				Type:         schema.TypeString,
@@ -98,10 +103,16 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "AWS Gov Access Key.",
 			},
 			"awsgov_secret_key": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "AWS Gov Secret Key. It is sent to the controller but not stored in the state.",
+			},
+			"awsgov_secret_key_version": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Sensitive:   true,
-				Description: "AWS Gov Secret Key.",
+				Description: "Version of 'awsgov_secret_key'. Change it to send a rotated 'awsgov_secret_key' to the controller.",
 			},
 			"gcloud_project_id": {
 				Type:        schema.TypeString,
@@ -113,6 +124,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Optional:    true,
 				Description: "GCloud Project credentials local file path.",
 			},
+			"gcloud_project_credentials_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of the GCloud Project credentials. Change it to upload the rotated credentials file to the controller.",
+			},
 			"arm_subscription_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -131,10 +147,16 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Azure Application ID.",
 			},
 			"arm_application_key": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "Azure Application Key. It is sent to the controller but not stored in the state.",
+			},
+			"arm_application_key_version": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Sensitive:   true,
-				Description: "Azure Application Key.",
+				Description: "Version of 'arm_application_key'. Change it to send a rotated 'arm_application_key' to the controller.",
 			},
 			"oci_tenancy_id": {
 				Type:        schema.TypeString,
@@ -178,10 +200,16 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Azure Gov Application ID.",
 			},
 			"azuregov_application_key": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "Azure Gov Application Key. It is sent to the controller but not stored in the state.",
+			},
+			"azuregov_application_key_version": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Sensitive:   true,
-				Description: "Azure Gov Application Key.",
+				Description: "Version of 'azuregov_application_key'. Change it to send a rotated 'azuregov_application_key' to the controller.",
 			},
 			"alicloud_account_id": {
 				Type:        schema.TypeString,
@@ -195,10 +223,16 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Alibaba Cloud Access Key.",
 			},
 			"alicloud_secret_key": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "Alibaba Cloud Secret Key. It is sent to the controller but not stored in the state.",
+			},
+			"alicloud_secret_key_version": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Sensitive:   true,
-				Description: "Alibaba Cloud Secret Key.",
+				Description: "Version of 'alicloud_secret_key'. Change it to send a rotated 'alicloud_secret_key' to the controller.",
 			},
 			"audit_account": {
 				Type:        schema.TypeBool,
@@ -225,11 +259,17 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description:   "AWS China Access Key.",
 			},
 			"awschina_secret_key": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"awschina_role_app", "awschina_role_ec2"},
-				Description:   "AWS China Secret Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"awschina_role_app", "awschina_role_ec2"},
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "AWS China Secret Key. It is sent to the controller but not stored in the state.",
+			},
+			"awschina_secret_key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of 'awschina_secret_key'. Change it to send a rotated 'awschina_secret_key' to the controller.",
 			},
 			"azurechina_subscription_id": {
 				Type:        schema.TypeString,
@@ -249,10 +289,16 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Azure China Application ID.",
 			},
 			"azurechina_application_key": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "Azure China Application Key. It is sent to the controller but not stored in the state.",
+			},
+			"azurechina_application_key_version": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Sensitive:   true,
-				Description: "Azure China Application Key.",
+				Description: "Version of 'azurechina_application_key'. Change it to send a rotated 'azurechina_application_key' to the controller.",
 			},
 			"awsts_account_number": {
 				Type:        schema.TypeString,
@@ -349,14 +395,20 @@ func resourceAviatrixAccount() *schema.Resource {
 					"deprecated in the V3.2.0 release.",
 			},
 			"edge_csp_password": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"edge_zededa_password"},
-				Description:   "Edge CSP password.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"edge_zededa_password"},
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "Edge CSP password. It is sent to the controller but not stored in the state.",
 				Deprecated: "Since V3.1.1+, please use edge_zededa_password instead, edge_csp_password will be " +
 					"deprecated in the V3.2.0 release.",
 			},
+			"edge_csp_password_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of 'edge_csp_password'. Change it to send a rotated 'edge_csp_password' to the controller.",
+			},
 			"edge_zededa_username": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -364,11 +416,17 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description:   "Edge Zededa username.",
 			},
 			"edge_zededa_password": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"edge_csp_password"},
-				Description:   "Edge Zededa password.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"edge_csp_password"},
+				DiffSuppressFunc: suppressWriteOnlyDiff,
+				Description:      "Edge Zededa password. It is sent to the controller but not stored in the state.",
+			},
+			"edge_zededa_password_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of 'edge_zededa_password'. Change it to send a rotated 'edge_zededa_password' to the controller.",
 			},
 			"aws_role_app": {
 				Type:        schema.TypeString,
@@ -434,7 +492,7 @@ func resourceAviatrixAccount() *schema.Resource {
 }
 
 func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AccountClient)
 
 	account := &goaviatrix.Account{
 		AccountName:                           d.Get("account_name").(string),
@@ -445,38 +503,38 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		AwsGatewayRoleApp:                     d.Get("aws_gateway_role_app").(string),
 		AwsGatewayRoleEc2:                     d.Get("aws_gateway_role_ec2").(string),
 		AwsAccessKey:                          d.Get("aws_access_key").(string),
-		AwsSecretKey:                          d.Get("aws_secret_key").(string),
+		AwsSecretKey:                          getWriteOnlyString(d, "aws_secret_key"),
 		AwsgovAccountNumber:                   d.Get("awsgov_account_number").(string),
 		AwsgovRoleApp:                         d.Get("awsgov_role_app").(string),
 		AwsgovRoleEc2:                         d.Get("awsgov_role_ec2").(string),
 		AwsgovAccessKey:                       d.Get("awsgov_access_key").(string),
-		AwsgovSecretKey:                       d.Get("awsgov_secret_key").(string),
+		AwsgovSecretKey:                       getWriteOnlyString(d, "awsgov_secret_key"),
 		GcloudProjectName:                     d.Get("gcloud_project_id").(string),
 		GcloudProjectCredentialsFilepathLocal: d.Get("gcloud_project_credentials_filepath").(string),
 		ArmSubscriptionId:                     d.Get("arm_subscription_id").(string),
 		ArmApplicationEndpoint:                d.Get("arm_directory_id").(string),
 		ArmApplicationClientId:                d.Get("arm_application_id").(string),
-		ArmApplicationClientSecret:            d.Get("arm_application_key").(string),
+		ArmApplicationClientSecret:            getWriteOnlyString(d, "arm_application_key"),
 		AzuregovSubscriptionId:                d.Get("azuregov_subscription_id").(string),
 		AzuregovApplicationEndpoint:           d.Get("azuregov_directory_id").(string),
 		AzuregovApplicationClientId:           d.Get("azuregov_application_id").(string),
-		AzuregovApplicationClientSecret:       d.Get("azuregov_application_key").(string),
+		AzuregovApplicationClientSecret:       getWriteOnlyString(d, "azuregov_application_key"),
 		OciTenancyID:                          d.Get("oci_tenancy_id").(string),
 		OciUserID:                             d.Get("oci_user_id").(string),
 		OciCompartmentID:                      d.Get("oci_compartment_id").(string),
 		OciApiPrivateKeyFilePath:              d.Get("oci_api_private_key_filepath").(string),
 		AlicloudAccountId:                     d.Get("alicloud_account_id").(string),
 		AlicloudAccessKey:                     d.Get("alicloud_access_key").(string),
-		AlicloudSecretKey:                     d.Get("alicloud_secret_key").(string),
+		AlicloudSecretKey:                     getWriteOnlyString(d, "alicloud_secret_key"),
 		AwsChinaAccountNumber:                 d.Get("awschina_account_number").(string),
 		AwsChinaRoleApp:                       d.Get("awschina_role_app").(string),
 		AwsChinaRoleEc2:                       d.Get("awschina_role_ec2").(string),
 		AwsChinaAccessKey:                     d.Get("awschina_access_key").(string),
-		AwsChinaSecretKey:                     d.Get("awschina_secret_key").(string),
+		AwsChinaSecretKey:                     getWriteOnlyString(d, "awschina_secret_key"),
 		AzureChinaSubscriptionId:              d.Get("azurechina_subscription_id").(string),
 		AzureChinaApplicationEndpoint:         d.Get("azurechina_directory_id").(string),
 		AzureChinaApplicationClientId:         d.Get("azurechina_application_id").(string),
-		AzureChinaApplicationClientSecret:     d.Get("azurechina_application_key").(string),
+		AzureChinaApplicationClientSecret:     getWriteOnlyString(d, "azurechina_application_key"),
 		AwsTsAccountNumber:                    d.Get("awsts_account_number").(string),
 		AwsTsCapUrl:                           d.Get("awsts_cap_url").(string),
 		AwsTsCapAgency:                        d.Get("awsts_cap_agency").(string),
@@ -504,11 +562,11 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		AccountName:     d.Get("account_name").(string),
 		CloudType:       d.Get("cloud_type").(int),
 		EdgeCSPUsername: d.Get("edge_csp_username").(string),
-		EdgeCSPPassword: d.Get("edge_csp_password").(string),
+		EdgeCSPPassword: getWriteOnlyString(d, "edge_csp_password"),
 	}
-	if d.Get("edge_zededa_username").(string) != "" && d.Get("edge_zededa_password").(string) != "" {
+	if d.Get("edge_zededa_username").(string) != "" && getWriteOnlyString(d, "edge_zededa_password") != "" {
 		edgeAccount.EdgeCSPUsername = d.Get("edge_zededa_username").(string)
-		edgeAccount.EdgeCSPPassword = d.Get("edge_zededa_password").(string)
+		edgeAccount.EdgeCSPPassword = getWriteOnlyString(d, "edge_zededa_password")
 	}
 
 	if _, ok := d.GetOk("rbac_groups"); ok {
@@ -578,10 +636,10 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSS) && (account.AwsSAccountNumber != "" || account.AwsSCapUrl != "" || account.AwsSCapAgency != "" || account.AwsSCapAccountName != "" || account.AwsSCapRoleName != "" || account.AwsSCapCert != "" || account.AwsSCapCertKey != "" || account.AwsSCaChainCert != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'awss_account_number', 'awss_cap_url', 'awss_cap_agency', 'awss_cap_account_name', 'awss_cap_role_name', awss_cap_cert', 'awss_cap_cert_key' and 'awss_ca_chain_cert' can only be set when 'cloud_type' is AWS Secret Region (32768)")
 	}
-	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_csp_username").(string) != "" || d.Get("edge_csp_password").(string) != "") {
+	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_csp_username").(string) != "" || getWriteOnlyString(d, "edge_csp_password") != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'edge_csp_username' and 'edge_csp_password' can only be set when 'cloud_type' is Edge CSP (65536)")
 	}
-	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_zededa_username").(string) != "" || d.Get("edge_zededa_password").(string) != "") {
+	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_zededa_username").(string) != "" || getWriteOnlyString(d, "edge_zededa_password") != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'edge_zededa_username' and 'edge_zededa_password' can only be set when 'cloud_type' is Edge Zededa (65536)")
 	}
 
@@ -593,7 +651,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("aws iam can only be 'true' or 'false'")
 		}
 
-		log.Printf("[INFO] Creating Aviatrix account: %#v", account)
+		logInfo(ctx, "Creating Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 		if awsIam {
 			if account.AwsAccessKey != "" || account.AwsSecretKey != "" {
 				return diag.Errorf("could not create Aviatrix Account: 'aws_access_key' and 'aws_secret_key' can only be set when 'aws_iam' is false and 'cloud_type' is AWS (1)")
@@ -605,8 +663,10 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			if _, ok := d.GetOk("aws_role_ec2"); !ok {
 				account.AwsRoleEc2 = fmt.Sprintf("arn:aws:iam::%s:role/aviatrix-role-ec2", account.AwsAccountNumber)
 			}
-			log.Printf("[TRACE] Reading Aviatrix account aws_role_app: [%s]", d.Get("aws_role_app").(string))
-			log.Printf("[TRACE] Reading Aviatrix account aws_role_ec2: [%s]", d.Get("aws_role_ec2").(string))
+			logTrace(ctx, "Reading Aviatrix account AWS roles", map[string]interface{}{
+				"aws_role_app": d.Get("aws_role_app").(string),
+				"aws_role_ec2": d.Get("aws_role_ec2").(string),
+			})
 		} else {
 			if account.AwsRoleApp != "" || account.AwsRoleEc2 != "" {
 				return diag.Errorf("could not create Aviatrix Account: 'aws_role_app' and 'aws_role_ec2' can only be set when 'aws_iam' is true and 'cloud_type' is AWS (1)")
@@ -619,7 +679,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		if account.GcloudProjectCredentialsFilepathLocal == "" {
 			return diag.Errorf("gcloud project credentials local filepath needed to upload file to controller")
 		}
-		log.Printf("[INFO] Creating Aviatrix account: %#v", account)
+		logInfo(ctx, "Creating Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 	} else if account.CloudType == goaviatrix.Azure {
 		if account.ArmSubscriptionId == "" {
 			return diag.Errorf("arm subscription id needed for azure cloud")
@@ -719,13 +779,13 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	} else if account.CloudType == goaviatrix.AliCloud {
 		if account.AlicloudAccountId == "" {
-			return diag.Errorf("alicloud_account_id is required for alibaba cloud")
+			return attributeErrorf("alicloud_account_id", "alicloud_account_id is required for alibaba cloud")
 		}
 		if account.AlicloudAccessKey == "" {
-			return diag.Errorf("alicloud_access_key is required for alibaba cloud")
+			return attributeErrorf("alicloud_access_key", "alicloud_access_key is required for alibaba cloud")
 		}
 		if account.AlicloudSecretKey == "" {
-			return diag.Errorf("alicloud_secret_key is required for alibaba cloud")
+			return attributeErrorf("alicloud_secret_key", "alicloud_secret_key is required for alibaba cloud")
 		}
 	} else if account.CloudType == goaviatrix.AWSTS {
 		if account.AwsTsAccountNumber == "" {
@@ -784,13 +844,13 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		//if edgeAccount.EdgeCSPPassword == "" {
 		//	return diag.Errorf("edge_csp_password is required to create an Aviatrix account for Edge CSP")
 		//}
-		if !((d.Get("edge_csp_username").(string) != "" && d.Get("edge_csp_password").(string) != "") ||
-			(d.Get("edge_zededa_username").(string) != "" && d.Get("edge_zededa_password").(string) != "")) {
-			return diag.Errorf("edge_csp_username and edge_csp_password are required to create an Aviatrix account for Edge CSP, " +
+		if !((d.Get("edge_csp_username").(string) != "" && getWriteOnlyString(d, "edge_csp_password") != "") ||
+			(d.Get("edge_zededa_username").(string) != "" && getWriteOnlyString(d, "edge_zededa_password") != "")) {
+			return attributeErrorf("edge_csp_username", "edge_csp_username and edge_csp_password are required to create an Aviatrix account for Edge CSP, "+
 				"edge_zededa_username and edge_zededa_password are required to create an Aviatrix account for Edge Zededa")
 		}
 	} else if account.CloudType == goaviatrix.EDGEEQUINIX || account.CloudType == goaviatrix.EDGENEO {
-		log.Printf("no check is needed to create an Aviatrix account for Edge Equinix and Edge NEO")
+		logDebug(ctx, "No check is needed to create an Aviatrix account for Edge Equinix and Edge NEO")
 	} else {
 		return diag.Errorf("cloud type can only be either AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384), AWS Secret (32768), Edge CSP/Zededa (65536), Edge Equinix (524288) or Edge NEO/Platform (262144)")
 	}
@@ -833,7 +893,7 @@ func resourceAviatrixAccountReadIfRequired(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(goaviatrix.ClientInterface)
+	client := meta.(goaviatrix.AccountClient)
 
 	var diags diag.Diagnostics
 
@@ -841,7 +901,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 	isImport := accountName == ""
 	if isImport {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no account name received", map[string]interface{}{"import_id": id})
 		d.Set("account_name", id)
 		d.SetId(id)
 	}
@@ -850,7 +910,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 		AccountName: d.Get("account_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account: %#v", account)
+	logInfo(ctx, "Looking for Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
 	acc, err := client.GetAccount(account)
 	if err != nil {
@@ -862,6 +922,10 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if acc != nil {
+		// Secrets are write-only, drop the values and hashes stored by earlier provider versions
+		for _, attribute := range accountSecrets {
+			d.Set(attribute, "")
+		}
 		d.Set("account_name", acc.AccountName)
 		d.Set("cloud_type", acc.CloudType)
 		if acc.CloudType == goaviatrix.AWS {
@@ -869,7 +933,6 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 			if acc.AwsRoleEc2 != "" {
 				//force default setting and save to .tfstate file
 				d.Set("aws_access_key", "")
-				d.Set("aws_secret_key", "")
 				d.Set("aws_iam", true)
 				d.Set("aws_role_app", acc.AwsRoleApp)
 				d.Set("aws_role_ec2", acc.AwsRoleEc2)
@@ -886,7 +949,6 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 			d.Set("awsgov_account_number", acc.AwsgovAccountNumber)
 			if acc.AwsgovRoleEc2 != "" {
 				d.Set("awsgov_access_key", "")
-				d.Set("awsgov_secret_key", "")
 				d.Set("awsgov_iam", true)
 				d.Set("awsgov_role_app", acc.AwsgovRoleApp)
 				d.Set("awsgov_role_ec2", acc.AwsgovRoleEc2)
@@ -902,7 +964,6 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 			if acc.AwsChinaRoleEc2 != "" {
 				// Force access key and secret key to be empty
 				d.Set("awschina_access_key", "")
-				d.Set("awschina_secret_key", "")
 				d.Set("awschina_iam", true)
 				d.Set("awschina_role_app", acc.AwsChinaRoleApp)
 				d.Set("awschina_role_ec2", acc.AwsChinaRoleEc2)
@@ -936,9 +997,9 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 			d.Set("awss_cap_cert_key_path", acc.AwsSCapCertKeyPath)
 			d.Set("aws_ca_cert_path", acc.AwsCaCertPath)
 		} else if acc.CloudType == goaviatrix.EDGECSP {
-			if d.Get("edge_csp_password").(string) != "" {
+			if d.Get("edge_csp_username").(string) != "" {
 				d.Set("edge_csp_username", acc.EdgeCSPUsername)
-			} else if d.Get("edge_zededa_password").(string) != "" {
+			} else if d.Get("edge_zededa_username").(string) != "" {
 				d.Set("edge_zededa_username", acc.EdgeCSPUsername)
 			} else {
 				// let user choose when importing CSP/Zededa account
@@ -968,7 +1029,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AccountClient)
 
 	account := &goaviatrix.Account{
 		AccountName:                           d.Get("account_name").(string),
@@ -979,38 +1040,38 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		AwsGatewayRoleApp:                     d.Get("aws_gateway_role_app").(string),
 		AwsGatewayRoleEc2:                     d.Get("aws_gateway_role_ec2").(string),
 		AwsAccessKey:                          d.Get("aws_access_key").(string),
-		AwsSecretKey:                          d.Get("aws_secret_key").(string),
+		AwsSecretKey:                          getWriteOnlyString(d, "aws_secret_key"),
 		AwsgovAccountNumber:                   d.Get("awsgov_account_number").(string),
 		AwsgovRoleApp:                         d.Get("awsgov_role_app").(string),
 		AwsgovRoleEc2:                         d.Get("awsgov_role_ec2").(string),
 		AwsgovAccessKey:                       d.Get("awsgov_access_key").(string),
-		AwsgovSecretKey:                       d.Get("awsgov_secret_key").(string),
+		AwsgovSecretKey:                       getWriteOnlyString(d, "awsgov_secret_key"),
 		GcloudProjectName:                     d.Get("gcloud_project_id").(string),
 		GcloudProjectCredentialsFilepathLocal: d.Get("gcloud_project_credentials_filepath").(string),
 		ArmSubscriptionId:                     d.Get("arm_subscription_id").(string),
 		ArmApplicationEndpoint:                d.Get("arm_directory_id").(string),
 		ArmApplicationClientId:                d.Get("arm_application_id").(string),
-		ArmApplicationClientSecret:            d.Get("arm_application_key").(string),
+		ArmApplicationClientSecret:            getWriteOnlyString(d, "arm_application_key"),
 		AzuregovSubscriptionId:                d.Get("azuregov_subscription_id").(string),
 		AzuregovApplicationEndpoint:           d.Get("azuregov_directory_id").(string),
 		AzuregovApplicationClientId:           d.Get("azuregov_application_id").(string),
-		AzuregovApplicationClientSecret:       d.Get("azuregov_application_key").(string),
+		AzuregovApplicationClientSecret:       getWriteOnlyString(d, "azuregov_application_key"),
 		OciTenancyID:                          d.Get("oci_tenancy_id").(string),
 		OciUserID:                             d.Get("oci_user_id").(string),
 		OciCompartmentID:                      d.Get("oci_compartment_id").(string),
 		OciApiPrivateKeyFilePath:              d.Get("oci_api_private_key_filepath").(string),
 		AlicloudAccountId:                     d.Get("alicloud_account_id").(string),
 		AlicloudAccessKey:                     d.Get("alicloud_access_key").(string),
-		AlicloudSecretKey:                     d.Get("alicloud_secret_key").(string),
+		AlicloudSecretKey:                     getWriteOnlyString(d, "alicloud_secret_key"),
 		AwsChinaAccountNumber:                 d.Get("awschina_account_number").(string),
 		AwsChinaRoleApp:                       d.Get("awschina_role_app").(string),
 		AwsChinaRoleEc2:                       d.Get("awschina_role_ec2").(string),
 		AwsChinaAccessKey:                     d.Get("awschina_access_key").(string),
-		AwsChinaSecretKey:                     d.Get("awschina_secret_key").(string),
+		AwsChinaSecretKey:                     getWriteOnlyString(d, "awschina_secret_key"),
 		AzureChinaSubscriptionId:              d.Get("azurechina_subscription_id").(string),
 		AzureChinaApplicationEndpoint:         d.Get("azurechina_directory_id").(string),
 		AzureChinaApplicationClientId:         d.Get("azurechina_application_id").(string),
-		AzureChinaApplicationClientSecret:     d.Get("azurechina_application_key").(string),
+		AzureChinaApplicationClientSecret:     getWriteOnlyString(d, "azurechina_application_key"),
 		AwsTsAccountNumber:                    d.Get("awsts_account_number").(string),
 		AwsTsCapUrl:                           d.Get("awsts_cap_url").(string),
 		AwsTsCapAgency:                        d.Get("awsts_cap_agency").(string),
@@ -1038,11 +1099,11 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		AccountName:     d.Get("account_name").(string),
 		CloudType:       d.Get("cloud_type").(int),
 		EdgeCSPUsername: d.Get("edge_csp_username").(string),
-		EdgeCSPPassword: d.Get("edge_csp_password").(string),
+		EdgeCSPPassword: getWriteOnlyString(d, "edge_csp_password"),
 	}
-	if d.Get("edge_zededa_username").(string) != "" && d.Get("edge_zededa_password").(string) != "" {
+	if d.Get("edge_zededa_username").(string) != "" && getWriteOnlyString(d, "edge_zededa_password") != "" {
 		edgeAccount.EdgeCSPUsername = d.Get("edge_zededa_username").(string)
-		edgeAccount.EdgeCSPPassword = d.Get("edge_zededa_password").(string)
+		edgeAccount.EdgeCSPPassword = getWriteOnlyString(d, "edge_zededa_password")
 	}
 
 	awsIam := d.Get("aws_iam").(bool)
@@ -1054,7 +1115,7 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	awsChinaIam := d.Get("awschina_iam").(bool)
 	account.AwsChinaIam = strconv.FormatBool(awsChinaIam)
 
-	log.Printf("[INFO] Updating Aviatrix account: %#v", account)
+	logInfo(ctx, "Updating Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
 	d.Partial(true)
 
@@ -1116,10 +1177,10 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSS) && (account.AwsSAccountNumber != "" || account.AwsSCapUrl != "" || account.AwsSCapAgency != "" || account.AwsSCapAccountName != "" || account.AwsSCapRoleName != "" || account.AwsSCapCert != "" || account.AwsSCapCertKey != "" || account.AwsSCaChainCert != "") {
 		return diag.Errorf("could not update Aviatrix Account: 'awss_account_number', 'awss_cap_url', 'awss_cap_agency', 'awss_cap_account_name', 'awss_cap_role_name', awss_cap_cert', 'awss_cap_cert_key' and 'awss_ca_chain_cert' can only be set when 'cloud_type' is AWS Secret Region (32768)")
 	}
-	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_csp_username").(string) != "" || d.Get("edge_csp_password").(string) != "") {
+	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_csp_username").(string) != "" || getWriteOnlyString(d, "edge_csp_password") != "") {
 		return diag.Errorf("could not update Aviatrix Account: 'edge_csp_username' and 'edge_csp_password' can only be set when 'cloud_type' is Edge CSP (65536)")
 	}
-	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_zededa_username").(string) != "" || d.Get("edge_zededa_password").(string) != "") {
+	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (d.Get("edge_zededa_username").(string) != "" || getWriteOnlyString(d, "edge_zededa_password") != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'edge_zededa_username' and 'edge_zededa_password' can only be set when 'cloud_type' is Edge Zededa (65536)")
 	}
 
@@ -1130,21 +1191,21 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("could not update Aviatrix Account: 'aws_role_app' and 'aws_role_ec2' can only be set when 'aws_iam' is true and 'cloud_type' is AWS (1)")
 		}
 
-		if d.HasChanges("aws_account_number", "aws_access_key", "aws_secret_key", "aws_iam", "aws_role_app", "aws_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
+		if d.HasChanges("aws_account_number", "aws_access_key", "aws_secret_key_version", "aws_iam", "aws_role_app", "aws_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.GCP {
-		if d.HasChange("gcloud_project_id") || d.HasChange("gcloud_project_credentials_filepath") {
+		if d.HasChanges("gcloud_project_id", "gcloud_project_credentials_filepath", "gcloud_project_credentials_version") {
 			err := client.UpdateGCPAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.Azure {
-		if d.HasChange("arm_subscription_id") || d.HasChange("arm_directory_id") || d.HasChange("arm_application_id") || d.HasChange("arm_application_key") {
+		if d.HasChange("arm_subscription_id") || d.HasChange("arm_directory_id") || d.HasChange("arm_application_id") || d.HasChange("arm_application_key_version") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
@@ -1161,21 +1222,21 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("could not update Aviatrix Account: 'awsgov_role_app' and 'awsgov_role_ec2' can only be set when 'awsgov_iam' is true and 'cloud_type' is AWSGov (256)")
 		}
 
-		if d.HasChanges("awsgov_account_number", "awsgov_access_key", "awsgov_secret_key", "awsgov_iam", "awsgov_role_app", "awsgov_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
+		if d.HasChanges("awsgov_account_number", "awsgov_access_key", "awsgov_secret_key_version", "awsgov_iam", "awsgov_role_app", "awsgov_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.AzureGov {
-		if d.HasChanges("azuregov_subscription_id", "azuregov_directory_id", "azuregov_application_id", "azuregov_application_key") {
+		if d.HasChanges("azuregov_subscription_id", "azuregov_directory_id", "azuregov_application_id", "azuregov_application_key_version") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Azure GOV Aviatrix Account: %v", err)
 			}
 		}
 	} else if goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSChina) {
-		if d.HasChanges("awschina_iam", "awschina_role_app", "awschina_role_ec2", "awschina_access_key", "awschina_secret_key", "aws_gateway_role_app", "aws_gateway_role_ec2") {
+		if d.HasChanges("awschina_iam", "awschina_role_app", "awschina_role_ec2", "awschina_access_key", "awschina_secret_key_version", "aws_gateway_role_app", "aws_gateway_role_ec2") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update AWSChina Aviatrix Account: %v", err)
@@ -1188,14 +1249,14 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("could not update Aviatrix Account: 'awschina_role_app' and 'awschina_role_ec2' can only be set when 'awschina_iam' is true and 'cloud_type' is AWSChina (1024)")
 		}
 
-		if d.HasChanges("azurechina_subscription_id", "azurechina_directory_id", "azurechina_application_id", "azurechina_application_key") {
+		if d.HasChanges("azurechina_subscription_id", "azurechina_directory_id", "azurechina_application_id", "azurechina_application_key_version") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update AzureChina Aviatrix Account: %v", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.AliCloud {
-		if d.HasChange("alicloud_account_id") || d.HasChange("alicloud_access_key") || d.HasChange("alicloud_secret_key") {
+		if d.HasChange("alicloud_account_id") || d.HasChange("alicloud_access_key") || d.HasChange("alicloud_secret_key_version") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
@@ -1230,14 +1291,12 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 	} else if account.CloudType == goaviatrix.EDGECSP {
-		if d.HasChange("edge_csp_username") || d.HasChange("edge_csp_password") {
+		if d.HasChanges("edge_csp_username", "edge_csp_password_version") {
 			err := client.UpdateEdgeAccount(edgeAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Edge CSP Account: %s", err)
 			}
-		}
-	} else if account.CloudType == goaviatrix.EDGECSP {
-		if d.HasChange("edge_zededa_username") || d.HasChange("edge_zededa_password") {
+		} else if d.HasChanges("edge_zededa_username", "edge_zededa_password_version") {
 			err := client.UpdateEdgeAccount(edgeAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Edge Zededa Account: %s", err)
@@ -1251,12 +1310,12 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 
 // for now, deleting gcp account will not delete the credential file
 func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(goaviatrix.ClientInterface)
+	client := meta.(goaviatrix.AccountClient)
 	account := &goaviatrix.Account{
 		AccountName: d.Get("account_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix account: %#v", account)
+	logInfo(ctx, "Deleting Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
 	err := client.DeleteAccount(account)
 	if err != nil {
@@ -1266,6 +1325,42 @@ func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// accountSecrets are the account credentials sent to the controller but never stored in the state.
+// Changing one of them is not detected, its "_version" attribute must be changed to update it.
+//
+// terraform-plugin-sdk v2.19 has no write-only attributes, the secrets are still sent to the
+// provider in the plan and the configuration.
+var accountSecrets = []string{
+	"aws_secret_key",
+	"awsgov_secret_key",
+	"arm_application_key",
+	"azuregov_application_key",
+	"alicloud_secret_key",
+	"awschina_secret_key",
+	"azurechina_application_key",
+	"edge_csp_password",
+	"edge_zededa_password",
+}
+
+// suppressWriteOnlyDiff suppresses every diff of a write-only attribute, its value is read from the
+// configuration with getWriteOnlyString and the state only ever holds an empty value.
+func suppressWriteOnlyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return true
+}
+
+// getWriteOnlyString returns the configured value of a top level write-only string attribute.
+func getWriteOnlyString(d *schema.ResourceData, attribute string) string {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(attribute) {
+		return ""
+	}
+	value := config.GetAttr(attribute)
+	if value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+	return value.AsString()
+}
+
 // Validate account number string is 12 digits
 func validateAwsAccountNumber(val interface{}, key string) (warns []string, errs []error) {
 	v := val.(string)
@@ -215,7 +215,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "account_name", fmt.Sprintf("tfa-aws-%d", rInt)),
 						resource.TestCheckResourceAttr(resourceName, "aws_iam", "false"),
 						resource.TestCheckResourceAttr(resourceName, "aws_access_key", os.Getenv("AWS_ACCESS_KEY")),
-						resource.TestCheckResourceAttr(resourceName, "aws_secret_key", os.Getenv("AWS_SECRET_KEY")),
+						resource.TestCheckResourceAttr(resourceName, "aws_secret_key", ""),
 					),
 				},
 				{
@@ -277,7 +277,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "arm_subscription_id", os.Getenv("ARM_SUBSCRIPTION_ID")),
 						resource.TestCheckResourceAttr(resourceName, "arm_directory_id", os.Getenv("ARM_DIRECTORY_ID")),
 						resource.TestCheckResourceAttr(resourceName, "arm_application_id", os.Getenv("ARM_APPLICATION_ID")),
-						resource.TestCheckResourceAttr(resourceName, "arm_application_key", os.Getenv("ARM_APPLICATION_KEY")),
+						resource.TestCheckResourceAttr(resourceName, "arm_application_key", ""),
 					),
 				},
 				{
@@ -344,7 +344,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "azuregov_subscription_id", os.Getenv("AZUREGOV_SUBSCRIPTION_ID")),
 						resource.TestCheckResourceAttr(resourceName, "azuregov_directory_id", os.Getenv("AZUREGOV_DIRECTORY_ID")),
 						resource.TestCheckResourceAttr(resourceName, "azuregov_application_id", os.Getenv("AZUREGOV_APPLICATION_ID")),
-						resource.TestCheckResourceAttr(resourceName, "azuregov_application_key", os.Getenv("AZUREGOV_APPLICATION_KEY")),
+						resource.TestCheckResourceAttr(resourceName, "azuregov_application_key", ""),
 					),
 				},
 				{
@@ -378,7 +378,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "account_name", fmt.Sprintf("tfa-awsgov-%d", rInt)),
 						resource.TestCheckResourceAttr(resourceName, "awsgov_account_number", os.Getenv("AWSGOV_ACCOUNT_NUMBER")),
 						resource.TestCheckResourceAttr(resourceName, "awsgov_access_key", os.Getenv("AWSGOV_ACCESS_KEY")),
-						resource.TestCheckResourceAttr(resourceName, "awsgov_secret_key", os.Getenv("AWSGOV_SECRET_KEY")),
+						resource.TestCheckResourceAttr(resourceName, "awsgov_secret_key", ""),
 					),
 				},
 				{
@@ -485,7 +485,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "awschina_account_number", os.Getenv("AWSCHINA_IAM_ACCOUNT_NUMBER")),
 						resource.TestCheckResourceAttr(resourceName, "awschina_iam", "false"),
 						resource.TestCheckResourceAttr(resourceName, "awschina_access_key", os.Getenv("AWSCHINA_ACCESS_KEY")),
-						resource.TestCheckResourceAttr(resourceName, "awschina_secret_key", os.Getenv("AWSCHINA_SECRET_KEY")),
+						resource.TestCheckResourceAttr(resourceName, "awschina_secret_key", ""),
 					),
 				},
 				{
@@ -518,7 +518,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "azurechina_subscription_id", os.Getenv("AZURECHINA_SUBSCRIPTION_ID")),
 						resource.TestCheckResourceAttr(resourceName, "azurechina_directory_id", os.Getenv("AZURECHINA_DIRECTORY_ID")),
 						resource.TestCheckResourceAttr(resourceName, "azurechina_application_id", os.Getenv("AZURECHINA_APPLICATION_ID")),
-						resource.TestCheckResourceAttr(resourceName, "azurechina_application_key", os.Getenv("AZURECHINA_APPLICATION_KEY")),
+						resource.TestCheckResourceAttr(resourceName, "azurechina_application_key", ""),
 					),
 				},
 				{
@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"unicode"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAccountUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAccountUserCreate,
-		Read:   resourceAviatrixAccountUserRead,
-		Update: resourceAviatrixAccountUserUpdate,
-		Delete: resourceAviatrixAccountUserDelete,
+		CreateContext: resourceAviatrixAccountUserCreate,
+		ReadContext:   resourceAviatrixAccountUserRead,
+		UpdateContext: resourceAviatrixAccountUserUpdate,
+		DeleteContext: resourceAviatrixAccountUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,8 +52,8 @@ func resourceAviatrixAccountUser() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAccountUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAccountUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	user := &goaviatrix.AccountUser{
 		Password: d.Get("password").(string),
@@ -60,37 +61,37 @@ func resourceAviatrixAccountUserCreate(d *schema.ResourceData, meta interface{})
 		UserName: d.Get("username").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix account user: %#v", user)
+	logInfo(ctx, "Creating Aviatrix account user", map[string]interface{}{"user_name": user.UserName, "account_name": user.AccountName})
 
 	d.SetId(user.UserName)
 	flag := false
-	defer resourceAviatrixAccountUserReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAccountUserReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateAccountUser(user)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Account User: %s", err)
+		return diag.Errorf("failed to create Aviatrix Account User: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix account user %s created", user.UserName)
+	logDebug(ctx, "Aviatrix account user created", map[string]interface{}{"user_name": user.UserName})
 
-	return resourceAviatrixAccountUserReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAccountUserReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAccountUserReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAccountUserReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAccountUserRead(d, meta)
+		return resourceAviatrixAccountUserRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAccountUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	userName := d.Get("username").(string)
 	if userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"import_id": id})
 		d.Set("username", id)
 		d.SetId(id)
 	}
@@ -99,7 +100,7 @@ func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta interface{}) e
 		UserName: d.Get("username").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account user: %#v", user)
+	logInfo(ctx, "Looking for Aviatrix account user", map[string]interface{}{"user_name": user.UserName, "account_name": user.AccountName})
 
 	acc, err := client.GetAccountUser(user)
 	if err != nil {
@@ -107,7 +108,7 @@ func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("aviatrix Account User: %s", err)
+		return diag.Errorf("aviatrix Account User: %s", err)
 	}
 	if acc != nil {
 		d.Set("email", acc.Email)
@@ -118,8 +119,8 @@ func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceAviatrixAccountUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAccountUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	user := &goaviatrix.AccountUserEdit{
 		Email:    d.Get("email").(string),
@@ -128,41 +129,41 @@ func resourceAviatrixAccountUserUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Updating Aviatrix account user: %#v", user)
+	logInfo(ctx, "Updating Aviatrix account user", map[string]interface{}{"user_name": user.UserName, "account_name": user.AccountName})
 
 	if d.HasChange("username") {
-		return fmt.Errorf("update username is not allowed")
+		return diag.Errorf("update username is not allowed")
 	}
 
 	if d.HasChange("email") {
 		_, n := d.GetChange("email")
 		if n == nil {
-			return fmt.Errorf("failed to updater Aviatrix Account User: email is required")
+			return diag.Errorf("failed to updater Aviatrix Account User: email is required")
 		}
 		user.Email = n.(string)
 		user.What = "email"
 		err := client.UpdateAccountUserObject(user)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Account User: %s", err)
+			return diag.Errorf("failed to update Aviatrix Account User: %s", err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAccountUserRead(d, meta)
+	return resourceAviatrixAccountUserRead(ctx, d, meta)
 }
 
-func resourceAviatrixAccountUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAccountUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	user := &goaviatrix.AccountUser{
 		UserName: d.Get("username").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix account user: %#v", user)
+	logInfo(ctx, "Deleting Aviatrix account user", map[string]interface{}{"user_name": user.UserName, "account_name": user.AccountName})
 
 	err := client.DeleteAccountUser(user)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Account User: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Account User: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAWSPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSPeerCreate,
-		Read:   resourceAviatrixAWSPeerRead,
-		Delete: resourceAviatrixAWSPeerDelete,
+		CreateContext: resourceAviatrixAWSPeerCreate,
+		ReadContext:   resourceAviatrixAWSPeerRead,
+		DeleteContext: resourceAviatrixAWSPeerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,8 +83,8 @@ func resourceAviatrixAWSPeer() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSPeerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.NetworkingClient)
 
 	awsPeer := &goaviatrix.AWSPeer{
 		AccountName1: d.Get("account_name1").(string),
@@ -106,36 +106,37 @@ func resourceAviatrixAWSPeerCreate(d *schema.ResourceData, meta interface{}) err
 		awsPeer.RtbList2 = "all"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix aws_peer: %#v", awsPeer)
+	logInfo(ctx, "Creating Aviatrix aws_peer", map[string]interface{}{"vpc_id1": awsPeer.VpcID1, "vpc_id2": awsPeer.VpcID2})
 
 	d.SetId(awsPeer.VpcID1 + "~" + awsPeer.VpcID2)
 	flag := false
-	defer resourceAviatrixAWSPeerReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSPeerReadIfRequired(ctx, d, meta, &flag)
 
 	_, err := client.CreateAWSPeer(awsPeer)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWSPeer: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWSPeer: %s", err)
 	}
 
-	return resourceAviatrixAWSPeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSPeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSPeerReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSPeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSPeerRead(d, meta)
+		return resourceAviatrixAWSPeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.NetworkingClient)
+	var diags diag.Diagnostics
 
 	vpcID1 := d.Get("vpc_id1").(string)
 	vpcID2 := d.Get("vpc_id2").(string)
 	if vpcID1 == "" || vpcID2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc id received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc id received", map[string]interface{}{"import_id": id})
 		d.Set("vpc_id1", strings.Split(id, "~")[0])
 		d.Set("vpc_id2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -152,10 +153,10 @@ func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix AWSPeer: %s", err)
+		return diag.Errorf("couldn't find Aviatrix AWSPeer: %s", err)
 	}
 
-	log.Printf("[TRACE] Reading aws_peer: %#v", ap)
+	logTrace(ctx, "Reading aws_peer", map[string]interface{}{"vpc_id1": ap.VpcID1, "vpc_id2": ap.VpcID2})
 
 	if ap != nil {
 		d.Set("vpc_id1", ap.VpcID1)
@@ -166,28 +167,28 @@ func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("vpc_reg2", ap.Region2)
 
 		if err := d.Set("rtb_list1", strings.Split(ap.RtbList1, ",")); err != nil {
-			log.Printf("[WARN] Error setting rtb_list1 for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("rtb_list1", "could not set rtb_list1 into state", err))
 		}
 		if err := d.Set("rtb_list2", strings.Split(ap.RtbList2, ",")); err != nil {
-			log.Printf("[WARN] Error setting rtb_list2 for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("rtb_list2", "could not set rtb_list2 into state", err))
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixAWSPeerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.NetworkingClient)
 	awsPeer := &goaviatrix.AWSPeer{
 		VpcID1: d.Get("vpc_id1").(string),
 		VpcID2: d.Get("vpc_id2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix aws_peer: %#v", awsPeer)
+	logInfo(ctx, "Deleting Aviatrix aws_peer", map[string]interface{}{"vpc_id1": awsPeer.VpcID1, "vpc_id2": awsPeer.VpcID2})
 
 	err := client.DeleteAWSPeer(awsPeer)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix AWSPeer: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AWSPeer: %s", err)
 	}
 
 	return nil
@@ -1,22 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixAWSTgw() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwCreate,
-		Read:   resourceAviatrixAWSTgwRead,
-		Update: resourceAviatrixAWSTgwUpdate,
-		Delete: resourceAviatrixAWSTgwDelete,
+		CreateContext: resourceAviatrixAWSTgwCreate,
+		ReadContext:   resourceAviatrixAWSTgwRead,
+		UpdateContext: resourceAviatrixAWSTgwUpdate,
+		DeleteContext: resourceAviatrixAWSTgwDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 3,
@@ -34,6 +34,12 @@ func resourceAviatrixAWSTgw() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tgw_name": {
 				Type:        schema.TypeString,
@@ -96,8 +102,8 @@ func resourceAviatrixAWSTgw() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	awsTgw := &goaviatrix.AWSTgw{
 		Name:                    d.Get("tgw_name").(string),
@@ -111,61 +117,61 @@ func resourceAviatrixAWSTgwCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if awsTgw.Name == "" {
-		return fmt.Errorf("tgw name can't be empty string")
+		return diag.Errorf("tgw name can't be empty string")
 	}
 	if awsTgw.AccountName == "" {
-		return fmt.Errorf("account name can't be empty string")
+		return diag.Errorf("account name can't be empty string")
 	}
 	if awsTgw.Region == "" {
-		return fmt.Errorf("tgw region can't be empty string")
+		return diag.Errorf("tgw region can't be empty string")
 	}
 	if awsTgw.AwsSideAsNumber == "" {
-		return fmt.Errorf("aws side number can't be empty string")
+		return diag.Errorf("aws side number can't be empty string")
 	}
 
-	log.Printf("[INFO] Creating AWS TGW")
+	logInfo(ctx, "Creating AWS TGW")
 
 	d.SetId(awsTgw.Name)
 	flag := false
-	defer resourceAviatrixAWSTgwReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSTgwReadIfRequired(ctx, d, meta, &flag)
 
-	err1 := client.CreateAWSTgw(awsTgw)
+	err1 := client.CreateAWSTgw(ctx, awsTgw)
 	if err1 != nil {
-		return fmt.Errorf("failed to create AWS TGW: %s", err1)
+		return diag.Errorf("failed to create AWS TGW: %s", err1)
 	}
 
 	if cidrs := getStringSet(d, "cidrs"); len(cidrs) != 0 {
 		err := client.UpdateTGWCidrs(awsTgw.Name, cidrs)
 		if err != nil {
-			return fmt.Errorf("could not update TGW CIDRs after creation: %v", err)
+			return diag.Errorf("could not update TGW CIDRs after creation: %v", err)
 		}
 	}
 
 	if awsTgw.InspectionMode == "Connection-based" {
 		err := client.UpdateTGWInspectionMode(awsTgw.Name, awsTgw.InspectionMode)
 		if err != nil {
-			return fmt.Errorf("could not update TGW inspection mode after creation: %v", err)
+			return diag.Errorf("could not update TGW inspection mode after creation: %v", err)
 		}
 	}
 
-	return resourceAviatrixAWSTgwReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSTgwReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwRead(d, meta)
+		return resourceAviatrixAWSTgwRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	tgwName := d.Get("tgw_name").(string)
 	if tgwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws tgw name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no aws tgw name received", map[string]interface{}{"import_id": id})
 		d.Set("tgw_name", id)
 		d.SetId(id)
 	}
@@ -179,7 +185,7 @@ func resourceAviatrixAWSTgwRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find AWS TGW %s: %v", awsTgw.Name, err)
+		return diag.Errorf("couldn't find AWS TGW %s: %v", awsTgw.Name, err)
 	}
 	d.Set("account_name", awsTgw.AccountName)
 	d.Set("tgw_name", awsTgw.Name)
@@ -190,16 +196,16 @@ func resourceAviatrixAWSTgwRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("tgw_id", awsTgw.TgwId)
 	d.Set("inspection_mode", awsTgw.InspectionMode)
 	if err := d.Set("cidrs", awsTgw.CidrList); err != nil {
-		return fmt.Errorf("could not set aws_tgw.cidrs into state: %v", err)
+		return diag.Errorf("could not set aws_tgw.cidrs into state: %v", err)
 	}
 
 	return nil
 }
 
-func resourceAviatrixAWSTgwUpdate(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[INFO] Updating AWS TGW")
+func resourceAviatrixAWSTgwUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logInfo(ctx, "Updating AWS TGW")
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 	awsTgw := &goaviatrix.AWSTgw{
 		Name:        d.Get("tgw_name").(string),
 		AccountName: d.Get("account_name").(string),
@@ -209,40 +215,40 @@ func resourceAviatrixAWSTgwUpdate(d *schema.ResourceData, meta interface{}) erro
 	d.Partial(true)
 
 	if d.HasChange("account_name") {
-		return fmt.Errorf("updating account_name is not allowed")
+		return diag.Errorf("updating account_name is not allowed")
 	}
 	if d.HasChange("region") {
-		return fmt.Errorf("updating region is not allowed")
+		return diag.Errorf("updating region is not allowed")
 	}
 	if d.HasChange("cloud_type") {
-		return fmt.Errorf("updating cloud_type is not allowed")
+		return diag.Errorf("updating cloud_type is not allowed")
 	}
 	if d.HasChange("enable_multicast") {
-		return fmt.Errorf("updating enable_multicast is not allowed")
+		return diag.Errorf("updating enable_multicast is not allowed")
 	}
 
 	if d.HasChange("cidrs") {
 		cidrs := getStringSet(d, "cidrs")
 		err := client.UpdateTGWCidrs(awsTgw.Name, cidrs)
 		if err != nil {
-			return fmt.Errorf("could not update TGW CIDRs during update: %v", err)
+			return diag.Errorf("could not update TGW CIDRs during update: %v", err)
 		}
 	}
 
 	if d.HasChange("inspection_mode") {
 		err := client.UpdateTGWInspectionMode(awsTgw.Name, d.Get("inspection_mode").(string))
 		if err != nil {
-			return fmt.Errorf("could not update TGW inspection mode during update: %v", err)
+			return diag.Errorf("could not update TGW inspection mode during update: %v", err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(awsTgw.Name)
-	return resourceAviatrixAWSTgwRead(d, meta)
+	return resourceAviatrixAWSTgwRead(ctx, d, meta)
 }
 
-func resourceAviatrixAWSTgwDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 	awsTgw := &goaviatrix.AWSTgw{
 		Name:                      d.Get("tgw_name").(string),
 		AccountName:               d.Get("account_name").(string),
@@ -252,15 +258,15 @@ func resourceAviatrixAWSTgwDelete(d *schema.ResourceData, meta interface{}) erro
 		SecurityDomains:           make([]goaviatrix.SecurityDomainRule, 0),
 	}
 
-	log.Printf("[INFO] Deleting AWS TGW")
+	logInfo(ctx, "Deleting AWS TGW")
 
-	err := client.DeleteAWSTgw(awsTgw)
+	err := client.DeleteAWSTgw(ctx, awsTgw)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't destroy AWS TGW %s: %v", awsTgw.Name, err)
+		return diag.Errorf("couldn't destroy AWS TGW %s: %v", awsTgw.Name, err)
 	}
 
 	return nil
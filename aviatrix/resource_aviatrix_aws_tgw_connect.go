@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -12,13 +11,18 @@ import (
 
 func resourceAviatrixAwsTgwConnect() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixAwsTgwConnectCreate,
-		ReadWithoutTimeout:   resourceAviatrixAwsTgwConnectRead,
-		DeleteWithoutTimeout: resourceAviatrixAwsTgwConnectDelete,
+		CreateContext: resourceAviatrixAwsTgwConnectCreate,
+		ReadContext:   resourceAviatrixAwsTgwConnectRead,
+		DeleteContext: resourceAviatrixAwsTgwConnectDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tgw_name": {
 				Type:        schema.TypeString,
@@ -68,7 +72,7 @@ func marshalAwsTgwConnectInput(d *schema.ResourceData) *goaviatrix.AwsTgwConnect
 }
 
 func resourceAviatrixAwsTgwConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	connect := marshalAwsTgwConnectInput(d)
 
@@ -81,13 +85,13 @@ func resourceAviatrixAwsTgwConnectCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixAwsTgwConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	connectionName := d.Get("connection_name").(string)
 	tgwName := d.Get("tgw_name").(string)
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws_tgw_connect connection_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no aws_tgw_connect connection_name received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received for aws_tgw_connect, ID must be in the form tgw_name~~connection_name")
@@ -122,7 +126,7 @@ func resourceAviatrixAwsTgwConnectRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixAwsTgwConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	connect := marshalAwsTgwConnectInput(d)
 	connect.ConnectAttachmentID = d.Get("connect_attachment_id").(string)
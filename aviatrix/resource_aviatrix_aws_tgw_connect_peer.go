@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,13 +12,18 @@ import (
 
 func resourceAviatrixAwsTgwConnectPeer() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixAwsTgwConnectPeerCreate,
-		ReadWithoutTimeout:   resourceAviatrixAwsTgwConnectPeerRead,
-		DeleteWithoutTimeout: resourceAviatrixAwsTgwConnectPeerDelete,
+		CreateContext: resourceAviatrixAwsTgwConnectPeerCreate,
+		ReadContext:   resourceAviatrixAwsTgwConnectPeerRead,
+		DeleteContext: resourceAviatrixAwsTgwConnectPeerDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tgw_name": {
 				Type:        schema.TypeString,
@@ -98,7 +102,7 @@ func marshalAwsTgwConnectPeerInput(d *schema.ResourceData) *goaviatrix.AwsTgwCon
 }
 
 func resourceAviatrixAwsTgwConnectPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	peer := marshalAwsTgwConnectPeerInput(d)
 	d.SetId(peer.ID())
@@ -121,14 +125,14 @@ func resourceAviatrixAwsTgwConnectPeerReadIfRequired(ctx context.Context, d *sch
 }
 
 func resourceAviatrixAwsTgwConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	connectionName := d.Get("connection_name").(string)
 	tgwName := d.Get("tgw_name").(string)
 	connectPeerName := d.Get("connect_peer_name").(string)
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws_tgw_connect_peer connection_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no aws_tgw_connect_peer connection_name received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~~")
 		if len(parts) != 3 {
 			return diag.Errorf("Invalid Import ID received for aws_tgw_connect_peer, ID must be in the form tgw_name~~connection_name~~connect_peer_name")
@@ -169,7 +173,7 @@ func resourceAviatrixAwsTgwConnectPeerRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixAwsTgwConnectPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	peer := marshalAwsTgwConnectPeerInput(d)
 	peer.ConnectPeerID = d.Get("connect_peer_id").(string)
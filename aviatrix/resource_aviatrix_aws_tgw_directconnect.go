@@ -1,22 +1,28 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAWSTgwDirectConnect() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwDirectConnectCreate,
-		Read:   resourceAviatrixAWSTgwDirectConnectRead,
-		Update: resourceAviatrixAWSTgwDirectConnectUpdate,
-		Delete: resourceAviatrixAWSTgwDirectConnectDelete,
+		CreateContext: resourceAviatrixAWSTgwDirectConnectCreate,
+		ReadContext:   resourceAviatrixAWSTgwDirectConnectRead,
+		UpdateContext: resourceAviatrixAWSTgwDirectConnectUpdate,
+		DeleteContext: resourceAviatrixAWSTgwDirectConnectDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,8 +65,8 @@ func resourceAviatrixAWSTgwDirectConnect() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwDirectConnectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:                  d.Get("tgw_name").(string),
@@ -79,35 +85,35 @@ func resourceAviatrixAWSTgwDirectConnectCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(awsTgwDirectConnect.TgwName + "~" + awsTgwDirectConnect.DxGatewayID)
 	flag := false
-	defer resourceAviatrixAWSTgwDirectConnectReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateAwsTgwDirectConnect(awsTgwDirectConnect)
+	err := client.CreateAwsTgwDirectConnect(ctx, awsTgwDirectConnect)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWS TGW Direct Connect: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWS TGW Direct Connect: %s", err)
 	}
 
-	return resourceAviatrixAWSTgwDirectConnectReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwDirectConnectReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwDirectConnectRead(d, meta)
+		return resourceAviatrixAWSTgwDirectConnectRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	tgwName := d.Get("tgw_name").(string)
 	directConnectGatewayID := d.Get("dx_gateway_id").(string)
 
 	if tgwName == "" || directConnectGatewayID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		if !strings.Contains(id, "~") {
-			log.Printf("[DEBUG] Import Id: %s is invalid", id)
+			logDebug(ctx, "Import Id is invalid", map[string]interface{}{"import_id": id})
 		}
 		d.Set("tgw_name", strings.Split(id, "~")[0])
 		d.Set("dx_gateway_id", strings.Split(id, "~")[1])
@@ -125,9 +131,9 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Aws Tgw Direct Connect: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Aws Tgw Direct Connect: %s", err)
 	}
-	log.Printf("[INFO] Found Aviatrix Aws Tgw Direct Connect: %#v", directConnect)
+	logInfo(ctx, "Found Aviatrix Aws Tgw Direct Connect", map[string]interface{}{"tgw_name": directConnect.TgwName, "direct_connect_account_name": directConnect.DirectConnectAccountName, "security_domain_name": directConnect.SecurityDomainName})
 
 	d.Set("tgw_name", directConnect.TgwName)
 	d.Set("directconnect_account_name", directConnect.DirectConnectAccountName)
@@ -145,8 +151,8 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:       d.Get("tgw_name").(string),
@@ -155,12 +161,12 @@ func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta inte
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Updating Aviatrix Site2Cloud: %#v", awsTgwDirectConnect)
+	logInfo(ctx, "Updating Aviatrix AWS TGW Direct Connect", map[string]interface{}{"tgw_name": awsTgwDirectConnect.TgwName, "direct_connect_account_name": awsTgwDirectConnect.DirectConnectAccountName, "security_domain_name": awsTgwDirectConnect.SecurityDomainName})
 	if ok := d.HasChange("allowed_prefix"); ok {
 		awsTgwDirectConnect.AllowedPrefix = d.Get("allowed_prefix").(string)
 		err := client.UpdateDirectConnAllowedPrefix(awsTgwDirectConnect)
 		if err != nil {
-			return fmt.Errorf("failed to update Aws Tgw Direct Connect Allowed Prefix: %s", err)
+			return diag.Errorf("failed to update Aws Tgw Direct Connect Allowed Prefix: %s", err)
 		}
 	}
 
@@ -170,33 +176,33 @@ func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta inte
 			awsTgwDirectConnect.LearnedCidrsApproval = "yes"
 			err := client.EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			awsTgwDirectConnect.LearnedCidrsApproval = "no"
 			err := client.DisableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAWSTgwDirectConnectRead(d, meta)
+	return resourceAviatrixAWSTgwDirectConnectRead(ctx, d, meta)
 }
 
-func resourceAviatrixAWSTgwDirectConnectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:         d.Get("tgw_name").(string),
 		DirectConnectID: d.Get("dx_gateway_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix AWS TGW Direct Connect: %#v", awsTgwDirectConnect)
+	logInfo(ctx, "Deleting Aviatrix AWS TGW Direct Connect", map[string]interface{}{"tgw_name": awsTgwDirectConnect.TgwName, "direct_connect_account_name": awsTgwDirectConnect.DirectConnectAccountName, "security_domain_name": awsTgwDirectConnect.SecurityDomainName})
 
 	err := client.DeleteAwsTgwDirectConnect(awsTgwDirectConnect)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix AWS TGW Direct Connect: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AWS TGW Direct Connect: %s", err)
 	}
 
 	return nil
@@ -1,180 +0,0 @@
-package aviatrix_test
-
-import (
-	"fmt"
-	"os"
-	"testing"
-
-	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
-	"github.com/gruntwork-io/terratest/modules/acctest"
-	"github.com/gruntwork-io/terratest/modules/random"
-	"github.com/gruntwork-io/terratest/modules/terraform"
-	"github.com/stretchr/testify/assert"
-)
-
-func TestAccAviatrixAwsTgwDirectConnect_basic(t *testing.T) {
-	var awsTgwDirectConnect goaviatrix.AwsTgwDirectConnect
-
-	rName := random.UniqueId()
-
-	skipAcc := os.Getenv("SKIP_AWS_TGW_DIRECTCONNECT")
-	if skipAcc == "yes" {
-		t.Skip("Skipping AWS TGW DIRECTCONNECT test as SKIP_AWS_TGW_DIRECTCONNECT is set")
-	}
-
-	msg := ". Set SKIP_AWS_TGW_DIRECTCONNECT to yes to skip AWS TGW DIRECTCONNECT tests"
-
-	awsSideAsNumber := "12"
-
-	terraformOptions := &terraform.Options{
-		TerraformDir: "../examples/aws_tgw_directconnect",
-
-		Vars: map[string]interface{}{
-			"account_name":        fmt.Sprintf("tfa-%s", rName),
-			"aws_account_number":  os.Getenv("AWS_ACCOUNT_NUMBER"),
-			"aws_access_key":      os.Getenv("AWS_ACCESS_KEY"),
-			"aws_secret_key":      os.Getenv("AWS_SECRET_KEY"),
-			"aws_region":          os.Getenv("AWS_REGION"),
-			"dx_gateway_id":       os.Getenv("AWS_DX_GATEWAY_ID"),
-			"tgw_name":            fmt.Sprintf("tft-%s", rName),
-			"aws_side_as_number":  awsSideAsNumber,
-			"network_domain_name": "Default_Domain",
-			"allowed_prefix":      "10.12.0.0/24",
-		},
-	}
-
-	defer terraform.Destroy(t, terraformOptions)
-
-	terraform.InitAndApply(t, terraformOptions)
-
-	resourceName := "aviatrix_aws_tgw_directconnect.test"
-	assert.True(t, terraform.ResourceExists(t, resourceName))
-
-	// Get the created AWS TGW Direct Connect
-	client := testAccProvider.Meta().(*goaviatrix.Client)
-	foundAwsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
-		TgwName:     terraform.Output(t, terraformOptions, "tgw_name").(string),
-		DxGatewayID: terraform.Output(t, terraformOptions, "dx_gateway_id").(string),
-	}
-	foundAwsTgwDirectConnect2, err := client.GetAwsTgwDirectConnect(foundAwsTgwDirectConnect)
-	assert.NoError(t, err)
-
-	// Check that the created AWS TGW Direct Connect has the expected attributes
-	assert.Equal(t, foundAwsTgwDirectConnect2.TgwName, terraform.Output(t, terraformOptions, "tgw_name").(string))
-	assert.Equal(t, foundAwsTgwDirectConnect2.DxGatewayID, terraform.Output(t, terraformOptions, "dx_gateway_id").(string))
-
-	awsTgwDirectConnect = *foundAwsTgwDirectConnect
-}
-
-
-func testAccAwsTgwDirectConnectConfigBasic(rName string, awsSideAsNumber string) string {
-	return fmt.Sprintf(`
-resource "aviatrix_account" "test_account" {
-	account_name       = "tfa-%s"
-	cloud_type         = 1
-	aws_account_number = "%s"
-	aws_iam	           = false
-	aws_access_key     = "%s"
-	aws_secret_key     = "%s"
-}
-resource "aviatrix_aws_tgw" "test_aws_tgw" {
-	account_name       = aviatrix_account.test_account.account_name
-	aws_side_as_number = "64513"
-	region             = "%s"
-	tgw_name           = "tft-%s"
-}
-resource "aviatrix_aws_tgw_network_domain" "Default_Domain" {
-	name     = "Default_Domain"
-	tgw_name = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-}
-resource "aviatrix_aws_tgw_network_domain" "Shared_Service_Domain" {
-	name     = "Shared_Service_Domain"
-	tgw_name = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-}
-resource "aviatrix_aws_tgw_network_domain" "Aviatrix_Edge_Domain" {
-	name     = "Aviatrix_Edge_Domain"
-	tgw_name = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-}
-resource "aviatrix_aws_tgw_peering_domain_conn" "default_nd_conn1" {
-	tgw_name1    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name1 = aviatrix_aws_tgw_network_domain.Aviatrix_Edge_Domain.name
-	tgw_name2    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name2 = aviatrix_aws_tgw_network_domain.Default_Domain.name
-}
-resource "aviatrix_aws_tgw_peering_domain_conn" "default_nd_conn2" {
-	tgw_name1    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name1 = aviatrix_aws_tgw_network_domain.Aviatrix_Edge_Domain.name
-	tgw_name2    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name2 = aviatrix_aws_tgw_network_domain.Shared_Service_Domain.name
-}
-resource "aviatrix_aws_tgw_peering_domain_conn" "default_nd_conn3" {
-	tgw_name1    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name1 = aviatrix_aws_tgw_network_domain.Default_Domain.name
-	tgw_name2    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name2 = aviatrix_aws_tgw_network_domain.Shared_Service_Domain.name
-}
-resource "aviatrix_aws_tgw_directconnect" "test" {
-	tgw_name                   = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	directconnect_account_name = aviatrix_account.test_account.account_name
-	dx_gateway_id              = "%s"
-	network_domain_name        = aviatrix_aws_tgw_network_domain.Default_Domain.name
-	allowed_prefix             = "10.12.0.0/24"
-}
-	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
-		os.Getenv("AWS_REGION"), rName, os.Getenv("AWS_DX_GATEWAY_ID"))
-}
-
-func tesAccCheckAwsTgwDirectConnectExists(n string, awsTgwDirectConnect *goaviatrix.AwsTgwDirectConnect) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("AWS TGW DIRECTCONNECT Not found: %s", n)
-		}
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("no AWS TGW DIRECTCONNECT ID is set")
-		}
-
-		client := testAccProvider.Meta().(*goaviatrix.Client)
-
-		foundAwsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
-			TgwName:     rs.Primary.Attributes["tgw_name"],
-			DxGatewayID: rs.Primary.Attributes["dx_gateway_id"],
-		}
-
-		foundAwsTgwDirectConnect2, err := client.GetAwsTgwDirectConnect(foundAwsTgwDirectConnect)
-		if err != nil {
-			return err
-		}
-		if foundAwsTgwDirectConnect2.TgwName != rs.Primary.Attributes["tgw_name"] {
-			return fmt.Errorf("tgw_name Not found in created attributes")
-		}
-		if foundAwsTgwDirectConnect2.DxGatewayID != rs.Primary.Attributes["dx_gateway_id"] {
-			return fmt.Errorf("dx_gateway_id Not found in created attributes")
-		}
-
-		*awsTgwDirectConnect = *foundAwsTgwDirectConnect
-		return nil
-	}
-}
-
-func testAccCheckAwsTgwDirectConnectDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*goaviatrix.Client)
-
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aviatrix_aws_tgw_directconnect" {
-			continue
-		}
-
-		foundAwsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
-			TgwName:     rs.Primary.Attributes["tgw_name"],
-			DxGatewayID: rs.Primary.Attributes["dx_gateway_id"],
-		}
-
-		_, err := client.GetAwsTgwDirectConnect(foundAwsTgwDirectConnect)
-		if err != goaviatrix.ErrNotFound {
-			return fmt.Errorf("failed to delete Aviatrix AWS TGW Direct Connect: " + err.Error())
-		}
-	}
-
-	return nil
-}
@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -12,13 +11,18 @@ import (
 
 func resourceAviatrixAwsTgwIntraDomainInspection() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixAwsTgwIntraDomainInspectionCreate,
-		ReadWithoutTimeout:   resourceAviatrixAwsTgwIntraDomainInspectionRead,
-		DeleteWithoutTimeout: resourceAviatrixAwsTgwIntraDomainInspectionDelete,
+		CreateContext: resourceAviatrixAwsTgwIntraDomainInspectionCreate,
+		ReadContext:   resourceAviatrixAwsTgwIntraDomainInspectionRead,
+		DeleteContext: resourceAviatrixAwsTgwIntraDomainInspectionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tgw_name": {
 				Type:        schema.TypeString,
@@ -51,7 +55,7 @@ func marshalAwsTgwIntraDomainInspectionInput(d *schema.ResourceData) *goaviatrix
 }
 
 func resourceAviatrixAwsTgwIntraDomainInspectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	intraDomainInspection := marshalAwsTgwIntraDomainInspectionInput(d)
 
@@ -65,11 +69,11 @@ func resourceAviatrixAwsTgwIntraDomainInspectionCreate(ctx context.Context, d *s
 }
 
 func resourceAviatrixAwsTgwIntraDomainInspectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	if d.Get("tgw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
@@ -80,7 +84,7 @@ func resourceAviatrixAwsTgwIntraDomainInspectionRead(ctx context.Context, d *sch
 		routeDomainName := parts[1]
 
 		if tgwName == "" || routeDomainName == "" {
-			return diag.Errorf("tgw_name or route_domain_name cannot be empty")
+			return attributeErrorf("tgw_name", "tgw_name or route_domain_name cannot be empty")
 		}
 
 		d.Set("tgw_name", tgwName)
@@ -107,7 +111,7 @@ func resourceAviatrixAwsTgwIntraDomainInspectionRead(ctx context.Context, d *sch
 }
 
 func resourceAviatrixAwsTgwIntraDomainInspectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	intraDomainInspection := marshalAwsTgwIntraDomainInspectionInput(d)
 
@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,13 +13,18 @@ import (
 
 func resourceAviatrixAwsTgwNetworkDomain() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixAwsTgwNetworkDomainCreate,
-		ReadWithoutTimeout:   resourceAviatrixAwsTgwNetworkDomainRead,
-		DeleteWithoutTimeout: resourceAviatrixAwsTgwNetworkDomainDelete,
+		CreateContext: resourceAviatrixAwsTgwNetworkDomainCreate,
+		ReadContext:   resourceAviatrixAwsTgwNetworkDomainRead,
+		DeleteContext: resourceAviatrixAwsTgwNetworkDomainDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -73,7 +77,7 @@ func marshalNetworkDomainInput(d *schema.ResourceData) *goaviatrix.SecurityDomai
 }
 
 func resourceAviatrixAwsTgwNetworkDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	networkDomain := marshalNetworkDomainInput(d)
 
@@ -95,7 +99,7 @@ func resourceAviatrixAwsTgwNetworkDomainCreate(ctx context.Context, d *schema.Re
 	flag := false
 	defer resourceAviatrixAwsTgwNetworkDomainReadIfRequired(ctx, d, meta, &flag)
 
-	if err := client.CreateSecurityDomain(networkDomain); err != nil {
+	if err := client.CreateSecurityDomain(ctx, networkDomain); err != nil {
 		return diag.Errorf("could not create network domain: %v", err)
 	}
 
@@ -111,13 +115,13 @@ func resourceAviatrixAwsTgwNetworkDomainReadIfRequired(ctx context.Context, d *s
 }
 
 func resourceAviatrixAwsTgwNetworkDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	name := d.Get("name").(string)
 
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("invalid ID, expected ID tgw_name~domain_name, instead got %s", d.Id())
@@ -153,7 +157,7 @@ func resourceAviatrixAwsTgwNetworkDomainRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixAwsTgwNetworkDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	networkDomain := &goaviatrix.SecurityDomain{
 		Name:       d.Get("name").(string),
@@ -1,21 +1,26 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAWSTgwPeeringDomainConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwPeeringDomainConnCreate,
-		Read:   resourceAviatrixAWSTgwPeeringDomainConnRead,
-		Delete: resourceAviatrixAWSTgwPeeringDomainConnDelete,
+		CreateContext: resourceAviatrixAWSTgwPeeringDomainConnCreate,
+		ReadContext:   resourceAviatrixAWSTgwPeeringDomainConnRead,
+		DeleteContext: resourceAviatrixAWSTgwPeeringDomainConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,8 +56,8 @@ func resourceAviatrixAWSTgwPeeringDomainConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwPeeringDomainConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	domainConn := &goaviatrix.DomainConn{
 		TgwName1:    d.Get("tgw_name1").(string),
@@ -61,30 +66,30 @@ func resourceAviatrixAWSTgwPeeringDomainConnCreate(d *schema.ResourceData, meta
 		DomainName2: d.Get("domain_name2").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix domain connection between tgw: %s and %s", domainConn.TgwName1, domainConn.TgwName2)
+	logInfo(ctx, "Creating Aviatrix domain connection", map[string]interface{}{"tgw_name1": domainConn.TgwName1, "tgw_name2": domainConn.TgwName2})
 
 	d.SetId(domainConn.TgwName1 + ":" + domainConn.DomainName1 + "~" + domainConn.TgwName2 + ":" + domainConn.DomainName2)
 	flag := false
-	defer resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateDomainConn(domainConn)
+	err := client.CreateDomainConn(ctx, domainConn)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix domain connection between two tgws: %s", err)
+		return diag.Errorf("failed to create Aviatrix domain connection between two tgws: %s", err)
 	}
 
-	return resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwPeeringDomainConnRead(d, meta)
+		return resourceAviatrixAWSTgwPeeringDomainConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwPeeringDomainConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	tgwName1 := d.Get("tgw_name1").(string)
 	domainName1 := d.Get("domain_name1").(string)
@@ -93,7 +98,7 @@ func resourceAviatrixAWSTgwPeeringDomainConnRead(d *schema.ResourceData, meta in
 
 	if tgwName1 == "" || domainName1 == "" || tgwName2 == "" || domainName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		tgwDomain1 := strings.Split(id, "~")[0]
 		tgwDomain2 := strings.Split(id, "~")[1]
 		d.Set("tgw_name1", strings.Split(tgwDomain1, ":")[0])
@@ -116,15 +121,15 @@ func resourceAviatrixAWSTgwPeeringDomainConnRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix domain connection: %s", err)
+		return diag.Errorf("couldn't find Aviatrix domain connection: %s", err)
 	}
 
 	d.SetId(domainConn.TgwName1 + ":" + domainConn.DomainName1 + "~" + domainConn.TgwName2 + ":" + domainConn.DomainName2)
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwPeeringDomainConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	domainConn := &goaviatrix.DomainConn{
 		TgwName1:    d.Get("tgw_name1").(string),
@@ -133,11 +138,11 @@ func resourceAviatrixAWSTgwPeeringDomainConnDelete(d *schema.ResourceData, meta
 		DomainName2: d.Get("domain_name2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix domain connection: %#v", domainConn)
+	logInfo(ctx, "Deleting Aviatrix domain connection", map[string]interface{}{"tgw_name1": domainConn.TgwName1, "tgw_name2": domainConn.TgwName2, "domain_name1": domainConn.DomainName1})
 
-	err := client.DeleteDomainConn(domainConn)
+	err := client.DeleteDomainConn(ctx, domainConn)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix domain connection: %s", err)
+		return diag.Errorf("failed to delete Aviatrix domain connection: %s", err)
 	}
 
 	return nil
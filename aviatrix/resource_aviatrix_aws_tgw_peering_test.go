@@ -10,87 +10,120 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
-func TestAccAviatrixAWSTgwPeering_basic(t *testing.T) {
-	t.Parallel()
-
-	awsRegion1 := "us-east-1"
-	awsRegion2 := "us-east-2"
-	terraformDir := "../examples/aviatrix-aws-tgw-peering"
 
-	accountName := fmt.Sprintf("tfa-%s", strings.ToLower(random.UniqueId()))
+func TestAccAviatrixAWSTgwPeering_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_aws_tgw_peering.test"
 
-	// Skip the test if the SKIP_AWS_TGW_PEERING env var is set to "yes"
-	if os.Getenv("SKIP_AWS_TGW_PEERING") == "yes" {
-		t.Skip("Skipping Aviatrix AWS TGW peering tests as 'SKIP_AWS_TGW_PEERING' is set")
+	skipAcc := os.Getenv("SKIP_AWS_TGW_PEERING")
+	if skipAcc == "yes" {
+		t.Skip("Skipping AWS TGW peering test as SKIP_AWS_TGW_PEERING is set")
 	}
-
-	terraformOptions := &terraform.Options{
-		TerraformDir: terraformDir,
-
-		// Variables to pass to Terraform
-		Vars: map[string]interface{}{
-			"account_name":       accountName,
-			"aws_region1":        awsRegion1,
-			"aws_region2":        awsRegion2,
-			"aws_account_number": os.Getenv("AWS_ACCOUNT_NUMBER"),
-			"aws_access_key":     os.Getenv("AWS_ACCESS_KEY"),
-			"aws_secret_key":     os.Getenv("AWS_SECRET_KEY"),
+	msg := ". Set SKIP_AWS_TGW_PEERING to yes to skip AWS TGW peering tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, msg)
+			if os.Getenv("AWS_REGION2") == "" {
+				t.Fatal("Environment variable AWS_REGION2 is not set" + msg)
+			}
 		},
-	}
-
-	// Destroy the Terraform infrastructure at the end of the test
-	defer terraform.Destroy(t, terraformOptions)
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTgwPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTgwPeeringConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTgwPeeringExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tgw_name1", fmt.Sprintf("tft-1-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tgw_name2", fmt.Sprintf("tft-2-%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
 
-	// Deploy the Terraform infrastructure
-	terraform.InitAndApply(t, terraformOptions)
+func testAccAWSTgwPeeringConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_aws_tgw" "test1" {
+	account_name       = aviatrix_account.test_account.account_name
+	aws_side_as_number = "64512"
+	region             = "%s"
+	tgw_name           = "tft-1-%[1]s"
+}
+resource "aviatrix_aws_tgw" "test2" {
+	account_name       = aviatrix_account.test_account.account_name
+	aws_side_as_number = "64513"
+	region             = "%s"
+	tgw_name           = "tft-2-%[1]s"
+}
+resource "aviatrix_aws_tgw_peering" "test" {
+	tgw_name1 = aviatrix_aws_tgw.test1.tgw_name
+	tgw_name2 = aviatrix_aws_tgw.test2.tgw_name
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_REGION"), os.Getenv("AWS_REGION2"))
+}
 
-	// Verify the Aviatrix AWS TGW peering exists
-	verifyAwsTgwPeeringExists(t, terraformOptions)
+func testAccCheckAWSTgwPeeringExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("AWS TGW peering Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no AWS TGW peering ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		foundAwsTgwPeering := &goaviatrix.AwsTgwPeering{
+			TgwName1: rs.Primary.Attributes["tgw_name1"],
+			TgwName2: rs.Primary.Attributes["tgw_name2"],
+		}
+
+		if err := client.GetAwsTgwPeering(foundAwsTgwPeering); err != nil {
+			return err
+		}
+		if foundAwsTgwPeering.TgwName1+"~"+foundAwsTgwPeering.TgwName2 != rs.Primary.ID {
+			return fmt.Errorf("AWS TGW peering not found")
+		}
+		return nil
+	}
 }
 
-// Verify the Aviatrix AWS TGW peering exists
-func verifyAwsTgwPeeringExists(t *testing.T, terraformOptions *terraform.Options) {
-	t.Helper()
+func testAccCheckAWSTgwPeeringDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
 
-	// Get the Terraform output
-	tgwName1 := terraform.Output(t, terraformOptions, "tgw_name1")
-	tgwName2 := terraform.Output(t, terraformOptions, "tgw_name2")
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_aws_tgw_peering" {
+			continue
+		}
 
-	// Create an Aviatrix client
-	client, err := goaviatrix.NewClientWithConfig(goaviatrix.ClientConfig{
-		APIUser:     os.Getenv("AVIATRIX_API_USER"),
-		APIPass:     os.Getenv("AVIATRIX_API_PASSWORD"),
-		APIEndpoint: os.Getenv("AVIATRIX_API_ENDPOINT"),
-	})
-	assert.NoError(t, err)
+		foundAwsTgwPeering := &goaviatrix.AwsTgwPeering{
+			TgwName1: rs.Primary.Attributes["tgw_name1"],
+			TgwName2: rs.Primary.Attributes["tgw_name2"],
+		}
 
-	// Get the AWS TGW peering
-	awsTgwPeering := &goaviatrix.AwsTgwPeering{
-		TgwName1: tgwName1,
-		TgwName2: tgwName2,
+		err := client.GetAwsTgwPeering(foundAwsTgwPeering)
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("AWS TGW peering still exists")
+		}
 	}
-	err = client.GetAwsTgwPeering(awsTgwPeering)
-	assert.NoError(t, err)
-	assert.NotNil(t, awsTgwPeering)
-}
-func testAccCheckAWSTgwPeeringDestroy(t *testing.T, terraformOptions *terraform.Options) {
-    // Retrieve the provider client from the Terraform options
-    client := terraformProvider.Meta().(*goaviatrix.Client)
-
-    // Destroyed resources should no longer exist
-    for _, resource := range terraform.ListResources(t, terraformOptions) {
-        if resource.Type != "aviatrix_aws_tgw_peering" {
-            continue
-        }
-
-        foundAwsTgwPeering := &goaviatrix.AwsTgwPeering{
-            TgwName1: resource.Primary.Attributes["tgw_name1"],
-            TgwName2: resource.Primary.Attributes["tgw_name2"],
-        }
 
-        err := client.GetAwsTgwPeering(foundAwsTgwPeering)
-        if err != goaviatrix.ErrNotFound {
-            t.Errorf("Resource %s still exists", resource.Primary.ID)
-        }
-    }
+	return nil
 }
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"fmt"
 	"os"
-	"strings"
 	"testing"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -111,7 +110,7 @@ func testAccCheckAWSTgwDestroy(s *terraform.State) error {
 
 		_, err := client.GetAWSTgw(foundAWSTgw)
 		if err != nil {
-			if strings.Contains(err.Error(), "does not exist") {
+			if goaviatrix.IsNotFound(err) {
 				return nil
 			}
 			return fmt.Errorf("AWS TGW still exists: %v", err)
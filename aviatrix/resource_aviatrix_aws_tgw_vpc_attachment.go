@@ -1,22 +1,28 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAwsTgwVpcAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwVpcAttachmentCreate,
-		Read:   resourceAviatrixAwsTgwVpcAttachmentRead,
-		Update: resourceAviatrixAwsTgwVpcAttachmentUpdate,
-		Delete: resourceAviatrixAwsTgwVpcAttachmentDelete,
+		CreateContext: resourceAviatrixAwsTgwVpcAttachmentCreate,
+		ReadContext:   resourceAviatrixAwsTgwVpcAttachmentRead,
+		UpdateContext: resourceAviatrixAwsTgwVpcAttachmentUpdate,
+		DeleteContext: resourceAviatrixAwsTgwVpcAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,8 +99,8 @@ func resourceAviatrixAwsTgwVpcAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
 		TgwName:                      d.Get("tgw_name").(string),
@@ -113,60 +119,60 @@ func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta inte
 	isFirewallSecurityDomain, err := client.IsFirewallSecurityDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("could not find Security Domain: " + awsTgwVpcAttachment.SecurityDomainName)
+			return diag.Errorf("could not find Security Domain: " + awsTgwVpcAttachment.SecurityDomainName)
 		}
-		return fmt.Errorf("could not find Security Domain due to: %v", err)
+		return diag.Errorf("could not find Security Domain due to: %v", err)
 	}
 
-	log.Printf("[INFO] Attaching vpc: %s to tgw %s", awsTgwVpcAttachment.VpcID, awsTgwVpcAttachment.TgwName)
+	logInfo(ctx, "Attaching vpc to tgw", map[string]interface{}{"vpc_id": awsTgwVpcAttachment.VpcID, "tgw_name": awsTgwVpcAttachment.TgwName})
 
 	d.SetId(awsTgwVpcAttachment.TgwName + "~" + awsTgwVpcAttachment.SecurityDomainName + "~" + awsTgwVpcAttachment.VpcID)
 	flag := false
-	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 
 	if isFirewallSecurityDomain {
 		err = client.CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach for FireNet: %s", err)
+			return diag.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach for FireNet: %s", err)
 		}
 
 		if awsTgwVpcAttachment.EdgeAttachment != "" {
 			err = client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
 			if err != nil {
-				return fmt.Errorf("failed to enable firewall attachment access from onprem: %s", err)
+				return diag.Errorf("failed to enable firewall attachment access from onprem: %s", err)
 			}
 		}
 	} else {
 		if awsTgwVpcAttachment.EdgeAttachment != "" {
-			return fmt.Errorf("management access from onprem only works for FireNet")
+			return diag.Errorf("management access from onprem only works for FireNet")
 		}
 
-		err = client.CreateAwsTgwVpcAttachment(awsTgwVpcAttachment)
+		err = client.CreateAwsTgwVpcAttachment(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach: %s", err)
+			return diag.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach: %s", err)
 		}
 	}
 
-	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAwsTgwVpcAttachmentRead(d, meta)
+		return resourceAviatrixAwsTgwVpcAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpcAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	tgwName := d.Get("tgw_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 
 	if tgwName == "" || d.Get("network_domain_name").(string) == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc names received", map[string]interface{}{"import_id": id})
 		d.Set("tgw_name", strings.Split(id, "~")[0])
 		d.Set("network_domain_name", strings.Split(id, "~")[1])
 		d.Set("vpc_id", strings.Split(id, "~")[2])
@@ -183,7 +189,7 @@ func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to get Aviatrix Aws Tgw Vpc Attach: %s", err)
+		return diag.Errorf("failed to get Aviatrix Aws Tgw Vpc Attach: %s", err)
 	}
 	if aTVA != nil {
 		d.Set("tgw_name", aTVA.TgwName)
@@ -233,21 +239,21 @@ func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	return fmt.Errorf("no Aviatrix Aws Tgw Vpc Attach found")
+	return diag.Errorf("no Aviatrix Aws Tgw Vpc Attach found")
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixAwsTgwVpcAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	flag := false
-	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	d.Partial(true)
 	if d.HasChange("region") {
-		return fmt.Errorf("updating region is not allowed")
+		return diag.Errorf("updating region is not allowed")
 	}
 	if d.HasChange("vpc_account_name") {
-		return fmt.Errorf("updating vpc_account_name is not allowed")
+		return diag.Errorf("updating vpc_account_name is not allowed")
 	}
 	if d.HasChange("customized_routes") {
 		awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
@@ -257,7 +263,7 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 		}
 		err := client.EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to update spoke vpc customized routes: %s", err)
+			return diag.Errorf("failed to update spoke vpc customized routes: %s", err)
 		}
 	}
 	if d.HasChange("customized_route_advertisement") {
@@ -268,7 +274,7 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 		}
 		err := client.EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to update spoke vpc customized routes advertisement: %s", err)
+			return diag.Errorf("failed to update spoke vpc customized routes advertisement: %s", err)
 		}
 	}
 
@@ -283,9 +289,9 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 		isFirewallSecurityDomain, err := client.IsFirewallSecurityDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 		if err != nil {
 			if err == goaviatrix.ErrNotFound {
-				return fmt.Errorf("could not find Network Domain: " + awsTgwVpcAttachment.SecurityDomainName)
+				return diag.Errorf("could not find Network Domain: " + awsTgwVpcAttachment.SecurityDomainName)
 			}
-			return fmt.Errorf("could not find Network Domain due to: %v", err)
+			return diag.Errorf("could not find Network Domain due to: %v", err)
 		}
 
 		oldEA, newEA := d.GetChange("edge_attachment")
@@ -298,35 +304,35 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 
 				err := client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
 				if err != nil {
-					return fmt.Errorf("failed to disable firewall attachment access from onprem while updating: %s", err)
+					return diag.Errorf("failed to disable firewall attachment access from onprem while updating: %s", err)
 				}
 
 				awsTgwVpcAttachment.EdgeAttachment = newEAString
 
 				err = client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
 				if err != nil {
-					return fmt.Errorf("failed to enable firewall attachment access from onprem while updating: %s", err)
+					return diag.Errorf("failed to enable firewall attachment access from onprem while updating: %s", err)
 				}
 			} else {
 				err := client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
 				if err != nil {
-					return fmt.Errorf("failed to update firewall attachment access from onprem: %s", err)
+					return diag.Errorf("failed to update firewall attachment access from onprem: %s", err)
 				}
 			}
 		} else {
 			if newEAString != "" {
-				return fmt.Errorf("management access from onprem only works for FireNet")
+				return diag.Errorf("management access from onprem only works for FireNet")
 			}
 		}
 
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpcAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AWSTgwClient)
 
 	awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
 		TgwName:            d.Get("tgw_name").(string),
@@ -339,20 +345,20 @@ func resourceAviatrixAwsTgwVpcAttachmentDelete(d *schema.ResourceData, meta inte
 	isFirewallSecurityDomain, err := client.IsFirewallSecurityDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("could not find Network Domain: " + awsTgwVpcAttachment.VpcID)
+			return diag.Errorf("could not find Network Domain: " + awsTgwVpcAttachment.VpcID)
 		}
-		return fmt.Errorf(("could not find Network Domain due to: ") + err.Error())
+		return diag.Errorf(("could not find Network Domain due to: ") + err.Error())
 	}
 
 	if isFirewallSecurityDomain {
-		err := client.DeleteAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment)
+		err := client.DeleteAwsTgwVpcAttachmentForFireNet(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to detach FireNet VPC from TGW: %s", err)
+			return diag.Errorf("failed to detach FireNet VPC from TGW: %s", err)
 		}
 	} else {
-		err := client.DeleteAwsTgwVpcAttachment(awsTgwVpcAttachment)
+		err := client.DeleteAwsTgwVpcAttachment(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to detach VPC from TGW: %s", err)
+			return diag.Errorf("failed to detach VPC from TGW: %s", err)
 		}
 	}
 
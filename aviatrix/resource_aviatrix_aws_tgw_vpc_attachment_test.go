@@ -1,202 +0,0 @@
-package aviatrix_test
-
-import (
-	"context"
-	"fmt"
-	"os"
-	"strings"
-	"testing"
-
-	"github.com/AviatrixSystems/terraform-provider-aviatrix/goaviatrix"
-	"github.com/gruntwork-io/terratest/modules/terraform"
-	"github.com/stretchr/testify/assert"
-)
-
-func TestAccAviatrixAwsTgwVpcAttachment_basic(t *testing.T) {
-	var awsTgwVpcAttachment goaviatrix.AwsTgwVpcAttachment
-
-	rName := strings.ToLower(t.Name())
-	resourceName := fmt.Sprintf("aviatrix_aws_tgw_vpc_attachment.test_%s", rName)
-
-	skipAcc := os.Getenv("SKIP_AWS_TGW_VPC_ATTACHMENT")
-	if skipAcc == "yes" {
-		t.Skip("Skipping AWS TGW VPC ATTACH test as SKIP_AWS_TGW_VPC_ATTACHMENT is set")
-	}
-
-	ctx := context.Background()
-
-	awsSideAsNumber := "64512"
-	nDm := "myNdn"
-
-	terraformOptions := &terraform.Options{
-		TerraformDir: "./",
-		Vars: map[string]interface{}{
-			"account_name":             "tfa-" + rName,
-			"aws_account_number":       os.Getenv("AWS_ACCOUNT_NUMBER"),
-			"aws_iam":                  false,
-			"aws_access_key":           os.Getenv("AWS_ACCESS_KEY"),
-			"aws_secret_key":           os.Getenv("AWS_SECRET_KEY"),
-			"aws_side_as_number":       awsSideAsNumber,
-			"region":                   os.Getenv("AWS_REGION"),
-			"tgw_name":                 "tft-" + rName,
-			"network_domain_name":      nDm,
-			"vpc_account_name":         "aviatrix_account.test_account.account_name",
-			"vpc_id":                   os.Getenv("AWS_VPC_ID"),
-			"skip_account_existence_check": true,
-		},
-		EnvVars: map[string]string{
-			"AVX_USER":      os.Getenv("AVX_USER"),
-			"AVX_PASSWORD":  os.Getenv("AVX_PASSWORD"),
-			"AVX_CONTROLLER": os.Getenv("AVX_CONTROLLER"),
-		},
-	}
-
-	defer terraform.Destroy(t, terraformOptions)
-
-	terraform.InitAndApply(t, terraformOptions)
-
-	err := terraform.OutputStruct(resourceName, &awsTgwVpcAttachment)
-	assert.NoError(t, err)
-
-	client := goaviatrix.NewClient(os.Getenv("AVX_CONTROLLER"), os.Getenv("AVX_USER"), os.Getenv("AVX_PASSWORD"))
-
-	foundAwsTgwVpcAttachment, err := client.GetAwsTgwVpcAttachment(&goaviatrix.AwsTgwVpcAttachment{
-		TgwName:            awsTgwVpcAttachment.TgwName,
-		SecurityDomainName: awsTgwVpcAttachment.SecurityDomainName,
-		VpcID:              awsTgwVpcAttachment.VpcID,
-	})
-	assert.NoError(t, err)
-
-	assert.Equal(t, awsTgwVpcAttachment.TgwName, foundAwsTgwVpcAttachment.TgwName)
-	assert.Equal(t, awsTgwVpcAttachment.SecurityDomainName, foundAwsTgwVpcAttachment.SecurityDomainName)
-	assert.Equal(t, awsTgwVpcAttachment.VpcID, foundAwsTgwVpcAttachment.VpcID)
-
-}
-
-func testAccAwsTgwVpcAttachmentConfigBasic(rName string, awsSideAsNumber string, nDm string) string {
-	return fmt.Sprintf(`
-resource "aviatrix_account" "test_account" {
-	account_name       = "tfa-%s"
-	cloud_type         = 1
-	aws_account_number = "%s"
-	aws_iam            = false
-	aws_access_key     = "%s"
-	aws_secret_key     = "%s"
-}
-resource "aviatrix_aws_tgw" "test_aws_tgw" {
-	account_name       = aviatrix_account.test_account.account_name
-	aws_side_as_number = "%s"
-	region             = "%s"
-	tgw_name           = "tft-%s"
-}
-resource "aviatrix_aws_tgw_network_domain" "Default_Domain" {
-	name     = "Default_Domain"
-	tgw_name = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-}
-resource "aviatrix_aws_tgw_network_domain" "Shared_Service_Domain" {
-	name     = "Shared_Service_Domain"
-	tgw_name = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-}
-resource "aviatrix_aws_tgw_network_domain" "Aviatrix_Edge_Domain" {
-	name     = "Aviatrix_Edge_Domain"
-	tgw_name = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-}
-resource "aviatrix_aws_tgw_peering_domain_conn" "default_sd_conn1" {
-	tgw_name1    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name1 = aviatrix_aws_tgw_network_domain.Aviatrix_Edge_Domain.name
-	tgw_name2    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name2 = aviatrix_aws_tgw_network_domain.Default_Domain.name
-}
-resource "aviatrix_aws_tgw_peering_domain_conn" "default_sd_conn2" {
-	tgw_name1    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name1 = aviatrix_aws_tgw_network_domain.Aviatrix_Edge_Domain.name
-	tgw_name2    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name2 = aviatrix_aws_tgw_network_domain.Shared_Service_Domain.name
-}
-resource "aviatrix_aws_tgw_peering_domain_conn" "default_sd_conn3" {
-	tgw_name1    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name1 = aviatrix_aws_tgw_network_domain.Default_Domain.name
-	tgw_name2    = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	domain_name2 = aviatrix_aws_tgw_network_domain.Shared_Service_Domain.name
-}
-resource "aviatrix_aws_tgw_network_domain" "test" {
-	name       = "%s"
-	tgw_name   = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	depends_on = [
-    	aviatrix_aws_tgw_network_domain.Default_Domain,
-    	aviatrix_aws_tgw_network_domain.Shared_Service_Domain,
-    	aviatrix_aws_tgw_network_domain.Aviatrix_Edge_Domain
-	]
-}
-resource "aviatrix_aws_tgw_vpc_attachment" "test" {
-	tgw_name            = aviatrix_aws_tgw.test_aws_tgw.tgw_name
-	region              = "%s"
-	network_domain_name = aviatrix_aws_tgw_network_domain.test.name
-	vpc_account_name    = aviatrix_account.test_account.account_name
-	vpc_id              = "%s"
-}
-	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
-		awsSideAsNumber, os.Getenv("AWS_REGION"), rName, nDm, os.Getenv("AWS_REGION"), os.Getenv("AWS_VPC_ID"))
-}
-
-func tesAccCheckAwsTgwVpcAttachmentExists(n string, awsTgwVpcAttachment *goaviatrix.AwsTgwVpcAttachment) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("AWS TGW VPC ATTACH Not found: %s", n)
-		}
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("no AWS TGW VPC ATTACH ID is set")
-		}
-
-		client := testAccProvider.Meta().(*goaviatrix.Client)
-
-		foundAwsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
-			TgwName:            rs.Primary.Attributes["tgw_name"],
-			SecurityDomainName: rs.Primary.Attributes["network_domain_name"],
-			VpcID:              rs.Primary.Attributes["vpc_id"],
-		}
-
-		foundAwsTgwVpcAttachment2, err := client.GetAwsTgwVpcAttachment(foundAwsTgwVpcAttachment)
-		if err != nil {
-			return err
-		}
-		if foundAwsTgwVpcAttachment2.TgwName != rs.Primary.Attributes["tgw_name"] {
-			return fmt.Errorf("tgw_name Not found in created attributes")
-		}
-		if foundAwsTgwVpcAttachment2.SecurityDomainName != rs.Primary.Attributes["network_domain_name"] {
-			return fmt.Errorf("network_domain_name Not found in created attributes")
-		}
-		if foundAwsTgwVpcAttachment2.VpcID != rs.Primary.Attributes["vpc_id"] {
-			return fmt.Errorf("vpc_id Not found in created attributes")
-		}
-
-		*awsTgwVpcAttachment = *foundAwsTgwVpcAttachment2
-		return nil
-	}
-}
-
-func testAccCheckAwsTgwVpcAttachmentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*goaviatrix.Client)
-
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aviatrix_aws_tgw_vpc_attachment" {
-			continue
-		}
-
-		foundAwsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
-			TgwName:            rs.Primary.Attributes["tgw_name"],
-			SecurityDomainName: rs.Primary.Attributes["network_domain_name"],
-			VpcID:              rs.Primary.Attributes["vpc_id"],
-		}
-
-		_, err := client.GetAwsTgwVpcAttachment(foundAwsTgwVpcAttachment)
-		if err == nil {
-			return fmt.Errorf("aviatrix AWS TGW VPC ATTACH still exists")
-		}
-
-		return nil
-	}
-
-	return nil
-}
@@ -1,20 +1,20 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAzureVngConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzureVngConnCreate,
-		Read:   resourceAviatrixAzureVngConnRead,
-		Delete: resourceAviatrixAzureVngConnDelete,
+		CreateContext: resourceAviatrixAzureVngConnCreate,
+		ReadContext:   resourceAviatrixAzureVngConnRead,
+		DeleteContext: resourceAviatrixAzureVngConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,38 +56,38 @@ func marshalAzureVngConnInput(d *schema.ResourceData) *goaviatrix.AzureVngConn {
 	}
 }
 
-func resourceAviatrixAzureVngConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureVngConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	azureVngConn := marshalAzureVngConnInput(d)
 
 	d.SetId(azureVngConn.ConnectionName)
 	flag := false
-	defer resourceAviatrixAzureVngConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAzureVngConnReadIfRequired(ctx, d, meta, &flag)
 
 	if err := client.ConnectAzureVng(azureVngConn); err != nil {
-		return fmt.Errorf("could not connect to azure vng: %v", err)
+		return diag.Errorf("could not connect to azure vng: %v", err)
 	}
 
-	return resourceAviatrixAzureVngConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzureVngConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzureVngConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAzureVngConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzureVngConnRead(d, meta)
+		return resourceAviatrixAzureVngConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzureVngConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureVngConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	connectionName := d.Get("connection_name").(string)
 
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 
 		d.Set("connection_name", id)
 		connectionName = id
@@ -99,7 +99,7 @@ func resourceAviatrixAzureVngConnRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get azure vng conn status: %v", err)
+		return diag.Errorf("could not get azure vng conn status: %v", err)
 	}
 
 	d.Set("primary_gateway_name", azureVngConnStatus.PrimaryGatewayName)
@@ -111,14 +111,14 @@ func resourceAviatrixAzureVngConnRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixAzureVngConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureVngConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	vpcId := d.Get("vpc_id").(string)
 	connectionName := d.Get("connection_name").(string)
 
 	if err := client.DisconnectAzureVng(vpcId, connectionName); err != nil {
-		return fmt.Errorf("could not disconnect vng connection: %v", err)
+		return diag.Errorf("could not disconnect vng connection: %v", err)
 	}
 
 	return nil
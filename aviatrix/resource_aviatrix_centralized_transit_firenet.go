@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -47,7 +46,7 @@ func marshalCentralizedTransitFireNetInput(d *schema.ResourceData) *goaviatrix.C
 }
 
 func resourceAviatrixCentralizedTransitFireNetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	centralizedTransitFirenet := marshalCentralizedTransitFireNetInput(d)
 
@@ -88,12 +87,12 @@ func resourceAviatrixCentralizedTransitFireNetReadIfRequired(ctx context.Context
 }
 
 func resourceAviatrixCentralizedTransitFireNetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	// handle import
 	if d.Get("primary_firenet_gw_name").(string) == "" || d.Get("secondary_firenet_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no primary or secondary gateway name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no primary or secondary gateway name received", map[string]interface{}{"import_id": id})
 		d.Set("primary_firenet_gw_name", strings.Split(id, "~")[0])
 		d.Set("secondary_firenet_gw_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -115,7 +114,7 @@ func resourceAviatrixCentralizedTransitFireNetRead(ctx context.Context, d *schem
 }
 
 func resourceAviatrixCentralizedTransitFireNetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	centralizedFirenet := marshalCentralizedTransitFireNetInput(d)
 
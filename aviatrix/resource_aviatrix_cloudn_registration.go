@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 	"time"
 
@@ -69,13 +68,14 @@ func resourceAviatrixCloudnRegistration() *schema.Resource {
 }
 
 func resourceAviatrixCloudnRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
+	username, password := client.Credentials()
 	cloudnRegistration := &goaviatrix.CloudnRegistration{
 		Name:              d.Get("name").(string),
-		ControllerAddress: client.ControllerIP,
-		Username:          client.Username,
-		Password:          client.Password,
+		ControllerAddress: client.ControllerAddress(),
+		Username:          username,
+		Password:          password,
 	}
 
 	var cloudnClient *goaviatrix.Client
@@ -114,7 +114,7 @@ func resourceAviatrixCloudnRegistrationCreate(ctx context.Context, d *schema.Res
 	_, prependAsPathOk := d.GetOk("prepend_as_path")
 	if _, ok := d.GetOk("local_as_number"); ok {
 		localASNumber := d.Get("local_as_number").(string)
-		err := client.SetLocalASNumber(gateway, localASNumber)
+		err := client.SetLocalASNumber(ctx, gateway, localASNumber)
 		if err != nil {
 			return diag.Errorf("failed to create Aviatrix CloudN Registration: could not set local_as_number: %v", err)
 		}
@@ -125,7 +125,7 @@ func resourceAviatrixCloudnRegistrationCreate(ctx context.Context, d *schema.Res
 				prependASPath = append(prependASPath, v.(string))
 			}
 
-			err := client.SetPrependASPath(gateway, prependASPath)
+			err := client.SetPrependASPath(ctx, gateway, prependASPath)
 			if err != nil {
 				return diag.Errorf("failed to create Aviatrix CloudN Registration: could not set prepend_as_path: %v", err)
 			}
@@ -146,11 +146,11 @@ func resourceAviatrixCloudnRegistrationReadIfRequired(ctx context.Context, d *sc
 }
 
 func resourceAviatrixCloudnRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no name received", map[string]interface{}{"import_id": id})
 		d.Set("name", id)
 		d.SetId(id)
 	}
@@ -185,7 +185,7 @@ func resourceAviatrixCloudnRegistrationRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixCloudnRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 	gateway := &goaviatrix.TransitVpc{
@@ -201,7 +201,7 @@ func resourceAviatrixCloudnRegistrationUpdate(ctx context.Context, d *schema.Res
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(prependASPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gateway, nil)
+			err := client.SetPrependASPath(ctx, gateway, nil)
 			if err != nil {
 				return diag.Errorf("failed to delete prepend_as_path during Aviatrix CloudN Registration update: %v", err)
 			}
@@ -209,14 +209,14 @@ func resourceAviatrixCloudnRegistrationUpdate(ctx context.Context, d *schema.Res
 
 		if d.HasChange("local_as_number") {
 			localASNumber := d.Get("local_as_number").(string)
-			err := client.SetLocalASNumber(gateway, localASNumber)
+			err := client.SetLocalASNumber(ctx, gateway, localASNumber)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix CloudN Registration: could not set local_as_number: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(prependASPath) > 0 {
-			err := client.SetPrependASPath(gateway, prependASPath)
+			err := client.SetPrependASPath(ctx, gateway, prependASPath)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix CloudN Registration prepend_as_path: %v", err)
 			}
@@ -228,7 +228,7 @@ func resourceAviatrixCloudnRegistrationUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceAviatrixCloudnRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	cloudnRegistration := &goaviatrix.CloudnRegistration{
 		Name: d.Get("name").(string),
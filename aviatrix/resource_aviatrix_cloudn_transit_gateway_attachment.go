@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strconv"
 	"strings"
 
@@ -13,14 +12,20 @@ import (
 
 func resourceAviatrixCloudnTransitGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixCloudnTransitGatewayAttachmentCreate,
-		ReadWithoutTimeout:   resourceAviatrixCloudnTransitGatewayAttachmentRead,
-		UpdateWithoutTimeout: resourceAviatrixCloudnTransitGatewayAttachmentUpdate,
-		DeleteWithoutTimeout: resourceAviatrixCloudnTransitGatewayAttachmentDelete,
+		CreateContext: resourceAviatrixCloudnTransitGatewayAttachmentCreate,
+		ReadContext:   resourceAviatrixCloudnTransitGatewayAttachmentRead,
+		UpdateContext: resourceAviatrixCloudnTransitGatewayAttachmentUpdate,
+		DeleteContext: resourceAviatrixCloudnTransitGatewayAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"device_name": {
 				Type:        schema.TypeString,
@@ -136,7 +141,7 @@ func marshalCloudnTransitGatewayAttachmentInput(d *schema.ResourceData) *goaviat
 }
 
 func resourceAviatrixCloudnTransitGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := marshalCloudnTransitGatewayAttachmentInput(d)
 
@@ -218,12 +223,12 @@ func resourceAviatrixCloudnTransitGatewayAttachmentReadIfRequired(ctx context.Co
 }
 
 func resourceAviatrixCloudnTransitGatewayAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	connName := d.Get("connection_name").(string)
 	if connName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		connName = id
 		d.Set("connection_name", connName)
 		d.SetId(connName)
@@ -282,7 +287,7 @@ func resourceAviatrixCloudnTransitGatewayAttachmentRead(ctx context.Context, d *
 }
 
 func resourceAviatrixCloudnTransitGatewayAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	attachment := marshalCloudnTransitGatewayAttachmentInput(d)
@@ -374,11 +379,11 @@ func resourceAviatrixCloudnTransitGatewayAttachmentUpdate(ctx context.Context, d
 }
 
 func resourceAviatrixCloudnTransitGatewayAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := marshalCloudnTransitGatewayAttachmentInput(d)
 
-	err := client.DeleteDeviceAttachment(attachment.ConnectionName)
+	err := client.DeleteDeviceAttachment(ctx, attachment.ConnectionName)
 	if err != nil {
 		return diag.Errorf("could not delete cloudn transit gateway attachment: %v", err)
 	}
@@ -1,20 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixCloudwatchAgent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixCloudwatchAgentCreate,
-		Read:   resourceAviatrixCloudwatchAgentRead,
-		Delete: resourceAviatrixCloudwatchAgentDelete,
+		CreateContext: resourceAviatrixCloudwatchAgentCreate,
+		ReadContext:   resourceAviatrixCloudwatchAgentRead,
+		DeleteContext: resourceAviatrixCloudwatchAgentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,29 +74,29 @@ func marshalCloudwatchAgentInput(d *schema.ResourceData) *goaviatrix.CloudwatchA
 	return cloudwatchAgent
 }
 
-func resourceAviatrixCloudwatchAgentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixCloudwatchAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	_, err := client.GetCloudwatchAgentStatus()
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the cloudwatch_agent is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the cloudwatch_agent is already enabled, please import to manage with Terraform")
 	}
 
 	cloudwatchAgent := marshalCloudwatchAgentInput(d)
 
 	if err := client.EnableCloudwatchAgent(cloudwatchAgent); err != nil {
-		return fmt.Errorf("could not enable cloudwatch agent: %v", err)
+		return diag.Errorf("could not enable cloudwatch agent: %v", err)
 	}
 
 	d.SetId("cloudwatch_agent")
 	return nil
 }
 
-func resourceAviatrixCloudwatchAgentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixCloudwatchAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if d.Id() != "cloudwatch_agent" {
-		return fmt.Errorf("invalid ID, expected ID \"cloudwatch_agent\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"cloudwatch_agent\", instead got %s", d.Id())
 	}
 
 	cloudwatchAgentStatus, err := client.GetCloudwatchAgentStatus()
@@ -104,7 +105,7 @@ func resourceAviatrixCloudwatchAgentRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get cloudwatch agent status: %v", err)
+		return diag.Errorf("could not get cloudwatch agent status: %v", err)
 	}
 
 	d.Set("cloudwatch_role_arn", cloudwatchAgentStatus.RoleArn)
@@ -119,11 +120,11 @@ func resourceAviatrixCloudwatchAgentRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAviatrixCloudwatchAgentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixCloudwatchAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if err := client.DisableCloudwatchAgent(); err != nil {
-		return fmt.Errorf("could not disable cloudwatch agent: %v", err)
+		return diag.Errorf("could not disable cloudwatch agent: %v", err)
 	}
 
 	return nil
@@ -70,7 +70,7 @@ func marshalControllerAccessAllowListConfigInput(d *schema.ResourceData) *goavia
 }
 
 func resourceAviatrixControllerAccessAllowListConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	allowList := marshalControllerAccessAllowListConfigInput(d)
 
@@ -82,7 +82,7 @@ func resourceAviatrixControllerAccessAllowListConfigCreate(ctx context.Context,
 		return diag.Errorf("failed to create controller access allow list config: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixControllerAccessAllowListConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -95,9 +95,9 @@ func resourceAviatrixControllerAccessAllowListConfigReadIfRequired(ctx context.C
 }
 
 func resourceAviatrixControllerAccessAllowListConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -126,12 +126,12 @@ func resourceAviatrixControllerAccessAllowListConfigRead(ctx context.Context, d
 		return diag.Errorf("failed to set allow_list: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerAccessAllowListConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	d.Partial(true)
 	if d.HasChanges("allow_list", "enable_enforce") {
@@ -148,7 +148,7 @@ func resourceAviatrixControllerAccessAllowListConfigUpdate(ctx context.Context,
 }
 
 func resourceAviatrixControllerAccessAllowListConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.DeleteControllerAccessAllowList(ctx)
 	if err != nil {
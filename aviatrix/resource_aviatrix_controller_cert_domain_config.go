@@ -32,7 +32,7 @@ func resourceAviatrixControllerCertDomainConfig() *schema.Resource {
 }
 
 func resourceAviatrixControllerCertDomainConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	certDomain := d.Get("cert_domain").(string)
 
@@ -57,14 +57,14 @@ func resourceAviatrixControllerCertDomainConfigCreate(ctx context.Context, d *sc
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixControllerCertDomainConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixControllerCertDomainConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -75,12 +75,12 @@ func resourceAviatrixControllerCertDomainConfigRead(ctx context.Context, d *sche
 
 	d.Set("cert_domain", certDomainConfig.CertDomain)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerCertDomainConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	if d.HasChange("cert_domain") {
 		err := client.SetCertDomain(ctx, d.Get("cert_domain").(string))
@@ -109,7 +109,7 @@ func resourceAviatrixControllerCertDomainConfigUpdate(ctx context.Context, d *sc
 }
 
 func resourceAviatrixControllerCertDomainConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.SetCertDomain(ctx, "aviatrixnetwork.com")
 	if err != nil {
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,12 +18,12 @@ var validAwsGuardDutyScanningIntervals = []int{5, 10, 15, 30, 60}
 
 func resourceAviatrixControllerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerConfigCreate,
-		Read:   resourceAviatrixControllerConfigRead,
-		Update: resourceAviatrixControllerConfigUpdate,
-		Delete: resourceAviatrixControllerConfigDelete,
+		CreateContext: resourceAviatrixControllerConfigCreate,
+		ReadContext:   resourceAviatrixControllerConfigRead,
+		UpdateContext: resourceAviatrixControllerConfigUpdate,
+		DeleteContext: resourceAviatrixControllerConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -177,22 +178,22 @@ func resourceAviatrixControllerConfig() *schema.Resource {
 	}
 }
 
-func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	flag := false
-	defer resourceAviatrixControllerConfigReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixControllerConfigReadIfRequired(ctx, d, meta, &flag)
 
-	log.Printf("[INFO] Configuring Aviatrix controller : %#v", d)
+	logInfo(ctx, "Configuring Aviatrix controller")
 
 	httpAccess := d.Get("http_access").(bool)
 	if httpAccess {
 		curStatus, _ := client.GetHttpAccessEnabled()
 		if curStatus == "True" {
-			log.Printf("[INFO] Http Access is already enabled")
+			logInfo(ctx, "Http Access is already enabled")
 		} else {
 			err = client.EnableHttpAccess()
 			time.Sleep(10 * time.Second)
@@ -200,34 +201,34 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 	} else {
 		curStatus, _ := client.GetHttpAccessEnabled()
 		if curStatus == "False" {
-			log.Printf("[INFO] Http Access is already disabled")
+			logInfo(ctx, "Http Access is already disabled")
 		} else {
 			err = client.DisableHttpAccess()
 			time.Sleep(10 * time.Second)
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("failed to configure controller http access: %s", err)
+		return diag.Errorf("failed to configure controller http access: %s", err)
 	}
 
 	fqdnExceptionRule := d.Get("fqdn_exception_rule").(bool)
 	if fqdnExceptionRule {
 		curStatus, _ := client.GetExceptionRuleStatus()
 		if curStatus {
-			log.Printf("[INFO] FQDN Exception Rule is already enabled")
+			logInfo(ctx, "FQDN Exception Rule is already enabled")
 		} else {
 			err = client.EnableExceptionRule()
 		}
 	} else {
 		curStatus, _ := client.GetExceptionRuleStatus()
 		if !curStatus {
-			log.Printf("[INFO] FQDN Exception Rule is already disabled")
+			logInfo(ctx, "FQDN Exception Rule is already disabled")
 		} else {
 			err = client.DisableExceptionRule()
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("failed to configure controller exception rule: %s", err)
+		return diag.Errorf("failed to configure controller exception rule: %s", err)
 	}
 
 	version := &goaviatrix.Version{
@@ -237,10 +238,10 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		manageGatewayUpgrades := d.Get("manage_gateway_upgrades").(bool)
 		err = client.AsyncUpgrade(version, manageGatewayUpgrades)
 		if err != nil {
-			return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
+			return diag.Errorf("failed to upgrade Aviatrix Controller: %s", err)
 		}
-		newCurrent, _, _ := client.GetCurrentVersion()
-		log.Printf("Upgrade complete (now %s)", newCurrent)
+		newCurrent, _, _ := client.GetCurrentVersion(ctx)
+		logInfo(ctx, "Upgrade complete", map[string]interface{}{"version": newCurrent})
 	}
 
 	backupConfiguration := d.Get("backup_configuration").(bool)
@@ -255,7 +256,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 	if backupConfiguration {
 		err = validateBackupConfig(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -272,13 +273,13 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 
 		err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
 		if err != nil {
-			return fmt.Errorf("failed to enable backup configuration: %s", err)
+			return diag.Errorf("failed to enable backup configuration: %s", err)
 		}
 	} else {
 		if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 			backupContainerName != "" || backupRegion != "" || multipleBackups {
-			return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
-				" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
+			return attributeErrorf("backup_cloud_type", "'backup_cloud_type', 'backup_account_name', 'backup_bucket_name',"+
+				" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty,"+
 				" 'multiple_backups' should be empty or false for not enabling backup configuration")
 		}
 	}
@@ -286,7 +287,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 	enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
 	err = client.SetControllerVpcDnsServer(enableVpcDnsServer)
 	if err != nil {
-		return fmt.Errorf("could not toggle controller vpc dns server: %v", err)
+		return diag.Errorf("could not toggle controller vpc dns server: %v", err)
 	}
 
 	if _, useFilePath := d.GetOk("ca_certificate_file_path"); useFilePath {
@@ -297,7 +298,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		}
 		err = client.ImportNewHTTPSCerts(certConfig)
 		if err != nil {
-			return fmt.Errorf("could not import HTTPS certs: %v", err)
+			return diag.Errorf("could not import HTTPS certs: %v", err)
 		}
 	} else if _, useFileContent := d.GetOk("ca_certificate_file"); useFileContent {
 		certConfig := &goaviatrix.HTTPSCertConfig{
@@ -307,34 +308,34 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		}
 		err = client.ImportNewHTTPSCerts(certConfig)
 		if err != nil {
-			return fmt.Errorf("could not import HTTPS certs: %v", err)
+			return diag.Errorf("could not import HTTPS certs: %v", err)
 		}
 	}
 
 	scanningInterval := d.Get("aws_guard_duty_scanning_interval")
 	err = client.UpdateAwsGuardDutyPollInterval(scanningInterval.(int))
 	if err != nil {
-		return fmt.Errorf("could not update scanning interval: %v", err)
+		return diag.Errorf("could not update scanning interval: %v", err)
 	}
 
-	return resourceAviatrixControllerConfigReadIfRequired(d, meta, &flag)
+	return resourceAviatrixControllerConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixControllerConfigReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixControllerConfigReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixControllerConfigRead(d, meta)
+		return resourceAviatrixControllerConfigRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Getting controller %s configuration", d.Id())
+	logInfo(ctx, "Getting controller configuration", map[string]interface{}{"id": d.Id()})
 	result, err := client.GetHttpAccessEnabled()
 	if err != nil {
-		return fmt.Errorf("could not read Aviatrix Controller http access configuration: %s", err)
+		return diag.Errorf("could not read Aviatrix Controller http access configuration: %s", err)
 	}
 
 	if result == "True" {
@@ -345,7 +346,7 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	res, err := client.GetExceptionRuleStatus()
 	if err != nil {
-		return fmt.Errorf("could not read Aviatrix Controller Exception Rule Status: %s", err)
+		return diag.Errorf("could not read Aviatrix Controller Exception Rule Status: %s", err)
 	}
 	if res {
 		d.Set("fqdn_exception_rule", true)
@@ -360,7 +361,7 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 		versionInfo, err = client.GetVersionInfo()
 		if err != nil {
 			if try == maxTries {
-				return fmt.Errorf("unable to read Controller version information: %s", err)
+				return diag.Errorf("unable to read Controller version information: %s", err)
 			}
 			time.Sleep(backoff)
 			// Double the backoff time after each failed try
@@ -386,7 +387,7 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	cloudnBackupConfig, err := client.GetCloudnBackupConfig()
 	if err != nil {
-		return fmt.Errorf("unable to read current controller cloudn backup config: %s", err)
+		return diag.Errorf("unable to read current controller cloudn backup config: %s", err)
 	}
 	if cloudnBackupConfig != nil && cloudnBackupConfig.BackupConfiguration == "yes" {
 		d.Set("backup_configuration", true)
@@ -409,14 +410,14 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	vpcDnsServerEnabled, err := client.GetControllerVpcDnsServerStatus()
 	if err != nil {
-		return fmt.Errorf("could not get controller vpc dns server status: %v", err)
+		return diag.Errorf("could not get controller vpc dns server status: %v", err)
 	}
 
 	d.Set("enable_vpc_dns_server", vpcDnsServerEnabled)
 
 	httpsCertsImported, err := client.GetHTTPSCertsStatus()
 	if err != nil {
-		return fmt.Errorf("could not get HTTPS Certificate status: %v", err)
+		return diag.Errorf("could not get HTTPS Certificate status: %v", err)
 	}
 	if !httpsCertsImported {
 		d.Set("ca_certificate_file_path", "")
@@ -429,18 +430,18 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	guardDuty, err := client.GetAwsGuardDuty()
 	if err != nil {
-		return fmt.Errorf("could not get aws guard duty scanning interval: %v", err)
+		return diag.Errorf("could not get aws guard duty scanning interval: %v", err)
 	}
 	d.Set("aws_guard_duty_scanning_interval", guardDuty.ScanningInterval)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
-func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Controller configuration: %#v", d)
+	logInfo(ctx, "Updating Controller configuration")
 	d.Partial(true)
 
 	if d.HasChange("http_access") {
@@ -449,15 +450,15 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 			err := client.EnableHttpAccess()
 			time.Sleep(10 * time.Second)
 			if err != nil {
-				log.Printf("[ERROR] Failed to enable http access on controller %s", d.Id())
-				return err
+				logError(ctx, "Failed to enable http access on controller", map[string]interface{}{"id": d.Id()})
+				return diag.FromErr(err)
 			}
 		} else {
 			err := client.DisableHttpAccess()
 			time.Sleep(10 * time.Second)
 			if err != nil {
-				log.Printf("[ERROR] Failed to disable http access on controller %s", d.Id())
-				return err
+				logError(ctx, "Failed to disable http access on controller", map[string]interface{}{"id": d.Id()})
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -467,14 +468,14 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		if fqdnExceptionRule {
 			err := client.EnableExceptionRule()
 			if err != nil {
-				log.Printf("[ERROR] Failed to enable exception rule on controller %s", d.Id())
-				return err
+				logError(ctx, "Failed to enable exception rule on controller", map[string]interface{}{"id": d.Id()})
+				return diag.FromErr(err)
 			}
 		} else {
 			err := client.DisableExceptionRule()
 			if err != nil {
-				log.Printf("[ERROR] Failed to disable exception rule on controller %s", d.Id())
-				return err
+				logError(ctx, "Failed to disable exception rule on controller", map[string]interface{}{"id": d.Id()})
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -495,26 +496,26 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 					if len(cur) != len(latest) {
 						err := client.AsyncUpgrade(version, manageGatewayUpgrades)
 						if err != nil {
-							return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
+							return diag.Errorf("failed to upgrade Aviatrix Controller: %s", err)
 						}
 					} else {
 						for i := range cur {
 							if cur[i] != latest[i] {
 								err := client.AsyncUpgrade(version, manageGatewayUpgrades)
 								if err != nil {
-									return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
+									return diag.Errorf("failed to upgrade Aviatrix Controller: %s", err)
 								}
 								break
 							}
 						}
 					}
 				} else {
-					log.Printf("[INFO] Controller is already on latest version")
+					logInfo(ctx, "Controller is already on latest version")
 				}
 			} else {
 				err := client.AsyncUpgrade(version, manageGatewayUpgrades)
 				if err != nil {
-					return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
+					return diag.Errorf("failed to upgrade Aviatrix Controller: %s", err)
 				}
 			}
 		}
@@ -533,7 +534,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		if backupConfiguration {
 			err := validateBackupConfig(d)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -550,19 +551,19 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 			err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
 			if err != nil {
-				return fmt.Errorf("failed to enable backup configuration: %s", err)
+				return diag.Errorf("failed to enable backup configuration: %s", err)
 			}
 		} else {
 			if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 				backupContainerName != "" || backupRegion != "" || multipleBackups {
-				return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
-					" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
+				return attributeErrorf("backup_cloud_type", "'backup_cloud_type', 'backup_account_name', 'backup_bucket_name',"+
+					" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty,"+
 					" 'multiple_backups' should be empty or false for not enabling backup configuration")
 			}
 
 			err := client.DisableCloudnBackupConfig()
 			if err != nil {
-				return fmt.Errorf("failed to disable backup configuration: %s", err)
+				return diag.Errorf("failed to disable backup configuration: %s", err)
 			}
 		}
 	} else {
@@ -574,12 +575,12 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 			if backupConfiguration {
 				err := validateBackupConfig(d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				err = client.DisableCloudnBackupConfig()
 				if err != nil {
-					return fmt.Errorf("failed to disable backup configuration: %s", err)
+					return diag.Errorf("failed to disable backup configuration: %s", err)
 				}
 
 				cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -596,13 +597,13 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 				err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
 				if err != nil {
-					return fmt.Errorf("failed to enable backup configuration: %s", err)
+					return diag.Errorf("failed to enable backup configuration: %s", err)
 				}
 			} else {
 				if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 					backupContainerName != "" || backupRegion != "" || multipleBackups {
-					return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
-						" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
+					return attributeErrorf("backup_cloud_type", "'backup_cloud_type', 'backup_account_name', 'backup_bucket_name',"+
+						" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty,"+
 						" 'multiple_backups' should be empty or false for not enabling backup configuration")
 				}
 			}
@@ -613,7 +614,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
 		err := client.SetControllerVpcDnsServer(enableVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("could not toggle controller vpc dns server: %v", err)
+			return diag.Errorf("could not toggle controller vpc dns server: %v", err)
 		}
 	}
 
@@ -628,7 +629,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 			err := client.ImportNewHTTPSCerts(certConfig)
 			if err != nil {
-				return fmt.Errorf("could not import new HTTPS certs: %v", err)
+				return diag.Errorf("could not import new HTTPS certs: %v", err)
 			}
 		} else if _, useFileContent := d.GetOk("ca_certificate_file"); useFileContent {
 			certConfig := &goaviatrix.HTTPSCertConfig{
@@ -639,7 +640,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 			err := client.ImportNewHTTPSCerts(certConfig)
 			if err != nil {
-				return fmt.Errorf("could not import new HTTPS certs: %v", err)
+				return diag.Errorf("could not import new HTTPS certs: %v", err)
 			}
 		}
 	}
@@ -648,24 +649,24 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		scanningInterval := d.Get("aws_guard_duty_scanning_interval").(int)
 		err := client.UpdateAwsGuardDutyPollInterval(scanningInterval)
 		if err != nil {
-			return fmt.Errorf("could not update scanning interval: %v", err)
+			return diag.Errorf("could not update scanning interval: %v", err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixControllerConfigRead(d, meta)
+	return resourceAviatrixControllerConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	d.Set("http_access", false)
 	curStatusHttp, _ := client.GetHttpAccessEnabled()
 	if curStatusHttp != "Disabled" {
 		err := client.DisableHttpAccess()
 		time.Sleep(10 * time.Second)
 		if err != nil {
-			log.Printf("[ERROR] Failed to disable http access on controller %s", d.Id())
-			return err
+			logError(ctx, "Failed to disable http access on controller", map[string]interface{}{"id": d.Id()})
+			return diag.FromErr(err)
 		}
 	}
 
@@ -674,8 +675,8 @@ func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interfa
 	if !curStatusException {
 		err := client.EnableExceptionRule()
 		if err != nil {
-			log.Printf("[ERROR] Failed to enable exception rule on controller %s", d.Id())
-			return err
+			logError(ctx, "Failed to enable exception rule on controller", map[string]interface{}{"id": d.Id()})
+			return diag.FromErr(err)
 		}
 	}
 
@@ -684,24 +685,24 @@ func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interfa
 	if cloudnBackupConfig.BackupConfiguration == "yes" {
 		err := client.DisableCloudnBackupConfig()
 		if err != nil {
-			log.Printf("[ERROR] Failed to disable cloudn backup config on controller %s", d.Id())
-			return err
+			logError(ctx, "Failed to disable cloudn backup config on controller", map[string]interface{}{"id": d.Id()})
+			return diag.FromErr(err)
 		}
 	}
 
 	err := client.SetControllerVpcDnsServer(false)
 	if err != nil {
-		return fmt.Errorf("could not disable controller vpc dns server: %v", err)
+		return diag.Errorf("could not disable controller vpc dns server: %v", err)
 	}
 
 	err = client.DisableImportedHTTPSCerts()
 	if err != nil {
-		return fmt.Errorf("could not disable imported certs: %v", err)
+		return diag.Errorf("could not disable imported certs: %v", err)
 	}
 
 	err = client.UpdateAwsGuardDutyPollInterval(defaultAwsGuardDutyScanningInterval)
 	if err != nil {
-		return fmt.Errorf("could not update scanning interval: %v", err)
+		return diag.Errorf("could not update scanning interval: %v", err)
 	}
 
 	return nil
@@ -1,7 +1,6 @@
 package aviatrix
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -45,8 +44,8 @@ func TestAccAviatrixControllerConfig_basic(t *testing.T) {
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
-					ImportStateVerify:       true,
-					ImportStateVerifyIgnore: importStateVerifyIgnore,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: importStateVerifyIgnore,
 			},
 		},
 	})
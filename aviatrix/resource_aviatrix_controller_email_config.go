@@ -71,7 +71,7 @@ func resourceAviatrixControllerEmailConfig() *schema.Resource {
 }
 
 func resourceAviatrixControllerEmailConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	emailConfiguration := &goaviatrix.EmailConfiguration{
 		AdminAlertEmail:                  d.Get("admin_alert_email").(string),
@@ -81,7 +81,7 @@ func resourceAviatrixControllerEmailConfigCreate(ctx context.Context, d *schema.
 		StatusChangeNotificationInterval: d.Get("status_change_notification_interval").(int),
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixControllerEmailConfigReadIfRequired(ctx, d, meta, &flag)
 
@@ -109,9 +109,9 @@ func resourceAviatrixControllerEmailConfigReadIfRequired(ctx context.Context, d
 }
 
 func resourceAviatrixControllerEmailConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -129,12 +129,12 @@ func resourceAviatrixControllerEmailConfigRead(ctx context.Context, d *schema.Re
 	d.Set("security_event_email_verified", emailConfiguration.SecurityEventEmailVerified)
 	d.Set("status_change_email_verified", emailConfiguration.StatusChangeEmailVerified)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerEmailConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	if d.HasChanges("admin_alert_email", "critical_alert_email", "security_event_email", "status_change_email") {
 		emailConfiguration := &goaviatrix.EmailConfiguration{}
@@ -172,7 +172,7 @@ func resourceAviatrixControllerEmailConfigUpdate(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixControllerEmailConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	emailConfiguration := &goaviatrix.EmailConfiguration{
 		StatusChangeNotificationInterval: 60,
@@ -31,7 +31,7 @@ func resourceAviatrixControllerEmailExceptionNotificationConfig() *schema.Resour
 }
 
 func resourceAviatrixControllerEmailExceptionNotificationConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	enableEmailExceptionNotification := d.Get("enable_email_exception_notification").(bool)
 	if !enableEmailExceptionNotification {
@@ -41,14 +41,14 @@ func resourceAviatrixControllerEmailExceptionNotificationConfigCreate(ctx contex
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixControllerEmailExceptionNotificationConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixControllerEmailExceptionNotificationConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -58,12 +58,12 @@ func resourceAviatrixControllerEmailExceptionNotificationConfigRead(ctx context.
 	}
 	d.Set("enable_email_exception_notification", enableEmailExceptionNotification)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerEmailExceptionNotificationConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	if d.HasChange("enable_email_exception_notification") {
 		err := client.SetEmailExceptionNotification(ctx, d.Get("enable_email_exception_notification").(bool))
@@ -76,7 +76,7 @@ func resourceAviatrixControllerEmailExceptionNotificationConfigUpdate(ctx contex
 }
 
 func resourceAviatrixControllerEmailExceptionNotificationConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.SetEmailExceptionNotification(ctx, true)
 	if err != nil {
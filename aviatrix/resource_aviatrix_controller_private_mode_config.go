@@ -35,7 +35,7 @@ func resourceAviatrixControllerPrivateModeConfig() *schema.Resource {
 }
 
 func resourceAviatrixControllerPrivateModeConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	enablePrivateMode := d.Get("enable_private_mode").(bool)
 	if !enablePrivateMode {
@@ -59,7 +59,7 @@ func resourceAviatrixControllerPrivateModeConfigCreate(ctx context.Context, d *s
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 
 	if _, ok := d.GetOk("copilot_instance_id"); ok {
 		copilotInstanceId := d.Get("copilot_instance_id").(string)
@@ -81,9 +81,9 @@ func resourceAviatrixControllerPrivateModeConfigReadIfRequired(ctx context.Conte
 }
 
 func resourceAviatrixControllerPrivateModeConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -95,12 +95,12 @@ func resourceAviatrixControllerPrivateModeConfigRead(ctx context.Context, d *sch
 	d.Set("enable_private_mode", controllerPrivateModeConfig.EnablePrivateMode)
 	d.Set("copilot_instance_id", controllerPrivateModeConfig.CopilotInstanceID)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerPrivateModeConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	enablePrivateMode := d.Get("enable_private_mode").(bool)
 	if d.HasChanges("enable_private_mode", "copilot_instance_id") && !enablePrivateMode {
@@ -135,7 +135,7 @@ func resourceAviatrixControllerPrivateModeConfigUpdate(ctx context.Context, d *s
 }
 
 func resourceAviatrixControllerPrivateModeConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.DisablePrivateMode(ctx)
 	if err != nil {
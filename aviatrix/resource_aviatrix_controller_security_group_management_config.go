@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 )
 
 func resourceAviatrixControllerSecurityGroupManagementConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerSecurityGroupManagementConfigCreate,
-		Read:   resourceAviatrixControllerSecurityGroupManagementConfigRead,
-		Update: resourceAviatrixControllerSecurityGroupManagementConfigUpdate,
-		Delete: resourceAviatrixControllerSecurityGroupManagementConfigDelete,
+		CreateContext: resourceAviatrixControllerSecurityGroupManagementConfigCreate,
+		ReadContext:   resourceAviatrixControllerSecurityGroupManagementConfigRead,
+		UpdateContext: resourceAviatrixControllerSecurityGroupManagementConfigUpdate,
+		DeleteContext: resourceAviatrixControllerSecurityGroupManagementConfigDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -35,64 +35,64 @@ func resourceAviatrixControllerSecurityGroupManagementConfig() *schema.Resource
 	}
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerSecurityGroupManagementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ControllerClient)
 
 	account := d.Get("account_name").(string)
 	enableSecurityGroupManagement := d.Get("enable_security_group_management").(bool)
 
 	if enableSecurityGroupManagement {
 		if account == "" {
-			return fmt.Errorf("account_name is needed to enable controller Security Group Management")
+			return attributeErrorf("account_name", "account_name is needed to enable controller Security Group Management")
 		}
 		curStatus, _ := client.GetSecurityGroupManagementStatus()
 		if curStatus.State == "Enabled" {
-			log.Printf("[INFO] Security Group Management is already enabled")
+			logInfo(ctx, "Security Group Management is already enabled")
 		} else {
 			err := client.EnableSecurityGroupManagement(account)
 			if err != nil {
-				return fmt.Errorf("failed to enable controller Security Group Management: %s", err)
+				return diag.Errorf("failed to enable controller Security Group Management: %s", err)
 			}
 		}
 	} else {
 		if account != "" {
-			return fmt.Errorf("account_name isn't needed to disable controller Security Group Management")
+			return attributeErrorf("account_name", "account_name isn't needed to disable controller Security Group Management")
 		}
 		curStatus, _ := client.GetSecurityGroupManagementStatus()
 		if curStatus.State == "Disabled" {
-			log.Printf("[INFO] Security Group Management is already disabled")
+			logInfo(ctx, "Security Group Management is already disabled")
 		} else {
 			err := client.DisableSecurityGroupManagement()
 			if err != nil {
-				return fmt.Errorf("failed to disable controller Security Group Management: %s", err)
+				return diag.Errorf("failed to disable controller Security Group Management: %s", err)
 			}
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
-	return resourceAviatrixControllerSecurityGroupManagementConfigRead(d, meta)
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
+	return resourceAviatrixControllerSecurityGroupManagementConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerSecurityGroupManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ControllerClient)
 
 	sgm, err := client.GetSecurityGroupManagementStatus()
 	if err != nil {
-		return fmt.Errorf("could not read Aviatrix Controller Security Group Management Status: %s", err)
+		return diag.Errorf("could not read Aviatrix Controller Security Group Management Status: %s", err)
 	}
 	if sgm != nil {
 		d.Set("enable_security_group_management", sgm.State == "Enabled")
 		d.Set("account_name", sgm.AccountName)
 	} else {
-		return fmt.Errorf("could not read Aviatrix Controller Security Group Management Status")
+		return diag.Errorf("could not read Aviatrix Controller Security Group Management Status")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ControllerClient)
 
 	if d.HasChange("account_name") || d.HasChange("enable_security_group_management") {
 		oldAccount, newAccount := d.GetChange("account_name")
@@ -102,21 +102,21 @@ func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(d *schema.Res
 			err := client.DisableSecurityGroupManagement()
 			if err != nil {
 				if err != nil {
-					return fmt.Errorf("failed to disable Security Group Management on controller %s: %s", d.Id(), err)
+					return diag.Errorf("failed to disable Security Group Management on controller %s: %s", d.Id(), err)
 				}
 			}
 			err = client.EnableSecurityGroupManagement(newAccount.(string))
 			if err != nil {
-				return fmt.Errorf("failed to enable Security Group Management on controller %s: %s", d.Id(), err)
+				return diag.Errorf("failed to enable Security Group Management on controller %s: %s", d.Id(), err)
 			}
 		} else {
-			return resourceAviatrixControllerSecurityGroupManagementConfigCreate(d, meta)
+			return resourceAviatrixControllerSecurityGroupManagementConfigCreate(ctx, d, meta)
 		}
 	}
 
-	return resourceAviatrixControllerSecurityGroupManagementConfigRead(d, meta)
+	return resourceAviatrixControllerSecurityGroupManagementConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerSecurityGroupManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
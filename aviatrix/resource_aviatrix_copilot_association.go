@@ -32,7 +32,7 @@ func resourceAviatrixCopilotAssociation() *schema.Resource {
 }
 
 func resourceAviatrixCopilotAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	addr := d.Get("copilot_address").(string)
 	err := client.EnableCopilotAssociation(ctx, addr)
@@ -40,12 +40,12 @@ func resourceAviatrixCopilotAssociationCreate(ctx context.Context, d *schema.Res
 		return diag.Errorf("could not associate copilot: %v", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixCopilotAssociationRead(ctx, d, meta)
 }
 
 func resourceAviatrixCopilotAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	copilot, err := client.GetCopilotAssociationStatus(ctx)
 	if err == goaviatrix.ErrNotFound {
@@ -57,12 +57,12 @@ func resourceAviatrixCopilotAssociationRead(ctx context.Context, d *schema.Resou
 	}
 
 	d.Set("copilot_address", copilot.IP)
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.DisableCopilotAssociation(ctx)
 	if err != nil {
@@ -19,6 +19,8 @@ func resourceAviatrixCopilotFaultTolerantDeployment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_copilot_fault_tolerant_deployment", goaviatrix.FeatureCopilotDeployment),
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:        schema.TypeInt,
@@ -150,7 +152,7 @@ func marshalCopilotFaultTolerantDeploymentInput(d *schema.ResourceData) *goaviat
 }
 
 func resourceAviatrixCopilotFaultTolerantDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	copilotFaultTolerantDeployment := marshalCopilotFaultTolerantDeploymentInput(d)
 
@@ -158,7 +160,7 @@ func resourceAviatrixCopilotFaultTolerantDeploymentCreate(ctx context.Context, d
 		return diag.Errorf("at least three cluster data nodes are required")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixCopilotFaultTolerantDeploymentReadIfRequired(ctx, d, meta, &flag)
 
@@ -196,9 +198,9 @@ func resourceAviatrixCopilotFaultTolerantDeploymentReadIfRequired(ctx context.Co
 }
 
 func resourceAviatrixCopilotFaultTolerantDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -214,12 +216,12 @@ func resourceAviatrixCopilotFaultTolerantDeploymentRead(ctx context.Context, d *
 	d.Set("main_copilot_private_ip", copilotAssociationStatus.IP)
 	d.Set("main_copilot_public_ip", copilotAssociationStatus.PublicIp)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotFaultTolerantDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.DeleteCopilotFaultTolerant(ctx)
 	if err != nil {
@@ -76,11 +76,11 @@ func marshalCopilotSecurityGroupManagementConfigInput(d *schema.ResourceData) *g
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	copilotSecurityGroupManagementConfig := marshalCopilotSecurityGroupManagementConfigInput(d)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixCopilotSecurityGroupManagementConfigReadIfRequired(ctx, d, meta, &flag)
 
@@ -88,17 +88,17 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigCreate(ctx context.Cont
 		if copilotSecurityGroupManagementConfig.CloudType == 0 || copilotSecurityGroupManagementConfig.AccountName == "" ||
 			copilotSecurityGroupManagementConfig.VpcId == "" || copilotSecurityGroupManagementConfig.InstanceId == "" ||
 			(copilotSecurityGroupManagementConfig.Region == "" && copilotSecurityGroupManagementConfig.Zone == "") {
-			return diag.Errorf("'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are required to enable copilot security group management")
+			return attributeErrorf("cloud_type", "'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are required to enable copilot security group management")
 		}
 
 		if goaviatrix.IsCloudType(copilotSecurityGroupManagementConfig.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) &&
 			copilotSecurityGroupManagementConfig.Zone != "" {
-			return diag.Errorf("'zone' is only supported for 'GCP', please use 'region' for AWS and Azure")
+			return attributeErrorf("zone", "'zone' is only supported for 'GCP', please use 'region' for AWS and Azure")
 		}
 
 		if goaviatrix.IsCloudType(copilotSecurityGroupManagementConfig.CloudType, goaviatrix.GCPRelatedCloudTypes) &&
 			copilotSecurityGroupManagementConfig.Region != "" {
-			return diag.Errorf("'region' is only supported for AWS and Azure, please use 'zone' for GCP")
+			return attributeErrorf("region", "'region' is only supported for AWS and Azure, please use 'zone' for GCP")
 		}
 
 		if err := client.EnableCopilotSecurityGroupManagement(ctx, copilotSecurityGroupManagementConfig); err != nil {
@@ -108,7 +108,7 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigCreate(ctx context.Cont
 		if copilotSecurityGroupManagementConfig.CloudType != 0 || copilotSecurityGroupManagementConfig.AccountName != "" ||
 			copilotSecurityGroupManagementConfig.VpcId != "" || copilotSecurityGroupManagementConfig.InstanceId != "" ||
 			copilotSecurityGroupManagementConfig.Region != "" || copilotSecurityGroupManagementConfig.Zone != "" {
-			return diag.Errorf("'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are not needed to disable copilot security group management")
+			return attributeErrorf("cloud_type", "'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are not needed to disable copilot security group management")
 		}
 
 		err := client.DisableCopilotSecurityGroupManagement(ctx)
@@ -129,9 +129,9 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigReadIfRequired(ctx cont
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -156,12 +156,12 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigRead(ctx context.Contex
 		return diag.Errorf("could not read copilot security group management config")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	copilotSecurityGroupManagementConfig := marshalCopilotSecurityGroupManagementConfigInput(d)
 
@@ -170,17 +170,17 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Cont
 			if copilotSecurityGroupManagementConfig.CloudType == 0 || copilotSecurityGroupManagementConfig.AccountName == "" ||
 				copilotSecurityGroupManagementConfig.VpcId == "" || copilotSecurityGroupManagementConfig.InstanceId == "" ||
 				(copilotSecurityGroupManagementConfig.Region == "" && copilotSecurityGroupManagementConfig.Zone == "") {
-				return diag.Errorf("'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are required to enable copilot security group management")
+				return attributeErrorf("cloud_type", "'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are required to enable copilot security group management")
 			}
 
 			if goaviatrix.IsCloudType(copilotSecurityGroupManagementConfig.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) &&
 				copilotSecurityGroupManagementConfig.Zone != "" {
-				return diag.Errorf("'zone' is only supported for 'GCP', please use 'region' for AWS and Azure")
+				return attributeErrorf("zone", "'zone' is only supported for 'GCP', please use 'region' for AWS and Azure")
 			}
 
 			if goaviatrix.IsCloudType(copilotSecurityGroupManagementConfig.CloudType, goaviatrix.GCPRelatedCloudTypes) &&
 				copilotSecurityGroupManagementConfig.Region != "" {
-				return diag.Errorf("'region' is only supported for AWS and Azure, please use 'zone' for GCP")
+				return attributeErrorf("region", "'region' is only supported for AWS and Azure, please use 'zone' for GCP")
 			}
 
 			if err := client.EnableCopilotSecurityGroupManagement(ctx, copilotSecurityGroupManagementConfig); err != nil {
@@ -190,7 +190,7 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Cont
 			if copilotSecurityGroupManagementConfig.CloudType != 0 || copilotSecurityGroupManagementConfig.AccountName != "" ||
 				copilotSecurityGroupManagementConfig.VpcId != "" || copilotSecurityGroupManagementConfig.InstanceId != "" ||
 				copilotSecurityGroupManagementConfig.Region != "" || copilotSecurityGroupManagementConfig.Zone != "" {
-				return diag.Errorf("'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are not needed to disable copilot security group management")
+				return attributeErrorf("cloud_type", "'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are not needed to disable copilot security group management")
 			}
 
 			err := client.DisableCopilotSecurityGroupManagement(ctx)
@@ -209,17 +209,17 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Cont
 				if copilotSecurityGroupManagementConfig.CloudType == 0 || copilotSecurityGroupManagementConfig.AccountName == "" ||
 					copilotSecurityGroupManagementConfig.VpcId == "" || copilotSecurityGroupManagementConfig.InstanceId == "" ||
 					(copilotSecurityGroupManagementConfig.Region == "" && copilotSecurityGroupManagementConfig.Zone == "") {
-					return diag.Errorf("'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are required to enable copilot security group management")
+					return attributeErrorf("cloud_type", "'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are required to enable copilot security group management")
 				}
 
 				if goaviatrix.IsCloudType(copilotSecurityGroupManagementConfig.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) &&
 					copilotSecurityGroupManagementConfig.Zone != "" {
-					return diag.Errorf("'zone' is only supported for 'GCP', please use 'region' for AWS and Azure")
+					return attributeErrorf("zone", "'zone' is only supported for 'GCP', please use 'region' for AWS and Azure")
 				}
 
 				if goaviatrix.IsCloudType(copilotSecurityGroupManagementConfig.CloudType, goaviatrix.GCPRelatedCloudTypes) &&
 					copilotSecurityGroupManagementConfig.Region != "" {
-					return diag.Errorf("'region' is only supported for AWS and Azure, please use 'zone' for GCP")
+					return attributeErrorf("region", "'region' is only supported for AWS and Azure, please use 'zone' for GCP")
 				}
 
 				if err := client.EnableCopilotSecurityGroupManagement(ctx, copilotSecurityGroupManagementConfig); err != nil {
@@ -228,7 +228,7 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Cont
 			}
 		} else {
 			if d.HasChanges("cloud_type", "account_name", "region", "zone", "vpc_id", "instance_id") {
-				return diag.Errorf("'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are not allowed to be changed when_copilot security group management is disabled")
+				return attributeErrorf("cloud_type", "'cloud_type', 'account_name', 'region'/'zone', 'vpc_id' and 'instance_id' are not allowed to be changed when_copilot security group management is disabled")
 			}
 		}
 	}
@@ -237,7 +237,7 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Cont
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.DisableCopilotSecurityGroupManagement(ctx)
 	if err != nil {
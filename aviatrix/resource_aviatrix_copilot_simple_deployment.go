@@ -19,6 +19,8 @@ func resourceAviatrixCopilotSimpleDeployment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_copilot_simple_deployment", goaviatrix.FeatureCopilotDeployment),
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:        schema.TypeInt,
@@ -108,11 +110,11 @@ func marshalCopilotSimpleDeploymentInput(d *schema.ResourceData) *goaviatrix.Cop
 }
 
 func resourceAviatrixCopilotSimpleDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	copilotSimpleDeployment := marshalCopilotSimpleDeploymentInput(d)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixCopilotSimpleDeploymentReadIfRequired(ctx, d, meta, &flag)
 
@@ -150,7 +152,7 @@ func resourceAviatrixCopilotSimpleDeploymentReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixCopilotSimpleDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	copilotAssociationStatus, err := client.GetCopilotAssociationStatus(ctx)
 	if err == goaviatrix.ErrNotFound {
@@ -164,12 +166,12 @@ func resourceAviatrixCopilotSimpleDeploymentRead(ctx context.Context, d *schema.
 	d.Set("private_ip", copilotAssociationStatus.IP)
 	d.Set("public_ip", copilotAssociationStatus.PublicIp)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotSimpleDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	err := client.DeleteCopilotSimple(ctx)
 	if err != nil {
@@ -1,9 +1,10 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,11 +13,11 @@ import (
 
 func resourceAviatrixDatadogAgent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixDatadogAgentCreate,
-		Read:   resourceAviatrixDatadogAgentRead,
-		Delete: resourceAviatrixDatadogAgentDelete,
+		CreateContext: resourceAviatrixDatadogAgentCreate,
+		ReadContext:   resourceAviatrixDatadogAgentRead,
+		DeleteContext: resourceAviatrixDatadogAgentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,28 +79,28 @@ func marshalDatadogAgentInput(d *schema.ResourceData) *goaviatrix.DatadogAgent {
 	return datadogAgent
 }
 
-func resourceAviatrixDatadogAgentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixDatadogAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	_, err := client.GetDatadogAgentStatus()
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the datadog_agent is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the datadog_agent is already enabled, please import to manage with Terraform")
 	}
 
 	datadogAgent := marshalDatadogAgentInput(d)
 
 	if err := client.EnableDatadogAgent(datadogAgent); err != nil {
-		return fmt.Errorf("could not enable datadog agent: %v KEY IS %s", err, d.Get("api_key"))
+		return diag.Errorf("could not enable datadog agent: %v KEY IS %s", err, d.Get("api_key"))
 	}
 
 	d.SetId("datadog_agent")
 	return nil
 }
-func resourceAviatrixDatadogAgentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixDatadogAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if d.Id() != "datadog_agent" {
-		return fmt.Errorf("invalid ID, expected ID \"datadog_agent\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"datadog_agent\", instead got %s", d.Id())
 	}
 
 	datadogAgentStatus, err := client.GetDatadogAgentStatus()
@@ -108,7 +109,7 @@ func resourceAviatrixDatadogAgentRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get remote syslog status: %v", err)
+		return diag.Errorf("could not get remote syslog status: %v", err)
 	}
 
 	d.Set("site", datadogAgentStatus.Site)
@@ -122,11 +123,11 @@ func resourceAviatrixDatadogAgentRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixDatadogAgentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixDatadogAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if err := client.DisableDatadogAgent(); err != nil {
-		return fmt.Errorf("could not disable datadog agent: %v", err)
+		return diag.Errorf("could not disable datadog agent: %v", err)
 	}
 
 	return nil
@@ -17,7 +17,7 @@ func resourceAviatrixDistributedFirewallingIntraVpc() *schema.Resource {
 		UpdateWithoutTimeout: resourceAviatrixDistributedFirewallingIntraVpcUpdate,
 		DeleteWithoutTimeout: resourceAviatrixDistributedFirewallingIntraVpcDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +50,7 @@ func resourceAviatrixDistributedFirewallingIntraVpc() *schema.Resource {
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	vpcList, err := marshalDistributedFirewallingIntraVpcListInput(d)
 	if err != nil {
@@ -64,7 +64,7 @@ func resourceAviatrixDistributedFirewallingIntraVpcCreate(ctx context.Context, d
 	if err != nil {
 		return diag.Errorf("failed to create Distributed-firewalling Intra VPC: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingIntraVpcReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -77,7 +77,7 @@ func resourceAviatrixDistributedFirewallingIntraVpcReadIfRequired(ctx context.Co
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	vpcList, err := client.GetDistributedFirewallingIntraVpc(ctx)
 	if err != nil {
@@ -102,12 +102,12 @@ func resourceAviatrixDistributedFirewallingIntraVpcRead(ctx context.Context, d *
 		return diag.Errorf("failed to set vpcs during Distributed-firewalling Intra VPC read: %s\n", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	d.Partial(true)
 	if d.HasChange("vpcs") {
@@ -126,7 +126,7 @@ func resourceAviatrixDistributedFirewallingIntraVpcUpdate(ctx context.Context, d
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	err := client.DeleteDistributedFirewallingIntraVpc(ctx)
 	if err != nil {
@@ -21,6 +21,8 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfig() *schema
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_distributed_firewalling_origin_cert_enforcement_config", goaviatrix.FeatureDistributedFirewallingOriginCertEnforcement),
+
 		Schema: map[string]*schema.Schema{
 			"enforcement_level": {
 				Type:         schema.TypeString,
@@ -34,7 +36,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfig() *schema
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	enforcementLevel := &goaviatrix.EnforcementLevel{
 		Level: d.Get("enforcement_level").(string),
@@ -48,7 +50,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigCreate(ctx
 		return diag.Errorf("failed to config Distributed-firewalling origin cert enforcement level: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -61,9 +63,9 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigReadIfRequ
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -84,12 +86,12 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigRead(ctx c
 		d.Set("enforcement_level", "Permissive")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	d.Partial(true)
 	if d.HasChange("enforcement_level") {
@@ -108,7 +110,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigUpdate(ctx
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	err := client.DeleteEnforcementLevel(ctx)
 	if err != nil {
@@ -16,9 +16,11 @@ func resourceAviatrixDistributedFirewallingProxyCaConfig() *schema.Resource {
 		ReadWithoutTimeout:   resourceAviatrixDistributedFirewallingProxyCaConfigRead,
 		DeleteWithoutTimeout: resourceAviatrixDistributedFirewallingProxyCaConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_distributed_firewalling_proxy_ca_config", goaviatrix.FeatureDistributedFirewallingProxyCa),
+
 		Schema: map[string]*schema.Schema{
 			"ca_cert": {
 				Type:        schema.TypeString,
@@ -63,7 +65,7 @@ func resourceAviatrixDistributedFirewallingProxyCaConfig() *schema.Resource {
 }
 
 func resourceAviatrixDistributedFirewallingProxyCaConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	proxyCaConfig := &goaviatrix.ProxyCaConfig{
 		CaCert: d.Get("ca_cert").(string),
@@ -74,14 +76,14 @@ func resourceAviatrixDistributedFirewallingProxyCaConfigCreate(ctx context.Conte
 		return diag.Errorf("failed to set new Distributed-firewalling proxy ca certificate: %v", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingProxyCaConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingProxyCaConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -104,12 +106,12 @@ func resourceAviatrixDistributedFirewallingProxyCaConfigRead(ctx context.Context
 		d.Set("upload_info", proxyCaCertInstance.UploadInfo)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingProxyCaConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	err := client.DeleteCaCertificate(ctx)
 	if err != nil {
@@ -82,7 +82,7 @@ func marshalDNSProfileInput(d *schema.ResourceData) map[string]interface{} {
 }
 
 func resourceAviatrixDNSProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	data := marshalDNSProfileInput(d)
 
@@ -106,7 +106,7 @@ func resourceAviatrixDNSProfileReadIfRequired(ctx context.Context, d *schema.Res
 }
 
 func resourceAviatrixDNSProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	if d.Get("name").(string) == "" {
 		id := d.Id()
@@ -133,7 +133,7 @@ func resourceAviatrixDNSProfileRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixDNSProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	d.Partial(true)
 
@@ -150,7 +150,7 @@ func resourceAviatrixDNSProfileUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixDNSProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	data := marshalDNSProfileInput(d)
 
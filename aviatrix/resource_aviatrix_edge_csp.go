@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -17,14 +16,20 @@ import (
 
 func resourceAviatrixEdgeCSP() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeCSPCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeCSPRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeCSPUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeCSPDelete,
+		CreateContext: resourceAviatrixEdgeCSPCreate,
+		ReadContext:   resourceAviatrixEdgeCSPRead,
+		UpdateContext: resourceAviatrixEdgeCSPUpdate,
+		DeleteContext: resourceAviatrixEdgeCSPDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -456,7 +461,7 @@ func marshalEdgeCSPInput(d *schema.ResourceData) *goaviatrix.EdgeCSP {
 }
 
 func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeCSP := marshalEdgeCSPInput(d)
@@ -467,12 +472,12 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if !edgeCSP.EnableLearnedCidrsApproval && len(edgeCSP.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeCSP.PrependAsPath) != 0 {
 		if edgeCSP.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -509,14 +514,14 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if edgeCSP.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeCSP.LocalAsNumber)
+		err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeCSP.LocalAsNumber)
 		if err != nil {
 			return diag.Errorf("could not set 'local_as_number' after Edge CSP creation: %v", err)
 		}
 	}
 
 	if len(edgeCSP.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeCSP.PrependAsPath)
+		err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeCSP.PrependAsPath)
 		if err != nil {
 			return diag.Errorf("could not set 'prepend_as_path' after Edge CSP creation: %v", err)
 		}
@@ -531,7 +536,7 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 
 	if len(edgeCSP.ApprovedLearnedCidrs) != 0 {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeCSP.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved CIDRs after Edge CSP creation: %v", err)
 		}
@@ -539,7 +544,7 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 
 	if len(edgeCSP.SpokeBgpManualAdvertisedCidrs) != 0 {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeCSP.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge CSP creation: %v", err)
 		}
@@ -560,7 +565,7 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if edgeCSP.BgpHoldTime >= 12 && edgeCSP.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeCSP.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, gatewayForSpokeFunctions.GwName, edgeCSP.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change BGP Hold Time after Edge CSP creation: %v", err)
 		}
@@ -591,7 +596,7 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 
 	if edgeCSP.RxQueueSize != "" {
 		gatewayForGatewayFunctions.RxQueueSize = edgeCSP.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not set rx queue size after Edge CSP creation: %v", err)
 		}
@@ -631,12 +636,12 @@ func resourceAviatrixEdgeCSPReadIfRequired(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -770,7 +775,7 @@ func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeCSP := marshalEdgeCSPInput(d)
@@ -781,12 +786,12 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if !edgeCSP.EnableLearnedCidrsApproval && len(edgeCSP.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeCSP.PrependAsPath) != 0 {
 		if edgeCSP.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -819,21 +824,21 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeCSP.PrependAsPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, nil)
 			if err != nil {
 				return diag.Errorf("could not delete prepend_as_path during Edge CSP update: %v", err)
 			}
 		}
 
 		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeCSP.LocalAsNumber)
+			err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeCSP.LocalAsNumber)
 			if err != nil {
 				return diag.Errorf("could not set local_as_number during Edge CSP update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(edgeCSP.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeCSP.PrependAsPath)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeCSP.PrependAsPath)
 			if err != nil {
 				return diag.Errorf("could not set prepend_as_path during Edge CSP update: %v", err)
 			}
@@ -856,7 +861,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if edgeCSP.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeCSP.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved learned CIDRs during Edge CSP update: %v", err)
 		}
@@ -864,7 +869,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeCSP.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge CSP update: %v", err)
 		}
@@ -892,7 +897,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeCSP.GwName, edgeCSP.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, edgeCSP.GwName, edgeCSP.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change bgp hold time during Edge CSP update: %v", err)
 		}
@@ -919,7 +924,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 				return diag.Errorf("could not enable jumbo frame during Edge CSP update: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
+			err := client.DisableJumboFrame(ctx, gatewayForGatewayFunctions)
 			if err != nil {
 				return diag.Errorf("could not disable jumbo frame during Edge CSP update: %v", err)
 			}
@@ -937,7 +942,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("rx_queue_size") {
 		gatewayForGatewayFunctions.RxQueueSize = edgeCSP.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not update rx queue size during Edge CSP update: %v", err)
 		}
@@ -976,7 +981,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeCSPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
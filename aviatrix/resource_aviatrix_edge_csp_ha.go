@@ -2,24 +2,30 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 )
 
 func resourceAviatrixEdgeCSPHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeCSPHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeCSPHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeCSPHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeCSPHaDelete,
+		CreateContext: resourceAviatrixEdgeCSPHaCreate,
+		ReadContext:   resourceAviatrixEdgeCSPHaRead,
+		UpdateContext: resourceAviatrixEdgeCSPHaUpdate,
+		DeleteContext: resourceAviatrixEdgeCSPHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -142,7 +148,7 @@ func marshalEdgeCSPHaInput(d *schema.ResourceData) *goaviatrix.EdgeCSPHa {
 }
 
 func resourceAviatrixEdgeCSPHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeCSPHa := marshalEdgeCSPHaInput(d)
 
@@ -156,11 +162,11 @@ func resourceAviatrixEdgeCSPHaCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeCSPHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -211,7 +217,7 @@ func resourceAviatrixEdgeCSPHaRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeCSPHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeCSPHa := marshalEdgeCSPHaInput(d)
 
@@ -236,7 +242,7 @@ func resourceAviatrixEdgeCSPHaUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeCSPHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	accountName := d.Get("account_name").(string)
 
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
@@ -18,14 +17,20 @@ import (
 
 func resourceAviatrixEdgeEquinix() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeEquinixCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeEquinixRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeEquinixUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeEquinixDelete,
+		CreateContext: resourceAviatrixEdgeEquinixCreate,
+		ReadContext:   resourceAviatrixEdgeEquinixRead,
+		UpdateContext: resourceAviatrixEdgeEquinixUpdate,
+		DeleteContext: resourceAviatrixEdgeEquinixDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -414,7 +419,7 @@ func marshalEdgeEquinixInput(d *schema.ResourceData) *goaviatrix.EdgeEquinix {
 }
 
 func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeEquinix := marshalEdgeEquinixInput(d)
@@ -425,12 +430,12 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if !edgeEquinix.EnableLearnedCidrsApproval && len(edgeEquinix.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeEquinix.PrependAsPath) != 0 {
 		if edgeEquinix.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -467,14 +472,14 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if edgeEquinix.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeEquinix.LocalAsNumber)
+		err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeEquinix.LocalAsNumber)
 		if err != nil {
 			return diag.Errorf("could not set 'local_as_number' after Edge Equinix creation: %v", err)
 		}
 	}
 
 	if len(edgeEquinix.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeEquinix.PrependAsPath)
+		err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeEquinix.PrependAsPath)
 		if err != nil {
 			return diag.Errorf("could not set 'prepend_as_path' after Edge Equinix creation: %v", err)
 		}
@@ -489,7 +494,7 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 
 	if len(edgeEquinix.ApprovedLearnedCidrs) != 0 {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeEquinix.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved CIDRs after Edge Equinix creation: %v", err)
 		}
@@ -497,7 +502,7 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 
 	if len(edgeEquinix.SpokeBgpManualAdvertisedCidrs) != 0 {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeEquinix.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge Equinix creation: %v", err)
 		}
@@ -518,7 +523,7 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if edgeEquinix.BgpHoldTime >= 12 && edgeEquinix.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeEquinix.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, gatewayForSpokeFunctions.GwName, edgeEquinix.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change BGP Hold Time after Edge Equinix creation: %v", err)
 		}
@@ -549,7 +554,7 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 
 	if edgeEquinix.RxQueueSize != "" {
 		gatewayForGatewayFunctions.RxQueueSize = edgeEquinix.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not set rx queue size after Edge Equinix creation: %v", err)
 		}
@@ -589,12 +594,12 @@ func resourceAviatrixEdgeEquinixReadIfRequired(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -722,7 +727,7 @@ func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeEquinix := marshalEdgeEquinixInput(d)
@@ -733,12 +738,12 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if !edgeEquinix.EnableLearnedCidrsApproval && len(edgeEquinix.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeEquinix.PrependAsPath) != 0 {
 		if edgeEquinix.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -771,21 +776,21 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeEquinix.PrependAsPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, nil)
 			if err != nil {
 				return diag.Errorf("could not delete prepend_as_path during Edge Equinix update: %v", err)
 			}
 		}
 
 		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeEquinix.LocalAsNumber)
+			err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeEquinix.LocalAsNumber)
 			if err != nil {
 				return diag.Errorf("could not set local_as_number during Edge Equinix update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(edgeEquinix.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeEquinix.PrependAsPath)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeEquinix.PrependAsPath)
 			if err != nil {
 				return diag.Errorf("could not set prepend_as_path during Edge Equinix update: %v", err)
 			}
@@ -808,7 +813,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 
 	if edgeEquinix.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeEquinix.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved learned CIDRs during Edge Equinix update: %v", err)
 		}
@@ -816,7 +821,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 
 	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeEquinix.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge Equinix update: %v", err)
 		}
@@ -844,7 +849,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeEquinix.GwName, edgeEquinix.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, edgeEquinix.GwName, edgeEquinix.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change bgp hold time during Edge Equinix update: %v", err)
 		}
@@ -871,7 +876,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 				return diag.Errorf("could not enable jumbo frame during Edge Equinix update: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
+			err := client.DisableJumboFrame(ctx, gatewayForGatewayFunctions)
 			if err != nil {
 				return diag.Errorf("could not disable jumbo frame during Edge Equinix update: %v", err)
 			}
@@ -889,7 +894,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 
 	if d.HasChange("rx_queue_size") {
 		gatewayForGatewayFunctions.RxQueueSize = edgeEquinix.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not update rx queue size during Edge Equinix update: %v", err)
 		}
@@ -928,7 +933,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixEdgeEquinixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
@@ -944,7 +949,7 @@ func resourceAviatrixEdgeEquinixDelete(ctx context.Context, d *schema.ResourceDa
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		logWarn(ctx, "Could not remove the ztp file", map[string]interface{}{"error": err.Error()})
 	}
 
 	return nil
@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 
@@ -14,14 +13,20 @@ import (
 
 func resourceAviatrixEdgeEquinixHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeEquinixHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeEquinixHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeEquinixHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeEquinixHaDelete,
+		CreateContext: resourceAviatrixEdgeEquinixHaCreate,
+		ReadContext:   resourceAviatrixEdgeEquinixHaRead,
+		UpdateContext: resourceAviatrixEdgeEquinixHaUpdate,
+		DeleteContext: resourceAviatrixEdgeEquinixHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -145,7 +150,7 @@ func marshalEdgeEquinixHaInput(d *schema.ResourceData) *goaviatrix.EdgeEquinixHa
 }
 
 func resourceAviatrixEdgeEquinixHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 
@@ -159,11 +164,11 @@ func resourceAviatrixEdgeEquinixHaCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixEdgeEquinixHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -213,7 +218,7 @@ func resourceAviatrixEdgeEquinixHaRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixEdgeEquinixHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 
@@ -238,7 +243,7 @@ func resourceAviatrixEdgeEquinixHaUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixEdgeEquinixHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 	accountName := d.Get("account_name").(string)
@@ -252,7 +257,7 @@ func resourceAviatrixEdgeEquinixHaDelete(ctx context.Context, d *schema.Resource
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		logWarn(ctx, "Could not remove the ztp file", map[string]interface{}{"error": err.Error()})
 	}
 
 	return nil
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
@@ -17,14 +16,20 @@ import (
 
 func resourceAviatrixEdgeGatewaySelfmanaged() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeGatewaySelfmanagedRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedDelete,
+		CreateContext: resourceAviatrixEdgeGatewaySelfmanagedCreate,
+		ReadContext:   resourceAviatrixEdgeGatewaySelfmanagedRead,
+		UpdateContext: resourceAviatrixEdgeGatewaySelfmanagedUpdate,
+		DeleteContext: resourceAviatrixEdgeGatewaySelfmanagedDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
 				Type:        schema.TypeString,
@@ -371,7 +376,7 @@ func marshalEdgeGatewaySelfmanagedInput(d *schema.ResourceData) *goaviatrix.Edge
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeSpoke := marshalEdgeGatewaySelfmanagedInput(d)
@@ -382,12 +387,12 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 	}
 
 	if !edgeSpoke.EnableLearnedCidrsApproval && len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeSpoke.PrependAsPath) != 0 {
 		if edgeSpoke.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -421,14 +426,14 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 	}
 
 	if edgeSpoke.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeSpoke.LocalAsNumber)
+		err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeSpoke.LocalAsNumber)
 		if err != nil {
 			return diag.Errorf("could not set 'local_as_number' after Edge Gateway Selfmanaged creation: %v", err)
 		}
 	}
 
 	if len(edgeSpoke.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeSpoke.PrependAsPath)
+		err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeSpoke.PrependAsPath)
 		if err != nil {
 			return diag.Errorf("could not set 'prepend_as_path' after Edge Gateway Selfmanaged creation: %v", err)
 		}
@@ -443,7 +448,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 
 	if len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeSpoke.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved CIDRs after Edge Gateway Selfmanaged creation: %v", err)
 		}
@@ -451,7 +456,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 
 	if len(edgeSpoke.SpokeBgpManualAdvertisedCidrs) != 0 {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeSpoke.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge as a Spoke creation: %v", err)
 		}
@@ -472,7 +477,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 	}
 
 	if edgeSpoke.BgpHoldTime >= 12 && edgeSpoke.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeSpoke.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, gatewayForSpokeFunctions.GwName, edgeSpoke.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change BGP Hold Time after Edge Gateway Selfmanaged creation: %v", err)
 		}
@@ -501,7 +506,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 
 	if edgeSpoke.RxQueueSize != "" {
 		gatewayForGatewayFunctions.RxQueueSize = edgeSpoke.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not set rx queue size after Edge Gateway Selfmanaged creation: %v", err)
 		}
@@ -526,12 +531,12 @@ func resourceAviatrixEdgeGatewaySelfmanagedReadIfRequired(ctx context.Context, d
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -655,7 +660,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedRead(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeSpoke := marshalEdgeGatewaySelfmanagedInput(d)
@@ -666,12 +671,12 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 	}
 
 	if !edgeSpoke.EnableLearnedCidrsApproval && len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeSpoke.PrependAsPath) != 0 {
 		if edgeSpoke.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -701,21 +706,21 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeSpoke.PrependAsPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, nil)
 			if err != nil {
 				return diag.Errorf("could not delete prepend_as_path during Edge Gateway Selfmanaged update: %v", err)
 			}
 		}
 
 		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeSpoke.LocalAsNumber)
+			err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeSpoke.LocalAsNumber)
 			if err != nil {
 				return diag.Errorf("could not set local_as_number during Edge Gateway Selfmanaged update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(edgeSpoke.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeSpoke.PrependAsPath)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeSpoke.PrependAsPath)
 			if err != nil {
 				return diag.Errorf("could not set prepend_as_path during Edge Gateway Selfmanaged update: %v", err)
 			}
@@ -738,7 +743,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 
 	if edgeSpoke.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeSpoke.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved learned CIDRs during Edge Gateway Selfmanaged update: %v", err)
 		}
@@ -746,7 +751,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 
 	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeSpoke.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge Gateway Selfmanaged update: %v", err)
 		}
@@ -774,7 +779,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeSpoke.GwName, edgeSpoke.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, edgeSpoke.GwName, edgeSpoke.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change bgp hold time during Edge Gateway Selfmanaged update: %v", err)
 		}
@@ -801,7 +806,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 				return diag.Errorf("could not enable jumbo frame during Edge Gateway Selfmanaged update: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
+			err := client.DisableJumboFrame(ctx, gatewayForGatewayFunctions)
 			if err != nil {
 				return diag.Errorf("could not disable jumbo frame during Edge Gateway Selfmanaged update: %v", err)
 			}
@@ -817,7 +822,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 
 	if d.HasChange("rx_queue_size") {
 		gatewayForGatewayFunctions.RxQueueSize = edgeSpoke.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not update rx queue size during Edge Gateway Selfmanaged update: %v", err)
 		}
@@ -838,7 +843,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	siteId := d.Get("site_id").(string)
@@ -860,7 +865,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedDelete(ctx context.Context, d *schema
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		logWarn(ctx, "Could not remove the ztp file", map[string]interface{}{"error": err.Error()})
 	}
 
 	return nil
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -17,14 +16,20 @@ import (
 
 func resourceAviatrixEdgeNEO() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeNEOCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeNEORead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeNEOUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeNEODelete,
+		CreateContext: resourceAviatrixEdgeNEOCreate,
+		ReadContext:   resourceAviatrixEdgeNEORead,
+		UpdateContext: resourceAviatrixEdgeNEOUpdate,
+		DeleteContext: resourceAviatrixEdgeNEODelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -449,7 +454,7 @@ func marshalEdgeNEOInput(d *schema.ResourceData) *goaviatrix.EdgeNEO {
 }
 
 func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeNEO := marshalEdgeNEOInput(d)
@@ -460,12 +465,12 @@ func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if !edgeNEO.EnableLearnedCidrsApproval && len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeNEO.PrependAsPath) != 0 {
 		if edgeNEO.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -502,14 +507,14 @@ func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if edgeNEO.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
+		err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
 		if err != nil {
 			return diag.Errorf("could not set 'local_as_number' after Edge NEO creation: %v", err)
 		}
 	}
 
 	if len(edgeNEO.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeNEO.PrependAsPath)
+		err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeNEO.PrependAsPath)
 		if err != nil {
 			return diag.Errorf("could not set 'prepend_as_path' after Edge NEO creation: %v", err)
 		}
@@ -524,7 +529,7 @@ func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData,
 
 	if len(edgeNEO.ApprovedLearnedCidrs) != 0 {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeNEO.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved CIDRs after Edge NEO creation: %v", err)
 		}
@@ -532,7 +537,7 @@ func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData,
 
 	if len(edgeNEO.SpokeBgpManualAdvertisedCidrs) != 0 {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeNEO.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge NEO creation: %v", err)
 		}
@@ -553,7 +558,7 @@ func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if edgeNEO.BgpHoldTime >= 12 && edgeNEO.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeNEO.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, gatewayForSpokeFunctions.GwName, edgeNEO.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change BGP Hold Time after Edge NEO creation: %v", err)
 		}
@@ -584,7 +589,7 @@ func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData,
 
 	if edgeNEO.RxQueueSize != "" {
 		gatewayForGatewayFunctions.RxQueueSize = edgeNEO.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not set rx queue size after Edge NEO creation: %v", err)
 		}
@@ -624,12 +629,12 @@ func resourceAviatrixEdgeNEOReadIfRequired(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeNEORead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -762,7 +767,7 @@ func resourceAviatrixEdgeNEORead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeNEO := marshalEdgeNEOInput(d)
@@ -773,12 +778,12 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if !edgeNEO.EnableLearnedCidrsApproval && len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeNEO.PrependAsPath) != 0 {
 		if edgeNEO.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -811,21 +816,21 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeNEO.PrependAsPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, nil)
 			if err != nil {
 				return diag.Errorf("could not delete prepend_as_path during Edge NEO update: %v", err)
 			}
 		}
 
 		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
+			err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
 			if err != nil {
 				return diag.Errorf("could not set local_as_number during Edge NEO update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(edgeNEO.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeNEO.PrependAsPath)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeNEO.PrependAsPath)
 			if err != nil {
 				return diag.Errorf("could not set prepend_as_path during Edge NEO update: %v", err)
 			}
@@ -848,7 +853,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if edgeNEO.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeNEO.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved learned CIDRs during Edge NEO update: %v", err)
 		}
@@ -856,7 +861,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeNEO.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge NEO update: %v", err)
 		}
@@ -884,7 +889,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeNEO.GwName, edgeNEO.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, edgeNEO.GwName, edgeNEO.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change bgp hold time during Edge NEO update: %v", err)
 		}
@@ -911,7 +916,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 				return diag.Errorf("could not enable jumbo frame during Edge NEO update: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
+			err := client.DisableJumboFrame(ctx, gatewayForGatewayFunctions)
 			if err != nil {
 				return diag.Errorf("could not disable jumbo frame during Edge NEO update: %v", err)
 			}
@@ -929,7 +934,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("rx_queue_size") {
 		gatewayForGatewayFunctions.RxQueueSize = edgeNEO.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not update rx queue size during Edge NEO update: %v", err)
 		}
@@ -968,7 +973,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeNEODelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
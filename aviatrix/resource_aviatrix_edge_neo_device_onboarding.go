@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 	"time"
@@ -15,14 +14,20 @@ import (
 
 func resourceAviatrixEdgeNEODeviceOnboarding() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeNEODeviceOnboardingCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeNEODeviceOnboardingRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeNEODeviceOnboardingUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeNEODeviceOnboardingDelete,
+		CreateContext: resourceAviatrixEdgeNEODeviceOnboardingCreate,
+		ReadContext:   resourceAviatrixEdgeNEODeviceOnboardingRead,
+		UpdateContext: resourceAviatrixEdgeNEODeviceOnboardingUpdate,
+		DeleteContext: resourceAviatrixEdgeNEODeviceOnboardingDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -141,7 +146,7 @@ func marshalEdgeNEODeviceOnboardingInput(d *schema.ResourceData) *goaviatrix.Edg
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -165,7 +170,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingCreate(ctx context.Context, d *schem
 		}
 	}
 
-	for i := 0; ; i++ {
+	for {
 		edgeNEODeviceResp, err := client.GetEdgeNEODevice(ctx, edgeNEODevice.AccountName, edgeNEODevice.DeviceName)
 
 		if err != nil {
@@ -180,10 +185,8 @@ func resourceAviatrixEdgeNEODeviceOnboardingCreate(ctx context.Context, d *schem
 			break
 		}
 
-		if i < 30 {
-			time.Sleep(time.Duration(20) * time.Second)
-		} else {
-			return diag.Errorf("Edge NEO device connection status could not become connected after 10 minutes")
+		if err := goaviatrix.SleepContext(ctx, 20*time.Second); err != nil {
+			return diag.Errorf("Edge NEO device connection status did not become connected before the timeout: %v", err)
 		}
 	}
 
@@ -200,13 +203,13 @@ func resourceAviatrixEdgeNEODeviceOnboardingReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	accountName := d.Get("account_name").(string)
 	deviceName := d.Get("device_name").(string)
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no account name received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 32 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~device_name")
@@ -219,7 +222,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.
 	var edgeNEODeviceResp *goaviatrix.EdgeNEODeviceResp
 	var err error
 
-	for i := 0; ; i++ {
+	for {
 		edgeNEODeviceResp, err = client.GetEdgeNEODevice(ctx, accountName, deviceName)
 
 		if err != nil {
@@ -233,11 +236,9 @@ func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.
 			break
 		}
 
-		if i < 30 {
-			time.Sleep(time.Duration(20) * time.Second)
-		} else {
+		if goaviatrix.SleepContext(ctx, 20*time.Second) != nil {
 			d.SetId("")
-			return diag.Errorf("could not read Edge NEO device after 10 minutes: %s", err)
+			return diag.Errorf("could not read Edge NEO device before the timeout: %s", err)
 		}
 	}
 
@@ -269,7 +270,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -308,7 +309,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schem
 			fileName := oldConfigFileDownloadPath.(string) + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 			err := os.Remove(fileName)
 			if err != nil {
-				log.Printf("[WARN] could not remove the config file: %v", err)
+				logWarn(ctx, "Could not remove the config file", map[string]interface{}{"error": err.Error()})
 			}
 		}
 	}
@@ -324,7 +325,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schem
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -337,7 +338,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingDelete(ctx context.Context, d *schem
 		fileName := edgeNEODevice.ConfigFileDownloadPath + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 		err = os.Remove(fileName)
 		if err != nil {
-			log.Printf("[WARN] could not remove the config file: %v", err)
+			logWarn(ctx, "Could not remove the config file", map[string]interface{}{"error": err.Error()})
 		}
 	}
 
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -17,14 +16,22 @@ import (
 
 func resourceAviatrixEdgePlatform() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgePlatformCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgePlatformRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgePlatformUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgePlatformDelete,
+		CreateContext: resourceAviatrixEdgePlatformCreate,
+		ReadContext:   resourceAviatrixEdgePlatformRead,
+		UpdateContext: resourceAviatrixEdgePlatformUpdate,
+		DeleteContext: resourceAviatrixEdgePlatformDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_edge_platform", goaviatrix.FeatureEdgePlatform),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -447,7 +454,7 @@ func marshalEdgePlatformInput(d *schema.ResourceData) *goaviatrix.EdgeNEO {
 }
 
 func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeNEO := marshalEdgePlatformInput(d)
@@ -458,12 +465,12 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if !edgeNEO.EnableLearnedCidrsApproval && len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeNEO.PrependAsPath) != 0 {
 		if edgeNEO.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -500,14 +507,14 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if edgeNEO.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
+		err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
 		if err != nil {
 			return diag.Errorf("could not set 'local_as_number' after Edge Platform creation: %v", err)
 		}
 	}
 
 	if len(edgeNEO.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeNEO.PrependAsPath)
+		err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeNEO.PrependAsPath)
 		if err != nil {
 			return diag.Errorf("could not set 'prepend_as_path' after Edge Platform creation: %v", err)
 		}
@@ -522,7 +529,7 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 
 	if len(edgeNEO.ApprovedLearnedCidrs) != 0 {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeNEO.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved CIDRs after Edge Platform creation: %v", err)
 		}
@@ -530,7 +537,7 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 
 	if len(edgeNEO.SpokeBgpManualAdvertisedCidrs) != 0 {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeNEO.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge Platform creation: %v", err)
 		}
@@ -551,7 +558,7 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if edgeNEO.BgpHoldTime >= 12 && edgeNEO.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeNEO.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, gatewayForSpokeFunctions.GwName, edgeNEO.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change BGP Hold Time after Edge Platform creation: %v", err)
 		}
@@ -582,7 +589,7 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 
 	if edgeNEO.RxQueueSize != "" {
 		gatewayForGatewayFunctions.RxQueueSize = edgeNEO.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not set rx queue size after Edge Platform creation: %v", err)
 		}
@@ -622,12 +629,12 @@ func resourceAviatrixEdgePlatformReadIfRequired(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgePlatformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -760,7 +767,7 @@ func resourceAviatrixEdgePlatformRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeNEO := marshalEdgePlatformInput(d)
@@ -771,12 +778,12 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if !edgeNEO.EnableLearnedCidrsApproval && len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if len(edgeNEO.PrependAsPath) != 0 {
 		if edgeNEO.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' must be empty if 'local_as_number' is not set")
 		}
 	}
 
@@ -809,21 +816,21 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeNEO.PrependAsPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, nil)
 			if err != nil {
 				return diag.Errorf("could not delete prepend_as_path during Edge Platform update: %v", err)
 			}
 		}
 
 		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
+			err := client.SetLocalASNumber(ctx, gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
 			if err != nil {
 				return diag.Errorf("could not set local_as_number during Edge Platform update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(edgeNEO.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeNEO.PrependAsPath)
+			err := client.SetPrependASPath(ctx, gatewayForTransitFunctions, edgeNEO.PrependAsPath)
 			if err != nil {
 				return diag.Errorf("could not set prepend_as_path during Edge Platform update: %v", err)
 			}
@@ -846,7 +853,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 
 	if edgeNEO.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
 		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeNEO.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not update approved learned CIDRs during Edge Platform update: %v", err)
 		}
@@ -854,7 +861,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 
 	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
 		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeNEO.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gatewayForTransitFunctions)
 		if err != nil {
 			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge Platform update: %v", err)
 		}
@@ -882,7 +889,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeNEO.GwName, edgeNEO.BgpHoldTime)
+		err := client.ChangeBgpHoldTime(ctx, edgeNEO.GwName, edgeNEO.BgpHoldTime)
 		if err != nil {
 			return diag.Errorf("could not change bgp hold time during Edge Platform update: %v", err)
 		}
@@ -909,7 +916,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.Errorf("could not enable jumbo frame during Edge Platform update: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
+			err := client.DisableJumboFrame(ctx, gatewayForGatewayFunctions)
 			if err != nil {
 				return diag.Errorf("could not disable jumbo frame during Edge Platform update: %v", err)
 			}
@@ -927,7 +934,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 
 	if d.HasChange("rx_queue_size") {
 		gatewayForGatewayFunctions.RxQueueSize = edgeNEO.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		err := client.SetRxQueueSize(ctx, gatewayForGatewayFunctions)
 		if err != nil {
 			return diag.Errorf("could not update rx queue size during Edge Platform update: %v", err)
 		}
@@ -966,7 +973,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgePlatformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
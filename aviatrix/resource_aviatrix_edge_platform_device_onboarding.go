@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 	"time"
@@ -15,14 +14,22 @@ import (
 
 func resourceAviatrixEdgePlatformDeviceOnboarding() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgePlatformDeviceOnboardingCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgePlatformDeviceOnboardingRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgePlatformDeviceOnboardingUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgePlatformDeviceOnboardingDelete,
+		CreateContext: resourceAviatrixEdgePlatformDeviceOnboardingCreate,
+		ReadContext:   resourceAviatrixEdgePlatformDeviceOnboardingRead,
+		UpdateContext: resourceAviatrixEdgePlatformDeviceOnboardingUpdate,
+		DeleteContext: resourceAviatrixEdgePlatformDeviceOnboardingDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_edge_platform_device_onboarding", goaviatrix.FeatureEdgePlatform),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -139,7 +146,7 @@ func marshalEdgePlatformDeviceOnboardingInput(d *schema.ResourceData) *goaviatri
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -163,7 +170,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingCreate(ctx context.Context, d *
 		}
 	}
 
-	for i := 0; ; i++ {
+	for {
 		edgeNEODeviceResp, err := client.GetEdgeNEODevice(ctx, edgeNEODevice.AccountName, edgeNEODevice.DeviceName)
 
 		if err != nil {
@@ -178,10 +185,8 @@ func resourceAviatrixEdgePlatformDeviceOnboardingCreate(ctx context.Context, d *
 			break
 		}
 
-		if i < 30 {
-			time.Sleep(time.Duration(20) * time.Second)
-		} else {
-			return diag.Errorf("Edge Platform device connection status could not become connected after 10 minutes")
+		if err := goaviatrix.SleepContext(ctx, 20*time.Second); err != nil {
+			return diag.Errorf("Edge Platform device connection status did not become connected before the timeout: %v", err)
 		}
 	}
 
@@ -198,13 +203,13 @@ func resourceAviatrixEdgePlatformDeviceOnboardingReadIfRequired(ctx context.Cont
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	accountName := d.Get("account_name").(string)
 	deviceName := d.Get("device_name").(string)
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no account name received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 32 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~device_name")
@@ -217,7 +222,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *sc
 	var edgeNEODeviceResp *goaviatrix.EdgeNEODeviceResp
 	var err error
 
-	for i := 0; ; i++ {
+	for {
 		edgeNEODeviceResp, err = client.GetEdgeNEODevice(ctx, accountName, deviceName)
 
 		if err != nil {
@@ -231,11 +236,9 @@ func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *sc
 			break
 		}
 
-		if i < 30 {
-			time.Sleep(time.Duration(20) * time.Second)
-		} else {
+		if goaviatrix.SleepContext(ctx, 20*time.Second) != nil {
 			d.SetId("")
-			return diag.Errorf("could not read Edge Platform device after 10 minutes: %s", err)
+			return diag.Errorf("could not read Edge Platform device before the timeout: %s", err)
 		}
 	}
 
@@ -267,7 +270,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *sc
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -306,7 +309,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *
 			fileName := oldConfigFileDownloadPath.(string) + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 			err := os.Remove(fileName)
 			if err != nil {
-				log.Printf("[WARN] could not remove the config file: %v", err)
+				logWarn(ctx, "Could not remove the config file", map[string]interface{}{"error": err.Error()})
 			}
 		}
 	}
@@ -322,7 +325,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -335,7 +338,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingDelete(ctx context.Context, d *
 		fileName := edgeNEODevice.ConfigFileDownloadPath + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 		err = os.Remove(fileName)
 		if err != nil {
-			log.Printf("[WARN] could not remove the config file: %v", err)
+			logWarn(ctx, "Could not remove the config file", map[string]interface{}{"error": err.Error()})
 		}
 	}
 
@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,14 +12,22 @@ import (
 
 func resourceAviatrixEdgePlatformHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgePlatformHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgePlatformHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgePlatformHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgePlatformHaDelete,
+		CreateContext: resourceAviatrixEdgePlatformHaCreate,
+		ReadContext:   resourceAviatrixEdgePlatformHaRead,
+		UpdateContext: resourceAviatrixEdgePlatformHaUpdate,
+		DeleteContext: resourceAviatrixEdgePlatformHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeature("aviatrix_edge_platform_ha", goaviatrix.FeatureEdgePlatform),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -141,7 +148,7 @@ func marshalEdgePlatformHaInput(d *schema.ResourceData) *goaviatrix.EdgeNEOHa {
 }
 
 func resourceAviatrixEdgePlatformHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEOHa := marshalEdgePlatformHaInput(d)
 
@@ -155,11 +162,11 @@ func resourceAviatrixEdgePlatformHaCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgePlatformHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -210,7 +217,7 @@ func resourceAviatrixEdgePlatformHaRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgePlatformHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeNEOHa := marshalEdgePlatformHaInput(d)
 
@@ -235,7 +242,7 @@ func resourceAviatrixEdgePlatformHaUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgePlatformHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	accountName := d.Get("account_name").(string)
 
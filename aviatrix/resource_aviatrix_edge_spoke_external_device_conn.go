@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"regexp"
 	"strconv"
 	"strings"
@@ -18,14 +17,24 @@ import (
 
 func resourceAviatrixEdgeSpokeExternalDeviceConn() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeSpokeExternalDeviceConnCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeSpokeExternalDeviceConnRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeSpokeExternalDeviceConnUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeSpokeExternalDeviceConnDelete,
+		CreateContext: resourceAviatrixEdgeSpokeExternalDeviceConnCreate,
+		ReadContext:   resourceAviatrixEdgeSpokeExternalDeviceConnRead,
+		UpdateContext: resourceAviatrixEdgeSpokeExternalDeviceConnUpdate,
+		DeleteContext: resourceAviatrixEdgeSpokeExternalDeviceConnDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: requireControllerFeatures(map[string]goaviatrix.Feature{
+			"manual_bgp_advertised_cidrs": goaviatrix.FeatureEdgeManualBgpAdvertisedCidrs,
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"site_id": {
 				Type:        schema.TypeString,
@@ -223,12 +232,12 @@ func marshalEdgeSpokeExternalDeviceConnInput(d *schema.ResourceData) *goaviatrix
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := marshalEdgeSpokeExternalDeviceConnInput(d)
 
 	if !externalDeviceConn.EnableEdgeUnderlay && externalDeviceConn.ConnectionName == "" {
-		return diag.Errorf("'connection_name' is required when 'enable_edge_underlay' is false")
+		return attributeErrorf("connection_name", "'connection_name' is required when 'enable_edge_underlay' is false")
 	}
 
 	if externalDeviceConn.EnableEdgeUnderlay && externalDeviceConn.ConnectionName != "" {
@@ -283,9 +292,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnCreate(ctx context.Context, d *s
 		} else {
 			break
 		}
-		if i < numberOfRetries {
-			time.Sleep(time.Duration(retryInterval) * time.Second)
-		} else {
+		if i >= numberOfRetries || goaviatrix.SleepContext(ctx, time.Duration(retryInterval)*time.Second) != nil {
 			d.SetId("")
 			return diag.Errorf("failed to create Edge as a Spoke external device connection: %s", err)
 		}
@@ -335,12 +342,12 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnReadIfRequired(ctx context.Conte
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcID := d.Get("site_id").(string)
 	if vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no 'site_id' received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no 'site_id' received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 3 {
 			return diag.Errorf("expected import ID in the form 'connection_name~site_id~gw_name' instead got %q", id)
@@ -417,7 +424,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *sch
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	externalDeviceConn := marshalEdgeSpokeExternalDeviceConnInput(d)
@@ -457,7 +464,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnUpdate(ctx context.Context, d *s
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := marshalEdgeSpokeExternalDeviceConnInput(d)
 
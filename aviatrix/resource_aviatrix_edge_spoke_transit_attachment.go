@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 	"time"
 
@@ -14,12 +13,18 @@ import (
 
 func resourceAviatrixEdgeSpokeTransitAttachment() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeSpokeTransitAttachmentCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeSpokeTransitAttachmentRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeSpokeTransitAttachmentUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeSpokeTransitAttachmentDelete,
+		CreateContext: resourceAviatrixEdgeSpokeTransitAttachmentCreate,
+		ReadContext:   resourceAviatrixEdgeSpokeTransitAttachmentRead,
+		UpdateContext: resourceAviatrixEdgeSpokeTransitAttachmentUpdate,
+		DeleteContext: resourceAviatrixEdgeSpokeTransitAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -123,7 +128,7 @@ func marshalEdgeSpokeTransitAttachmentInput(d *schema.ResourceData) *goaviatrix.
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := marshalEdgeSpokeTransitAttachmentInput(d)
 
@@ -145,7 +150,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentCreate(ctx context.Context, d *sc
 
 	var err error
 	for i := 0; ; i++ {
-		err = client.CreateSpokeTransitAttachment(attachment)
+		err = client.CreateSpokeTransitAttachment(ctx, attachment)
 		if err != nil {
 			if !strings.Contains(err.Error(), "not ready") && !strings.Contains(err.Error(), "not up") &&
 				!strings.Contains(err.Error(), "try again") {
@@ -154,9 +159,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentCreate(ctx context.Context, d *sc
 		} else {
 			break
 		}
-		if i < numberOfRetries {
-			time.Sleep(time.Duration(retryInterval) * time.Second)
-		} else {
+		if i >= numberOfRetries || goaviatrix.SleepContext(ctx, time.Duration(retryInterval)*time.Second) != nil {
 			d.SetId("")
 			return diag.Errorf("could not attach Edge as a Spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
 		}
@@ -198,13 +201,13 @@ func resourceAviatrixEdgeSpokeTransitAttachmentReadIfRequired(ctx context.Contex
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeGwName := d.Get("spoke_gw_name").(string)
 	transitGwName := d.Get("transit_gw_name").(string)
 	if spokeGwName == "" || transitGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no spoke_gw_name or transit_gw_name received", map[string]interface{}{"import_id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
@@ -277,7 +280,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentRead(ctx context.Context, d *sche
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	enableInsaneMode := d.Get("enable_insane_mode").(bool)
 	enableOverPrivateNetwork := d.Get("enable_over_private_network").(bool)
@@ -342,14 +345,14 @@ func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *sc
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := &goaviatrix.SpokeTransitAttachment{
 		SpokeGwName:   d.Get("spoke_gw_name").(string),
 		TransitGwName: d.Get("transit_gw_name").(string),
 	}
 
-	if err := client.DeleteSpokeTransitAttachment(attachment); err != nil {
+	if err := client.DeleteSpokeTransitAttachment(ctx, attachment); err != nil {
 		return diag.Errorf("could not detach Edge as a Spoke: %s from transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
 	}
 
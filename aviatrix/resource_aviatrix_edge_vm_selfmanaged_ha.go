@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 
@@ -16,14 +15,20 @@ import (
 
 func resourceAviatrixEdgeVmSelfmanagedHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeVmSelfmanagedHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaDelete,
+		CreateContext: resourceAviatrixEdgeVmSelfmanagedHaCreate,
+		ReadContext:   resourceAviatrixEdgeVmSelfmanagedHaRead,
+		UpdateContext: resourceAviatrixEdgeVmSelfmanagedHaUpdate,
+		DeleteContext: resourceAviatrixEdgeVmSelfmanagedHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -135,7 +140,7 @@ func marshalEdgeVmSelfmanagedHaInput(d *schema.ResourceData) *goaviatrix.EdgeVmS
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
@@ -149,11 +154,11 @@ func resourceAviatrixEdgeVmSelfmanagedHaCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -203,7 +208,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
@@ -228,7 +233,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	err := client.DeleteEdgeSpoke(ctx, d.Id())
 	if err != nil {
@@ -246,7 +251,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.Re
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		logWarn(ctx, "Could not remove the ztp file", map[string]interface{}{"error": err.Error()})
 	}
 
 	return nil
@@ -0,0 +1,60 @@
+package aviatrix
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestResourceAviatrixEdgeVmSelfmanagedRoundTrip(t *testing.T) {
+	testRoundTrip(t, roundTripCase{
+		resource: "aviatrix_edge_vm_selfmanaged",
+		config: map[string]interface{}{
+			"ztp_file_download_path": t.TempDir(),
+			"interfaces": []interface{}{
+				map[string]interface{}{
+					"name":          "eth0",
+					"type":          "WAN",
+					"ip_address":    "10.4.0.10/24",
+					"gateway_ip":    "10.4.0.1",
+					"wan_public_ip": "203.0.113.10",
+				},
+				map[string]interface{}{
+					"name":       "eth1",
+					"type":       "LAN",
+					"ip_address": "10.4.1.10/24",
+				},
+				map[string]interface{}{
+					"name":        "eth2",
+					"type":        "MANAGEMENT",
+					"enable_dhcp": true,
+				},
+			},
+		},
+		values: map[string]func(r *rand.Rand) interface{}{
+			"site_id":                          oneOf("site-1", "site-2"),
+			"ztp_file_type":                    oneOf("iso", "cloud-init"),
+			"management_egress_ip_prefix_list": func(r *rand.Rand) interface{} { return []interface{}{"203.0.113.0/24"} },
+			"dns_server_ip":                    oneOf("8.8.8.8", "1.1.1.1"),
+			"secondary_dns_server_ip":          oneOf("8.8.4.4", "1.0.0.1"),
+			"approved_learned_cidrs":           randomCIDRSet(24),
+			"spoke_bgp_manual_advertise_cidrs": randomCIDRSet(24),
+			"bgp_polling_time":                 func(r *rand.Rand) interface{} { return 10 + r.Intn(41) },
+			"bgp_hold_time":                    func(r *rand.Rand) interface{} { return 12 + r.Intn(349) },
+			"latitude":                         func(r *rand.Rand) interface{} { return fmt.Sprintf("%.6f", r.Float64()*180-90) },
+			"longitude":                        func(r *rand.Rand) interface{} { return fmt.Sprintf("%.6f", r.Float64()*360-180) },
+			"rx_queue_size":                    oneOf("1K", "2K", "4K"),
+		},
+		fix: func(r *rand.Rand, config map[string]interface{}) {
+			if _, ok := config["local_as_number"]; !ok {
+				delete(config, "prepend_as_path")
+			}
+			if config["enable_edge_active_standby"] != true {
+				delete(config, "enable_edge_active_standby_preemptive")
+			}
+			if config["enable_learned_cidrs_approval"] != true {
+				delete(config, "approved_learned_cidrs")
+			}
+		},
+	})
+}
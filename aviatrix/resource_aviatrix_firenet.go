@@ -1,11 +1,11 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,12 +14,12 @@ import (
 
 func resourceAviatrixFireNet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFireNetCreate,
-		Read:   resourceAviatrixFireNetRead,
-		Update: resourceAviatrixFireNetUpdate,
-		Delete: resourceAviatrixFireNetDelete,
+		CreateContext: resourceAviatrixFireNetCreate,
+		ReadContext:   resourceAviatrixFireNetRead,
+		UpdateContext: resourceAviatrixFireNetUpdate,
+		DeleteContext: resourceAviatrixFireNetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -31,6 +31,12 @@ func resourceAviatrixFireNet() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:        schema.TypeString,
@@ -92,10 +98,10 @@ func resourceAviatrixFireNet() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
-	log.Printf("[INFO] Creating an Aviatrix Firenet on vpc: %s", d.Get("vpc_id"))
+	logInfo(ctx, "Creating an Aviatrix Firenet on vpc", map[string]interface{}{"vpc_id": d.Get("vpc_id").(string)})
 
 	fireNet := &goaviatrix.FireNet{
 		VpcID: d.Get("vpc_id").(string),
@@ -103,33 +109,33 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 
 	fireNetDetail, err := client.GetFireNet(fireNet)
 	if err != nil {
-		return fmt.Errorf("couldn't find vpc %s: %v", fireNet.VpcID, err)
+		return diag.Errorf("couldn't find vpc %s: %v", fireNet.VpcID, err)
 	}
 	cloudType, _ := strconv.Atoi(fireNetDetail.CloudType)
 
 	if d.Get("keep_alive_via_lan_interface_enabled").(bool) {
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) || goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enabling keep alive via lan interface on FireNet does not support Azure & GCP related clouds")
+			return diag.Errorf("enabling keep alive via lan interface on FireNet does not support Azure & GCP related clouds")
 		}
 		if fireNetDetail.LanPing != "yes" {
-			return fmt.Errorf("keep alive via lan interface only supports non-GWLB AWS/OCI cloud FireNet, please set keep_alive_via_lan_interface_enabled to false")
+			return diag.Errorf("keep alive via lan interface only supports non-GWLB AWS/OCI cloud FireNet, please set keep_alive_via_lan_interface_enabled to false")
 		}
 	} else {
 		if fireNetDetail.LanPing == "yes" {
-			return fmt.Errorf("keep alive via lan interface has to be enabled for non-GWLB AWS/OCI cloud FireNet, please set keep_alive_via_lan_interface_enabled to true")
+			return diag.Errorf("keep alive via lan interface has to be enabled for non-GWLB AWS/OCI cloud FireNet, please set keep_alive_via_lan_interface_enabled to true")
 		}
 	}
 
 	d.SetId(fireNet.VpcID)
 
 	flag := false
-	defer resourceAviatrixFireNetReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFireNetReadIfRequired(ctx, d, meta, &flag)
 
 	if d.Get("hashing_algorithm").(string) == "2-Tuple" {
 		fireNet.HashingAlgorithm = d.Get("hashing_algorithm").(string)
 		err := client.EditFireNetHashingAlgorithm(fireNet)
 		if err != nil {
-			return fmt.Errorf("failed to edit hashing algorithm: %s", err)
+			return diag.Errorf("failed to edit hashing algorithm: %s", err)
 		}
 	}
 
@@ -138,9 +144,9 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 		err := client.EditFireNetInspection(fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from disabling traffic inspection: %v\n", err)
+				logInfo(ctx, "Ignoring error from disabling traffic inspection", map[string]interface{}{"error": err.Error()})
 			} else {
-				return fmt.Errorf("couldn't disable inspection due to %v", err)
+				return diag.Errorf("couldn't disable inspection due to %v", err)
 			}
 		}
 	}
@@ -150,9 +156,9 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 		err := client.EditFireNetEgress(fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from enabling egress: %v\n", err)
+				logInfo(ctx, "Ignoring error from enabling egress", map[string]interface{}{"error": err.Error()})
 			} else {
-				return fmt.Errorf("couldn't enable egress due to %v", err)
+				return diag.Errorf("couldn't enable egress due to %v", err)
 			}
 		}
 	}
@@ -160,7 +166,7 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 	if d.Get("tgw_segmentation_for_egress_enabled").(bool) {
 		err := client.EnableTgwSegmentationForEgress(fireNet)
 		if err != nil {
-			return fmt.Errorf("could not enable tgw segmentation for egress: %v", err)
+			return diag.Errorf("could not enable tgw segmentation for egress: %v", err)
 		}
 	}
 
@@ -171,14 +177,14 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 
 	if len(egressStaticCidrs) != 0 {
 		if !d.Get("egress_enabled").(bool) {
-			return fmt.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
+			return diag.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
 		}
 
 		fireNet.EgressStaticCidrs = strings.Join(egressStaticCidrs, ",")
 
 		err := client.EditFirenetEgressStaticCidr(fireNet)
 		if err != nil {
-			return fmt.Errorf("could not edit egress static cidrs: %v", err)
+			return diag.Errorf("could not edit egress static cidrs: %v", err)
 		}
 	}
 
@@ -190,28 +196,28 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 		fireNet.ExcludedCidrs = strings.Join(excludedCidrs, ",")
 		err := client.EditFirenetExcludedCidr(fireNet)
 		if err != nil {
-			return fmt.Errorf("could not edit east-west inspection excluded cidrs: %v", err)
+			return diag.Errorf("could not edit east-west inspection excluded cidrs: %v", err)
 		}
 	}
 
-	return resourceAviatrixFireNetReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFireNetReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFireNetReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFireNetReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFireNetRead(d, meta)
+		return resourceAviatrixFireNetRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	vpcID := d.Get("vpc_id").(string)
 	if vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"import_id": id})
 		d.Set("vpc_id", id)
 		d.SetId(id)
 	}
@@ -225,10 +231,10 @@ func resourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FireNet: %s", err)
+		return diag.Errorf("couldn't find FireNet: %s", err)
 	}
 
-	log.Printf("[INFO] Found FireNet: %#v", fireNetDetail.VpcID)
+	logInfo(ctx, "Found FireNet", map[string]interface{}{"vpc_id": fireNetDetail.VpcID})
 
 	d.Set("vpc_id", fireNetDetail.VpcID)
 	d.Set("hashing_algorithm", fireNetDetail.HashingAlgorithm)
@@ -243,14 +249,14 @@ func resourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
-	log.Printf("[INFO] Updating Aviatrix FireNet: %#v", d.Get("vpc_id").(string))
+	logInfo(ctx, "Updating Aviatrix FireNet", map[string]interface{}{"vpc_id": d.Get("vpc_id").(string)})
 
 	d.Partial(true)
 	if d.HasChange("vpc_id") {
-		return fmt.Errorf("updating vpc_id is not allowed")
+		return diag.Errorf("updating vpc_id is not allowed")
 	}
 
 	if d.HasChange("hashing_algorithm") {
@@ -260,7 +266,7 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.EditFireNetHashingAlgorithm(fn)
 		if err != nil {
-			return fmt.Errorf("failed to enable inspection on fireNet: %v", err)
+			return diag.Errorf("failed to enable inspection on fireNet: %v", err)
 		}
 	}
 
@@ -273,13 +279,13 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 			fn.Inspection = true
 			err := client.EditFireNetInspection(fn)
 			if err != nil {
-				return fmt.Errorf("failed to enable inspection on fireNet: %v", err)
+				return diag.Errorf("failed to enable inspection on fireNet: %v", err)
 			}
 		} else {
 			fn.Inspection = false
 			err := client.EditFireNetInspection(fn)
 			if err != nil {
-				return fmt.Errorf("failed to disable inspection on fireNet: %v", err)
+				return diag.Errorf("failed to disable inspection on fireNet: %v", err)
 			}
 		}
 
@@ -299,21 +305,21 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 			fn.FirewallEgress = true
 			err := client.EditFireNetEgress(fn)
 			if err != nil {
-				return fmt.Errorf("failed to enable firewall egress on fireNet: %v", err)
+				return diag.Errorf("failed to enable firewall egress on fireNet: %v", err)
 			}
 		} else {
 			if len(egressStaticCidrs) > 0 {
-				return fmt.Errorf("'egress_static_cidrs' must be empty before disabling egress")
+				return attributeErrorf("egress_static_cidrs", "'egress_static_cidrs' must be empty before disabling egress")
 			} else if d.HasChange("egress_static_cidrs") && len(egressStaticCidrs) == 0 {
 				err := client.EditFirenetEgressStaticCidr(fn)
 				if err != nil {
-					return fmt.Errorf("could not disable egress static cidrs: %v", err)
+					return diag.Errorf("could not disable egress static cidrs: %v", err)
 				}
 			}
 			fn.FirewallEgress = false
 			err := client.EditFireNetEgress(fn)
 			if err != nil {
-				return fmt.Errorf("failed to enable firewall egress on fireNet: %v", err)
+				return diag.Errorf("failed to enable firewall egress on fireNet: %v", err)
 			}
 		}
 	}
@@ -322,7 +328,7 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 		egressEnabled := d.Get("egress_enabled").(bool)
 
 		if !d.HasChange("egress_enabled") && !egressEnabled {
-			return fmt.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
+			return diag.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
 		}
 
 		if egressEnabled {
@@ -333,7 +339,7 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 
 			err := client.EditFirenetEgressStaticCidr(fn)
 			if err != nil {
-				return fmt.Errorf("could not update egress static cidrs: %v", err)
+				return diag.Errorf("could not update egress static cidrs: %v", err)
 			}
 		}
 	}
@@ -349,7 +355,7 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.EditFirenetExcludedCidr(fn)
 		if err != nil {
-			return fmt.Errorf("could not edit east-west inspection excluded cidrs during update: %v", err)
+			return diag.Errorf("could not edit east-west inspection excluded cidrs during update: %v", err)
 		}
 	}
 
@@ -360,12 +366,12 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 		if d.Get("keep_alive_via_lan_interface_enabled").(bool) {
 			err := client.EnableFireNetLanKeepAlive(fn)
 			if err != nil {
-				return fmt.Errorf("could not enable keep alive via lan interface while updating firenet: %v", err)
+				return diag.Errorf("could not enable keep alive via lan interface while updating firenet: %v", err)
 			}
 		} else {
 			err := client.DisableFireNetLanKeepAlive(fn)
 			if err != nil {
-				return fmt.Errorf("could not disable keep alive via lan interface while updating firenet: %v", err)
+				return diag.Errorf("could not disable keep alive via lan interface while updating firenet: %v", err)
 			}
 		}
 	}
@@ -377,22 +383,22 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 		if d.Get("tgw_segmentation_for_egress_enabled").(bool) {
 			err := client.EnableTgwSegmentationForEgress(fn)
 			if err != nil {
-				return fmt.Errorf("could not enable tgw_segmentation_for_egress: %v", err)
+				return diag.Errorf("could not enable tgw_segmentation_for_egress: %v", err)
 			}
 		} else {
 			err := client.DisableTgwSegmentationForEgress(fn)
 			if err != nil {
-				return fmt.Errorf("could not disable tgw_segmentation_for_egress: %v", err)
+				return diag.Errorf("could not disable tgw_segmentation_for_egress: %v", err)
 			}
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFireNetRead(d, meta)
+	return resourceAviatrixFireNetRead(ctx, d, meta)
 }
 
-func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	fireNet := &goaviatrix.FireNet{
 		VpcID: d.Get("vpc_id").(string),
@@ -401,14 +407,14 @@ func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta interface{}) err
 	if len(d.Get("egress_static_cidrs").(*schema.Set).List()) != 0 {
 		err := client.EditFirenetEgressStaticCidr(fireNet)
 		if err != nil {
-			return fmt.Errorf("could not disable egress static cidrs: %v", err)
+			return diag.Errorf("could not disable egress static cidrs: %v", err)
 		}
 	}
 
 	if len(d.Get("east_west_inspection_excluded_cidrs").(*schema.Set).List()) != 0 {
 		err := client.EditFirenetExcludedCidr(fireNet)
 		if err != nil {
-			return fmt.Errorf("could not disable east-west inspection excluded cidrs during firenet destroy: %v", err)
+			return diag.Errorf("could not disable east-west inspection excluded cidrs during firenet destroy: %v", err)
 		}
 	}
 
@@ -417,9 +423,9 @@ func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta interface{}) err
 		err := client.EditFireNetEgress(fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from disabling egress: %v\n", err)
+				logInfo(ctx, "Ignoring error from disabling egress", map[string]interface{}{"error": err.Error()})
 			} else {
-				return fmt.Errorf("failed to disable firewall egress on fireNet: %v", err)
+				return diag.Errorf("failed to disable firewall egress on fireNet: %v", err)
 			}
 		}
 	}
@@ -427,15 +433,15 @@ func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta interface{}) err
 	if d.Get("tgw_segmentation_for_egress_enabled").(bool) {
 		err := client.DisableTgwSegmentationForEgress(fireNet)
 		if err != nil {
-			return fmt.Errorf("failed to disable tgw segmentation for egress: %v", err)
+			return diag.Errorf("failed to disable tgw segmentation for egress: %v", err)
 		}
 	}
 
-	log.Printf("[INFO] Deleting FireNet: %#v", fireNet)
+	logInfo(ctx, "Deleting FireNet", map[string]interface{}{"gw_name": fireNet.GwName, "vpc_id": fireNet.VpcID})
 
 	_, err := client.GetFireNet(fireNet)
 	if err != nil {
-		return fmt.Errorf("failed to delete FireNet: %s", err)
+		return diag.Errorf("failed to delete FireNet: %s", err)
 	}
 
 	return nil
@@ -1,9 +1,10 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAviatrixFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallCreate,
-		Read:   resourceAviatrixFirewallRead,
-		Update: resourceAviatrixFirewallUpdate,
-		Delete: resourceAviatrixFirewallDelete,
+		CreateContext: resourceAviatrixFirewallCreate,
+		ReadContext:   resourceAviatrixFirewallRead,
+		UpdateContext: resourceAviatrixFirewallUpdate,
+		DeleteContext: resourceAviatrixFirewallDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -108,8 +109,8 @@ func resourceAviatrixFirewall() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewall := &goaviatrix.Firewall{
 		GwName:     d.Get("gw_name").(string),
@@ -119,30 +120,30 @@ func resourceAviatrixFirewallCreate(d *schema.ResourceData, meta interface{}) er
 	_, hasSetPolicies := d.GetOk("policy")
 	enabledInlinePolicies := d.Get("manage_firewall_policies").(bool)
 	if hasSetPolicies && !enabledInlinePolicies {
-		return fmt.Errorf("manage_firewall_policies must be set to true to set in-line policies")
+		return attributeErrorf("manage_firewall_policies", "manage_firewall_policies must be set to true to set in-line policies")
 	}
 
 	// If policies are present and manage_firewall_policies is set to true, update policies
 	if hasSetPolicies && enabledInlinePolicies {
 		policyList, err := getAndValidatePolicy(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		firewall.PolicyList = policyList
 	}
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewall)
+	logInfo(ctx, "Creating Aviatrix firewall", map[string]interface{}{"gw_name": firewall.GwName})
 
 	d.SetId(firewall.GwName)
 	flag := false
-	defer resourceAviatrixFirewallReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFirewallReadIfRequired(ctx, d, meta, &flag)
 
 	//If base_policy or base_log enable is present, set base policy
 	if firewall.BasePolicy == "allow-all" {
 		firewall.BaseLogEnabled = "off"
 		err := client.SetBasePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to set base firewall policy for GW %s: %s", firewall.GwName, err)
+			return diag.Errorf("failed to set base firewall policy for GW %s: %s", firewall.GwName, err)
 		}
 	}
 
@@ -151,34 +152,35 @@ func resourceAviatrixFirewallCreate(d *schema.ResourceData, meta interface{}) er
 		firewall.BaseLogEnabled = "on"
 		err := client.SetBasePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to enable base logging for GW %s: %s", firewall.GwName, err)
+			return diag.Errorf("failed to enable base logging for GW %s: %s", firewall.GwName, err)
 		}
 	}
 
 	if hasSetPolicies && enabledInlinePolicies {
 		err := client.UpdatePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to set Aviatrix firewall policies for GW %s: %s", firewall.GwName, err)
+			return diag.Errorf("failed to set Aviatrix firewall policies for GW %s: %s", firewall.GwName, err)
 		}
 	}
-	return resourceAviatrixFirewallReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFirewallReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallRead(d, meta)
+		return resourceAviatrixFirewallRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
+	var diags diag.Diagnostics
 
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.Set("manage_firewall_policies", true)
 		d.SetId(id)
@@ -194,10 +196,10 @@ func resourceAviatrixFirewallRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error fetching policy for gateway %s: %s", firewall.GwName, err)
+		return diag.Errorf("error fetching policy for gateway %s: %s", firewall.GwName, err)
 	}
 
-	log.Printf("[TRACE] Reading policy for gateway %s: %#v", firewall.GwName, fw)
+	logTrace(ctx, "Reading policy for gateway", map[string]interface{}{"gw_name": firewall.GwName})
 
 	var policiesFromFile []map[string]interface{}
 	if fw != nil {
@@ -220,14 +222,14 @@ func resourceAviatrixFirewallRead(d *schema.ResourceData, meta interface{}) erro
 	// Only write policies to state if the user has enabled in-line policies.
 	if d.Get("manage_firewall_policies").(bool) {
 		if err := d.Set("policy", policiesFromFile); err != nil {
-			log.Printf("[WARN] Error setting policy for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("policy", "could not set policy into state", err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewall := &goaviatrix.Firewall{
 		GwName: d.Get("gw_name").(string),
@@ -235,12 +237,12 @@ func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta interface{}) er
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewall)
+	logInfo(ctx, "Creating Aviatrix firewall", map[string]interface{}{"gw_name": firewall.GwName})
 
 	_, hasSetPolicies := d.GetOk("policy")
 	enabledInlinePolicies := d.Get("manage_firewall_policies").(bool)
 	if hasSetPolicies && !enabledInlinePolicies {
-		return fmt.Errorf("manage_firewall_policies must be set to true to set in-line policies")
+		return attributeErrorf("manage_firewall_policies", "manage_firewall_policies must be set to true to set in-line policies")
 	}
 
 	if ok := d.HasChange("base_policy"); ok {
@@ -268,7 +270,7 @@ func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta interface{}) er
 
 		err := client.SetBasePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to update base firewall policies for GW %s: %s", firewall.GwName, err)
+			return diag.Errorf("failed to update base firewall policies for GW %s: %s", firewall.GwName, err)
 		}
 	}
 
@@ -282,29 +284,29 @@ func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta interface{}) er
 
 		err := client.SetBasePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to update base logging for GW %s: %s", firewall.GwName, err)
+			return diag.Errorf("failed to update base logging for GW %s: %s", firewall.GwName, err)
 		}
 	}
 
 	if ok := d.HasChange("policy"); ok && enabledInlinePolicies {
 		policyList, err := getAndValidatePolicy(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		firewall.PolicyList = policyList
 
 		err = client.UpdatePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Firewall policy: %s", err)
+			return diag.Errorf("failed to update Aviatrix Firewall policy: %s", err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFirewallRead(d, meta)
+	return resourceAviatrixFirewallRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewall := &goaviatrix.Firewall{
 		GwName: d.Get("gw_name").(string),
@@ -314,7 +316,7 @@ func resourceAviatrixFirewallDelete(d *schema.ResourceData, meta interface{}) er
 
 	err := client.UpdatePolicy(firewall)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Firewall policy list: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Firewall policy list: %s", err)
 	}
 
 	if d.Get("base_policy").(string) != "deny-all" {
@@ -327,7 +329,7 @@ func resourceAviatrixFirewallDelete(d *schema.ResourceData, meta interface{}) er
 		}
 		err = client.SetBasePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to set base firewall policies to default: %s", err)
+			return diag.Errorf("failed to set base firewall policies to default: %s", err)
 		}
 	}
 
@@ -336,7 +338,7 @@ func resourceAviatrixFirewallDelete(d *schema.ResourceData, meta interface{}) er
 		firewall.BaseLogEnabled = "off"
 		err = client.SetBasePolicy(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to set base logging to default: %s", err)
+			return diag.Errorf("failed to set base logging to default: %s", err)
 		}
 	}
 
@@ -1,22 +1,29 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixFirewallInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallInstanceCreate,
-		Read:   resourceAviatrixFirewallInstanceRead,
-		Update: resourceAviatrixFirewallInstanceUpdate,
-		Delete: resourceAviatrixFirewallInstanceDelete,
+		CreateContext: resourceAviatrixFirewallInstanceCreate,
+		ReadContext:   resourceAviatrixFirewallInstanceRead,
+		UpdateContext: resourceAviatrixFirewallInstanceUpdate,
+		DeleteContext: resourceAviatrixFirewallInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -261,8 +268,8 @@ func resourceAviatrixFirewallInstance() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallInstance := &goaviatrix.FirewallInstance{
 		VpcID:                d.Get("vpc_id").(string),
@@ -303,12 +310,12 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta interfa
 		var err error
 		cloudType, err = client.GetCloudTypeFromVpcID(firewallInstance.VpcID)
 		if err != nil {
-			log.Printf("[WARN] Could not get cloud_type from vpc_id: %v", err)
+			logWarn(ctx, "Could not get cloud_type from vpc_id", map[string]interface{}{"error": err.Error()})
 		}
 	} else {
 		gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: firewallInstance.GwName})
 		if err != nil {
-			log.Printf("[WARN] Could not get cloud_type from firenet_gw_name: %v", err)
+			logWarn(ctx, "Could not get cloud_type from firenet_gw_name", map[string]interface{}{"error": err.Error()})
 		} else {
 			cloudType = gw.CloudType
 		}
@@ -317,48 +324,47 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta interfa
 
 	if strings.HasPrefix(firewallInstance.FirewallImage, "Palo Alto Networks") {
 		if firewallInstance.ManagementSubnet == "" {
-			return fmt.Errorf("'management_subnet' is required for Palo Alto Networks VM-Series")
+			return attributeErrorf("management_subnet", "'management_subnet' is required for Palo Alto Networks VM-Series")
 		}
 	} else if strings.Contains(firewallInstance.FirewallImage, "CloudGuard") {
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && firewallInstance.ManagementSubnet == "" {
-			return fmt.Errorf("'management_subnet' is required for Check Point CloudGuard for OCI")
+			return attributeErrorf("management_subnet", "'management_subnet' is required for Check Point CloudGuard for OCI")
 		}
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && firewallInstance.ManagementSubnet != "" {
-			return fmt.Errorf("'management_subnet' is required to be empty for Check Point CloudGuard except for OCI")
+			return attributeErrorf("management_subnet", "'management_subnet' is required to be empty for Check Point CloudGuard except for OCI")
 		}
 	} else if strings.HasPrefix(firewallInstance.FirewallImage, "Fortinet FortiGate") {
 		if firewallInstance.ManagementSubnet != "" {
-			return fmt.Errorf("'management_subnet' is required to be empty for Fortinet FortiGate series")
+			return attributeErrorf("management_subnet", "'management_subnet' is required to be empty for Fortinet FortiGate series")
 		}
 	}
 
 	firenetDetail, err := client.GetFireNet(&goaviatrix.FireNet{VpcID: firewallInstance.VpcID})
 	var isNativeGWLBVpc bool
 	if err != nil {
-		log.Printf("[INFO] Could not get FireNet detail for vpc_id(%s) because of (%v),"+
-			" assuming this is a non-GWLB vpc", firewallInstance.VpcID, err)
+		logInfo(ctx, "Could not get FireNet detail, assuming this is a non-GWLB vpc", map[string]interface{}{"vpc_id": firewallInstance.VpcID, "error": err.Error()})
 	} else {
 		isNativeGWLBVpc = firenetDetail.NativeGwlb
 	}
 	if isNativeGWLBVpc {
 		if firewallInstance.GwName != "" {
-			return fmt.Errorf("VPC %s has Native GWLB enabled but a 'firenet_gw_name' was provided. "+
+			return diag.Errorf("VPC %s has Native GWLB enabled but a 'firenet_gw_name' was provided. "+
 				"Please remove 'firenet_gw_name' when using a Native GWLB enabled VPC", firewallInstance.VpcID)
 		}
 		if d.Get("zone") == "" {
-			return fmt.Errorf("VPC %s has Native GWLB enabled but a 'zone' was not provided. "+
+			return diag.Errorf("VPC %s has Native GWLB enabled but a 'zone' was not provided. "+
 				"Please provide a 'zone' in your terraform config", firewallInstance.VpcID)
 		}
 	} else {
 		if firewallInstance.GwName == "" {
-			return fmt.Errorf("'firenet_gw_name' is required when using a non Native GWLB VPC. " +
+			return attributeErrorf("firenet_gw_name", "'firenet_gw_name' is required when using a non Native GWLB VPC. "+
 				"Please provide a 'firenet_gw_name' in your terraform config")
 		}
 	}
 
 	zone := d.Get("zone").(string)
 	if zone != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.Azure|goaviatrix.AWS|goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("'zone' attribute is only valid for AWS, GCP or Azure")
+		return attributeErrorf("zone", "'zone' attribute is only valid for AWS, GCP or Azure")
 	}
 	if zone != "" {
 		firewallInstance.AvailabilityZone = zone
@@ -370,102 +376,102 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta interfa
 
 	if !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 		if firewallInstance.ManagementVpc != "" {
-			return fmt.Errorf("'management_vpc_id' is only valid for GCP")
+			return attributeErrorf("management_vpc_id", "'management_vpc_id' is only valid for GCP")
 		}
 		if firewallInstance.EgressVpc != "" {
-			return fmt.Errorf("'egress_vpc_id' is only valid for GCP")
+			return attributeErrorf("egress_vpc_id", "'egress_vpc_id' is only valid for GCP")
 		}
 	} else if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 		if firewallInstance.ManagementVpc == "" && strings.HasPrefix(firewallInstance.FirewallImage, "Palo Alto Networks") {
-			return fmt.Errorf("'management_vpc_id' is required for GCP with Palo Alto Networks Firewall")
+			return attributeErrorf("management_vpc_id", "'management_vpc_id' is required for GCP with Palo Alto Networks Firewall")
 		}
 		if firewallInstance.ManagementVpc != "" && !strings.HasPrefix(firewallInstance.FirewallImage, "Palo Alto Networks") {
-			return fmt.Errorf("'management_vpc_id' is required to be empty for GCP Check Point or FortiGate firewall")
+			return attributeErrorf("management_vpc_id", "'management_vpc_id' is required to be empty for GCP Check Point or FortiGate firewall")
 		}
 		if firewallInstance.EgressVpc == "" {
-			return fmt.Errorf("'egress_vpc_id' is required for GCP")
+			return attributeErrorf("egress_vpc_id", "'egress_vpc_id' is required for GCP")
 		}
 	}
 
 	if firewallInstance.Username != "" || firewallInstance.Password != "" || firewallInstance.SshPublicKey != "" {
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("'username' and 'password' or 'ssh_public_key' are only supported for Azure")
+			return attributeErrorf("username", "'username' and 'password' or 'ssh_public_key' are only supported for Azure")
 		}
 	}
 	if firewallInstance.Password != "" && firewallInstance.SshPublicKey != "" {
-		return fmt.Errorf("anthentication method can be either a password or an SSH public key. Please specify one of them and set the other one to empty")
+		return diag.Errorf("anthentication method can be either a password or an SSH public key. Please specify one of them and set the other one to empty")
 	}
 	if firewallInstance.IamRole != "" {
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("advanced option 'iam_role' is only supported for AWS provider, please set to empty")
+			return diag.Errorf("advanced option 'iam_role' is only supported for AWS provider, please set to empty")
 		}
 	}
 	if firewallInstance.UserData != "" && strings.HasPrefix(firewallInstance.FirewallImage, "Palo Alto Networks") {
-		return fmt.Errorf("advanced option of 'user_data' is only supported for Check Point Series and Fortinet FortiGate Series, not for %s", firewallInstance.FirewallImage)
+		return diag.Errorf("advanced option of 'user_data' is only supported for Check Point Series and Fortinet FortiGate Series, not for %s", firewallInstance.FirewallImage)
 	}
 	if firewallInstance.StorageAccessKey != "" || firewallInstance.FileShareFolder != "" || firewallInstance.ShareDirectory != "" {
 		if !strings.HasPrefix(firewallInstance.FirewallImage, "Palo Alto Networks") || !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("advanced options of 'storage_access_key', 'file_share_folder' and 'share_directory' are only supported for Azure and Palo Alto Networks VM-Series")
+			return diag.Errorf("advanced options of 'storage_access_key', 'file_share_folder' and 'share_directory' are only supported for Azure and Palo Alto Networks VM-Series")
 		}
 	}
 	if firewallInstance.ContainerFolder != "" || firewallInstance.SasUrlConfig != "" || firewallInstance.SasUriLicense != "" {
 		if !strings.HasPrefix(firewallInstance.FirewallImage, "Fortinet FortiGate") || !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("advanced options of 'container_folder', 'sas_url_config' and 'sas_url_license' are only supported for Azure and Fortinet FortiGate series")
+			return diag.Errorf("advanced options of 'container_folder', 'sas_url_config' and 'sas_url_license' are only supported for Azure and Fortinet FortiGate series")
 		}
 	}
 	if firewallInstance.BootstrapStorageName != "" {
 		if strings.HasPrefix(firewallInstance.FirewallImage, "Check Point CloudGuard") || !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("advanced option of 'bootstrap_storage_name' is only supported for Azure and Palo Alto Networks VM-Series/Fortinet FortiGate series")
+			return diag.Errorf("advanced option of 'bootstrap_storage_name' is only supported for Azure and Palo Alto Networks VM-Series/Fortinet FortiGate series")
 		}
 	}
 	if firewallInstance.SicKey != "" {
 		if !strings.HasPrefix(firewallInstance.FirewallImage, "Check Point CloudGuard") {
-			return fmt.Errorf("advanced option of 'sic_key' is only supported for Check Point Series")
+			return diag.Errorf("advanced option of 'sic_key' is only supported for Check Point Series")
 		}
 	}
 
 	if firewallInstance.FirewallImageId != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'firewall_image_id' is only supported for AWS")
+		return attributeErrorf("firewall_image_id", "'firewall_image_id' is only supported for AWS")
 	}
 
 	tags, err := extractTags(d, cloudType)
 	if err != nil {
-		return fmt.Errorf("error creating tags for firewall instance: %v", err)
+		return diag.Errorf("error creating tags for firewall instance: %v", err)
 	}
 	tagJson, err := TagsMapToJson(tags)
 	if err != nil {
-		return fmt.Errorf("failed to add tags when creating firewall instance: %v", err)
+		return diag.Errorf("failed to add tags when creating firewall instance: %v", err)
 	}
 	firewallInstance.Tags = tags
 	firewallInstance.TagJson = tagJson
 
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (firewallInstance.AvailabilityDomain == "" || firewallInstance.FaultDomain == "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
+		return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are required for OCI")
 	}
 	if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (firewallInstance.AvailabilityDomain != "" || firewallInstance.FaultDomain != "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are only valid for OCI")
 	}
 
-	instanceID, err := client.CreateFirewallInstance(firewallInstance)
+	instanceID, err := client.CreateFirewallInstance(ctx, firewallInstance)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("failed to get firewall instance information")
+			return diag.Errorf("failed to get firewall instance information")
 		}
-		return fmt.Errorf("failed to create a new firewall instance: %s", err)
+		return diag.Errorf("failed to create a new firewall instance: %s", err)
 	}
 
 	d.SetId(instanceID)
-	return resourceAviatrixFirewallInstanceRead(d, meta)
+	return resourceAviatrixFirewallInstanceRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixFirewallInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	ignoreTagsConfig := client.IgnoreTags()
 
 	instanceID := d.Get("instance_id").(string)
 	if instanceID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall names received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no firewall names received", map[string]interface{}{"import_id": id})
 		d.Set("instance_id", id)
 		d.SetId(id)
 	}
@@ -480,10 +486,10 @@ func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Firewall Instance: %s", err)
+		return diag.Errorf("couldn't find Firewall Instance: %s", err)
 	}
 
-	log.Printf("[INFO] Found Firewall Instance: %#v", firewallInstance)
+	logInfo(ctx, "Found Firewall Instance", map[string]interface{}{"gw_name": firewallInstance.GwName, "vpc_id": firewallInstance.VpcID, "instance_id": firewallInstance.InstanceID})
 
 	cloudType := goaviatrix.VendorToCloudType(fI.CloudVendor)
 
@@ -564,7 +570,7 @@ func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta interface
 		tags := goaviatrix.KeyValueTags(fI.Tags).IgnoreConfig(ignoreTagsConfig)
 		err := d.Set("tags", tags)
 		if err != nil {
-			return fmt.Errorf("failed to set tags for firewall_instance on read: %v", err)
+			return diag.Errorf("failed to set tags for firewall_instance on read: %v", err)
 		}
 	}
 	if fI.FirewallImageId != "" && goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
@@ -578,16 +584,16 @@ func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceAviatrixFirewallInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixFirewallInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("firewall_image_id") {
-		return fmt.Errorf("can not change firewall_image_id")
+		return diag.Errorf("can not change firewall_image_id")
 	}
 
-	return resourceAviatrixFirewallInstanceRead(d, meta)
+	return resourceAviatrixFirewallInstanceRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallInstance := &goaviatrix.FirewallInstance{
 		VpcID:      d.Get("vpc_id").(string),
@@ -597,11 +603,11 @@ func resourceAviatrixFirewallInstanceDelete(d *schema.ResourceData, meta interfa
 		firewallInstance.VpcID = d.Get("gcp_vpc_id").(string)
 	}
 
-	log.Printf("[INFO] Deleting firewall instance: %#v", firewallInstance)
+	logInfo(ctx, "Deleting firewall instance", map[string]interface{}{"gw_name": firewallInstance.GwName, "vpc_id": firewallInstance.VpcID, "instance_id": firewallInstance.InstanceID})
 
-	err := client.DeleteFirewallInstance(firewallInstance)
+	err := client.DeleteFirewallInstance(ctx, firewallInstance)
 	if err != nil {
-		return fmt.Errorf("failed to delete firewall instance: %s", err)
+		return diag.Errorf("failed to delete firewall instance: %s", err)
 	}
 
 	return nil
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -15,12 +16,18 @@ const FQDNVendorType = "fqdn_gateway"
 
 func resourceAviatrixFirewallInstanceAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallInstanceAssociationCreate,
-		Read:   resourceAviatrixFirewallInstanceAssociationRead,
-		Delete: resourceAviatrixFirewallInstanceAssociationDelete,
+		CreateContext: resourceAviatrixFirewallInstanceAssociationCreate,
+		ReadContext:   resourceAviatrixFirewallInstanceAssociationRead,
+		DeleteContext: resourceAviatrixFirewallInstanceAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:        schema.TypeString,
@@ -100,8 +107,8 @@ func marshalFirewallInstanceAssociationInput(d *schema.ResourceData) *goaviatrix
 	}
 }
 
-func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallInstanceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewall := marshalFirewallInstanceAssociationInput(d)
 
@@ -109,25 +116,25 @@ func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, m
 	if firewall.VendorType == FQDNVendorType {
 		gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: firewall.InstanceID})
 		if err != nil {
-			return fmt.Errorf("could not find FQDN gateway before creating association: %v", err)
+			return diag.Errorf("could not find FQDN gateway before creating association: %v", err)
 		}
 		cloudType = gw.CloudType
 	} else {
 		fwInfo, err := client.GetFirewallInstance(firewall)
 		if err != nil {
 			// Cannot find the firewall instance, likely created outside of Aviatrix controller
-			log.Printf("[INFO] Failed to get firewall details before creating association: %v\n", err)
+			logInfo(ctx, "Failed to get firewall details before creating association", map[string]interface{}{"error": err.Error()})
 		} else {
 			cloudType = goaviatrix.VendorToCloudType(fwInfo.CloudVendor)
 		}
 	}
 	if cloudType == goaviatrix.GCP {
 		if firewall.FirewallName != "" {
-			return fmt.Errorf("attribute 'firewall_name' is not valid for GCP firewall association")
+			return attributeErrorf("firewall_name", "attribute 'firewall_name' is not valid for GCP firewall association")
 		}
 		vpcParts := strings.Split(firewall.VpcID, "~-~")
 		if len(vpcParts) != 2 {
-			return fmt.Errorf("GCP firewall instance association requires 'vpc_id' in the "+
+			return diag.Errorf("GCP firewall instance association requires 'vpc_id' in the "+
 				"form 'vpc_name~-~project_name' instead got %q", firewall.VpcID)
 		}
 	}
@@ -135,44 +142,44 @@ func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, m
 	id := fmt.Sprintf("%s~~%s~~%s", firewall.VpcID, firewall.GwName, firewall.InstanceID)
 	d.SetId(id)
 	flag := false
-	defer resourceAviatrixFirewallInstanceAssociationReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFirewallInstanceAssociationReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.AssociateFirewallWithFireNet(firewall)
+	err := client.AssociateFirewallWithFireNet(ctx, firewall)
 	if err != nil {
-		return fmt.Errorf("failed to associate gateway and firewall/fqdn_gateway: %v", err)
+		return diag.Errorf("failed to associate gateway and firewall/fqdn_gateway: %v", err)
 	}
 
 	if d.Get("attached").(bool) {
 		err = client.AttachFirewallToFireNet(firewall)
 		if err != nil {
-			return fmt.Errorf("failed to attach gateway and firewall/fqdn_gateway: %v", err)
+			return diag.Errorf("failed to attach gateway and firewall/fqdn_gateway: %v", err)
 		}
 	}
 
-	return resourceAviatrixFirewallInstanceAssociationReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallInstanceAssociationReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallInstanceAssociationReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFirewallInstanceAssociationReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallInstanceAssociationRead(d, meta)
+		return resourceAviatrixFirewallInstanceAssociationRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallInstanceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallInstanceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcID := d.Get("vpc_id").(string)
 	firenetGwName := d.Get("firenet_gw_name").(string)
 	instanceID := d.Get("instance_id").(string)
 	if vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"import_id": id})
 
 		parts := strings.Split(id, "~~")
 		if len(parts) != 3 {
-			return fmt.Errorf("invalid import ID, expected import ID in the form "+
+			return diag.Errorf("invalid import ID, expected import ID in the form "+
 				"vpc_id~~firenet_gw_name~~instance_id, instead got %q", id)
 		}
 
@@ -189,7 +196,7 @@ func resourceAviatrixFirewallInstanceAssociationRead(d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FireNet: %s", err)
+		return diag.Errorf("couldn't find FireNet: %s", err)
 	}
 
 	var instanceInfo *goaviatrix.FirewallInstanceInfo
@@ -236,14 +243,14 @@ func resourceAviatrixFirewallInstanceAssociationRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAviatrixFirewallInstanceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallInstanceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewall := marshalFirewallInstanceAssociationInput(d)
 
-	err := client.DisassociateFirewallFromFireNet(firewall)
+	err := client.DisassociateFirewallFromFireNet(ctx, firewall)
 	if err != nil {
-		return fmt.Errorf("failed to disassociate firewall %v from FireNet: %v", firewall.InstanceID, err)
+		return diag.Errorf("failed to disassociate firewall %v from FireNet: %v", firewall.InstanceID, err)
 	}
 
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixFirewallTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallTagCreate,
-		Read:   resourceAviatrixFirewallTagRead,
-		Update: resourceAviatrixFirewallTagUpdate,
-		Delete: resourceAviatrixFirewallTagDelete,
+		CreateContext: resourceAviatrixFirewallTagCreate,
+		ReadContext:   resourceAviatrixFirewallTagRead,
+		UpdateContext: resourceAviatrixFirewallTagUpdate,
+		DeleteContext: resourceAviatrixFirewallTagDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,24 +47,24 @@ func resourceAviatrixFirewallTag() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
 	}
 
 	if firewallTag.Name == "" {
-		return fmt.Errorf("invalid choice: firewall tag can't be empty")
+		return diag.Errorf("invalid choice: firewall tag can't be empty")
 	}
 
 	d.SetId(firewallTag.Name)
 	flag := false
-	defer resourceAviatrixFirewallTagReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFirewallTagReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateFirewallTag(firewallTag)
 	if err != nil {
-		return fmt.Errorf("failed to create firewall tag: %s", err)
+		return diag.Errorf("failed to create firewall tag: %s", err)
 	}
 
 	//If cidr list is present, update cidr list
@@ -77,38 +77,39 @@ func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta interface{})
 				CIDR:    cm["cidr"].(string),
 			}
 			if cidrMember.CIDRTag == "" {
-				return fmt.Errorf("invalid choice: cidr_tag_name can't be empty")
+				return diag.Errorf("invalid choice: cidr_tag_name can't be empty")
 			}
 			if cidrMember.CIDR == "" {
-				return fmt.Errorf("invalid choice: cidr can't be empty")
+				return diag.Errorf("invalid choice: cidr can't be empty")
 			}
 			firewallTag.CIDRList = append(firewallTag.CIDRList, cidrMember)
 		}
 
 		err := client.UpdateFirewallTag(firewallTag)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix FirewallTag: %s", err)
+			return diag.Errorf("failed to update Aviatrix FirewallTag: %s", err)
 		}
 	}
 
-	return resourceAviatrixFirewallTagReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallTagReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallTagReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFirewallTagReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallTagRead(d, meta)
+		return resourceAviatrixFirewallTagRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
+	var diags diag.Diagnostics
 
 	fTag := d.Get("firewall_tag").(string)
 	if fTag == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall tag name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no firewall tag name received", map[string]interface{}{"import_id": id})
 		d.Set("firewall_tag", id)
 		d.SetId(id)
 	}
@@ -122,10 +123,10 @@ func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error fetching firewall tag %s: %s", firewallTag.Name, err)
+		return diag.Errorf("error fetching firewall tag %s: %s", firewallTag.Name, err)
 	}
 
-	log.Printf("[TRACE] Reading cidr list for tag %s: %#v", firewallTag.Name, fwt)
+	logTrace(ctx, "Reading cidr list for tag", map[string]interface{}{"name": firewallTag.Name})
 
 	if fwt != nil {
 		var cidrList []map[string]interface{}
@@ -138,15 +139,15 @@ func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if err := d.Set("cidr_list", cidrList); err != nil {
-			log.Printf("[WARN] Error setting cidr_list for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("cidr_list", "could not set cidr_list into state", err))
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
@@ -154,7 +155,7 @@ func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewallTag)
+	logInfo(ctx, "Creating Aviatrix firewall tag", map[string]interface{}{"name": firewallTag.Name})
 
 	//Update cidr list
 	cidrList := d.Get("cidr_list").([]interface{})
@@ -169,15 +170,15 @@ func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta interface{})
 
 	err := client.UpdateFirewallTag(firewallTag)
 	if err != nil {
-		return fmt.Errorf("failed to update Aviatrix FirewallTag: %s", err)
+		return diag.Errorf("failed to update Aviatrix FirewallTag: %s", err)
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFirewallTagRead(d, meta)
+	return resourceAviatrixFirewallTagRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallTagDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
@@ -185,7 +186,7 @@ func resourceAviatrixFirewallTagDelete(d *schema.ResourceData, meta interface{})
 
 	err := client.DeleteFirewallTag(firewallTag)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix FirewallTag policy list: %s", err)
+		return diag.Errorf("failed to delete Aviatrix FirewallTag policy list: %s", err)
 	}
 
 	return nil
@@ -1,9 +1,9 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -12,12 +12,12 @@ import (
 
 func resourceAviatrixFQDN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFQDNCreate,
-		Read:   resourceAviatrixFQDNRead,
-		Update: resourceAviatrixFQDNUpdate,
-		Delete: resourceAviatrixFQDNDelete,
+		CreateContext: resourceAviatrixFQDNCreate,
+		ReadContext:   resourceAviatrixFQDNRead,
+		UpdateContext: resourceAviatrixFQDNUpdate,
+		DeleteContext: resourceAviatrixFQDNDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 2,
@@ -109,13 +109,13 @@ func resourceAviatrixFQDN() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	_, hasSetDomainNames := d.GetOk("domain_names")
 	enabledInlineDomainNames := d.Get("manage_domain_names").(bool)
 	if hasSetDomainNames && !enabledInlineDomainNames {
-		return fmt.Errorf("manage_domain_names must be set to true to set in-line domain names")
+		return attributeErrorf("manage_domain_names", "manage_domain_names must be set to true to set in-line domain names")
 	}
 
 	fqdn := &goaviatrix.FQDN{
@@ -130,15 +130,15 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 		fqdn.FQDNStatus = "disabled"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix FQDN: %#v", fqdn)
+	logInfo(ctx, "Creating Aviatrix FQDN", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
 	d.SetId(fqdn.FQDNTag)
 	flag := false
-	defer resourceAviatrixFQDNReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFQDNReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateFQDN(fqdn)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix FQDN: %s", err)
+		return diag.Errorf("failed to create Aviatrix FQDN: %s", err)
 	}
 
 	if hasSetDomainNames && enabledInlineDomainNames {
@@ -155,14 +155,14 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 				}
 				str := fqdnFilter.FQDN + fqdnFilter.Protocol + fqdnFilter.Port + fqdnFilter.Verdict
 				if mapDomains[str] {
-					return fmt.Errorf("validation on domain_names failed: duplicate rules are not allowed")
+					return diag.Errorf("validation on domain_names failed: duplicate rules are not allowed")
 				}
 				mapDomains[str] = true
 				fqdn.DomainList = append(fqdn.DomainList, fqdnFilter)
 			}
 		}
 		if err := client.UpdateDomains(fqdn); err != nil {
-			return fmt.Errorf("failed to set domain names: %s", err)
+			return diag.Errorf("failed to set domain names: %s", err)
 		}
 	}
 
@@ -174,7 +174,7 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 		}
 		err := client.AttachTagToGw(fqdn, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to add filter tag to gateway : %s", err)
+			return diag.Errorf("failed to add filter tag to gateway : %s", err)
 		}
 		sourceIPs := make([]string, 0)
 		for _, sourceIP := range gFT["source_ip_list"].(*schema.Set).List() {
@@ -184,48 +184,49 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 		if len(sourceIPs) != 0 {
 			err = client.UpdateSourceIPFilters(fqdn, gateway, sourceIPs)
 			if err != nil {
-				return fmt.Errorf("failed to update source ips to gateway : %s", err)
+				return diag.Errorf("failed to update source ips to gateway : %s", err)
 			}
 		}
 	}
 
 	if fqdnStatus := d.Get("fqdn_enabled").(bool); fqdnStatus {
 		fqdn.FQDNStatus = "enabled"
-		log.Printf("[INFO] Enable FQDN tag status: %#v", fqdn)
+		logInfo(ctx, "Enable FQDN tag status", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
 		err := client.UpdateFQDNStatus(fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN status : %s", err)
+			return diag.Errorf("failed to update FQDN status : %s", err)
 		}
 	}
 
 	// update fqdn_mode when set to non-default "blacklist" mode
 	if fqdnMode := d.Get("fqdn_mode").(string); fqdnMode == "black" {
-		log.Printf("[INFO] Enable FQDN Mode: %#v", fqdn)
+		logInfo(ctx, "Enable FQDN Mode", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 		err := client.UpdateFQDNMode(fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN mode : %s", err)
+			return diag.Errorf("failed to update FQDN mode : %s", err)
 		}
 	}
 
-	return resourceAviatrixFQDNReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFQDNReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFQDNReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFQDNReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFQDNRead(d, meta)
+		return resourceAviatrixFQDNRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
+	var diags diag.Diagnostics
 
 	fqdnTag := d.Get("fqdn_tag").(string)
 	if fqdnTag == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no fqdn tag received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no fqdn tag received", map[string]interface{}{"import_id": id})
 		d.Set("fqdn_tag", id)
 		d.Set("manage_domain_names", true)
 		d.SetId(id)
@@ -241,7 +242,7 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FQDN tag: %s", err)
+		return diag.Errorf("couldn't find FQDN tag: %s", err)
 	}
 
 	if fqdn.FQDNStatus == "enabled" {
@@ -252,14 +253,14 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("fqdn_mode", fqdn.FQDNMode)
 
-	log.Printf("[INFO] Reading Aviatrix FQDN: %#v", fqdn)
+	logInfo(ctx, "Reading Aviatrix FQDN", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 	newfqdn, err := client.GetFQDNTag(fqdn)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FQDN tag: %s", err)
+		return diag.Errorf("couldn't find FQDN tag: %s", err)
 	}
 
 	if newfqdn != nil {
@@ -276,9 +277,9 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	newfqdn, err = client.ListDomains(fqdn)
 	if err != nil {
-		return fmt.Errorf("couldn't list FQDN domains: %s", err)
+		return diag.Errorf("couldn't list FQDN domains: %s", err)
 	}
-	log.Printf("[INFO] Enable FQDN tag status: %#v", newfqdn)
+	logInfo(ctx, "Enable FQDN tag status", map[string]interface{}{"fqdn_tag": newfqdn.FQDNTag})
 
 	if newfqdn != nil {
 		// This is nothing IF ListDomains return empty
@@ -292,19 +293,19 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 			filter = append(filter, dn)
 		}
 
-		log.Printf("[INFO] Enable FQDN tag status: %#v", fqdn)
+		logInfo(ctx, "Enable FQDN tag status", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
 		// Only write domain names to state if the user has enabled in-line domain names.
 		if d.Get("manage_domain_names").(bool) {
 			if err = d.Set("domain_names", filter); err != nil {
-				log.Printf("[WARN] Error setting domain_names for (%s): %s", d.Id(), err)
+				diags = append(diags, attributeWarning("domain_names", "could not set domain_names into state", err))
 			}
 		}
 	}
 
 	newfqdn, err = client.GetGwFilterTagList(newfqdn)
 	if err != nil {
-		return fmt.Errorf("couldn't list FQDN Filter Tags: %s", err)
+		return diag.Errorf("couldn't list FQDN Filter Tags: %s", err)
 	}
 
 	mGwFilterTags := make(map[string]map[string]interface{})
@@ -361,19 +362,19 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("gw_filter_tag_list", gwFilterTagList); err != nil {
-		log.Printf("[WARN] Error setting gw_filter_tag_list for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("gw_filter_tag_list", "could not set gw_filter_tag_list into state", err))
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	_, hasSetDomainNames := d.GetOk("domain_names")
 	enabledInlineDomainNames := d.Get("manage_domain_names").(bool)
 	if hasSetDomainNames && !enabledInlineDomainNames {
-		return fmt.Errorf("manage_domain_names must be set to true to set in-line domain names")
+		return attributeErrorf("manage_domain_names", "manage_domain_names must be set to true to set in-line domain names")
 	}
 
 	fqdn := &goaviatrix.FQDN{
@@ -390,18 +391,18 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.Partial(true)
 	if d.HasChange("fqdn_tag") {
-		return fmt.Errorf("updating fqdn_tag is not allowed")
+		return diag.Errorf("updating fqdn_tag is not allowed")
 	}
 	if d.HasChange("fqdn_enabled") {
 		err := client.UpdateFQDNStatus(fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN status : %s", err)
+			return diag.Errorf("failed to update FQDN status : %s", err)
 		}
 	}
 	if d.HasChange("fqdn_mode") {
 		err := client.UpdateFQDNMode(fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN mode : %s", err)
+			return diag.Errorf("failed to update FQDN mode : %s", err)
 		}
 	}
 	// Update Domain list
@@ -419,14 +420,14 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 				}
 				str := fqdnDomain.FQDN + fqdnDomain.Protocol + fqdnDomain.Port + fqdnDomain.Verdict
 				if mapDomains[str] {
-					return fmt.Errorf("validation on domain_names failed in update: duplicate rules are not allowed")
+					return diag.Errorf("validation on domain_names failed in update: duplicate rules are not allowed")
 				}
 				mapDomains[str] = true
 				fqdn.DomainList = append(fqdn.DomainList, fqdnDomain)
 			}
 		}
 		if err := client.UpdateDomains(fqdn); err != nil {
-			return fmt.Errorf("failed to set domain names in update : %s", err)
+			return diag.Errorf("failed to set domain names in update : %s", err)
 		}
 	}
 	if d.HasChange("gw_filter_tag_list") {
@@ -465,11 +466,11 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 			if !ok {
 				err := client.AttachTagToGw(fqdn, gateway)
 				if err != nil {
-					return fmt.Errorf("failed to add filter tag to gateway : %s", err)
+					return diag.Errorf("failed to add filter tag to gateway : %s", err)
 				}
 				err = client.UpdateSourceIPFilters(fqdn, gateway, sourceIPs)
 				if err != nil {
-					return fmt.Errorf("failed to update source ips to gateway : %s", err)
+					return diag.Errorf("failed to update source ips to gateway : %s", err)
 				}
 				continue
 			}
@@ -477,7 +478,7 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 			if !goaviatrix.Equivalent(val, sourceIPs) {
 				err := client.UpdateSourceIPFilters(fqdn, gateway, sourceIPs)
 				if err != nil {
-					return fmt.Errorf("failed to update source ips to gateway : %s", err)
+					return diag.Errorf("failed to update source ips to gateway : %s", err)
 				}
 			}
 			delete(mapOldTagList, gateway.GwName)
@@ -490,37 +491,37 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 		if len(keys) != 0 {
 			err := client.DetachGws(fqdn, keys)
 			if err != nil {
-				return fmt.Errorf("failed to delete GWs for fqdn in update: %s", err)
+				return diag.Errorf("failed to delete GWs for fqdn in update: %s", err)
 			}
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(fqdn.FQDNTag)
-	return resourceAviatrixFQDNRead(d, meta)
+	return resourceAviatrixFQDNRead(ctx, d, meta)
 }
 
-func resourceAviatrixFQDNDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	fqdn := &goaviatrix.FQDN{
 		FQDNTag: d.Get("fqdn_tag").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix FQDN: %#v", fqdn)
+	logInfo(ctx, "Deleting Aviatrix FQDN", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
 	gwList, err := client.ListGws(fqdn)
 	if err != nil {
-		return fmt.Errorf("failed to get GW list for fqdn: %s", err)
+		return diag.Errorf("failed to get GW list for fqdn: %s", err)
 	}
 	err = client.DetachGws(fqdn, gwList)
 	if err != nil {
-		return fmt.Errorf("failed to delete GWs for fqdn: %s", err)
+		return diag.Errorf("failed to delete GWs for fqdn: %s", err)
 	}
 
 	err = client.DeleteFQDN(fqdn)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix FQDN: %s", err)
+		return diag.Errorf("failed to delete Aviatrix FQDN: %s", err)
 	}
 
 	return nil
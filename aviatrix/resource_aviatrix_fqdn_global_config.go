@@ -65,25 +65,25 @@ func resourceAviatrixFQDNGlobalConfig() *schema.Resource {
 }
 
 func resourceAviatrixFQDNGlobalConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	enablePrivateNetworkFiltering := d.Get("enable_private_network_filtering").(bool)
 	enableCustomNetworkFiltering := d.Get("enable_custom_network_filtering").(bool)
 
 	if enablePrivateNetworkFiltering && enableCustomNetworkFiltering {
-		return diag.Errorf("enable_private_network_filtering and enable_custom_network_filtering can't be set true at the same time")
+		return attributeErrorf("enable_private_network_filtering", "enable_private_network_filtering and enable_custom_network_filtering can't be set true at the same time")
 	}
 	if enableCustomNetworkFiltering {
 		if _, ok := d.GetOk("configured_ips"); !ok {
-			return diag.Errorf("configured_ips is required to enable custom network filtering")
+			return attributeErrorf("configured_ips", "configured_ips is required to enable custom network filtering")
 		}
 	} else {
 		if _, ok := d.GetOk("configured_ips"); ok {
-			return diag.Errorf("configured_ips is required to be empty to disable custom network filtering")
+			return attributeErrorf("configured_ips", "configured_ips is required to be empty to disable custom network filtering")
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixFQDNGlobalConfigReadIfRequired(ctx, d, meta, &flag)
 
@@ -158,9 +158,9 @@ func resourceAviatrixFQDNGlobalConfigReadIfRequired(ctx context.Context, d *sche
 }
 
 func resourceAviatrixFQDNGlobalConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.ControllerAddress(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -209,12 +209,12 @@ func resourceAviatrixFQDNGlobalConfigRead(ctx context.Context, d *schema.Resourc
 		d.Set("enable_exact_match", false)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixFQDNGlobalConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	d.Partial(true)
 
@@ -237,15 +237,15 @@ func resourceAviatrixFQDNGlobalConfigUpdate(ctx context.Context, d *schema.Resou
 		enableCustomNetworkFiltering := d.Get("enable_custom_network_filtering").(bool)
 
 		if enablePrivateNetworkFiltering && enableCustomNetworkFiltering {
-			return diag.Errorf("enable_private_network_filtering and enable_custom_network_filtering can't be set true at the same time")
+			return attributeErrorf("enable_private_network_filtering", "enable_private_network_filtering and enable_custom_network_filtering can't be set true at the same time")
 		}
 		if enableCustomNetworkFiltering {
 			if _, ok := d.GetOk("configured_ips"); !ok {
-				return diag.Errorf("configured_ips is required to enable custom network filtering")
+				return attributeErrorf("configured_ips", "configured_ips is required to enable custom network filtering")
 			}
 		} else {
 			if _, ok := d.GetOk("configured_ips"); ok {
-				return diag.Errorf("configured_ips is required to be empty to disable custom network filtering")
+				return attributeErrorf("configured_ips", "configured_ips is required to be empty to disable custom network filtering")
 			}
 		}
 
@@ -320,7 +320,7 @@ func resourceAviatrixFQDNGlobalConfigUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixFQDNGlobalConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client) //default enabled
+	client := meta.(goaviatrix.SecurityClient) //default enabled
 
 	err := client.EnableFQDNExceptionRule(ctx)
 	if err != nil {
@@ -11,39 +11,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccAviatrixFQDN_basic(t *testing.T) {
-	var fqdn goaviatrix.FQDN
-
+func TestAccAviatrixFQDNPassThrough_basic(t *testing.T) {
 	rName := acctest.RandString(5)
 
-	skipAcc := os.Getenv("SKIP_FQDN")
+	skipAcc := os.Getenv("SKIP_FQDN_PASS_THROUGH")
 	if skipAcc == "yes" {
-		t.Skip("Skipping FQDN test as SKIP_FQDN is set")
+		t.Skip("Skipping FQDN pass through test as SKIP_FQDN_PASS_THROUGH is set")
 	}
 
-	resourceName := "aviatrix_fqdn.foo"
+	resourceName := "aviatrix_fqdn_pass_through.test_fqdn_pass_through"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
-			preGatewayCheck(t, ". Set SKIP_FQDN to yes to skip FQDN tests")
+			preGatewayCheck(t, ". Set SKIP_FQDN_PASS_THROUGH to yes to skip FQDN pass through tests")
 		},
 		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckFQDNDestroy,
+		CheckDestroy: testAccCheckFQDNPassThroughDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFQDNConfigBasic(rName),
+				Config: testAccFQDNPassThroughConfigBasic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckFQDNExists(resourceName, &fqdn),
-					resource.TestCheckResourceAttr(resourceName, "fqdn_tag", fmt.Sprintf("tff-%s", rName)),
-					resource.TestCheckResourceAttr(resourceName, "fqdn_enabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "fqdn_mode", "white"),
-					resource.TestCheckResourceAttr(resourceName, "gw_filter_tag_list.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "gw_filter_tag_list.0.gw_name", fmt.Sprintf("tfg-%s", rName)),
-					resource.TestCheckResourceAttr(resourceName, "domain_names.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "domain_names.0.fqdn", "facebook.com"),
-					resource.TestCheckResourceAttr(resourceName, "domain_names.0.proto", "tcp"),
-					resource.TestCheckResourceAttr(resourceName, "domain_names.0.port", "443"),
+					testAccCheckFQDNPassThroughExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", fmt.Sprintf("tfg-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "pass_through_cidrs.#", "3"),
 				),
 			},
 			{
@@ -55,7 +46,7 @@ func TestAccAviatrixFQDN_basic(t *testing.T) {
 	})
 }
 
-func testAccFQDNConfigBasic(rName string) string {
+func testAccFQDNPassThroughConfigBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aviatrix_account" "test" {
 	account_name       = "tfa-%s"
@@ -75,7 +66,7 @@ resource "aviatrix_gateway" "test" {
 	subnet         = "%[7]s"
 	single_ip_snat = true
 }
-resource "aviatrix_fqdn" "foo" {
+resource "aviatrix_fqdn" "test" {
 	fqdn_tag     = "tff-%[1]s"
 	fqdn_enabled = true
 	fqdn_mode    = "white"
@@ -86,25 +77,24 @@ resource "aviatrix_fqdn" "foo" {
 	}
 
 	domain_names {
-		fqdn  = "facebook.com"
+		fqdn   = "facebook.com"
 		proto  = "tcp"
 		port   = "443"
 		action = "Allow"
 	}
 }
-
 resource "aviatrix_fqdn_pass_through" "test_fqdn_pass_through" {
-	gw_name            = aviatrix_gateway.test_gw_aws.gw_name
+	gw_name            = aviatrix_gateway.test.gw_name
 	pass_through_cidrs = [
 		"10.0.0.0/24",
 		"10.0.1.0/24",
 		"10.0.2.0/24",
 	]
 
-	depends_on         = [aviatrix_fqdn.test_fqdn]
+	depends_on = [aviatrix_fqdn.test]
 }
 	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
-		os.Getenv("AWS_REGION"))
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
 }
 
 func testAccCheckFQDNPassThroughExists(n string) resource.TestCheckFunc {
@@ -45,7 +45,7 @@ func marshalGatewayCertificateConfigInput(d *schema.ResourceData) *goaviatrix.Ga
 }
 
 func resourceAviatrixGatewayCertificateConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.GatewayClient)
 
 	gwCert := marshalGatewayCertificateConfigInput(d)
 
@@ -53,12 +53,12 @@ func resourceAviatrixGatewayCertificateConfigCreate(ctx context.Context, d *sche
 		return diag.FromErr(fmt.Errorf("could not configure gateway certificates: %v", err))
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	return resourceAviatrixGatewayCertificateConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixGatewayCertificateConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.GatewayClient)
 
 	gwCertStatus, err := client.GetGatewayCertificateStatus(ctx)
 	if err != nil {
@@ -66,7 +66,7 @@ func resourceAviatrixGatewayCertificateConfigRead(ctx context.Context, d *schema
 	}
 
 	if gwCertStatus == "enabled" {
-		d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+		d.SetId(strings.Replace(client.ControllerAddress(), ".", "-", -1))
 	} else {
 		d.SetId("")
 	}
@@ -75,7 +75,7 @@ func resourceAviatrixGatewayCertificateConfigRead(ctx context.Context, d *schema
 }
 
 func resourceAviatrixGatewayCertificateConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.GatewayClient)
 
 	if err := client.DisableGatewayCertificate(ctx); err != nil {
 		return diag.FromErr(fmt.Errorf("could not disable gateway certificate checking: %v", err))
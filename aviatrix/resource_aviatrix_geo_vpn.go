@@ -1,25 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
-	"golang.org/x/net/context"
-
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixGeoVPN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGeoVPNCreate,
-		Read:   resourceAviatrixGeoVPNRead,
-		Update: resourceAviatrixGeoVPNUpdate,
-		Delete: resourceAviatrixGeoVPNDelete,
+		CreateContext: resourceAviatrixGeoVPNCreate,
+		ReadContext:   resourceAviatrixGeoVPNRead,
+		UpdateContext: resourceAviatrixGeoVPNUpdate,
+		DeleteContext: resourceAviatrixGeoVPNDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,8 +58,8 @@ func resourceAviatrixGeoVPN() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGeoVPNCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	geoVPN := &goaviatrix.GeoVPN{
 		CloudType:   d.Get("cloud_type").(int),
@@ -70,7 +68,7 @@ func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta interface{}) erro
 		DomainName:  d.Get("domain_name").(string),
 	}
 
-	log.Printf("[INFO] Enabling Aviatrix Geo VPN: %#v", geoVPN)
+	logInfo(ctx, "Enabling Aviatrix Geo VPN", map[string]interface{}{"account_name": geoVPN.AccountName})
 
 	elbDNSNames := make([]string, 0)
 	for _, elbDNSName := range d.Get("elb_dns_names").([]interface{}) {
@@ -78,46 +76,47 @@ func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if len(elbDNSNames) == 0 {
-		return fmt.Errorf("please specify 'elb_dns_names' to enable Aviatrix Geo VPN")
+		return diag.Errorf("please specify 'elb_dns_names' to enable Aviatrix Geo VPN")
 	}
 
 	d.SetId(geoVPN.ServiceName + "~" + geoVPN.DomainName)
 	flag := false
-	defer resourceAviatrixGeoVPNReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixGeoVPNReadIfRequired(ctx, d, meta, &flag)
 
 	geoVPN.ElbDNSName = elbDNSNames[0]
-	err := client.EnableGeoVPN(context.Background(), geoVPN)
+	err := client.EnableGeoVPN(ctx, geoVPN)
 	if err != nil {
-		return fmt.Errorf("failed to enable Aviatrix Geo VPN due to: %s", err)
+		return diag.Errorf("failed to enable Aviatrix Geo VPN due to: %s", err)
 	}
 
 	for i := 1; i < len(elbDNSNames); i++ {
 		geoVPN.ElbDNSName = elbDNSNames[i]
 		err := client.AddElbToGeoVPN(geoVPN)
 		if err != nil {
-			return fmt.Errorf("failed to add elb: %s to Aviatrix Geo VPN due to: %s", geoVPN.ElbDNSName, err)
+			return diag.Errorf("failed to add elb: %s to Aviatrix Geo VPN due to: %s", geoVPN.ElbDNSName, err)
 		}
 	}
 
-	return resourceAviatrixGeoVPNReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGeoVPNReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGeoVPNReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixGeoVPNReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGeoVPNRead(d, meta)
+		return resourceAviatrixGeoVPNRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGeoVPNRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGeoVPNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
+	var diags diag.Diagnostics
 
 	domainName := d.Get("domain_name").(string)
 	serviceName := d.Get("service_name").(string)
 	if domainName == "" || serviceName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no domain name or service name received. Import id is %s", id)
+		logDebug(ctx, "Looks like an import, no domain name or service name received", map[string]interface{}{"import_id": id})
 		d.Set("cloud_type", goaviatrix.AWS)
 		d.Set("service_name", strings.Split(id, "~")[0])
 		d.Set("domain_name", strings.Split(id, "~")[1])
@@ -136,7 +135,7 @@ func resourceAviatrixGeoVPNRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("unable to read Aviatrix Geo VPN Info due to %v", err)
+		return diag.Errorf("unable to read Aviatrix Geo VPN Info due to %v", err)
 	}
 
 	d.Set("cloud_type", geoVPNDetail.CloudType)
@@ -144,16 +143,16 @@ func resourceAviatrixGeoVPNRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("service_name", geoVPNDetail.ServiceName)
 	d.Set("domain_name", geoVPNDetail.DomainName)
 	if err := d.Set("elb_dns_names", strings.Split(geoVPNDetail.ElbDNSName, ",")); err != nil {
-		log.Printf("[WARN] Error setting 'elb_dns_names' for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("elb_dns_names", "could not set elb_dns_names into state", err))
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixGeoVPNUpdate(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[INFO] Updating Aviatrix Geo VPN")
+func resourceAviatrixGeoVPNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logInfo(ctx, "Updating Aviatrix Geo VPN")
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.VPNClient)
 
 	geoVPN := &goaviatrix.GeoVPN{
 		CloudType:   d.Get("cloud_type").(int),
@@ -184,7 +183,7 @@ func resourceAviatrixGeoVPNUpdate(d *schema.ResourceData, meta interface{}) erro
 			geoVPN.ElbDNSName = toDeleteElbs[i]
 			err := client.DeleteElbFromGeoVPN(geoVPN)
 			if err != nil {
-				return fmt.Errorf("failed to delete ELB: %s from Aviatrix Geo VPN due to: %s", toDeleteElbs[i], err)
+				return diag.Errorf("failed to delete ELB: %s from Aviatrix Geo VPN due to: %s", toDeleteElbs[i], err)
 			}
 		}
 
@@ -192,27 +191,27 @@ func resourceAviatrixGeoVPNUpdate(d *schema.ResourceData, meta interface{}) erro
 			geoVPN.ElbDNSName = toAddElbs[i]
 			err := client.AddElbToGeoVPN(geoVPN)
 			if err != nil {
-				return fmt.Errorf("failed to add ELB: %s to Aviatrix Geo VPN due to: %s", toAddElbs[i], err)
+				return diag.Errorf("failed to add ELB: %s to Aviatrix Geo VPN due to: %s", toAddElbs[i], err)
 			}
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixGeoVPNRead(d, meta)
+	return resourceAviatrixGeoVPNRead(ctx, d, meta)
 }
 
-func resourceAviatrixGeoVPNDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGeoVPNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	geoVPN := &goaviatrix.GeoVPN{
 		CloudType: d.Get("cloud_type").(int),
 	}
 
-	log.Printf("[INFO] Disabling Aviatrix Geo VPN: %#v", geoVPN)
+	logInfo(ctx, "Disabling Aviatrix Geo VPN", map[string]interface{}{"account_name": geoVPN.AccountName})
 
 	err := client.DisableGeoVPN(geoVPN)
 	if err != nil {
-		return fmt.Errorf("failed to disable Aviatrix Geo VPN due to: %s", err)
+		return diag.Errorf("failed to disable Aviatrix Geo VPN due to: %s", err)
 	}
 
 	return nil
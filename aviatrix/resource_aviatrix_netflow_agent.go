@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixNetflowAgent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixNetflowAgentCreate,
-		Read:   resourceAviatrixNetflowAgentRead,
-		Delete: resourceAviatrixNetflowAgentDelete,
+		CreateContext: resourceAviatrixNetflowAgentCreate,
+		ReadContext:   resourceAviatrixNetflowAgentRead,
+		DeleteContext: resourceAviatrixNetflowAgentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,28 +90,28 @@ func marshalNetflowAgentInput(d *schema.ResourceData) *goaviatrix.NetflowAgent {
 	return netflowAgent
 }
 
-func resourceAviatrixNetflowAgentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixNetflowAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	_, err := client.GetNetflowAgentStatus()
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the netflow_agent is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the netflow_agent is already enabled, please import to manage with Terraform")
 	}
 
 	netflowAgent := marshalNetflowAgentInput(d)
 
 	if err := client.EnableNetflowAgent(netflowAgent); err != nil {
-		return fmt.Errorf("could not enable netflow agent: %v", err)
+		return diag.Errorf("could not enable netflow agent: %v", err)
 	}
 
 	d.SetId("netflow_agent")
 	return nil
 }
-func resourceAviatrixNetflowAgentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixNetflowAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if d.Id() != "netflow_agent" {
-		return fmt.Errorf("invalid ID, expected ID \"netflow_agent\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"netflow_agent\", instead got %s", d.Id())
 	}
 
 	netflowAgentStatus, err := client.GetNetflowAgentStatus()
@@ -119,7 +120,7 @@ func resourceAviatrixNetflowAgentRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get netflow agent status: %v", err)
+		return diag.Errorf("could not get netflow agent status: %v", err)
 	}
 
 	d.Set("server_ip", netflowAgentStatus.ServerIp)
@@ -137,11 +138,11 @@ func resourceAviatrixNetflowAgentRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixNetflowAgentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixNetflowAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if err := client.DisableNetflowAgent(); err != nil {
-		return fmt.Errorf("could not disable netflow agent: %v", err)
+		return diag.Errorf("could not disable netflow agent: %v", err)
 	}
 
 	return nil
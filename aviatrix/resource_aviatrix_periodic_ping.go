@@ -1,22 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strconv"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixPeriodicPing() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixPeriodicPingCreate,
-		Read:   resourceAviatrixPeriodicPingRead,
-		Delete: resourceAviatrixPeriodicPingDelete,
+		CreateContext: resourceAviatrixPeriodicPingCreate,
+		ReadContext:   resourceAviatrixPeriodicPingRead,
+		DeleteContext: resourceAviatrixPeriodicPingDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,37 +51,37 @@ func marshalPeriodicPingInput(d *schema.ResourceData) *goaviatrix.PeriodicPing {
 	}
 }
 
-func resourceAviatrixPeriodicPingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixPeriodicPingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.GatewayClient)
 
 	pp := marshalPeriodicPingInput(d)
 
 	d.SetId(pp.GwName)
 	flag := false
-	defer resourceAviatrixPeriodicPingReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixPeriodicPingReadIfRequired(ctx, d, meta, &flag)
 
 	if err := client.CreatePeriodicPing(pp); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceAviatrixPeriodicPingReadIfRequired(d, meta, &flag)
+	return resourceAviatrixPeriodicPingReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixPeriodicPingReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixPeriodicPingReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixPeriodicPingRead(d, meta)
+		return resourceAviatrixPeriodicPingRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixPeriodicPingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixPeriodicPingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.GatewayClient)
 
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no periodic_ping gw_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no periodic_ping gw_name received", map[string]interface{}{"import_id": id})
 		d.SetId(id)
 		gwName = id
 	}
@@ -96,7 +96,7 @@ func resourceAviatrixPeriodicPingRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find periodic_ping %s: %v", gwName, err)
+		return diag.Errorf("could not find periodic_ping %s: %v", gwName, err)
 	}
 
 	d.Set("gw_name", gwName)
@@ -107,13 +107,13 @@ func resourceAviatrixPeriodicPingRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixPeriodicPingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixPeriodicPingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.GatewayClient)
 
 	pp := marshalPeriodicPingInput(d)
 
 	if err := client.DeletePeriodicPing(pp); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,7 +3,6 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -113,7 +112,7 @@ func marshalPrivateModeLb(d *schema.ResourceData) (*goaviatrix.PrivateModeLb, er
 }
 
 func resourceAviatrixPrivateModeLbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	privateModeLb, err := marshalPrivateModeLb(d)
 	if err != nil {
@@ -156,11 +155,11 @@ func resourceAviatrixPrivateModeLbReadIfRequired(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixPrivateModeLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	if _, ok := d.GetOk("vpc_id"); !ok {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"import_id": id})
 		d.Set("vpc_id", id)
 	}
 
@@ -206,7 +205,7 @@ func resourceAviatrixPrivateModeLbRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixPrivateModeLbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	privateModeLb, err := marshalPrivateModeLb(d)
 	if err != nil {
@@ -225,7 +224,7 @@ func resourceAviatrixPrivateModeLbUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixPrivateModeLbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	vpcId := d.Get("vpc_id").(string)
 	err := client.DeletePrivateModeLoadBalancer(ctx, vpcId)
@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,7 +52,7 @@ func resourceAviatrixPrivateModeMulticloudEndpoint() *schema.Resource {
 }
 
 func resourceAviatrixPrivateModeMulticloudEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	privateModeMulticloudEndpoint := &goaviatrix.PrivateModeMulticloudEndpoint{
 		AccountName:       d.Get("account_name").(string),
@@ -84,11 +83,11 @@ func resourceAviatrixPrivateModeMulticloudEndpointReadIfRequired(ctx context.Con
 }
 
 func resourceAviatrixPrivateModeMulticloudEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	if _, ok := d.GetOk("vpc_id"); !ok {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"import_id": id})
 		d.Set("vpc_id", id)
 	}
 
@@ -113,7 +112,7 @@ func resourceAviatrixPrivateModeMulticloudEndpointRead(ctx context.Context, d *s
 }
 
 func resourceAviatrixPrivateModeMulticloudEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ControllerClient)
 
 	vpcId := d.Get("vpc_id").(string)
 	err := client.DeletePrivateModeMulticloudEndpoint(ctx, vpcId)
@@ -48,7 +48,7 @@ func marshalQosClassInput(d *schema.ResourceData) *goaviatrix.QosClass {
 }
 
 func resourceAviatrixQosClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	qosClass := marshalQosClassInput(d)
 
@@ -73,7 +73,7 @@ func resourceAviatrixQosClassReadIfRequired(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixQosClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -94,7 +94,7 @@ func resourceAviatrixQosClassRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAviatrixQosClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -112,7 +112,7 @@ func resourceAviatrixQosClassUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixQosClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	uuid := d.Id()
 	err := client.DeleteQosClass(ctx, uuid)
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupCreate,
-		Read:   resourceAviatrixRbacGroupRead,
-		Delete: resourceAviatrixRbacGroupDelete,
-		Update: resourceAviatrixRbacGroupUpdate,
+		CreateContext: resourceAviatrixRbacGroupCreate,
+		ReadContext:   resourceAviatrixRbacGroupRead,
+		DeleteContext: resourceAviatrixRbacGroupDelete,
+		UpdateContext: resourceAviatrixRbacGroupUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,70 +35,70 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	groupName := d.Get("group_name").(string)
 	group := &goaviatrix.RbacGroup{
 		GroupName: groupName,
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group: %#v", group)
+	logInfo(ctx, "Creating Aviatrix RBAC permission group", map[string]interface{}{"group_name": group.GroupName})
 
 	d.SetId(group.GroupName)
 	flag := false
-	defer resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixRbacGroupReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreatePermissionGroup(group)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix RBAC permission group: %s", err)
+		return diag.Errorf("failed to create Aviatrix RBAC permission group: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group %s created", group.GroupName)
+	logDebug(ctx, "Aviatrix RBAC permission group created", map[string]interface{}{"group_name": group.GroupName})
 
 	if d.Get("local_login").(bool) {
 		err := client.EnableLocalLoginForRBACGroup(groupName)
 		if err != nil {
-			return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+			return diag.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
 		}
 	}
 
-	return resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 	groupName := d.Get("group_name").(string)
 
 	if d.Get("local_login").(bool) {
 		err := client.EnableLocalLoginForRBACGroup(groupName)
 		if err != nil {
-			return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+			return diag.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
 		}
 	} else {
 		err := client.DisableLocalLoginForRBACGroup(groupName)
 		if err != nil {
-			return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
+			return diag.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
 		}
 	}
-	return resourceAviatrixRbacGroupRead(d, meta)
+	return resourceAviatrixRbacGroupRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixRbacGroupReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupRead(d, meta)
+		return resourceAviatrixRbacGroupRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	groupName := d.Get("group_name").(string)
 	if groupName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no group name received", map[string]interface{}{"import_id": id})
 		d.Set("group_name", id)
 		d.SetId(id)
 		groupName = id
@@ -108,7 +108,7 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 		GroupName: groupName,
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group: %#v", group)
+	logInfo(ctx, "Looking for Aviatrix RBAC permission group", map[string]interface{}{"group_name": group.GroupName})
 
 	rGroup, err := client.GetPermissionGroupDetails(groupName)
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix RBAC permission group: %s", err)
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group: %s", err)
 	}
 	if rGroup != nil {
 		d.Set("group_name", rGroup.GroupName)
@@ -127,18 +127,18 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAviatrixRbacGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	group := &goaviatrix.RbacGroup{
 		GroupName: d.Get("group_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group: %#v", group)
+	logInfo(ctx, "Deleting Aviatrix RBAC permission group", map[string]interface{}{"group_name": group.GroupName})
 
 	err := client.DeletePermissionGroup(group)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix RBAC permission group: %s", err)
+		return diag.Errorf("failed to delete Aviatrix RBAC permission group: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroupAccessAccountAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupAccessAccountAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupAccessAccountAttachmentRead,
-		Delete: resourceAviatrixRbacGroupAccessAccountAttachmentDelete,
+		CreateContext: resourceAviatrixRbacGroupAccessAccountAttachmentCreate,
+		ReadContext:   resourceAviatrixRbacGroupAccessAccountAttachmentRead,
+		DeleteContext: resourceAviatrixRbacGroupAccessAccountAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,46 +35,46 @@ func resourceAviatrixRbacGroupAccessAccountAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	attachment := &goaviatrix.RbacGroupAccessAccountAttachment{
 		GroupName:         d.Get("group_name").(string),
 		AccessAccountName: d.Get("access_account_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group access account attachment: %#v", attachment)
+	logInfo(ctx, "Creating Aviatrix RBAC permission group access account attachment", map[string]interface{}{"group_name": attachment.GroupName, "access_account_name": attachment.AccessAccountName})
 
 	d.SetId(attachment.GroupName + "~" + attachment.AccessAccountName)
 	flag := false
-	defer resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateRbacGroupAccessAccountAttachment(attachment)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix RBAC permission group access account attachment: %s", err)
+		return diag.Errorf("failed to create Aviatrix RBAC permission group access account attachment: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group access account attachment created")
+	logDebug(ctx, "Aviatrix RBAC permission group access account attachment created")
 
-	return resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupAccessAccountAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupAccessAccountAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	groupName := d.Get("group_name").(string)
 	accessAccountName := d.Get("access_account_name").(string)
 	if groupName == "" || accessAccountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or access account name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no group name or access account name received", map[string]interface{}{"import_id": id})
 		d.Set("group_name", strings.Split(id, "~")[0])
 		d.Set("access_account_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -85,7 +85,7 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData
 		AccessAccountName: d.Get("access_account_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group access account attachment: %#v", attachment)
+	logInfo(ctx, "Looking for Aviatrix RBAC permission group access account attachment", map[string]interface{}{"group_name": attachment.GroupName, "access_account_name": attachment.AccessAccountName})
 
 	accessAccountAttachment, err := client.GetRbacGroupAccessAccountAttachment(attachment)
 	if err != nil {
@@ -93,7 +93,7 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix RBAC permission group access account attachment: %s", err)
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group access account attachment: %s", err)
 	}
 	if accessAccountAttachment != nil {
 		d.Set("group_name", accessAccountAttachment.GroupName)
@@ -104,19 +104,19 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	attachment := &goaviatrix.RbacGroupAccessAccountAttachment{
 		GroupName:         d.Get("group_name").(string),
 		AccessAccountName: d.Get("access_account_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group access account attachment: %#v", attachment)
+	logInfo(ctx, "Deleting Aviatrix RBAC permission group access account attachment", map[string]interface{}{"group_name": attachment.GroupName, "access_account_name": attachment.AccessAccountName})
 
 	err := client.DeleteRbacGroupAccessAccountAttachment(attachment)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix RBAC permission group access account attachment: %s", err)
+		return diag.Errorf("failed to delete Aviatrix RBAC permission group access account attachment: %s", err)
 	}
 
 	return nil
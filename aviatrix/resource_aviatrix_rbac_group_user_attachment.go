@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroupUserAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupUserAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupUserAttachmentRead,
-		Delete: resourceAviatrixRbacGroupUserAttachmentDelete,
+		CreateContext: resourceAviatrixRbacGroupUserAttachmentCreate,
+		ReadContext:   resourceAviatrixRbacGroupUserAttachmentRead,
+		DeleteContext: resourceAviatrixRbacGroupUserAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,46 +35,46 @@ func resourceAviatrixRbacGroupUserAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupUserAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	attachment := &goaviatrix.RbacGroupUserAttachment{
 		GroupName: d.Get("group_name").(string),
 		UserName:  d.Get("user_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group user attachment: %#v", attachment)
+	logInfo(ctx, "Creating Aviatrix RBAC permission group user attachment", map[string]interface{}{"user_name": attachment.UserName, "group_name": attachment.GroupName})
 
 	d.SetId(attachment.GroupName + "~" + attachment.UserName)
 	flag := false
-	defer resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateRbacGroupUserAttachment(attachment)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix RBAC permission group user attachment: %s", err)
+		return diag.Errorf("failed to create Aviatrix RBAC permission group user attachment: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group user attachment created")
+	logDebug(ctx, "Aviatrix RBAC permission group user attachment created")
 
-	return resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupUserAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupUserAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	groupName := d.Get("group_name").(string)
 	userName := d.Get("user_name").(string)
 	if groupName == "" || userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or account user name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no group name or account user name received", map[string]interface{}{"import_id": id})
 		d.Set("group_name", strings.Split(id, "~")[0])
 		d.Set("user_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -85,7 +85,7 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta in
 		UserName:  d.Get("user_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group user attachment: %#v", attachment)
+	logInfo(ctx, "Looking for Aviatrix RBAC permission group user attachment", map[string]interface{}{"user_name": attachment.UserName, "group_name": attachment.GroupName})
 
 	userAttachment, err := client.GetRbacGroupUserAttachment(attachment)
 	if err != nil {
@@ -93,7 +93,7 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix RBAC permission group user attachment: %s", err)
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group user attachment: %s", err)
 	}
 	if userAttachment != nil {
 		d.Set("group_name", userAttachment.GroupName)
@@ -104,19 +104,19 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.AccountClient)
 
 	attachment := &goaviatrix.RbacGroupUserAttachment{
 		GroupName: d.Get("group_name").(string),
 		UserName:  d.Get("user_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group user attachment: %#v", attachment)
+	logInfo(ctx, "Deleting Aviatrix RBAC permission group user attachment", map[string]interface{}{"user_name": attachment.UserName, "group_name": attachment.GroupName})
 
 	err := client.DeleteRbacGroupUserAttachment(attachment)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix RBAC permission group user attachment: %s", err)
+		return diag.Errorf("failed to delete Aviatrix RBAC permission group user attachment: %s", err)
 	}
 
 	return nil
@@ -1,13 +1,13 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -16,11 +16,11 @@ var remoteSyslogMatcher = regexp.MustCompile(`\bremote_syslog_[0-9]\b`)
 
 func resourceAviatrixRemoteSyslog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRemoteSyslogCreate,
-		Read:   resourceAviatrixRemoteSyslogRead,
-		Delete: resourceAviatrixRemoteSyslogDelete,
+		CreateContext: resourceAviatrixRemoteSyslogCreate,
+		ReadContext:   resourceAviatrixRemoteSyslogRead,
+		DeleteContext: resourceAviatrixRemoteSyslogDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,12 +141,12 @@ func marshalRemoteSyslogInput(d *schema.ResourceData) *goaviatrix.RemoteSyslog {
 	return remoteSyslog
 }
 
-func resourceAviatrixRemoteSyslogCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRemoteSyslogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	_, err := client.GetRemoteSyslogStatus(d.Get("index").(int))
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the remote_syslog with index %d is already enabled, please import to manage with Terraform", d.Get("index").(int))
+		return diag.Errorf("the remote_syslog with index %d is already enabled, please import to manage with Terraform", d.Get("index").(int))
 	}
 
 	remoteSyslog := marshalRemoteSyslogInput(d)
@@ -154,29 +154,29 @@ func resourceAviatrixRemoteSyslogCreate(d *schema.ResourceData, meta interface{}
 	if !((remoteSyslog.CaCertificate != "" && remoteSyslog.PublicCertificate != "" && remoteSyslog.PrivateKey != "") ||
 		(remoteSyslog.CaCertificate == "" && remoteSyslog.PublicCertificate == "" && remoteSyslog.PrivateKey == "") ||
 		(remoteSyslog.CaCertificate != "" && remoteSyslog.PublicCertificate == "" && remoteSyslog.PrivateKey == "")) {
-		return fmt.Errorf("one or more certificates missing")
+		return diag.Errorf("one or more certificates missing")
 	}
 
 	if err := client.EnableRemoteSyslog(remoteSyslog); err != nil {
-		return fmt.Errorf("could not enable remote syslog: %v", err)
+		return diag.Errorf("could not enable remote syslog: %v", err)
 	}
 
 	d.SetId("remote_syslog_" + strconv.Itoa(remoteSyslog.Index))
 	return nil
 }
 
-func resourceAviatrixRemoteSyslogRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRemoteSyslogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	server := d.Get("server").(string)
 
 	if server == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 
 		match := remoteSyslogMatcher.Match([]byte(id))
 		if !match {
-			return fmt.Errorf("invalid ID format, expected ID in format \"remote_syslog_{index}\", instead got %s", id)
+			return diag.Errorf("invalid ID format, expected ID in format \"remote_syslog_{index}\", instead got %s", id)
 		}
 
 		index, _ := strconv.Atoi(id[len(id)-1:])
@@ -190,7 +190,7 @@ func resourceAviatrixRemoteSyslogRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get remote syslog status: %v", err)
+		return diag.Errorf("could not get remote syslog status: %v", err)
 	}
 
 	idx, _ := strconv.Atoi(remoteSyslogStatus.Index)
@@ -211,11 +211,11 @@ func resourceAviatrixRemoteSyslogRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixRemoteSyslogDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRemoteSyslogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if err := client.DisableRemoteSyslog(d.Get("index").(int)); err != nil {
-		return fmt.Errorf("could not disable remote syslog: %v", err)
+		return diag.Errorf("could not disable remote syslog: %v", err)
 	}
 
 	return nil
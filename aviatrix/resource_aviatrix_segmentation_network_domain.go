@@ -1,20 +1,20 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSegmentationNetworkDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainDelete,
+		CreateContext: resourceAviatrixSegmentationNetworkDomainCreate,
+		ReadContext:   resourceAviatrixSegmentationNetworkDomainRead,
+		DeleteContext: resourceAviatrixSegmentationNetworkDomainDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,37 +34,37 @@ func marshalSegmentationNetworkDomainInput(d *schema.ResourceData) *goaviatrix.S
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SegmentationClient)
 
 	domain := marshalSegmentationNetworkDomainInput(d)
 
 	d.SetId(domain.DomainName)
 	flag := false
-	defer resourceAviatrixSegmentationNetworkDomainReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSegmentationNetworkDomainReadIfRequired(ctx, d, meta, &flag)
 
 	if err := client.CreateSegmentationSecurityDomain(domain); err != nil {
-		return fmt.Errorf("could not create network domain: %v", err)
+		return diag.Errorf("could not create network domain: %v", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SegmentationClient)
 
 	domainName := d.Get("domain_name").(string)
 	if domainName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no segmentation_network_domain domain_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no segmentation_network_domain domain_name received", map[string]interface{}{"import_id": id})
 		d.SetId(id)
 		domainName = id
 	}
@@ -79,7 +79,7 @@ func resourceAviatrixSegmentationNetworkDomainRead(d *schema.ResourceData, meta
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find segmentation_network_domain %s: %v", domainName, err)
+		return diag.Errorf("could not find segmentation_network_domain %s: %v", domainName, err)
 	}
 
 	d.Set("domain_name", domain.DomainName)
@@ -87,13 +87,13 @@ func resourceAviatrixSegmentationNetworkDomainRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SegmentationClient)
 
 	domain := marshalSegmentationNetworkDomainInput(d)
 
 	if err := client.DeleteSegmentationSecurityDomain(domain); err != nil {
-		return fmt.Errorf("could not delete segmentation_network_domain: %v", err)
+		return diag.Errorf("could not delete segmentation_network_domain: %v", err)
 	}
 
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSegmentationNetworkDomainConnectionPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete,
+		CreateContext: resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate,
+		ReadContext:   resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead,
+		DeleteContext: resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -46,38 +46,38 @@ func marshalSegmentationNetworkDomainConnectionPolicyInput(d *schema.ResourceDat
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SegmentationClient)
 
 	policy := marshalSegmentationNetworkDomainConnectionPolicyInput(d)
 
 	d.SetId(policy.Domain1.DomainName + "~" + policy.Domain2.DomainName)
 	flag := false
-	defer resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
 
 	if err := client.CreateSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
-		return fmt.Errorf("could not create network domain connection policy: %v", err)
+		return diag.Errorf("could not create network domain connection policy: %v", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SegmentationClient)
 
 	domainName1 := d.Get("domain_name_1").(string)
 	domainName2 := d.Get("domain_name_2").(string)
 	if domainName1 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no segmentation_network_domain_connection_policy domain_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no segmentation_network_domain_connection_policy domain_name received", map[string]interface{}{"import_id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		domainName1 = parts[0]
@@ -99,7 +99,7 @@ func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.Res
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find segmentation_network_domain_connection_policy %s: %v", domainName1+"~"+domainName2, err)
+		return diag.Errorf("could not find segmentation_network_domain_connection_policy %s: %v", domainName1+"~"+domainName2, err)
 	}
 
 	d.Set("domain_name_1", domainName1)
@@ -108,13 +108,13 @@ func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.Res
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SegmentationClient)
 
 	policy := marshalSegmentationNetworkDomainConnectionPolicyInput(d)
 
 	if err := client.DeleteSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
-		return fmt.Errorf("could not delete segmentation_network_domain_connection_policy: %v", err)
+		return diag.Errorf("could not delete segmentation_network_domain_connection_policy: %v", err)
 	}
 
 	return nil
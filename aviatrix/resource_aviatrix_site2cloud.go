@@ -1,10 +1,10 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,12 +24,12 @@ var customMappedAttributeNames = []string{
 
 func resourceAviatrixSite2Cloud() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSite2CloudCreate,
-		Read:   resourceAviatrixSite2CloudRead,
-		Update: resourceAviatrixSite2CloudUpdate,
-		Delete: resourceAviatrixSite2CloudDelete,
+		CreateContext: resourceAviatrixSite2CloudCreate,
+		ReadContext:   resourceAviatrixSite2CloudRead,
+		UpdateContext: resourceAviatrixSite2CloudUpdate,
+		DeleteContext: resourceAviatrixSite2CloudDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -428,8 +428,8 @@ func getCSVFromStringList(d *schema.ResourceData, attributeName string) string {
 	return strings.Join(expandedList, ",")
 }
 
-func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	s2c := &goaviatrix.Site2Cloud{
 		GwName:                        d.Get("primary_cloud_gateway_name").(string),
@@ -469,15 +469,15 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	haEnabled := d.Get("ha_enabled").(bool)
 	if s2c.AuthType == "Cert" {
 		if s2c.CaCertTagName == "" || s2c.RemoteIdentifier == "" {
-			return fmt.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
+			return attributeErrorf("ca_cert_tag_name", "'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
 		}
 		if haEnabled && s2c.BackupRemoteIdentifier == "" {
-			return fmt.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
+			return attributeErrorf("backup_remote_identifier", "'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
 		}
 		s2c.AuthType = "pubkey"
 	} else {
 		if s2c.CaCertTagName != "" || s2c.RemoteIdentifier != "" || s2c.BackupRemoteIdentifier != "" {
-			return fmt.Errorf("'ca_cert_tag_name', 'remote_identifier' and 'backup_remote_identifier' are required to be empty for PSK(Pubkey) based authentication type")
+			return attributeErrorf("ca_cert_tag_name", "'ca_cert_tag_name', 'remote_identifier' and 'backup_remote_identifier' are required to be empty for PSK(Pubkey) based authentication type")
 		}
 	}
 
@@ -486,9 +486,9 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 		s2c.HAEnabled = "yes"
 		// 22021: Remote GW IP is not required when singleIPHA is enabled as only 1 tunnel is created
 		if s2c.BackupGwName == "" || (s2c.RemoteGwIP2 == "" && !singleIpHA) {
-			return fmt.Errorf("'backup_gateway_name' and 'backup_remote_gateway_ip' are required when HA is enabled")
+			return attributeErrorf("backup_gateway_name", "'backup_gateway_name' and 'backup_remote_gateway_ip' are required when HA is enabled")
 		} else if s2c.RemoteGwIP2 != "" && singleIpHA {
-			return fmt.Errorf("'backup_remote_gateway_ip' is not required when HA is enabled and single ip ha is enabled")
+			return attributeErrorf("backup_remote_gateway_ip", "'backup_remote_gateway_ip' is not required when HA is enabled and single ip ha is enabled")
 		}
 		if s2c.RemoteGwIP2 != "" {
 			s2c.RemoteGwIP = s2c.RemoteGwIP + "," + s2c.RemoteGwIP2
@@ -497,25 +497,25 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		s2c.HAEnabled = "no"
 		if s2c.BackupGwName != "" || s2c.RemoteGwIP2 != "" || s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'backup_gateway_name', 'backup_remote_gateway_ip', 'backup_local_tunnel_ip' " +
+			return attributeErrorf("backup_gateway_name", "'backup_gateway_name', 'backup_remote_gateway_ip', 'backup_local_tunnel_ip' "+
 				"and 'backup_remote_tunnel_ip' are only valid when HA is enabled")
 		}
 	}
 
 	if s2c.TunnelType == "policy" {
 		if s2c.LocalTunnelIp != "" || s2c.RemoteTunnelIp != "" || s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'local_tunnel_ip', 'remote_tunnel_ip', 'backup_local_tunnel_ip' " +
+			return attributeErrorf("local_tunnel_ip", "'local_tunnel_ip', 'remote_tunnel_ip', 'backup_local_tunnel_ip' "+
 				"and 'backup_remote_tunnel_ip' are only valid for route based connection")
 		}
 	}
 
 	activeActive := d.Get("enable_active_active").(bool)
 	if activeActive && !haEnabled {
-		return fmt.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
+		return diag.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
 	}
 
 	if s2c.ConnType != "mapped" && s2c.ConnType != "unmapped" {
-		return fmt.Errorf("'connection_type' should be 'mapped' or 'unmapped'")
+		return attributeErrorf("connection_type", "'connection_type' should be 'mapped' or 'unmapped'")
 	}
 
 	gateway := &goaviatrix.Gateway{
@@ -525,59 +525,59 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	gw, err := client.GetGateway(gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("couldn't find Aviatrix Gateway %s", s2c.GwName)
+			return diag.Errorf("couldn't find Aviatrix Gateway %s", s2c.GwName)
 		} else {
-			return fmt.Errorf("couldn't find Aviatrix Gateway %s: %v", s2c.GwName, err)
+			return diag.Errorf("couldn't find Aviatrix Gateway %s: %v", s2c.GwName, err)
 		}
 	}
 
 	if gw.TransitVpc == "yes" {
 		if s2c.ConnType == "unmapped" && s2c.TunnelType == "policy" && haEnabled && !activeActive {
-			return fmt.Errorf("active_active_ha must be enabled if HA is enabled for transit gateway unmapped policy based site2cloud connection")
+			return diag.Errorf("active_active_ha must be enabled if HA is enabled for transit gateway unmapped policy based site2cloud connection")
 		}
 	}
 
 	if singleIpHA {
 		if !haEnabled {
-			return fmt.Errorf("'enable_single_ip_ha' can't be enabled if HA isn't enabled for site2cloud connection")
+			return attributeErrorf("enable_single_ip_ha", "'enable_single_ip_ha' can't be enabled if HA isn't enabled for site2cloud connection")
 		}
 		if s2c.AuthType == "pubkey" {
-			return fmt.Errorf("single IP HA is only supported for PSK authentication type based site2cloud connection")
+			return diag.Errorf("single IP HA is only supported for PSK authentication type based site2cloud connection")
 		}
 		if s2c.RemoteGwIP2 != "" && s2c.RemoteGwIP != s2c.RemoteGwIP2 {
-			return fmt.Errorf("'backup_remote_gateway_ip' is required to be empty or the same as 'remote_gateway_ip' when single IP HA is enabled")
+			return attributeErrorf("backup_remote_gateway_ip", "'backup_remote_gateway_ip' is required to be empty or the same as 'remote_gateway_ip' when single IP HA is enabled")
 		}
 		if s2c.BackupPreSharedKey != "" && s2c.PreSharedKey != s2c.BackupPreSharedKey {
-			return fmt.Errorf("'backup_pre_shared_key' is required to be empty or the same as 'pre_shared_key' when single IP HA is enabled")
+			return attributeErrorf("backup_pre_shared_key", "'backup_pre_shared_key' is required to be empty or the same as 'pre_shared_key' when single IP HA is enabled")
 		}
 		if s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'backup_local_tunnel_ip' and 'backup_remote_tunnel_ip' are required to be empty when single IP HA is enabled")
+			return attributeErrorf("backup_local_tunnel_ip", "'backup_local_tunnel_ip' and 'backup_remote_tunnel_ip' are required to be empty when single IP HA is enabled")
 		}
 		s2c.EnableSingleIpHA = true
 	}
 
 	if !s2c.CustomMap && s2c.RemoteSubnet == "" {
-		return fmt.Errorf("'remote_subnet_cidr' is required unless you are using 'custom_mapped'")
+		return attributeErrorf("remote_subnet_cidr", "'remote_subnet_cidr' is required unless you are using 'custom_mapped'")
 	}
 	if s2c.CustomMap {
 		if s2c.RemoteSubnet != "" {
-			return fmt.Errorf("'remote_subnet_cidr' is not valid for 'custom_mapped' connection")
+			return attributeErrorf("remote_subnet_cidr", "'remote_subnet_cidr' is not valid for 'custom_mapped' connection")
 		}
 		if s2c.RemoteSubnetVirtual != "" {
-			return fmt.Errorf("'remote_subnet_virtual' is not valid for 'custom_mapped' connection")
+			return attributeErrorf("remote_subnet_virtual", "'remote_subnet_virtual' is not valid for 'custom_mapped' connection")
 		}
 		if s2c.LocalSubnet != "" {
-			return fmt.Errorf("'local_subnet_cidr' is not valid for 'custom_mapped' connection")
+			return attributeErrorf("local_subnet_cidr", "'local_subnet_cidr' is not valid for 'custom_mapped' connection")
 		}
 		if s2c.LocalSubnetVirtual != "" {
-			return fmt.Errorf("'local_subnet_virtual' is not valid for 'custom_mapped' connection")
+			return attributeErrorf("local_subnet_virtual", "'local_subnet_virtual' is not valid for 'custom_mapped' connection")
 		}
 	}
 	if s2c.ConnType == "mapped" && !s2c.CustomMap && (s2c.RemoteSubnetVirtual == "" || s2c.LocalSubnetVirtual == "") {
-		return fmt.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' are both required for " +
+		return attributeErrorf("remote_subnet_virtual", "'remote_subnet_virtual' and 'local_subnet_virtual' are both required for "+
 			"connection type: mapped, unless 'custom_mapped' is enabled")
 	} else if s2c.ConnType == "unmapped" && (s2c.RemoteSubnetVirtual != "" || s2c.LocalSubnetVirtual != "") {
-		return fmt.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' both should be empty for " +
+		return attributeErrorf("remote_subnet_virtual", "'remote_subnet_virtual' and 'local_subnet_virtual' both should be empty for "+
 			"connection type: ummapped")
 	}
 	hasSetAnyCustomMapAttribute := s2c.RemoteSourceRealCIDRs != "" || s2c.RemoteSourceVirtualCIDRs != "" ||
@@ -585,15 +585,15 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 		s2c.LocalSourceRealCIDRs != "" || s2c.LocalSourceVirtualCIDRs != "" ||
 		s2c.LocalDestinationRealCIDRs != "" || s2c.LocalDestinationVirtualCIDRs != ""
 	if !s2c.CustomMap && hasSetAnyCustomMapAttribute {
-		return fmt.Errorf("attributes %v are only valid with 'custom_mapped' enabled", customMappedAttributeNames)
+		return diag.Errorf("attributes %v are only valid with 'custom_mapped' enabled", customMappedAttributeNames)
 	}
 	if s2c.CustomMap && (s2c.ConnType != "mapped" || s2c.TunnelType != "route") {
-		return fmt.Errorf("'connection_type' should be 'mapped' and 'tunnel_type' should be 'route' for 'custom_mapped' enabled connection")
+		return attributeErrorf("connection_type", "'connection_type' should be 'mapped' and 'tunnel_type' should be 'route' for 'custom_mapped' enabled connection")
 	}
 	hasSetAllCustomRemoteCIDRs := s2c.RemoteSourceRealCIDRs != "" && s2c.RemoteSourceVirtualCIDRs != "" && s2c.RemoteDestinationRealCIDRs != "" && s2c.RemoteDestinationVirtualCIDRs != ""
 	hasSetAllCustomLocalCIDRs := s2c.LocalSourceRealCIDRs != "" && s2c.LocalSourceVirtualCIDRs != "" && s2c.LocalDestinationRealCIDRs != "" && s2c.LocalDestinationVirtualCIDRs != ""
 	if s2c.CustomMap && !hasSetAllCustomLocalCIDRs && !hasSetAllCustomRemoteCIDRs {
-		return fmt.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initated CIDRs be provided")
+		return attributeErrorf("custom_mapped", "'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initated CIDRs be provided")
 	}
 
 	s2c.Phase1Auth = d.Get("phase_1_authentication").(string)
@@ -611,44 +611,44 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 			s2c.Phase2DhGroups == "" ||
 			s2c.Phase1Encryption == "" ||
 			s2c.Phase2Encryption == "" {
-			return fmt.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is enabled, please set all of the algorithm parameters")
 		} else if s2c.Phase1Auth == goaviatrix.Phase1AuthDefault &&
 			s2c.Phase2Auth == goaviatrix.Phase2AuthDefault &&
 			s2c.Phase1DhGroups == goaviatrix.Phase1DhGroupDefault &&
 			s2c.Phase2DhGroups == goaviatrix.Phase2DhGroupDefault &&
 			s2c.Phase1Encryption == goaviatrix.Phase1EncryptionDefault &&
 			s2c.Phase2Encryption == goaviatrix.Phase2EncryptionDefault {
-			return fmt.Errorf("custom_algorithms is enabled, cannot use default values for " +
+			return attributeErrorf("custom_algorithms", "custom_algorithms is enabled, cannot use default values for "+
 				"all six algorithm parameters. Please change the value of at least one of the six algorithm parameters")
 		}
 	} else {
 		if s2c.Phase1Auth != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_1_authentication should be empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is disabled, phase_1_authentication should be empty")
 		} else {
 			s2c.Phase1Auth = goaviatrix.Phase1AuthDefault
 		}
 		if s2c.Phase1DhGroups != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_1_dh_groups should be empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is disabled, phase_1_dh_groups should be empty")
 		} else {
 			s2c.Phase1DhGroups = goaviatrix.Phase1DhGroupDefault
 		}
 		if s2c.Phase1Encryption != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_1_encryption should be empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is disabled, phase_1_encryption should be empty")
 		} else {
 			s2c.Phase1Encryption = goaviatrix.Phase1EncryptionDefault
 		}
 		if s2c.Phase2Auth != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_2_authentication should be empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is disabled, phase_2_authentication should be empty")
 		} else {
 			s2c.Phase2Auth = goaviatrix.Phase2AuthDefault
 		}
 		if s2c.Phase2DhGroups != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_2_dh_groups should be empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is disabled, phase_2_dh_groups should be empty")
 		} else {
 			s2c.Phase2DhGroups = goaviatrix.Phase2DhGroupDefault
 		}
 		if s2c.Phase2Encryption != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_2_encryption should be empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is disabled, phase_2_encryption should be empty")
 		} else {
 			s2c.Phase2Encryption = goaviatrix.Phase2EncryptionDefault
 		}
@@ -671,18 +671,18 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	backupRemoteGwLongitude := d.Get("backup_remote_gateway_longitude").(float64)
 
 	if privateRouteEncryption && len(routeTableList) == 0 {
-		return fmt.Errorf("private_route_encryption is enabled, route_table_list cannot be empty")
+		return attributeErrorf("private_route_encryption", "private_route_encryption is enabled, route_table_list cannot be empty")
 	} else if privateRouteEncryption {
 		s2c.PrivateRouteEncryption = "true"
 		s2c.RouteTableList = routeTableList
 		if remoteGwLatitude == 0 || remoteGwLongitude == 0 {
-			return fmt.Errorf("private_route_encryption is enabled, please set remote_gateway_latitude and remote_gateway_longitude")
+			return attributeErrorf("private_route_encryption", "private_route_encryption is enabled, please set remote_gateway_latitude and remote_gateway_longitude")
 		}
 		s2c.RemoteGwLatitude = remoteGwLatitude
 		s2c.RemoteGwLongitude = remoteGwLongitude
 		if s2c.HAEnabled == "yes" {
 			if backupRemoteGwLatitude == 0 || backupRemoteGwLongitude == 0 {
-				return fmt.Errorf("private_route_encryption is enabled and ha is enabled, please set backup_remote_gateway_latitude and backup_remote_gateway_longitude")
+				return attributeErrorf("private_route_encryption", "private_route_encryption is enabled and ha is enabled, please set backup_remote_gateway_latitude and backup_remote_gateway_longitude")
 			}
 			s2c.BackupRemoteGwLatitude = backupRemoteGwLatitude
 			s2c.BackupRemoteGwLongitude = backupRemoteGwLongitude
@@ -690,10 +690,10 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		s2c.PrivateRouteEncryption = "false"
 		if len(routeTableList) != 0 {
-			return fmt.Errorf("private route encryption is disabled, route_table_list should be empty")
+			return diag.Errorf("private route encryption is disabled, route_table_list should be empty")
 		}
 		if remoteGwLatitude != 0 || remoteGwLongitude != 0 || backupRemoteGwLatitude != 0 || backupRemoteGwLongitude != 0 {
-			return fmt.Errorf("private route encryption is disabled, all of remote_gateway_latitude, " +
+			return diag.Errorf("private route encryption is disabled, all of remote_gateway_latitude, " +
 				"backup_remote_gateway_latitude and backup_remote_gateway_longitude should be empty")
 		}
 	}
@@ -701,14 +701,14 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	sslServerPool := d.Get("ssl_server_pool").(string)
 
 	if s2c.TunnelType == "udp" && sslServerPool != "" {
-		return fmt.Errorf("ssl_server_pool only supports tunnel type 'tcp'")
+		return attributeErrorf("ssl_server_pool", "ssl_server_pool only supports tunnel type 'tcp'")
 	}
 	if s2c.TunnelType == "tcp" && s2c.RemoteGwType != "avx" {
-		return fmt.Errorf("only 'avx' remote gateway type is supported for tunnel type 'tcp'")
+		return diag.Errorf("only 'avx' remote gateway type is supported for tunnel type 'tcp'")
 	}
 	if s2c.TunnelType == "tcp" {
 		if sslServerPool == goaviatrix.SslServerPoolDefault {
-			return fmt.Errorf("'192.168.44.0/24' is default, please specify a different value for ssl_server_pool")
+			return diag.Errorf("'192.168.44.0/24' is default, please specify a different value for ssl_server_pool")
 		} else if sslServerPool == "" {
 			s2c.SslServerPool = goaviatrix.SslServerPoolDefault
 		} else {
@@ -720,41 +720,41 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 	if haEnabled && !singleIpHA && len(phase1RemoteIdentifier) != 0 && len(phase1RemoteIdentifier) != 2 {
 		if !(s2c.RemoteGwIP != "" && s2c.RemoteGwIP2 != "" && s2c.RemoteGwIP == s2c.RemoteGwIP2 && len(phase1RemoteIdentifier) == 1) {
-			return fmt.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled and single IP HA is disabled")
+			return diag.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled and single IP HA is disabled")
 		}
 	} else if (!haEnabled || singleIpHA) && len(phase1RemoteIdentifier) > 1 {
-		return fmt.Errorf("please either set one phase 1 remote ID or none, when HA is disabled or single IP HA is enabled")
+		return diag.Errorf("please either set one phase 1 remote ID or none, when HA is disabled or single IP HA is enabled")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Site2Cloud: %#v", s2c)
+	logInfo(ctx, "Creating Aviatrix Site2Cloud", map[string]interface{}{"gw_name": s2c.GwName, "tunnel_name": s2c.TunnelName, "vpc_id": s2c.VpcID})
 
 	d.SetId(s2c.TunnelName + "~" + s2c.VpcID)
 	flag := false
-	defer resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSite2CloudReadIfRequired(ctx, d, meta, &flag)
 
 	err = client.CreateSite2Cloud(s2c)
 	if err != nil {
-		return fmt.Errorf("failed Site2Cloud create: %s", err)
+		return diag.Errorf("failed Site2Cloud create: %s", err)
 	}
 
 	enableDeadPeerDetection := d.Get("enable_dead_peer_detection").(bool)
 	if !enableDeadPeerDetection {
 		err := client.DisableDeadPeerDetection(s2c)
 		if err != nil {
-			return fmt.Errorf("failed to disable dead peer detection: %s", err)
+			return diag.Errorf("failed to disable dead peer detection: %s", err)
 		}
 	}
 
 	if activeActive {
 		err := client.EnableSite2cloudActiveActive(s2c)
 		if err != nil {
-			return fmt.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+			return diag.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 		}
 	} else {
 		if gw.TransitVpc == "no" && s2c.ConnType == "unmapped" && s2c.TunnelType == "route" && haEnabled {
 			err := client.DisableSite2cloudActiveActive(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		}
 	}
@@ -763,14 +763,14 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	if forwardToTransit {
 		err := client.EnableSpokeMappedSite2CloudForwarding(s2c)
 		if err != nil {
-			return fmt.Errorf("failed to enable traffic forwarding to transit: %v", err)
+			return diag.Errorf("failed to enable traffic forwarding to transit: %v", err)
 		}
 	}
 
 	if d.Get("enable_event_triggered_ha").(bool) {
 		err := client.EnableSite2CloudEventTriggeredHA(s2c.VpcID, s2c.TunnelName)
 		if err != nil {
-			return fmt.Errorf("could not enable event triggered HA for site2cloud after creation: %v", err)
+			return diag.Errorf("could not enable event triggered HA for site2cloud after creation: %v", err)
 		}
 	}
 
@@ -792,7 +792,7 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -818,7 +818,7 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -831,33 +831,34 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 			s2c.Phase1LocalIdentifier = "private_ip"
 			err = client.EditSite2CloudPhase1LocalIdentifier(s2c)
 			if err != nil {
-				return fmt.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
+				return diag.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSite2CloudReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSite2CloudReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSite2CloudReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSite2CloudRead(d, meta)
+		return resourceAviatrixSite2CloudRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	tunnelName := d.Get("connection_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 	if tunnelName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no tunnel name or vpc id names received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no tunnel name or vpc id names received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return fmt.Errorf("invalid import ID format")
+			return diag.Errorf("invalid import ID format")
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
@@ -874,7 +875,7 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Site2Cloud: %s, %#v", err, s2c)
+		return diag.Errorf("couldn't find Aviatrix Site2Cloud: %s, %#v", err, s2c)
 	}
 
 	if s2c != nil {
@@ -940,7 +941,7 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 		if s2c.PrivateRouteEncryption == "true" {
 			d.Set("private_route_encryption", true)
 			if err := d.Set("route_table_list", s2c.RouteTableList); err != nil {
-				log.Printf("[WARN] Error setting route_table_list for (%s): %s", d.Id(), err)
+				diags = append(diags, attributeWarning("route_table_list", "could not set route_table_list into state", err))
 			}
 			d.Set("remote_gateway_latitude", s2c.RemoteGwLatitude)
 			d.Set("remote_gateway_longitude", s2c.RemoteGwLongitude)
@@ -970,42 +971,42 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 
 		if s2c.RemoteSourceRealCIDRs != "" {
 			if err := d.Set("remote_source_real_cidrs", strings.Split(s2c.RemoteSourceRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_source_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_source_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.RemoteSourceVirtualCIDRs != "" {
 			if err := d.Set("remote_source_virtual_cidrs", strings.Split(s2c.RemoteSourceVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_source_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_source_virtual_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.RemoteDestinationRealCIDRs != "" {
 			if err := d.Set("remote_destination_real_cidrs", strings.Split(s2c.RemoteDestinationRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_destination_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_destination_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.RemoteDestinationVirtualCIDRs != "" {
 			if err := d.Set("remote_destination_virtual_cidrs", strings.Split(s2c.RemoteDestinationVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_destination_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_destination_virtual_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalSourceRealCIDRs != "" {
 			if err := d.Set("local_source_real_cidrs", strings.Split(s2c.LocalSourceRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_source_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_source_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalSourceVirtualCIDRs != "" {
 			if err := d.Set("local_source_virtual_cidrs", strings.Split(s2c.LocalSourceVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_source_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_source_virtual_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalDestinationRealCIDRs != "" {
 			if err := d.Set("local_destination_real_cidrs", strings.Split(s2c.LocalDestinationRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_destination_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_destination_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalDestinationVirtualCIDRs != "" {
 			if err := d.Set("local_destination_virtual_cidrs", strings.Split(s2c.LocalDestinationVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_destination_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_destination_virtual_cidrs' to state: %v", err)
 			}
 		}
 
@@ -1026,15 +1027,15 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("phase1_remote_identifier", ph1RemoteId)
 	}
 
-	log.Printf("[TRACE] Reading Aviatrix Site2Cloud %s: %#v", d.Get("connection_name").(string), site2cloud)
-	log.Printf("[TRACE] Reading Aviatrix Site2Cloud connection_type: [%s]", d.Get("connection_type").(string))
+	logTrace(ctx, "Reading Aviatrix Site2Cloud", map[string]interface{}{"connection_name": d.Get("connection_name").(string), "gw_name": site2cloud.GwName, "tunnel_name": site2cloud.TunnelName, "vpc_id": site2cloud.VpcID})
+	logTrace(ctx, "Reading Aviatrix Site2Cloud connection_type", map[string]interface{}{"connection_type": d.Get("connection_type").(string)})
 
 	d.SetId(site2cloud.TunnelName + "~" + site2cloud.VpcID)
-	return nil
+	return diags
 }
 
-func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	editSite2cloud := &goaviatrix.EditSite2Cloud{
 		GwName:   d.Get("primary_cloud_gateway_name").(string),
@@ -1043,69 +1044,69 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Partial(true)
-	log.Printf("[INFO] Updating Aviatrix Site2Cloud: %#v", editSite2cloud)
+	logInfo(ctx, "Updating Aviatrix Site2Cloud", map[string]interface{}{"gw_name": editSite2cloud.GwName, "conn_name": editSite2cloud.ConnName, "vpc_id": editSite2cloud.VpcID})
 
 	if d.HasChange("local_subnet_cidr") {
 		if d.Get("custom_mapped").(bool) && d.Get("local_subnet_cidr").(string) != "" {
-			return fmt.Errorf("'local_subnet_cidr' is not valid when 'custom_mapped' is enabled")
+			return attributeErrorf("local_subnet_cidr", "'local_subnet_cidr' is not valid when 'custom_mapped' is enabled")
 		}
 		editSite2cloud.CloudSubnetCidr = d.Get("local_subnet_cidr").(string)
 		editSite2cloud.CloudSubnetVirtual = d.Get("local_subnet_virtual").(string)
 		editSite2cloud.NetworkType = "1"
 		err := client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud local_subnet_cidr: %s", err)
+			return diag.Errorf("failed to update Site2Cloud local_subnet_cidr: %s", err)
 		}
 	}
 
 	if d.HasChange("local_subnet_virtual") {
 		if d.Get("custom_mapped").(bool) && d.Get("local_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'local_subnet_virtual' is not valid when 'custom_mapped' is enabled")
+			return attributeErrorf("local_subnet_virtual", "'local_subnet_virtual' is not valid when 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "mapped" && d.Get("local_subnet_virtual").(string) == "" {
-			return fmt.Errorf("'local_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
+			return attributeErrorf("local_subnet_virtual", "'local_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "unmapped" && d.Get("local_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'local_subnet_virtual' should be empty for connection type: ummapped")
+			return attributeErrorf("local_subnet_virtual", "'local_subnet_virtual' should be empty for connection type: ummapped")
 		}
 		editSite2cloud.CloudSubnetCidr = d.Get("local_subnet_cidr").(string)
 		editSite2cloud.CloudSubnetVirtual = d.Get("local_subnet_virtual").(string)
 		editSite2cloud.NetworkType = "1"
 		err := client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud local_subnet_virtual: %s", err)
+			return diag.Errorf("failed to update Site2Cloud local_subnet_virtual: %s", err)
 		}
 	}
 
 	if d.HasChange("remote_subnet_cidr") {
 		if d.Get("custom_mapped").(bool) && d.Get("remote_subnet_cidr").(string) != "" {
-			return fmt.Errorf("'remote_subnet_cidr' is not valid when 'custom_mapped' is enabled")
+			return attributeErrorf("remote_subnet_cidr", "'remote_subnet_cidr' is not valid when 'custom_mapped' is enabled")
 		}
 		editSite2cloud.CloudSubnetCidr = d.Get("remote_subnet_cidr").(string)
 		editSite2cloud.CloudSubnetVirtual = d.Get("remote_subnet_virtual").(string)
 		editSite2cloud.NetworkType = "2"
 		err := client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud remote_subnet_cidr: %s", err)
+			return diag.Errorf("failed to update Site2Cloud remote_subnet_cidr: %s", err)
 		}
 	}
 
 	if d.HasChange("remote_subnet_virtual") {
 		if d.Get("custom_mapped").(bool) && d.Get("remote_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'remote_subnet_virtual' is not valid when 'custom_mapped' is enabled")
+			return attributeErrorf("remote_subnet_virtual", "'remote_subnet_virtual' is not valid when 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "mapped" && d.Get("remote_subnet_virtual").(string) == "" {
-			return fmt.Errorf("'remote_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
+			return attributeErrorf("remote_subnet_virtual", "'remote_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "unmapped" && d.Get("remote_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'remote_subnet_virtual' should be empty for connection type: ummapped")
+			return attributeErrorf("remote_subnet_virtual", "'remote_subnet_virtual' should be empty for connection type: ummapped")
 		}
 		editSite2cloud.CloudSubnetCidr = d.Get("remote_subnet_cidr").(string)
 		editSite2cloud.CloudSubnetVirtual = d.Get("remote_subnet_virtual").(string)
 		editSite2cloud.NetworkType = "2"
 		err := client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud remote_subnet_virtual: %s", err)
+			return diag.Errorf("failed to update Site2Cloud remote_subnet_virtual: %s", err)
 		}
 	}
 
@@ -1118,12 +1119,12 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		if enableDeadPeerDetection {
 			err := client.EnableDeadPeerDetection(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to enable deed peer detection: %s", err)
+				return diag.Errorf("failed to enable deed peer detection: %s", err)
 			}
 		} else {
 			err := client.DisableDeadPeerDetection(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable deed peer detection: %s", err)
+				return diag.Errorf("failed to disable deed peer detection: %s", err)
 			}
 		}
 	}
@@ -1136,16 +1137,16 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		activeActive := d.Get("enable_active_active").(bool)
 		if activeActive {
 			if haEnabled := d.Get("ha_enabled").(bool); !haEnabled {
-				return fmt.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
+				return diag.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
 			}
 			err := client.EnableSite2cloudActiveActive(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		} else {
 			err := client.DisableSite2cloudActiveActive(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		}
 	}
@@ -1159,12 +1160,12 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		if forwardToTransit {
 			err := client.EnableSpokeMappedSite2CloudForwarding(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to enable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to enable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		} else {
 			err := client.DisableSpokeMappedSite2CloudForwarding(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to disable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		}
 	}
@@ -1173,19 +1174,19 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		if d.Get("enable_event_triggered_ha").(bool) {
 			err := client.EnableSite2CloudEventTriggeredHA(editSite2cloud.VpcID, editSite2cloud.ConnName)
 			if err != nil {
-				return fmt.Errorf("could not enable event triggered HA for site2cloud during update: %v", err)
+				return diag.Errorf("could not enable event triggered HA for site2cloud during update: %v", err)
 			}
 		} else {
 			err := client.DisableSite2CloudEventTriggeredHA(editSite2cloud.VpcID, editSite2cloud.ConnName)
 			if err != nil {
-				return fmt.Errorf("could not disable event triggered HA for site2cloud during update: %v", err)
+				return diag.Errorf("could not disable event triggered HA for site2cloud during update: %v", err)
 			}
 		}
 	}
 
 	if d.HasChanges(customMappedAttributeNames...) {
 		if !d.Get("custom_mapped").(bool) {
-			return fmt.Errorf("attributes %v are not valid when 'custom_mapped' is disabled", customMappedAttributeNames)
+			return diag.Errorf("attributes %v are not valid when 'custom_mapped' is disabled", customMappedAttributeNames)
 		}
 		s2c := &goaviatrix.EditSite2Cloud{
 			GwName:                        d.Get("primary_cloud_gateway_name").(string),
@@ -1204,11 +1205,11 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		hasSetAllCustomRemoteCIDRs := s2c.RemoteSourceRealCIDRs != "" && s2c.RemoteSourceVirtualCIDRs != "" && s2c.RemoteDestinationRealCIDRs != "" && s2c.RemoteDestinationVirtualCIDRs != ""
 		hasSetAllCustomLocalCIDRs := s2c.LocalSourceRealCIDRs != "" && s2c.LocalSourceVirtualCIDRs != "" && s2c.LocalDestinationRealCIDRs != "" && s2c.LocalDestinationVirtualCIDRs != ""
 		if !hasSetAllCustomLocalCIDRs && !hasSetAllCustomRemoteCIDRs {
-			return fmt.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initated CIDRs be provided")
+			return attributeErrorf("custom_mapped", "'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initated CIDRs be provided")
 		}
 		err := client.UpdateSite2Cloud(s2c)
 		if err != nil {
-			return fmt.Errorf("could not update site2cloud connection Remote or Local CIDRs: %v", err)
+			return diag.Errorf("could not update site2cloud connection Remote or Local CIDRs: %v", err)
 		}
 	}
 
@@ -1222,10 +1223,10 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 
 		if haEnabled && !singleIpHA && len(phase1RemoteIdentifier) != 2 {
 			if !(ip != "" && haIp != "" && ip == haIp && len(phase1RemoteIdentifier) == 1) {
-				return fmt.Errorf("please set two phase 1 remote IDs, when HA is enabled and single IP HA is disabled")
+				return diag.Errorf("please set two phase 1 remote IDs, when HA is enabled and single IP HA is disabled")
 			}
 		} else if (!haEnabled || singleIpHA) && len(phase1RemoteIdentifier) != 1 {
-			return fmt.Errorf("please set one phase 1 remote ID, when HA is disabled or single IP HA is enabled")
+			return diag.Errorf("please set one phase 1 remote ID, when HA is disabled or single IP HA is enabled")
 		}
 
 		var ph1RemoteId string
@@ -1253,7 +1254,7 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		editSite2cloud.Phase1RemoteIdentifier = ph1RemoteId
 		err := client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1265,14 +1266,14 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 
 		if authType == "Cert" {
 			if remoteIdentifier == "" {
-				return fmt.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
+				return attributeErrorf("ca_cert_tag_name", "'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
 			}
 			if haEnabled && backupRemoteIdentifier == "" {
-				return fmt.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
+				return attributeErrorf("backup_remote_identifier", "'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
 			}
 		} else {
 			if remoteIdentifier != "" || backupRemoteIdentifier != "" {
-				return fmt.Errorf("'remote_identifier' and 'backup_remote_identifier' are both required to be empty for PSK(Pubkey) based authentication type")
+				return attributeErrorf("remote_identifier", "'remote_identifier' and 'backup_remote_identifier' are both required to be empty for PSK(Pubkey) based authentication type")
 			}
 		}
 		if d.HasChanges("remote_identifier") {
@@ -1284,7 +1285,7 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 
 			err := client.UpdateSite2Cloud(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to update remote identifier: %s", err)
+				return diag.Errorf("failed to update remote identifier: %s", err)
 			}
 		}
 		if d.HasChanges("backup_remote_identifier") {
@@ -1296,7 +1297,7 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 
 			err := client.UpdateSite2Cloud(s2c)
 			if err != nil {
-				return fmt.Errorf("failed to update backup remote identifier: %s", err)
+				return diag.Errorf("failed to update backup remote identifier: %s", err)
 			}
 		}
 	}
@@ -1309,37 +1310,38 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		}
 		err := client.EditSite2CloudPhase1LocalIdentifier(s2c)
 		if err != nil {
-			return fmt.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
+			return diag.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(editSite2cloud.ConnName + "~" + editSite2cloud.VpcID)
-	return resourceAviatrixSite2CloudRead(d, meta)
+	return resourceAviatrixSite2CloudRead(ctx, d, meta)
 }
 
-func resourceAviatrixSite2CloudDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	s2c := &goaviatrix.Site2Cloud{
 		VpcID:      d.Get("vpc_id").(string),
 		TunnelName: d.Get("connection_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix s2c: %#v", s2c)
+	logInfo(ctx, "Deleting Aviatrix s2c", map[string]interface{}{"gw_name": s2c.GwName, "tunnel_name": s2c.TunnelName, "vpc_id": s2c.VpcID})
 
+	var diags diag.Diagnostics
 	forwardToTransit := d.Get("forward_traffic_to_transit").(bool)
 	if forwardToTransit {
 		err := client.DisableSpokeMappedSite2CloudForwarding(s2c)
 		if err != nil {
-			log.Println("[WARN] Failed to disable forwarding to transit:", err)
+			diags = append(diags, attributeWarning("forward_traffic_to_transit", "could not disable forwarding to transit", err))
 		}
 	}
 
 	err := client.DeleteSite2Cloud(s2c)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Site2Cloud: %s", err)
+		return append(diags, diag.Errorf("failed to delete Aviatrix Site2Cloud: %s", err)...)
 	}
 
-	return nil
+	return diags
 }
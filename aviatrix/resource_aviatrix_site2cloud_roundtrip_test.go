@@ -0,0 +1,132 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func TestResourceAviatrixSite2CloudRoundTrip(t *testing.T) {
+	randomIP := func(r *rand.Rand) interface{} {
+		return fmt.Sprintf("198.51.100.%d", 1+r.Intn(254))
+	}
+	algorithms := []string{"phase_1_authentication", "phase_1_dh_groups", "phase_1_encryption",
+		"phase_2_authentication", "phase_2_dh_groups", "phase_2_encryption"}
+	values := map[string]func(r *rand.Rand) interface{}{
+		"remote_gateway_type":      oneOf("generic", "aws", "azure", "avx", "sonicwall", "oracle"),
+		"connection_type":          oneOf("unmapped"),
+		"tunnel_type":              oneOf("policy", "route"),
+		"remote_gateway_ip":        randomIP,
+		"local_subnet_cidr":        randomCIDRList(24),
+		"backup_gateway_name":      oneOf("rt-gw-backup"),
+		"backup_remote_gateway_ip": randomIP,
+		"phase_1_authentication":   oneOf("SHA-256", "SHA-384", "SHA-512"),
+		"phase_1_dh_groups":        oneOf("14", "15", "16"),
+		"phase_1_encryption":       oneOf("AES-256-CBC", "AES-192-CBC"),
+		"phase_2_authentication":   oneOf("HMAC-SHA-256", "HMAC-SHA-384", "HMAC-SHA-512"),
+		"phase_2_dh_groups":        oneOf("14", "15", "16"),
+		"phase_2_encryption":       oneOf("AES-256-CBC", "AES-192-CBC"),
+		"phase1_local_identifier":  oneOf("public_ip", "private_ip"),
+		"local_tunnel_ip":          oneOf("169.254.10.1/30"),
+		"remote_tunnel_ip":         oneOf("169.254.10.2/30"),
+		"backup_local_tunnel_ip":   oneOf("169.254.20.1/30"),
+		"backup_remote_tunnel_ip":  oneOf("169.254.20.2/30"),
+	}
+	testRoundTrip(t, roundTripCase{
+		resource: "aviatrix_site2cloud",
+		config: map[string]interface{}{
+			"vpc_id":                     "vpc-0123456789",
+			"primary_cloud_gateway_name": "rt-gw",
+			"remote_subnet_cidr":         "10.3.0.0/16",
+		},
+		exclude: []string{
+			// the attributes of mapped connections, connection_type is always unmapped
+			"custom_mapped",
+			"local_subnet_virtual",
+			"remote_subnet_virtual",
+			"local_source_real_cidrs",
+			"local_source_virtual_cidrs",
+			"local_destination_real_cidrs",
+			"local_destination_virtual_cidrs",
+			"remote_source_real_cidrs",
+			"remote_source_virtual_cidrs",
+			"remote_destination_real_cidrs",
+			"remote_destination_virtual_cidrs",
+			// the fake controller does not implement certificate based authentication
+			"auth_type",
+			"ca_cert_tag_name",
+			"remote_identifier",
+			"backup_remote_identifier",
+			// the fake controller does not implement private route encryption
+			"private_route_encryption",
+			"route_table_list",
+			"remote_gateway_latitude",
+			"remote_gateway_longitude",
+			"backup_remote_gateway_latitude",
+			"backup_remote_gateway_longitude",
+		},
+		values: values,
+		fix: func(r *rand.Rand, config map[string]interface{}) {
+			if config["ha_enabled"] == true {
+				config["backup_gateway_name"] = "rt-gw-backup"
+				if config["enable_single_ip_ha"] == true {
+					delete(config, "backup_remote_gateway_ip")
+				} else if _, ok := config["backup_remote_gateway_ip"]; !ok {
+					config["backup_remote_gateway_ip"] = randomIP(r)
+				}
+			} else {
+				for _, k := range []string{"backup_gateway_name", "backup_remote_gateway_ip", "enable_single_ip_ha",
+					"enable_active_active", "enable_event_triggered_ha"} {
+					delete(config, k)
+				}
+			}
+			// The tunnel IPs are only valid for route based connections, and the backup tunnel IPs and
+			// pre-shared key for the connections with two remote gateways
+			for _, k := range []string{"local_tunnel_ip", "remote_tunnel_ip", "backup_local_tunnel_ip", "backup_remote_tunnel_ip"} {
+				if config["tunnel_type"] != "route" {
+					delete(config, k)
+				}
+			}
+			if config["ha_enabled"] != true || config["enable_single_ip_ha"] == true {
+				delete(config, "backup_local_tunnel_ip")
+				delete(config, "backup_remote_tunnel_ip")
+				delete(config, "backup_pre_shared_key")
+			}
+			// The phase 1 remote identifiers are always read back, one for each remote gateway
+			// of the connection
+			remoteIdentifiers := []interface{}{randomIP(r)}
+			if config["ha_enabled"] == true && config["enable_single_ip_ha"] != true {
+				remoteIdentifiers = append(remoteIdentifiers, randomIP(r))
+			}
+			config["phase1_remote_identifier"] = remoteIdentifiers
+			// The algorithms must be all set with custom_algorithms, and none without
+			for _, k := range algorithms {
+				if config["custom_algorithms"] != true {
+					delete(config, k)
+				} else if _, ok := config[k]; !ok {
+					config[k] = values[k](r)
+				}
+			}
+		},
+		setup: func(t *testing.T, client *goaviatrix.Client) {
+			createRoundTripAccount(t, client)
+			for _, name := range []string{"rt-gw", "rt-gw-backup"} {
+				err := client.CreateGateway(context.Background(), &goaviatrix.Gateway{
+					CloudType:   goaviatrix.AWS,
+					AccountName: roundTripAccount,
+					GwName:      name,
+					VpcID:       "vpc-0123456789",
+					VpcRegion:   "us-east-1",
+					VpcSize:     "t3.small",
+					VpcNet:      "10.2.0.0/24",
+				})
+				if err != nil {
+					t.Fatalf("could not create the gateway %s: %v", name, err)
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+func TestResourceAviatrixSite2CloudDeleteForwardingWarning(t *testing.T) {
+	server := fakecontroller.New()
+	defer server.Close()
+	client, err := server.NewClient()
+	if err != nil {
+		t.Fatalf("could not login to the fake controller: %v", err)
+	}
+	server.HandleAction("disable_spoke_mapped_site2cloud_forwarding", func(r *fakecontroller.Request) (interface{}, error) {
+		return nil, fakecontroller.Errorf("forwarding is not enabled")
+	})
+	deleted := false
+	server.HandleAction("delete_site2cloud_connection", func(r *fakecontroller.Request) (interface{}, error) {
+		deleted = true
+		return "deleted", nil
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSite2Cloud().Schema, map[string]interface{}{
+		"vpc_id":                     "vpc-0123456789",
+		"connection_name":            "s2c",
+		"forward_traffic_to_transit": true,
+	})
+	diags := resourceAviatrixSite2CloudDelete(context.Background(), d, client)
+	if diags.HasError() {
+		t.Fatalf("delete error = %s", diagnosticsString(diags))
+	}
+	if !deleted {
+		t.Error("site2cloud connection was not deleted")
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !diags[0].AttributePath.Equals(cty.GetAttrPath("forward_traffic_to_transit")) {
+		t.Errorf("delete diagnostics = %v, want a forward_traffic_to_transit warning", diags)
+	}
+}
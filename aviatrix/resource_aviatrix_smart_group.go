@@ -167,7 +167,7 @@ func marshalSmartGroupInput(d *schema.ResourceData) (*goaviatrix.SmartGroup, err
 }
 
 func resourceAviatrixSmartGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	smartGroup, err := marshalSmartGroupInput(d)
 	if err != nil {
@@ -194,7 +194,7 @@ func resourceAviatrixSmartGroupReadIfRequired(ctx context.Context, d *schema.Res
 }
 
 func resourceAviatrixSmartGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -243,7 +243,7 @@ func resourceAviatrixSmartGroupRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixSmartGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -264,7 +264,7 @@ func resourceAviatrixSmartGroupUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixSmartGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	uuid := d.Id()
 	err := client.DeleteSmartGroup(ctx, uuid)
@@ -0,0 +1,90 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixSmartGroupRead_SetsSelector(t *testing.T) {
+	client := &goaviatrix.SecurityClientMock{
+		GetSmartGroupFunc: func(ctx context.Context, uuid string) (*goaviatrix.SmartGroup, error) {
+			assert.Equal(t, "unit-test-uuid", uuid)
+			return &goaviatrix.SmartGroup{
+				Name: "unit_test_smart_group",
+				UUID: uuid,
+				Selector: goaviatrix.SmartGroupSelector{
+					Expressions: []*goaviatrix.SmartGroupMatchExpression{
+						{Type: "vm", AccountName: "unit_test_account", Region: "us-east-1"},
+						{CIDR: "10.0.0.0/16"},
+					},
+				},
+			}, nil
+		},
+	}
+	defer func() { assert.Len(t, client.GetSmartGroupCalls(), 1) }()
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]interface{}{})
+	d.SetId("unit-test-uuid")
+	res := resourceAviatrixSmartGroupRead(context.Background(), d, client)
+
+	assert.Empty(t, res)
+	assert.Equal(t, "unit-test-uuid", d.Get("uuid"))
+	assert.Equal(t, "unit_test_smart_group", d.Get("name"))
+	assert.Equal(t, 2, d.Get("selector.0.match_expressions.#"))
+	assert.Equal(t, "vm", d.Get("selector.0.match_expressions.0.type"))
+	assert.Equal(t, "unit_test_account", d.Get("selector.0.match_expressions.0.account_name"))
+	assert.Equal(t, "us-east-1", d.Get("selector.0.match_expressions.0.region"))
+	assert.Equal(t, "10.0.0.0/16", d.Get("selector.0.match_expressions.1.cidr"))
+}
+
+func TestResourceAviatrixSmartGroupRead_WhenNotFound(t *testing.T) {
+	client := &goaviatrix.SecurityClientMock{
+		GetSmartGroupFunc: func(ctx context.Context, uuid string) (*goaviatrix.SmartGroup, error) {
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]interface{}{})
+	d.SetId("unit-test-uuid")
+	res := resourceAviatrixSmartGroupRead(context.Background(), d, client)
+
+	assert.Empty(t, res)
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceAviatrixSmartGroupRead_WhenGetSmartGroupFails(t *testing.T) {
+	client := &goaviatrix.SecurityClientMock{
+		GetSmartGroupFunc: func(ctx context.Context, uuid string) (*goaviatrix.SmartGroup, error) {
+			return nil, errors.New("controller API failure")
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]interface{}{})
+	d.SetId("unit-test-uuid")
+	res := resourceAviatrixSmartGroupRead(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("failed to read Smart Group: controller API failure"), res)
+	assert.Equal(t, "unit-test-uuid", d.Id())
+}
+
+func TestResourceAviatrixSmartGroupDelete_WhenDeleteSmartGroupFails(t *testing.T) {
+	client := &goaviatrix.SecurityClientMock{
+		DeleteSmartGroupFunc: func(ctx context.Context, uuid string) error {
+			assert.Equal(t, "unit-test-uuid", uuid)
+			return errors.New("controller API failure")
+		},
+	}
+	defer func() { assert.Len(t, client.DeleteSmartGroupCalls(), 1) }()
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]interface{}{})
+	d.SetId("unit-test-uuid")
+	res := resourceAviatrixSmartGroupDelete(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("failed to delete Smart Group: controller API failure"), res)
+}
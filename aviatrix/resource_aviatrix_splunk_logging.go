@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSplunkLogging() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSplunkLoggingCreate,
-		Read:   resourceAviatrixSplunkLoggingRead,
-		Delete: resourceAviatrixSplunkLoggingDelete,
+		CreateContext: resourceAviatrixSplunkLoggingCreate,
+		ReadContext:   resourceAviatrixSplunkLoggingRead,
+		DeleteContext: resourceAviatrixSplunkLoggingDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,22 +68,22 @@ func resourceAviatrixSplunkLogging() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSplunkLoggingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSplunkLoggingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	_, err := client.GetSplunkLoggingStatus()
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the splunk_logging is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the splunk_logging is already enabled, please import to manage with Terraform")
 	} else {
-		return fmt.Errorf("the support for splunk logging is deprecated")
+		return diag.Errorf("the support for splunk logging is deprecated")
 	}
 }
 
-func resourceAviatrixSplunkLoggingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSplunkLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if d.Id() != "splunk_logging" {
-		return fmt.Errorf("invalid ID, expected ID \"splunk_logging\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"splunk_logging\", instead got %s", d.Id())
 	}
 
 	splunkLoggingStatus, err := client.GetSplunkLoggingStatus()
@@ -91,7 +92,7 @@ func resourceAviatrixSplunkLoggingRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get splunk logging status: %v", err)
+		return diag.Errorf("could not get splunk logging status: %v", err)
 	}
 
 	d.Set("server", splunkLoggingStatus.Server)
@@ -107,11 +108,11 @@ func resourceAviatrixSplunkLoggingRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixSplunkLoggingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSplunkLoggingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if err := client.DisableSplunkLogging(); err != nil {
-		return fmt.Errorf("could not disable splunk logging: %v", err)
+		return diag.Errorf("could not disable splunk logging: %v", err)
 	}
 
 	return nil
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeExternalDeviceConnCreate,
-		Read:   resourceAviatrixSpokeExternalDeviceConnRead,
-		Update: resourceAviatrixSpokeExternalDeviceConnUpdate,
-		Delete: resourceAviatrixSpokeExternalDeviceConnDelete,
+		CreateContext: resourceAviatrixSpokeExternalDeviceConnCreate,
+		ReadContext:   resourceAviatrixSpokeExternalDeviceConnRead,
+		UpdateContext: resourceAviatrixSpokeExternalDeviceConnUpdate,
+		DeleteContext: resourceAviatrixSpokeExternalDeviceConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -386,8 +387,8 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 		VpcID:                  d.Get("vpc_id").(string),
@@ -430,21 +431,21 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 		externalDeviceConn.LocalTunnelCidr != "" ||
 		externalDeviceConn.BackupRemoteGatewayIP != "" ||
 		externalDeviceConn.BackupLocalTunnelCidr != "") && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_gateway_ip', 'local_tunnel_cidr', 'backup_remote_gateway_ip' and 'backup_local_tunnel_cidr' " +
+		return attributeErrorf("remote_gateway_ip", "'remote_gateway_ip', 'local_tunnel_cidr', 'backup_remote_gateway_ip' and 'backup_local_tunnel_cidr' "+
 			"cannot be set with 'tunnel_protocol' = 'LAN'. Please use the appropriate LAN attributes instead")
 	}
 	if (externalDeviceConn.RemoteLanIP != "" ||
 		externalDeviceConn.LocalLanIP != "" ||
 		externalDeviceConn.BackupRemoteLanIP != "" ||
 		externalDeviceConn.BackupLocalLanIP != "") && externalDeviceConn.TunnelProtocol != "LAN" {
-		return fmt.Errorf("'remote_lan_ip', 'local_lan_ip', 'backup_remote_lan_ip' and 'backup_local_lan_ip' " +
+		return attributeErrorf("remote_lan_ip", "'remote_lan_ip', 'local_lan_ip', 'backup_remote_lan_ip' and 'backup_local_lan_ip' "+
 			"can only be set with 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.RemoteLanIP == "" && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_lan_ip' is required when 'tunnel_protocol' = 'LAN'")
+		return attributeErrorf("remote_lan_ip", "'remote_lan_ip' is required when 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.RemoteGatewayIP == "" && externalDeviceConn.TunnelProtocol != "LAN" {
-		return fmt.Errorf("'remote_gateway_ip' is required when 'tunnel_protocol' != 'LAN'")
+		return attributeErrorf("remote_gateway_ip", "'remote_gateway_ip' is required when 'tunnel_protocol' != 'LAN'")
 	}
 
 	bgpLocalAsNum, err := strconv.Atoi(d.Get("bgp_local_as_num").(string))
@@ -476,9 +477,9 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 	}
 
 	if externalDeviceConn.ConnectionType == "bgp" && externalDeviceConn.RemoteSubnet != "" {
-		return fmt.Errorf("'remote_subnet' is needed for connection type of 'static' not 'bgp'")
+		return attributeErrorf("remote_subnet", "'remote_subnet' is needed for connection type of 'static' not 'bgp'")
 	} else if externalDeviceConn.ConnectionType == "static" && (externalDeviceConn.BgpLocalAsNum != 0 || externalDeviceConn.BgpRemoteAsNum != 0) {
-		return fmt.Errorf("'bgp_local_as_num' and 'bgp_remote_as_num' are needed for connection type of 'bgp' not 'static'")
+		return attributeErrorf("bgp_local_as_num", "'bgp_local_as_num' and 'bgp_remote_as_num' are needed for connection type of 'bgp' not 'static'")
 	}
 
 	customAlgorithms := d.Get("custom_algorithms").(bool)
@@ -489,47 +490,47 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 			externalDeviceConn.Phase2DhGroups == "" ||
 			externalDeviceConn.Phase1Encryption == "" ||
 			externalDeviceConn.Phase2Encryption == "" {
-			return fmt.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is enabled, please set all of the algorithm parameters")
 		} else if externalDeviceConn.Phase1Auth == goaviatrix.Phase1AuthDefault &&
 			externalDeviceConn.Phase2Auth == goaviatrix.Phase2AuthDefault &&
 			externalDeviceConn.Phase1DhGroups == goaviatrix.Phase1DhGroupDefault &&
 			externalDeviceConn.Phase2DhGroups == goaviatrix.Phase2DhGroupDefault &&
 			externalDeviceConn.Phase1Encryption == goaviatrix.Phase1EncryptionDefault &&
 			externalDeviceConn.Phase2Encryption == goaviatrix.Phase2EncryptionDefault {
-			return fmt.Errorf("custom_algorithms is enabled, cannot use default values for " +
+			return attributeErrorf("custom_algorithms", "custom_algorithms is enabled, cannot use default values for "+
 				"all six algorithm parameters. Please change the value of at least one of the six algorithm parameters")
 		}
 	} else {
 		if externalDeviceConn.Phase1Auth != "" || externalDeviceConn.Phase1DhGroups != "" ||
 			externalDeviceConn.Phase1Encryption != "" || externalDeviceConn.Phase2Auth != "" ||
 			externalDeviceConn.Phase2DhGroups != "" || externalDeviceConn.Phase2Encryption != "" {
-			return fmt.Errorf("custom_algorithms is not enabled, all algorithm fields should be left empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is not enabled, all algorithm fields should be left empty")
 		}
 	}
 
 	if haEnabled {
 		if externalDeviceConn.TunnelProtocol == "LAN" {
 			if externalDeviceConn.BackupRemoteLanIP == "" {
-				return fmt.Errorf("ha is enabled and 'tunnel_protocol' = 'LAN', please specify 'backup_remote_lan_ip'")
+				return diag.Errorf("ha is enabled and 'tunnel_protocol' = 'LAN', please specify 'backup_remote_lan_ip'")
 			}
 		} else {
 			if externalDeviceConn.BackupRemoteGatewayIP == "" {
-				return fmt.Errorf("ha is enabled, please specify 'backup_remote_gateway_ip'")
+				return diag.Errorf("ha is enabled, please specify 'backup_remote_gateway_ip'")
 			}
 			remoteIP := strings.Split(externalDeviceConn.RemoteGatewayIP, ",")
 			if len(remoteIP) > 1 {
-				return fmt.Errorf("expected 'remote_gateway_ip' to contain only one valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
+				return diag.Errorf("expected 'remote_gateway_ip' to contain only one valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
 			}
 			ip := net.ParseIP(externalDeviceConn.RemoteGatewayIP)
 			if four := ip.To4(); four == nil {
-				return fmt.Errorf("expected 'remote_gateway_ip' to contain a valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
+				return diag.Errorf("expected 'remote_gateway_ip' to contain a valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
 			}
 			if externalDeviceConn.BackupRemoteGatewayIP == externalDeviceConn.RemoteGatewayIP {
-				return fmt.Errorf("expected 'backup_remote_gateway_ip' to contain a different valid IPv4 address than 'remote_gateway_ip'")
+				return diag.Errorf("expected 'backup_remote_gateway_ip' to contain a different valid IPv4 address than 'remote_gateway_ip'")
 			}
 		}
 		if externalDeviceConn.BackupBgpRemoteAsNum == 0 && externalDeviceConn.ConnectionType == "bgp" {
-			return fmt.Errorf("ha is enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num'")
+			return diag.Errorf("ha is enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num'")
 		}
 		if externalDeviceConn.BackupRemoteGatewayIP != "" {
 			externalDeviceConn.RemoteGatewayIP = externalDeviceConn.RemoteGatewayIP + "," + externalDeviceConn.BackupRemoteGatewayIP
@@ -537,31 +538,31 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 		}
 	} else {
 		if backupDirectConnect {
-			return fmt.Errorf("ha is not enabled, please set 'backup_direct_connect' to false")
+			return diag.Errorf("ha is not enabled, please set 'backup_direct_connect' to false")
 		}
 		if externalDeviceConn.BackupPreSharedKey != "" || externalDeviceConn.BackupLocalTunnelCidr != "" ||
 			externalDeviceConn.BackupRemoteTunnelCidr != "" || externalDeviceConn.BackupRemoteGatewayIP != "" ||
 			externalDeviceConn.BackupRemoteLanIP != "" || externalDeviceConn.BackupLocalLanIP != "" {
-			return fmt.Errorf("ha is not enabled, please set 'backup_pre_shared_key', 'backup_local_tunnel_cidr', " +
+			return diag.Errorf("ha is not enabled, please set 'backup_pre_shared_key', 'backup_local_tunnel_cidr', " +
 				"'backup_remote_gateway_ip', 'backup_remote_tunnel_cidr', 'backup_remote_lan_ip' and 'backup_local_lan_ip' to empty")
 		}
 		if externalDeviceConn.BackupBgpRemoteAsNum != 0 && externalDeviceConn.ConnectionType == "bgp" {
-			return fmt.Errorf("ha is not enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num' to empty")
+			return diag.Errorf("ha is not enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num' to empty")
 		}
 	}
 
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if externalDeviceConn.ConnectionType != "bgp" && enableLearnedCIDRApproval {
-		return fmt.Errorf("'connection_type' must be 'bgp' if 'enable_learned_cidrs_approval' is set to true")
+		return attributeErrorf("connection_type", "'connection_type' must be 'bgp' if 'enable_learned_cidrs_approval' is set to true")
 	}
 	manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 	if externalDeviceConn.ConnectionType != "bgp" && len(manualBGPCidrs) != 0 {
-		return fmt.Errorf("'connection_type' must be 'bgp' if 'manual_bgp_advertised_cidrs' is not empty")
+		return attributeErrorf("connection_type", "'connection_type' must be 'bgp' if 'manual_bgp_advertised_cidrs' is not empty")
 	}
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
-		return fmt.Errorf("creating spoke external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("creating spoke external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	enableIkev2 := d.Get("enable_ikev2").(bool)
@@ -570,57 +571,57 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 	}
 
 	if externalDeviceConn.ConnectionType != "bgp" && externalDeviceConn.TunnelProtocol != "IPsec" {
-		return fmt.Errorf("'tunnel_protocol' can not be set unless 'connection_type' is 'bgp'")
+		return attributeErrorf("tunnel_protocol", "'tunnel_protocol' can not be set unless 'connection_type' is 'bgp'")
 	}
 	greOrLan := externalDeviceConn.TunnelProtocol == "GRE" || externalDeviceConn.TunnelProtocol == "LAN"
 	if greOrLan && customAlgorithms {
-		return fmt.Errorf("custom algorithm paramters are not valid with 'tunnel_protocol' = GRE or LAN")
+		return diag.Errorf("custom algorithm paramters are not valid with 'tunnel_protocol' = GRE or LAN")
 	}
 	if greOrLan && enableIkev2 {
-		return fmt.Errorf("enable_ikev2 is not supported with 'tunnel_protocol' = GRE or LAN")
+		return attributeErrorf("enable_ikev2", "enable_ikev2 is not supported with 'tunnel_protocol' = GRE or LAN")
 	}
 	if greOrLan && externalDeviceConn.PreSharedKey != "" {
-		return fmt.Errorf("'pre_shared_key' is not valid with 'tunnel_protocol' = GRE or LAN")
+		return attributeErrorf("pre_shared_key", "'pre_shared_key' is not valid with 'tunnel_protocol' = GRE or LAN")
 	}
 	if externalDeviceConn.PeerVnetId != "" && (externalDeviceConn.ConnectionType != "bgp" || externalDeviceConn.TunnelProtocol != "LAN") {
-		return fmt.Errorf("'remote_vpc_name' is only valid for 'connection_type' = 'bgp' and 'tunnel_protocol' = 'LAN'")
+		return attributeErrorf("remote_vpc_name", "'remote_vpc_name' is only valid for 'connection_type' = 'bgp' and 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.TunnelProtocol == "LAN" {
 		if externalDeviceConn.DirectConnect == "true" || externalDeviceConn.BackupDirectConnect == "true" {
-			return fmt.Errorf("enabling 'direct_connect' or 'backup_direct_connect' is not allowed for BGP over LAN connections")
+			return diag.Errorf("enabling 'direct_connect' or 'backup_direct_connect' is not allowed for BGP over LAN connections")
 		}
 	}
 
 	phase1RemoteIdentifier := d.Get("phase1_remote_identifier").([]interface{})
 	ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 	if haEnabled && len(phase1RemoteIdentifier) != 0 && len(phase1RemoteIdentifier) != 2 {
-		return fmt.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled")
+		return diag.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled")
 	} else if !haEnabled && len(phase1RemoteIdentifier) > 1 {
-		return fmt.Errorf("please either set one phase 1 remote ID or none, when HA is disabled")
+		return diag.Errorf("please either set one phase 1 remote ID or none, when HA is disabled")
 	}
 
 	if _, ok := d.GetOk("prepend_as_path"); ok {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("'prepend_as_path' only supports 'bgp' connection. Please update 'connection_type' to 'bgp'")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' only supports 'bgp' connection. Please update 'connection_type' to 'bgp'")
 		}
 	}
 
 	if d.Get("enable_bgp_lan_activemesh").(bool) {
 		if externalDeviceConn.ConnectionType != "bgp" || externalDeviceConn.TunnelProtocol != "LAN" {
-			return fmt.Errorf("'enable_bgp_lan_activemesh' only supports 'bgp' connection with 'LAN' tunnel protocol")
+			return attributeErrorf("enable_bgp_lan_activemesh", "'enable_bgp_lan_activemesh' only supports 'bgp' connection with 'LAN' tunnel protocol")
 		}
 		if externalDeviceConn.HAEnabled != "true" {
-			return fmt.Errorf("'enable_bgp_lan_activemesh' can only be enabled with Remote Gateway HA enabled")
+			return attributeErrorf("enable_bgp_lan_activemesh", "'enable_bgp_lan_activemesh' can only be enabled with Remote Gateway HA enabled")
 		}
 		externalDeviceConn.EnableBgpLanActiveMesh = true
 	}
 
 	if externalDeviceConn.BgpMd5Key != "" || externalDeviceConn.BackupBgpMd5Key != "" {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("BGP MD5 authentication key is only supported for BGP connection")
+			return diag.Errorf("BGP MD5 authentication key is only supported for BGP connection")
 		}
 		if externalDeviceConn.BackupBgpMd5Key != "" && !haEnabled {
-			return fmt.Errorf("couldn't configure backup BGP MD5 authentication key since HA is not enabled for BGP connection: %s", externalDeviceConn.ConnectionName)
+			return diag.Errorf("couldn't configure backup BGP MD5 authentication key since HA is not enabled for BGP connection: %s", externalDeviceConn.ConnectionName)
 		}
 
 		if externalDeviceConn.BgpMd5Key != "" {
@@ -632,7 +633,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 				bgpRemoteIp = strings.Split(externalDeviceConn.RemoteTunnelCidr, ",")
 			}
 			if len(md5KeyList) != len(bgpRemoteIp) {
-				return fmt.Errorf("can't apply BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
+				return diag.Errorf("can't apply BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
 			}
 		}
 
@@ -645,7 +646,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 				backupBgpRemoteIp = strings.Split(externalDeviceConn.BackupRemoteTunnelCidr, ",")
 			}
 			if len(backupMd5KeyList) != len(backupBgpRemoteIp) {
-				return fmt.Errorf("can't apply Backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
+				return diag.Errorf("can't apply Backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
 			}
 		}
 	}
@@ -653,7 +654,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 	enableJumboFrame := d.Get("enable_jumbo_frame").(bool)
 	if enableJumboFrame {
 		if externalDeviceConn.ConnectionType != "bgp" || strings.ToUpper(externalDeviceConn.TunnelProtocol) != "GRE" {
-			return fmt.Errorf("jumbo frame is only supported on GRE tunnels under bgp connection")
+			return diag.Errorf("jumbo frame is only supported on GRE tunnels under bgp connection")
 		}
 	}
 
@@ -663,27 +664,27 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 
 	d.SetId(externalDeviceConn.ConnectionName + "~" + externalDeviceConn.VpcID)
 	flag := false
-	defer resourceAviatrixSpokeExternalDeviceConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSpokeExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 
 	err = client.CreateExternalDeviceConn(externalDeviceConn)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix external device connection: %s", err)
+		return diag.Errorf("failed to create Aviatrix external device connection: %s", err)
 	}
 
 	if d.Get("enable_event_triggered_ha").(bool) {
 		if err := client.EnableSite2CloudEventTriggeredHA(externalDeviceConn.VpcID, externalDeviceConn.ConnectionName); err != nil {
-			return fmt.Errorf("could not enable event triggered HA for external device conn after create: %v", err)
+			return diag.Errorf("could not enable event triggered HA for external device conn after create: %v", err)
 		}
 	}
 
 	if enableJumboFrame {
 		if err := client.EnableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-			return fmt.Errorf("could not enable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
+			return diag.Errorf("could not enable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
 		}
 	} else {
 		if externalDeviceConn.ConnectionType == "bgp" && strings.ToUpper(externalDeviceConn.TunnelProtocol) == "GRE" {
 			if err := client.DisableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("could not disable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
+				return diag.Errorf("could not disable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
 			}
 		}
 	}
@@ -691,12 +692,12 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 	if enableLearnedCIDRApproval {
 		err = client.EnableSpokeConnectionLearnedCIDRApproval(externalDeviceConn.GwName, externalDeviceConn.ConnectionName)
 		if err != nil {
-			return fmt.Errorf("could not enable learned cidr approval: %v", err)
+			return diag.Errorf("could not enable learned cidr approval: %v", err)
 		}
 		if len(approvedCidrs) > 0 {
 			err = client.UpdateSpokeConnectionPendingApprovedCidrs(externalDeviceConn.GwName, externalDeviceConn.ConnectionName, approvedCidrs)
 			if err != nil {
-				return fmt.Errorf("could not update spoke external device conn approved cidrs after creation: %v", err)
+				return diag.Errorf("could not update spoke external device conn approved cidrs after creation: %v", err)
 			}
 		}
 	}
@@ -704,7 +705,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 	if len(manualBGPCidrs) > 0 {
 		err = client.EditSpokeConnectionBGPManualAdvertiseCIDRs(externalDeviceConn.GwName, externalDeviceConn.ConnectionName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertised BGP cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertised BGP cidrs: %v", err)
 		}
 	}
 
@@ -726,7 +727,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -752,7 +753,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -764,7 +765,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 
 		err = client.EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
@@ -777,33 +778,33 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 			s2c.Phase1LocalIdentifier = "private_ip"
 			err = client.EditSite2CloudPhase1LocalIdentifier(s2c)
 			if err != nil {
-				return fmt.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
+				return diag.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixSpokeExternalDeviceConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeExternalDeviceConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSpokeExternalDeviceConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeExternalDeviceConnRead(d, meta)
+		return resourceAviatrixSpokeExternalDeviceConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName := d.Get("connection_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 	if connectionName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no 'connection_name' or 'vpc_id' received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no 'connection_name' or 'vpc_id' received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
-			return fmt.Errorf("expected import ID in the form 'connection_name~vpc_id' instead got %q", id)
+			return diag.Errorf("expected import ID in the form 'connection_name~vpc_id' instead got %q", id)
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
@@ -816,14 +817,14 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta in
 	}
 
 	conn, err := client.GetExternalDeviceConnDetail(externalDeviceConn)
-	log.Printf("[TRACE] Reading Aviatrix external device conn: %s : %#v", d.Get("connection_name").(string), externalDeviceConn)
+	logTrace(ctx, "Reading Aviatrix external device conn", map[string]interface{}{"connection_name": d.Get("connection_name").(string), "gw_name": externalDeviceConn.GwName, "vpc_id": externalDeviceConn.VpcID})
 
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix external device conn: %s, %#v", err, externalDeviceConn)
+		return diag.Errorf("couldn't find Aviatrix external device conn: %s, %#v", err, externalDeviceConn)
 	}
 
 	if conn != nil {
@@ -898,7 +899,7 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta in
 
 		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: externalDeviceConn.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get advanced config for spoke gateway: %v", err)
+			return diag.Errorf("could not get advanced config for spoke gateway: %v", err)
 		}
 
 		for _, v := range spokeAdvancedConfig.ConnectionLearnedCIDRApprovalInfo {
@@ -906,7 +907,7 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta in
 				d.Set("enable_learned_cidrs_approval", v.EnabledApproval == "yes")
 				err := d.Set("approved_cidrs", v.ApprovedLearnedCidrs)
 				if err != nil {
-					return fmt.Errorf("could not set 'approved_cidrs' in state: %v", err)
+					return diag.Errorf("could not set 'approved_cidrs' in state: %v", err)
 				}
 				break
 			}
@@ -923,7 +924,7 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta in
 		}
 
 		if err := d.Set("manual_bgp_advertised_cidrs", conn.ManualBGPCidrs); err != nil {
-			return fmt.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
+			return diag.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
 		}
 		if conn.TunnelProtocol == "" {
 			d.Set("tunnel_protocol", "IPsec")
@@ -957,7 +958,7 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta in
 
 			err = d.Set("prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set value for prepend_as_path: %v", err)
+				return diag.Errorf("could not set value for prepend_as_path: %v", err)
 			}
 		}
 	}
@@ -966,14 +967,14 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
-		return fmt.Errorf("updating spoke external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("updating spoke external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	gwName := d.Get("gw_name").(string)
@@ -984,12 +985,12 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		if enableLearnedCIDRApproval {
 			err := client.EnableSpokeConnectionLearnedCIDRApproval(gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable learned cidr approval: %v", err)
+				return diag.Errorf("could not enable learned cidr approval: %v", err)
 			}
 		} else {
 			err := client.DisableSpokeConnectionLearnedCIDRApproval(gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable learned cidr approval: %v", err)
+				return diag.Errorf("could not disable learned cidr approval: %v", err)
 			}
 		}
 	}
@@ -998,7 +999,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 		err := client.EditSpokeConnectionBGPManualAdvertiseCIDRs(gwName, connName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertise manual cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertise manual cidrs: %v", err)
 		}
 	}
 
@@ -1007,12 +1008,12 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		if d.Get("enable_event_triggered_ha").(bool) {
 			err := client.EnableSite2CloudEventTriggeredHA(vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable event triggered HA for external device conn during update: %v", err)
+				return diag.Errorf("could not enable event triggered HA for external device conn during update: %v", err)
 			}
 		} else {
 			err := client.DisableSite2CloudEventTriggeredHA(vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable event triggered HA for external device conn during update: %v", err)
+				return diag.Errorf("could not disable event triggered HA for external device conn during update: %v", err)
 			}
 		}
 	}
@@ -1028,14 +1029,14 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		}
 		if externalDeviceConn.EnableJumboFrame {
 			if externalDeviceConn.ConnectionType != "bgp" || strings.ToUpper(externalDeviceConn.TunnelProtocol) != "GRE" {
-				return fmt.Errorf("jumbo frame is only supported on GRE tunnels under BGP connection")
+				return diag.Errorf("jumbo frame is only supported on GRE tunnels under BGP connection")
 			}
 			if err := client.EnableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("could not enable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
+				return diag.Errorf("could not enable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
 			}
 		} else {
 			if err := client.DisableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("could not disable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
+				return diag.Errorf("could not disable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
 			}
 		}
 	}
@@ -1043,7 +1044,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 	if d.HasChange("approved_cidrs") {
 		err := client.UpdateSpokeConnectionPendingApprovedCidrs(gwName, connName, approvedCidrs)
 		if err != nil {
-			return fmt.Errorf("could not update spoke external device conn learned cidrs during update: %v", err)
+			return diag.Errorf("could not update spoke external device conn learned cidrs during update: %v", err)
 		}
 	}
 
@@ -1058,9 +1059,9 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		phase1RemoteIdentifier := d.Get("phase1_remote_identifier").([]interface{})
 		ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 		if haEnabled && len(phase1RemoteIdentifier) != 2 {
-			return fmt.Errorf("please either set two phase 1 remote IDs, when HA is enabled")
+			return diag.Errorf("please either set two phase 1 remote IDs, when HA is enabled")
 		} else if !haEnabled && len(phase1RemoteIdentifier) != 1 {
-			return fmt.Errorf("please set one phase 1 remote ID, when HA is disabled")
+			return diag.Errorf("please set one phase 1 remote ID, when HA is disabled")
 		}
 
 		var ph1RemoteId string
@@ -1089,7 +1090,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 
 		err := client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1100,7 +1101,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 			ConnectionType: d.Get("connection_type").(string),
 		}
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("'prepend_as_path' only supports 'bgp' connection. Can't update 'prepend_as_path' for '%s' connection", externalDeviceConn.ConnectionType)
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' only supports 'bgp' connection. Can't update 'prepend_as_path' for '%s' connection", externalDeviceConn.ConnectionType)
 		}
 
 		var prependASPath []string
@@ -1109,13 +1110,13 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		}
 		err := client.EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path: %v", err)
+			return diag.Errorf("could not update prepend_as_path: %v", err)
 		}
 	}
 
 	if d.HasChange("bgp_md5_key") {
 		if d.Get("connection_type").(string) != "bgp" {
-			return fmt.Errorf("can't update BGP MD5 authentication key since it is only supported for BGP connection")
+			return diag.Errorf("can't update BGP MD5 authentication key since it is only supported for BGP connection")
 		}
 
 		oldKey, newKey := d.GetChange("bgp_md5_key")
@@ -1128,7 +1129,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 			bgpRemoteIp = strings.Split(d.Get("remote_tunnel_cidr").(string), ",")
 		}
 		if newKey.(string) != "" && len(newKeyList) != len(bgpRemoteIp) {
-			return fmt.Errorf("can't update BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
+			return diag.Errorf("can't update BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
 		}
 		for i, v := range bgpRemoteIp {
 			bgpMd5Key := ""
@@ -1146,17 +1147,17 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 			}
 			err := client.EditBgpMd5Key(editBgpMd5Key)
 			if err != nil {
-				return fmt.Errorf("failed to update BGP MD5 authentication key: %v", err)
+				return diag.Errorf("failed to update BGP MD5 authentication key: %v", err)
 			}
 		}
 	}
 
 	if d.HasChange("backup_bgp_md5_key") {
 		if d.Get("connection_type").(string) != "bgp" {
-			return fmt.Errorf("can't update backup BGP MD5 authentication key since it is only supported for BGP connection")
+			return diag.Errorf("can't update backup BGP MD5 authentication key since it is only supported for BGP connection")
 		}
 		if !d.Get("ha_enabled").(bool) {
-			return fmt.Errorf("can't update BGP backup MD5 authentication key since ha is not enabled")
+			return diag.Errorf("can't update BGP backup MD5 authentication key since ha is not enabled")
 		}
 
 		oldKey, newKey := d.GetChange("backup_bgp_md5_key")
@@ -1169,7 +1170,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 			bgpRemoteIp = strings.Split(d.Get("backup_remote_tunnel_cidr").(string), ",")
 		}
 		if newKey.(string) != "" && len(newKeyList) != len(bgpRemoteIp) {
-			return fmt.Errorf("can't update backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
+			return diag.Errorf("can't update backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
 		}
 		for i, v := range bgpRemoteIp {
 			bgpMd5Key := ""
@@ -1187,7 +1188,7 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 			}
 			err := client.EditBgpMd5Key(editBgpMd5Key)
 			if err != nil {
-				return fmt.Errorf("failed to update backup BGP MD5 authentication key: %v", err)
+				return diag.Errorf("failed to update backup BGP MD5 authentication key: %v", err)
 			}
 		}
 	}
@@ -1200,28 +1201,28 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 		}
 		err := client.EditSite2CloudPhase1LocalIdentifier(s2c)
 		if err != nil {
-			return fmt.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
+			return diag.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceAviatrixSpokeExternalDeviceConnRead(d, meta)
+	return resourceAviatrixSpokeExternalDeviceConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeExternalDeviceConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 		VpcID:          d.Get("vpc_id").(string),
 		ConnectionName: d.Get("connection_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix external device connection: %#v", externalDeviceConn)
+	logInfo(ctx, "Deleting Aviatrix external device connection", map[string]interface{}{"gw_name": externalDeviceConn.GwName, "connection_name": externalDeviceConn.ConnectionName, "vpc_id": externalDeviceConn.VpcID})
 
 	err := client.DeleteExternalDeviceConn(externalDeviceConn)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix external device connection: %s", err)
+		return diag.Errorf("failed to delete Aviatrix external device connection: %s", err)
 	}
 
 	return nil
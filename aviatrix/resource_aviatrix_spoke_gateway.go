@@ -2,14 +2,14 @@ package aviatrix
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,14 +18,18 @@ import (
 
 func resourceAviatrixSpokeGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeGatewayCreate,
-		Read:   resourceAviatrixSpokeGatewayRead,
-		Update: resourceAviatrixSpokeGatewayUpdate,
-		Delete: resourceAviatrixSpokeGatewayDelete,
+		CreateContext: resourceAviatrixSpokeGatewayCreate,
+		ReadContext:   resourceAviatrixSpokeGatewayRead,
+		UpdateContext: resourceAviatrixSpokeGatewayUpdate,
+		DeleteContext: resourceAviatrixSpokeGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validateGatewayDiff(spokeGatewayDiffRules),
+			setTagsAll(goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes),
+		),
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -40,6 +44,12 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -269,6 +279,7 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the spoke gateway.",
 			},
+			"tags_all": tagsAllSchema(),
 			"enable_private_vpc_default_route": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -610,8 +621,8 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.SpokeVpc{
 		CloudType:            d.Get("cloud_type").(int),
@@ -643,20 +654,20 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if haSubnet != "" || haZone != "" || haInsaneModeAz != "" || haEip != "" || haAzureEipNameResourceGroup != "" ||
 			haGwSize != "" || haAvailabilityDomain != "" || haFaultDomain != "" || haOobManagementSubnet != "" ||
 			haPrivateModeSubnetZone != "" || haOobAvailabilityZone != "" || haSoftwareVersion != "" || haOobImageVersion != "" {
-			return fmt.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage spoke ha gateway")
+			return attributeErrorf("manage_ha_gateway", "'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage spoke ha gateway")
 		}
 	}
 
 	if d.Get("enable_private_vpc_default_route").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_private_vpc_default_route", "enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.Get("enable_skip_public_route_table_update").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if _, hasSetZone := d.GetOk("zone"); !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && hasSetZone {
-		return fmt.Errorf("attribute 'zone' is only valid for Azure (8), Azure GOV (32) and Azure CHINA (2048)")
+		return attributeErrorf("zone", "attribute 'zone' is only valid for Azure (8), Azure GOV (32) and Azure CHINA (2048)")
 	}
 
 	if _, hasSetZone := d.GetOk("zone"); goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && hasSetZone {
@@ -679,27 +690,27 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	disableRoutePropagation := d.Get("disable_route_propagation").(bool)
 	if enableBgp {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWS|goaviatrix.Azure) {
-			return fmt.Errorf("enabling BGP is only supported for AWS (1) and Azure (8)")
+			return diag.Errorf("enabling BGP is only supported for AWS (1) and Azure (8)")
 		}
 		gateway.EnableBgp = "yes"
 	} else {
 		if disableRoutePropagation {
-			return fmt.Errorf("disable route propagation is not supported on Non-BGP Spoke")
+			return diag.Errorf("disable route propagation is not supported on Non-BGP Spoke")
 		}
 	}
 
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if !learnedCidrsApproval && len(gateway.ApprovedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		gateway.VpcID = d.Get("vpc_id").(string)
 		if gateway.VpcID == "" {
-			return fmt.Errorf("'vpc_id' cannot be empty for creating a spoke gw")
+			return attributeErrorf("vpc_id", "'vpc_id' cannot be empty for creating a spoke gw")
 		}
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
@@ -708,14 +719,14 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		// for gcp, rest api asks for "zone" rather than vpc region
 		gateway.Zone = d.Get("vpc_reg").(string)
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192) or, AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192) or, AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain == "" || gateway.FaultDomain == "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
+		return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are required for OCI")
 	}
 	if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain != "" || gateway.FaultDomain != "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are only valid for OCI")
 	}
 
 	insaneMode := d.Get("insane_mode").(bool)
@@ -726,32 +737,32 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	haFaultDomain := d.Get("ha_fault_domain").(string)
 
 	if haZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
+		return attributeErrorf("ha_zone", "'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-		return fmt.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
+		return attributeErrorf("ha_zone", "'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-		return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure (4), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
+		return attributeErrorf("ha_subnet", "'ha_subnet' must be provided to enable HA on Azure (4), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
 	}
 	haGwSize := d.Get("ha_gw_size").(string)
 	if haSubnet == "" && haZone == "" && haGwSize != "" {
-		return fmt.Errorf("'ha_gw_size' is only required if enabling HA")
+		return attributeErrorf("ha_gw_size", "'ha_gw_size' is only required if enabling HA")
 	}
 	haInsaneModeAz := d.Get("ha_insane_mode_az").(string)
 	if insaneMode {
 		// Insane Mode encryption is not supported in Azure China regions
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|
 			goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return attributeErrorf("insane_mode", "insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 			if insaneModeAz == "" {
-				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return attributeErrorf("insane_mode_az", "insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 			if haSubnet != "" && haInsaneModeAz == "" {
-				return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) provider and ha_subnet is set")
+				return attributeErrorf("ha_insane_mode_az", "ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) provider and ha_subnet is set")
 			}
 			// Append availability zone to subnet
 			var strs []string
@@ -764,27 +775,27 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	}
 	if haZone != "" || haSubnet != "" {
 		if haGwSize == "" {
-			return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet or ha_zone is set")
 		}
 	}
 	if haSubnet != "" {
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-			return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
+			return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
 		}
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-			return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+			return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 		}
 	}
 
 	enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
 	customerManagedKeys := d.Get("customer_managed_keys").(string)
 	if enableEncryptVolume && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_encrypt_volume", "'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 	if customerManagedKeys != "" {
 		if !enableEncryptVolume {
-			return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+			return attributeErrorf("customer_managed_keys", "'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
 		}
 		gateway.CustomerManagedKeys = customerManagedKeys
 	}
@@ -799,24 +810,24 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	}
 	// Enable monitor gateway subnets does not work with AWSChina
 	if enableMonitorSubnets && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+		return attributeErrorf("enable_monitor_gateway_subnets", "'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return attributeErrorf("monitor_exclude_list", "'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 
 	bgpOverLan := d.Get("enable_bgp_over_lan").(bool)
 	if bgpOverLan && !enableBgp {
-		return fmt.Errorf("'enable_bgp' is required to be true to enable bgp over lan")
+		return attributeErrorf("enable_bgp", "'enable_bgp' is required to be true to enable bgp over lan")
 	}
 	if bgpOverLan && !(goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-		return fmt.Errorf("'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
+		return attributeErrorf("enable_bgp_over_lan", "'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
 	}
 	bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
 	if isCountSet && (!bgpOverLan || !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-		return fmt.Errorf("'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled spoke for Azure (8), AzureGov (32) or AzureChina (2048)")
+		return attributeErrorf("bgp_lan_interfaces_count", "'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled spoke for Azure (8), AzureGov (32) or AzureChina (2048)")
 	} else if !isCountSet && bgpOverLan && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure spoke: %s", gateway.GwName)
+		return diag.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure spoke: %s", gateway.GwName)
 	}
 	if bgpOverLan {
 		gateway.BgpOverLan = true
@@ -831,32 +842,32 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	if enablePrivateOob {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+			return attributeErrorf("enable_private_oob", "'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
 		if oobAvailabilityZone == "" {
-			return fmt.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
+			return attributeErrorf("oob_availability_zone", "\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
 		}
 
 		if oobManagementSubnet == "" {
-			return fmt.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
+			return attributeErrorf("oob_management_subnet", "\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
 		}
 
 		if haSubnet != "" {
 			if haOobAvailabilityZone == "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+				return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 			}
 
 			if haOobManagementSubnet == "" {
-				return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+				return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 			}
 		} else {
 			if haOobAvailabilityZone != "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+				return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 			}
 
 			if haOobManagementSubnet != "" {
-				return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
+				return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
 			}
 		}
 
@@ -865,49 +876,49 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gateway.OobManagementSubnet = oobManagementSubnet + "~~" + oobAvailabilityZone
 	} else {
 		if oobAvailabilityZone != "" {
-			return fmt.Errorf("\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("oob_availability_zone", "\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if oobManagementSubnet != "" {
-			return fmt.Errorf("\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("oob_management_subnet", "\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if haOobAvailabilityZone != "" {
-			return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if haOobManagementSubnet != "" {
-			return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
 		}
 	}
 
-	_, tagsOk := d.GetOk("tags")
+	_, tagsOk := d.GetOk("tags_all")
 	if tagsOk {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return errors.New("failed to create spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("failed to create spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTagsAll(d, gateway.CloudType)
 		if err != nil {
-			return fmt.Errorf("error creating tags for spoke gateway: %v", err)
+			return diag.Errorf("error creating tags for spoke gateway: %v", err)
 		}
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to add tags whenc creating spoke gateway: %v", err)
+			return diag.Errorf("failed to add tags whenc creating spoke gateway: %v", err)
 		}
 		gateway.TagJson = tagJson
 	}
 
 	enableActiveStandby := d.Get("enable_active_standby").(bool)
 	if haSubnet == "" && haZone == "" && enableActiveStandby {
-		return fmt.Errorf("could not configure Active-Standby as HA is not enabled")
+		return diag.Errorf("could not configure Active-Standby as HA is not enabled")
 	}
 	if !enableBgp && enableActiveStandby {
-		return fmt.Errorf("could not configure Active-Standby as it is not BGP capable gateway")
+		return diag.Errorf("could not configure Active-Standby as it is not BGP capable gateway")
 	}
 	enableActiveStandbyPreemptive := d.Get("enable_active_standby_preemptive").(bool)
 	if !enableActiveStandby && enableActiveStandbyPreemptive {
-		return fmt.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
+		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
 	}
 
 	enableSpotInstance := d.Get("enable_spot_instance").(bool)
@@ -915,11 +926,11 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	deleteSpot := d.Get("delete_spot").(bool)
 	if enableSpotInstance {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+			return attributeErrorf("enable_spot_instance", "enable_spot_instance only supports AWS and Azure related cloud types")
 		}
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
-			return fmt.Errorf("delete_spot only supports Azure")
+			return attributeErrorf("delete_spot", "delete_spot only supports Azure")
 		}
 
 		gateway.EnableSpotInstance = true
@@ -931,10 +942,10 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	rxQueueSize := d.Get("rx_queue_size").(string)
 	if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+		return attributeErrorf("rx_queue_size", "rx_queue_size only supports AWS related cloud types")
 	}
 
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 	if !enablePrivateOob && !privateModeInfo.EnablePrivateMode {
 		allocateNewEip := d.Get("allocate_new_eip").(bool)
 		if allocateNewEip {
@@ -943,21 +954,21 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			gateway.ReuseEip = "on"
 
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-				return fmt.Errorf("failed to create spoke gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
+				return diag.Errorf("failed to create spoke gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
 			}
 			if _, ok := d.GetOk("eip"); !ok {
-				return fmt.Errorf("failed to create spoke gateway: 'eip' must be set when 'allocate_new_eip' is false")
+				return diag.Errorf("failed to create spoke gateway: 'eip' must be set when 'allocate_new_eip' is false")
 			}
 			azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !azureEipNameOk {
-					return fmt.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), d.Get("eip").(string))
 			} else {
 				if azureEipNameOk {
-					return fmt.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				gateway.Eip = d.Get("eip").(string)
 			}
@@ -969,39 +980,39 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			gateway.Subnet = fmt.Sprintf("%s~~%s", gateway.Subnet, privateModeSubnetZone.(string))
 		} else {
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("%q must be set when creating a Spoke Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
+				return attributeErrorf("private_mode_subnet_zone", "%q must be set when creating a Spoke Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
 			}
 		}
 
 		if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
+				return diag.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
 			}
 
 			gateway.LbVpcId = d.Get("private_mode_lb_vpc_id").(string)
 		}
 	} else {
 		if _, ok := d.GetOk("private_mode_subnet_zone"); ok {
-			return fmt.Errorf("%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
+			return attributeErrorf("private_mode_subnet_zone", "%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
 		}
 		if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
-			return fmt.Errorf("%q is only valid when Private Mode is enabled", "private_mode_lb_vpc_id")
+			return attributeErrorf("private_mode_lb_vpc_id", "%q is only valid when Private Mode is enabled", "private_mode_lb_vpc_id")
 		}
 	}
 
 	if gateway.EnableGlobalVpc && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("'enable_global_vpc' is only valid for GCP")
+		return attributeErrorf("enable_global_vpc", "'enable_global_vpc' is only valid for GCP")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Spoke Gateway: %#v", gateway)
+	logInfo(ctx, "Creating Aviatrix Spoke Gateway", map[string]interface{}{"gw_name": gateway.GwName, "account_name": gateway.AccountName, "vpc_id": gateway.VpcID})
 
 	d.SetId(gateway.GwName)
 	flag := false
-	defer resourceAviatrixSpokeGatewayReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSpokeGatewayReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.LaunchSpokeVpc(gateway)
+	err := client.LaunchSpokeVpc(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("failed to create Aviatrix Spoke Gateway: %s", err)
 	}
 
 	if !singleAZ {
@@ -1010,11 +1021,11 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			SingleAZ: "disabled",
 		}
 
-		log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+		logInfo(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
-		err := client.DisableSingleAZGateway(singleAZGateway)
+		err := client.DisableSingleAZGateway(ctx, singleAZGateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable single AZ GW HA: %s", err)
+			return diag.Errorf("failed to disable single AZ GW HA: %s", err)
 		}
 	}
 
@@ -1051,7 +1062,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if privateModeInfo.EnablePrivateMode {
 			haPrivateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
 			if haPrivateModeSubnetZone == "" && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("%q must be set when creating a Spoke HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
+				return attributeErrorf("ha_private_mode_subnet_zone", "%q must be set when creating a Spoke HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
 			}
 			spokeHaGw.Subnet = haSubnet + "~~" + haPrivateModeSubnetZone
 		}
@@ -1061,26 +1072,26 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			if spokeHaGw.Eip != "" {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				spokeHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), spokeHaGw.Eip)
 			} else if haAzureEipNameOk {
-				return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
+				return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
-		_, err := client.CreateSpokeHaGw(spokeHaGw)
+		_, err := client.CreateSpokeHaGw(ctx, spokeHaGw)
 		if err != nil {
-			return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
+			return diag.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
 		}
 
-		log.Printf("[INFO]Resizing Spoke HA Gateway: %#v", haGwSize)
+		logInfo(ctx, "Resizing Spoke HA Gateway", map[string]interface{}{"gw_size": haGwSize})
 
 		if haGwSize != gateway.VpcSize {
 			if haGwSize == "" {
-				return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 					"ha_subnet or ha_zone is set")
 			}
 
@@ -1090,11 +1101,11 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 				VpcSize:   d.Get("ha_gw_size").(string),
 			}
 
-			log.Printf("[INFO] Resizing Spoke HA Gateway size to: %s ", haGateway.VpcSize)
+			logInfo(ctx, "Resizing Spoke HA Gateway", map[string]interface{}{"gw_size": haGateway.VpcSize})
 
-			err := client.UpdateGateway(haGateway)
+			err := client.UpdateGateway(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
+				return diag.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
 			}
 
 			d.Set("ha_gw_size", haGwSize)
@@ -1107,14 +1118,14 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			GwName: d.Get("gw_name").(string),
 		}
 
-		log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+		logInfo(ctx, "Enable VPC DNS Server", map[string]interface{}{"gw_name": gwVpcDnsServer.GwName})
 
-		err := client.EnableVpcDnsServer(gwVpcDnsServer)
+		err := client.EnableVpcDnsServer(ctx, gwVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+			return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 		}
 	} else if enableVpcDnsServer {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return attributeErrorf("enable_vpc_dns_server", "'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	if customizedSpokeVpcRoutes := d.Get("customized_spoke_vpc_routes").(string); customizedSpokeVpcRoutes != "" {
@@ -1123,16 +1134,18 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			CustomizedSpokeVpcRoutes: strings.Split(customizedSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes of spoke gateway: %s ", transitGateway.GwName)
-			err := client.EditGatewayCustomRoutes(transitGateway)
+			logInfo(ctx, "Editing customized routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
+			err := client.EditGatewayCustomRoutes(ctx, transitGateway)
 			if err == nil {
 				break
 			}
 			if i <= 18 && (strings.Contains(err.Error(), "when it is down") || strings.Contains(err.Error(), "hagw is down") ||
 				strings.Contains(err.Error(), "gateway is down")) {
-				time.Sleep(10 * time.Second)
+				if goaviatrix.SleepContext(ctx, 10*time.Second) != nil {
+					return diag.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				}
 			} else {
-				return fmt.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1143,16 +1156,18 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			FilteredSpokeVpcRoutes: strings.Split(filteredSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing filtered routes of spoke gateway: %s ", transitGateway.GwName)
-			err := client.EditGatewayFilterRoutes(transitGateway)
+			logInfo(ctx, "Editing filtered routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
+			err := client.EditGatewayFilterRoutes(ctx, transitGateway)
 			if err == nil {
 				break
 			}
 			if i <= 18 && (strings.Contains(err.Error(), "when it is down") || strings.Contains(err.Error(), "hagw is down") ||
 				strings.Contains(err.Error(), "gateway is down")) {
-				time.Sleep(10 * time.Second)
+				if goaviatrix.SleepContext(ctx, 10*time.Second) != nil {
+					return diag.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				}
 			} else {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1163,24 +1178,26 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			AdvertisedSpokeRoutes: strings.Split(includedAdvertisedSpokeRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes advertisement of spoke gateway: %s ", transitGateway.GwName)
-			err := client.EditGatewayAdvertisedCidr(transitGateway)
+			logInfo(ctx, "Editing customized routes advertisement of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
+			err := client.EditGatewayAdvertisedCidr(ctx, transitGateway)
 			if err == nil {
 				break
 			}
 			if i <= 30 && (strings.Contains(err.Error(), "when it is down") || strings.Contains(err.Error(), "hagw is down") ||
 				strings.Contains(err.Error(), "gateway is down")) {
-				time.Sleep(10 * time.Second)
+				if goaviatrix.SleepContext(ctx, 10*time.Second) != nil {
+					return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				}
 			} else {
-				return fmt.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
 
 	if enableMonitorSubnets {
-		err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
+		err := client.EnableMonitorGatewaySubnets(ctx, gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -1189,9 +1206,9 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			GwName: d.Get("gw_name").(string),
 		}
 
-		err := client.DisableJumboFrame(gw)
+		err := client.DisableJumboFrame(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("could not disable jumbo frame for spoke gateway: %v", err)
+			return diag.Errorf("could not disable jumbo frame for spoke gateway: %v", err)
 		}
 	}
 
@@ -1199,9 +1216,9 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gw := &goaviatrix.Gateway{
 			GwName: d.Get("gw_name").(string),
 		}
-		err := client.DisableGroGso(gw)
+		err := client.DisableGroGso(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("couldn't disable GRO/GSO on spoke gateway: %s", err)
+			return diag.Errorf("couldn't disable GRO/GSO on spoke gateway: %s", err)
 		}
 	}
 
@@ -1211,7 +1228,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.EnablePrivateVpcDefaultRoute(gw)
 		if err != nil {
-			return fmt.Errorf("could not enable private vpc default route after spoke gateway creation: %v", err)
+			return diag.Errorf("could not enable private vpc default route after spoke gateway creation: %v", err)
 		}
 	}
 
@@ -1221,7 +1238,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.EnableSkipPublicRouteUpdate(gw)
 		if err != nil {
-			return fmt.Errorf("could not enable skip public route update after spoke gateway creation: %v", err)
+			return diag.Errorf("could not enable skip public route update after spoke gateway creation: %v", err)
 		}
 	}
 
@@ -1231,14 +1248,14 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.EnableAutoAdvertiseS2CCidrs(gw)
 		if err != nil {
-			return fmt.Errorf("could not enable auto advertise s2c cidrs after spoke gateaway creation: %v", err)
+			return diag.Errorf("could not enable auto advertise s2c cidrs after spoke gateaway creation: %v", err)
 		}
 	}
 
 	if detectionTime, ok := d.GetOk("tunnel_detection_time"); ok {
-		err := client.ModifyTunnelDetectionTime(d.Get("gw_name").(string), detectionTime.(int))
+		err := client.ModifyTunnelDetectionTime(ctx, d.Get("gw_name").(string), detectionTime.(int))
 		if err != nil {
-			return fmt.Errorf("could not set tunnel detection time during Spoke Gateway creation: %v", err)
+			return diag.Errorf("could not set tunnel detection time during Spoke Gateway creation: %v", err)
 		}
 	}
 
@@ -1246,13 +1263,13 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gateway.LearnedCidrsApproval = "on"
 		err := client.EnableSpokeLearnedCidrsApproval(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+			return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 		}
 	}
 	if len(gateway.ApprovedLearnedCidrs) != 0 {
 		err := client.UpdateSpokePendingApprovedCidrs(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update approved CIDRs: %v", err)
+			return diag.Errorf("failed to update approved CIDRs: %v", err)
 		}
 	}
 
@@ -1265,39 +1282,39 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gateway.BgpManualSpokeAdvertiseCidrs = strings.Join(spokeBgpManualSpokeAdvertiseCidrs, ",")
 		err := client.SetSpokeBgpManualAdvertisedNetworks(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to set spoke BGP Manual Advertise Cidrs: %s", err)
+			return diag.Errorf("failed to set spoke BGP Manual Advertise Cidrs: %s", err)
 		}
 	}
 
 	if val, ok := d.GetOk("bgp_ecmp"); ok {
 		err := client.SetBgpEcmpSpoke(gateway, val.(bool))
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp: %v", err)
+			return diag.Errorf("could not set bgp_ecmp: %v", err)
 		}
 	}
 
 	if enableActiveStandby {
 		if enableActiveStandbyPreemptive {
 			if err := client.EnableActiveStandbyPreemptiveSpoke(gateway); err != nil {
-				return fmt.Errorf("could not enable Preemptive Mode for Active-Standby: %v", err)
+				return diag.Errorf("could not enable Preemptive Mode for Active-Standby: %v", err)
 			}
 		} else {
 			if err := client.EnableActiveStandbySpoke(gateway); err != nil {
-				return fmt.Errorf("could not enable Active-Standby: %v", err)
+				return diag.Errorf("could not enable Active-Standby: %v", err)
 			}
 		}
 	}
 
 	if disableRoutePropagation {
 		if err := client.DisableSpokeOnpremRoutePropagation(gateway); err != nil {
-			return fmt.Errorf("could not disable route propagation for Spoke %s : %v", gateway.GwName, err)
+			return diag.Errorf("could not disable route propagation for Spoke %s : %v", gateway.GwName, err)
 		}
 	}
 
 	if val, ok := d.GetOk("local_as_number"); ok {
 		err := client.SetLocalASNumberSpoke(gateway, val.(string))
 		if err != nil {
-			return fmt.Errorf("could not set local_as_number: %v", err)
+			return diag.Errorf("could not set local_as_number: %v", err)
 		}
 	}
 
@@ -1309,21 +1326,21 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetPrependASPathSpoke(gateway, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
 	if val, ok := d.GetOk("bgp_polling_time"); ok {
 		err := client.SetBgpPollingTimeSpoke(gateway, strconv.Itoa(val.(int)))
 		if err != nil {
-			return fmt.Errorf("could not set bgp polling time: %v", err)
+			return diag.Errorf("could not set bgp polling time: %v", err)
 		}
 	}
 
 	if holdTime := d.Get("bgp_hold_time").(int); holdTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gateway.GwName, holdTime)
+		err := client.ChangeBgpHoldTime(ctx, gateway.GwName, holdTime)
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time after Spoke Gateway creation: %v", err)
+			return diag.Errorf("could not change BGP Hold Time after Spoke Gateway creation: %v", err)
 		}
 	}
 
@@ -1332,10 +1349,10 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if enableBgp {
 			err := client.EnableSpokePreserveAsPath(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable spoke preserve as path: %v", err)
+				return diag.Errorf("could not enable spoke preserve as path: %v", err)
 			}
 		} else {
-			return fmt.Errorf("enable_preserve_as_path is not supported for Non-BGP Spoke Gateways")
+			return attributeErrorf("enable_preserve_as_path", "enable_preserve_as_path is not supported for Non-BGP Spoke Gateways")
 		}
 	}
 
@@ -1344,43 +1361,44 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			GwName:      d.Get("gw_name").(string),
 			RxQueueSize: rxQueueSize,
 		}
-		err := client.SetRxQueueSize(gwRxQueueSize)
+		err := client.SetRxQueueSize(ctx, gwRxQueueSize)
 		if err != nil {
-			return fmt.Errorf("failed to set rx queue size for spoke %s: %s", gateway.GwName, err)
+			return diag.Errorf("failed to set rx queue size for spoke %s: %s", gateway.GwName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
 				GwName:      d.Get("gw_name").(string) + "-hagw",
 				RxQueueSize: rxQueueSize,
 			}
-			err := client.SetRxQueueSize(haGwRxQueueSize)
+			err := client.SetRxQueueSize(ctx, haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("failed to set rx queue size for spoke ha %s : %s", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("failed to set rx queue size for spoke ha %s : %s", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixSpokeGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeGatewayReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSpokeGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeGatewayRead(d, meta)
+		return resourceAviatrixSpokeGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixSpokeGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
+	ignoreTagsConfig := client.IgnoreTags()
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.Set("manage_ha_gateway", true)
 		d.SetId(id)
@@ -1397,10 +1415,10 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading spoke gateway %s: %#v", d.Get("gw_name").(string), gw)
+	logTrace(ctx, "Reading spoke gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Set("cloud_type", gw.CloudType)
 	d.Set("account_name", gw.AccountName)
@@ -1423,14 +1441,14 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && gw.EnableBgpOverLan {
 		bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get BGP LAN IP info for Azure spoke gateway %s: %v", gateway.GwName, err)
+			return diag.Errorf("could not get BGP LAN IP info for Azure spoke gateway %s: %v", gateway.GwName, err)
 		}
 		if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-			log.Printf("[WARN] could not set bgp_lan_ip_list into state: %v", err)
+			diags = append(diags, attributeWarning("bgp_lan_ip_list", "could not set bgp_lan_ip_list into state", err))
 		}
 		if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 			if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-				log.Printf("[WARN] could not set ha_bgp_lan_ip_list into state: %v", err)
+				diags = append(diags, attributeWarning("ha_bgp_lan_ip_list", "could not set ha_bgp_lan_ip_list into state", err))
 			}
 		} else {
 			d.Set("ha_bgp_lan_ip_list", nil)
@@ -1454,11 +1472,11 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 	if gw.EnableLearnedCidrsApproval {
 		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: gw.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get advanced config for spoke gateway: %v", err)
+			return diag.Errorf("could not get advanced config for spoke gateway: %v", err)
 		}
 
 		if err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-			return fmt.Errorf("could not set approved_learned_cidrs into state: %v", err)
+			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 		}
 	} else {
 		d.Set("approved_learned_cidrs", nil)
@@ -1476,7 +1494,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 	}
 	err = d.Set("prepend_as_path", prependAsPath)
 	if err != nil {
-		return fmt.Errorf("could not set prepend_as_path: %v", err)
+		return diag.Errorf("could not set prepend_as_path: %v", err)
 	}
 	if gw.EnableBgp {
 		d.Set("learned_cidrs_approval_mode", gw.LearnedCidrsApprovalMode)
@@ -1496,7 +1514,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 		if len(azureEip) == 3 {
 			d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName)
+			diags = append(diags, attributeWarning("azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName), nil))
 		}
 	}
 
@@ -1591,13 +1609,13 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 	if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-		return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+		return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTags(d, client, tags); err != nil {
+			diags = append(diags, attributeWarning("tags", "could not set tags into state", err))
 		}
 	}
 
@@ -1658,7 +1676,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 
 	enableGroGso, err := client.GetGroGsoStatus(gw)
 	if err != nil {
-		return fmt.Errorf("failed to get GRO/GSO status of spoke gateway %s: %v", gw.GwName, err)
+		return diag.Errorf("failed to get GRO/GSO status of spoke gateway %s: %v", gw.GwName, err)
 	}
 	d.Set("enable_gro_gso", enableGroGso)
 
@@ -1686,7 +1704,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		log.Printf("[INFO] Spoke HA Gateway size: %s", gw.HaGw.GwSize)
+		logInfo(ctx, "Spoke HA Gateway size", map[string]interface{}{"gw_size": gw.HaGw.GwSize})
 		if goaviatrix.IsCloudType(gw.HaGw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
 			d.Set("ha_subnet", gw.HaGw.VpcNet)
 			if zone := d.Get("ha_zone"); goaviatrix.IsCloudType(gw.HaGw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && (isImport || zone.(string) != "") {
@@ -1748,16 +1766,16 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 			if len(azureEip) == 3 {
 				d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+				diags = append(diags, attributeWarning("ha_azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName), nil))
 			}
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
@@ -1779,7 +1797,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.HasChanges("ha_subnet", "ha_zone", "ha_gw_size", "ha_insane_mode_az", "ha_eip",
 			"ha_azure_eip_name_resource_group", "ha_availability_domain", "ha_fault_domain", "ha_oob_management_subnet",
 			"ha_private_mode_subnet_zone", "ha_oob_availability_zone", "ha_software_version", "ha_image_version") {
-			return fmt.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage editing spoke ha gateway")
+			return attributeErrorf("manage_ha_gateway", "'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage editing spoke ha gateway")
 		}
 	}
 
@@ -1789,106 +1807,106 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		VpcSize:   d.Get("ha_gw_size").(string),
 	}
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", gateway)
+	logInfo(ctx, "Updating Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	d.Partial(true)
 	if d.Get("enable_private_vpc_default_route").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_private_vpc_default_route", "enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 	if d.Get("enable_skip_public_route_table_update").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.HasChange("ha_zone") {
 		haZone := d.Get("ha_zone").(string)
 		if haZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
+			return attributeErrorf("ha_zone", "'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
 		}
 	}
 	if d.HasChange("ha_zone") || d.HasChange("ha_subnet") {
 		haZone := d.Get("ha_zone").(string)
 		haSubnet := d.Get("ha_subnet").(string)
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
+			return attributeErrorf("ha_zone", "'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
 		}
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-			return fmt.Errorf("'ha_subnet' must be provided to enable HA for Azure (8), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
+			return attributeErrorf("ha_subnet", "'ha_subnet' must be provided to enable HA for Azure (8), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
 		}
 	}
 	if d.HasChange("allocate_new_eip") {
-		return fmt.Errorf("updating allocate_new_eip is not allowed")
+		return diag.Errorf("updating allocate_new_eip is not allowed")
 	}
 	if d.HasChange("eip") {
-		return fmt.Errorf("updating eip is not allowed")
+		return diag.Errorf("updating eip is not allowed")
 	}
 	if d.HasChange("ha_eip") {
 		o, n := d.GetChange("ha_eip")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("updating ha_eip is not allowed")
+			return diag.Errorf("updating ha_eip is not allowed")
 		}
 	}
 	if d.HasChange("azure_eip_name_resource_group") {
-		return fmt.Errorf("failed to update spoke gateway: changing 'azure_eip_name_resource_group' is not allowed")
+		return diag.Errorf("failed to update spoke gateway: changing 'azure_eip_name_resource_group' is not allowed")
 	}
 	if d.HasChange("ha_azure_eip_name_resource_group") {
 		o, n := d.GetChange("ha_azure_eip_name_resource_group")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("failed to update spoke gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
+			return diag.Errorf("failed to update spoke gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
 		}
 	}
 
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	approvedLearnedCidrs := getStringSet(d, "approved_learned_cidrs")
 	if !learnedCidrsApproval && len(approvedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if d.HasChange("enable_private_oob") {
-		return fmt.Errorf("updating enable_private_oob is not allowed")
+		return diag.Errorf("updating enable_private_oob is not allowed")
 	}
 	enablePrivateOob := d.Get("enable_private_oob").(bool)
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 	if !enablePrivateOob {
 		if d.HasChange("ha_oob_management_subnet") {
-			return fmt.Errorf("updating ha_oob_management_subnet is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_management_subnet is not allowed if private oob is disabled")
 		}
 
 		if d.HasChange("ha_oob_availability_zone") {
-			return fmt.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
 		}
 	}
 	if !privateModeInfo.EnablePrivateMode {
 		if d.HasChange("ha_private_mode_subnet_zone") {
-			return fmt.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
+			return diag.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
 		}
 	}
 
 	if d.HasChange("enable_global_vpc") && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("global vpc can only be enabled for GCP")
+		return diag.Errorf("global vpc can only be enabled for GCP")
 	}
 
 	if d.HasChange("enable_preserve_as_path") {
 		enableBgp := d.Get("enable_bgp").(bool)
 		enableSpokePreserveAsPath := d.Get("enable_preserve_as_path").(bool)
 		if enableSpokePreserveAsPath && !enableBgp {
-			return fmt.Errorf("enable_preserve_as_path is not supported for Non-BGP Spoke during Spoke Gateway update")
+			return attributeErrorf("enable_preserve_as_path", "enable_preserve_as_path is not supported for Non-BGP Spoke during Spoke Gateway update")
 		}
 		if !enableSpokePreserveAsPath {
 			err := client.DisableSpokePreserveAsPath(&goaviatrix.SpokeVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not disable Preserve AS Path during Spoke Gateway update: %v", err)
+				return diag.Errorf("could not disable Preserve AS Path during Spoke Gateway update: %v", err)
 			}
 		} else {
 			err := client.EnableSpokePreserveAsPath(&goaviatrix.SpokeVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not enable Preserve AS Path during Spoke Gateway update: %v", err)
+				return diag.Errorf("could not enable Preserve AS Path during Spoke Gateway update: %v", err)
 			}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("error updating spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("error updating spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		tags := &goaviatrix.Tags{
 			ResourceType: "gw",
@@ -1896,27 +1914,27 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			CloudType:    gateway.CloudType,
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTagsAll(d, gateway.CloudType)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for spoke gateway: %v", err)
+			return diag.Errorf("failed to update tags for spoke gateway: %v", err)
 		}
 		tags.Tags = tagsMap
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for spoke gateway: %v", err)
+			return diag.Errorf("failed to update tags for spoke gateway: %v", err)
 		}
 		tags.TagJson = tagJson
 		err = client.UpdateTags(tags)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for spoke gateway: %v", err)
+			return diag.Errorf("failed to update tags for spoke gateway: %v", err)
 		}
 	}
 
 	if d.HasChange("gw_size") {
 		gateway.VpcSize = d.Get("gw_size").(string)
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGateway(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Spoke Gateway: %s", err)
+			return diag.Errorf("failed to update Aviatrix Spoke Gateway: %s", err)
 		}
 	}
 
@@ -1949,17 +1967,17 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				// No change will be detected when ha_eip is set to the empty string because it is computed.
 				// Instead, check ha_gw_size to detect when HA gateway is being deleted.
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				spokeHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), haEip)
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
 		if !d.HasChange("ha_subnet") && d.HasChange("ha_insane_mode_az") {
-			return fmt.Errorf("ha_subnet must change if ha_insane_mode_az changes")
+			return attributeErrorf("ha_subnet", "ha_subnet must change if ha_insane_mode_az changes")
 		}
 
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
@@ -1972,10 +1990,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			haFaultDomain := d.Get("ha_fault_domain").(string)
 			if newSubnet != "" {
 				if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
+					return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
 				}
 				if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+					return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 				}
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) {
@@ -2014,10 +2032,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				haSubnet := d.Get("ha_subnet").(string)
 
 				if insaneModeHaAz == "" && haSubnet != "" {
-					return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set " +
+					return attributeErrorf("ha_insane_mode_az", "ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set "+
 						"for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				} else if insaneModeHaAz != "" && haSubnet == "" {
-					return fmt.Errorf("ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set " +
+					return attributeErrorf("ha_subnet", "ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set "+
 						"for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				}
 
@@ -2028,10 +2046,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		if (newHaGwEnabled || changeHaGw) && haGwSize == "" {
-			return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet or ha_zone is set")
 		} else if deleteHaGw && haGwSize != "" {
-			return fmt.Errorf("ha_gw_size must be empty if spoke HA gateway is deleted")
+			return attributeErrorf("ha_gw_size", "ha_gw_size must be empty if spoke HA gateway is deleted")
 
 		}
 
@@ -2041,19 +2059,19 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if enablePrivateOob {
 			if newHaGwEnabled || changeHaGw {
 				if haOobAvailabilityZone == "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 
 				if haOobManagementSubnet == "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 			} else if deleteHaGw {
 				if haOobAvailabilityZone != "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+					return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 				}
 
 				if haOobManagementSubnet != "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
+					return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
 				}
 			}
 		}
@@ -2061,7 +2079,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if privateModeInfo.EnablePrivateMode {
 			if newHaGwEnabled || changeHaGw {
 				if _, ok := d.GetOk("ha_private_mode_subnet_zone"); !ok && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-					return fmt.Errorf("%q is required when creating a Spoke HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
+					return attributeErrorf("ha_private_mode_subnet_zone", "%q is required when creating a Spoke HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
 				}
 
 				privateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
@@ -2071,9 +2089,9 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 		if newHaGwEnabled {
 			//New configuration to enable HA
-			_, err := client.CreateSpokeHaGw(spokeHaGw)
+			_, err := client.CreateSpokeHaGw(ctx, spokeHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if d.Get("rx_queue_size").(string) != "" && !d.HasChange("rx_queue_size") {
@@ -2081,32 +2099,32 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 						GwName:      d.Get("gw_name").(string) + "-hagw",
 						RxQueueSize: d.Get("rx_queue_size").(string),
 					}
-					err := client.SetRxQueueSize(haGwRxQueueSize)
+					err := client.SetRxQueueSize(ctx, haGwRxQueueSize)
 					if err != nil {
-						return fmt.Errorf("could not set rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+						return diag.Errorf("could not set rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 					}
 				}
 			}
 			//}
 		} else if deleteHaGw {
 			//Ha configuration has been deleted
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGateway(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
 			}
 		} else if changeHaGw {
 			//HA subnet has been modified. Delete older HA GW,
 			// and launch new HA GW in new subnet.
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGateway(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
 			}
 
 			spokeHaGw.Eip = ""
 
-			_, err = client.CreateSpokeHaGw(spokeHaGw)
+			_, err = client.CreateSpokeHaGw(ctx, spokeHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
 			}
 			newHaGwEnabled = true
 		}
@@ -2129,11 +2147,11 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			logInfo(ctx, "Enable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled && manageHaGw {
@@ -2142,23 +2160,23 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
-			err := client.DisableSingleAZGateway(singleAZGateway)
+			logInfo(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
+			err := client.DisableSingleAZGateway(ctx, singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled && manageHaGw {
 				singleAZGatewayHA := &goaviatrix.Gateway{
 					GwName: d.Get("gw_name").(string) + "-hagw",
 				}
-				err := client.DisableSingleAZGateway(singleAZGatewayHA)
+				err := client.DisableSingleAZGateway(ctx, singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		}
@@ -2170,17 +2188,17 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			// If HA gateway does not exist, don't try to change gateway size and continue with the rest of the updates
 			// to the gateway
 			if err != goaviatrix.ErrNotFound {
-				return fmt.Errorf("couldn't find Aviatrix Spoke HA Gateway while trying to update HA Gw size: %s", err)
+				return diag.Errorf("couldn't find Aviatrix Spoke HA Gateway while trying to update HA Gw size: %s", err)
 			}
 		} else {
 			if haGateway.VpcSize == "" {
-				return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 					"ha_subnet or ha_zone is set")
 			}
-			err = client.UpdateGateway(haGateway)
-			log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+			err = client.UpdateGateway(ctx, haGateway)
+			logInfo(ctx, "Updating HA Gateway size", map[string]interface{}{"gw_size": haGateway.VpcSize})
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
+				return diag.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
 			}
 		}
 	}
@@ -2194,12 +2212,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if enableSNat {
 			err := client.EnableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable single_ip' mode SNAT: %s", err)
+				return diag.Errorf("failed to enable single_ip' mode SNAT: %s", err)
 			}
 		} else {
 			err := client.DisableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable 'single_ip' mode SNAT: %s", err)
+				return diag.Errorf("failed to enable 'single_ip' mode SNAT: %s", err)
 			}
 		}
 	}
@@ -2212,19 +2230,19 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 		enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
 		if enableVpcDnsServer {
-			err := client.EnableVpcDnsServer(gw)
+			err := client.EnableVpcDnsServer(ctx, gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 			}
 		} else {
 			err := client.DisableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to disable VPC DNS Server: %s", err)
 			}
 		}
 
 	} else if d.HasChange("enable_vpc_dns_server") {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_vpc_dns_server", "'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.HasChange("enable_learned_cidrs_approval") {
@@ -2235,13 +2253,13 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			gw.LearnedCidrsApproval = "on"
 			err := client.EnableSpokeLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			gw.LearnedCidrsApproval = "off"
 			err := client.DisableSpokeLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
@@ -2254,14 +2272,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 		err := client.UpdateSpokePendingApprovedCidrs(gw)
 		if err != nil {
-			return fmt.Errorf("could not update approved CIDRs: %v", err)
+			return diag.Errorf("could not update approved CIDRs: %v", err)
 		}
 	}
 
 	if d.HasChange("enable_encrypt_volume") {
 		if d.Get("enable_encrypt_volume").(bool) {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+				return attributeErrorf("enable_encrypt_volume", "'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              d.Get("gw_name").(string),
@@ -2269,7 +2287,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			}
 			err := client.EnableEncryptVolume(gwEncVolume)
 			if err != nil {
-				return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
+				return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
 			}
 
 			haSubnet := d.Get("ha_subnet").(string)
@@ -2282,14 +2300,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
 				if err != nil {
-					return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
+					return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
 				}
 			}
 		} else {
-			return fmt.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
+			return diag.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
 		}
 	} else if d.HasChange("customer_managed_keys") {
-		return fmt.Errorf("updating customer_managed_keys only is not allowed")
+		return diag.Errorf("updating customer_managed_keys only is not allowed")
 	}
 
 	if d.HasChange("customized_spoke_vpc_routes") {
@@ -2301,10 +2319,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				GwName:                   d.Get("gw_name").(string),
 				CustomizedSpokeVpcRoutes: newRouteList,
 			}
-			err := client.EditGatewayCustomRoutes(transitGateway)
-			log.Printf("[INFO] Customizeing routes of spoke gateway: %s ", transitGateway.GwName)
+			err := client.EditGatewayCustomRoutes(ctx, transitGateway)
+			logInfo(ctx, "Customizing routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2318,10 +2336,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				GwName:                 d.Get("gw_name").(string),
 				FilteredSpokeVpcRoutes: newRouteList,
 			}
-			err := client.EditGatewayFilterRoutes(transitGateway)
-			log.Printf("[INFO] Editing filtered spoke vpc routes of spoke gateway: %s ", transitGateway.GwName)
+			err := client.EditGatewayFilterRoutes(ctx, transitGateway)
+			logInfo(ctx, "Editing filtered spoke vpc routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2335,10 +2353,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				GwName:                d.Get("gw_name").(string),
 				AdvertisedSpokeRoutes: newRouteList,
 			}
-			err := client.EditGatewayAdvertisedCidr(transitGateway)
-			log.Printf("[INFO] Editing included advertised spoke vpc routes of spoke gateway: %s ", transitGateway.GwName)
+			err := client.EditGatewayAdvertisedCidr(ctx, transitGateway)
+			logInfo(ctx, "Editing included advertised spoke vpc routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit included advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit included advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2349,28 +2367,28 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		excludedInstances = append(excludedInstances, v.(string))
 	}
 	if !monitorGatewaySubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return attributeErrorf("monitor_exclude_list", "'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 	if d.HasChange("enable_monitor_gateway_subnets") {
 		if monitorGatewaySubnets {
-			err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
+			err := client.EnableMonitorGatewaySubnets(ctx, gateway.GwName, excludedInstances)
 			if err != nil {
-				return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+				return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 			}
 		} else {
 			err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable monitor gateway subnets: %v", err)
+				return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 			}
 		}
 	} else if d.HasChange("monitor_exclude_list") {
 		err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not disable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 		}
-		err = client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
+		err = client.EnableMonitorGatewaySubnets(ctx, gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -2378,12 +2396,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_jumbo_frame").(bool) {
 			err := client.EnableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable jumbo frame for spoke gateway when updating: %v", err)
+				return diag.Errorf("could not enable jumbo frame for spoke gateway when updating: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gateway)
+			err := client.DisableJumboFrame(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable jumbo frame for spoke gateway when updating: %v", err)
+				return diag.Errorf("could not disable jumbo frame for spoke gateway when updating: %v", err)
 			}
 		}
 	}
@@ -2392,12 +2410,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_gro_gso").(bool) {
 			err := client.EnableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable GRO/GSO on spoke gateway when updating: %s", err)
+				return diag.Errorf("couldn't enable GRO/GSO on spoke gateway when updating: %s", err)
 			}
 		} else {
-			err := client.DisableGroGso(gateway)
+			err := client.DisableGroGso(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable GRO/GSO on spoke gateway when updating: %s", err)
+				return diag.Errorf("couldn't disable GRO/GSO on spoke gateway when updating: %s", err)
 			}
 		}
 	}
@@ -2406,12 +2424,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_private_vpc_default_route").(bool) {
 			err := client.EnablePrivateVpcDefaultRoute(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable private vpc default route during spoke gateway update: %v", err)
+				return diag.Errorf("could not enable private vpc default route during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisablePrivateVpcDefaultRoute(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable private vpc default route during spoke gateway update: %v", err)
+				return diag.Errorf("could not disable private vpc default route during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2420,12 +2438,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_skip_public_route_table_update").(bool) {
 			err := client.EnableSkipPublicRouteUpdate(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable skip public route update during spoke gateway update: %v", err)
+				return diag.Errorf("could not enable skip public route update during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableSkipPublicRouteUpdate(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable skip public route update during spoke gateway update: %v", err)
+				return diag.Errorf("could not disable skip public route update during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2434,12 +2452,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_auto_advertise_s2c_cidrs").(bool) {
 			err := client.EnableAutoAdvertiseS2CCidrs(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable auto advertise s2c cidrs during spoke gateway update: %v", err)
+				return diag.Errorf("could not enable auto advertise s2c cidrs during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableAutoAdvertiseS2CCidrs(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable auto advertise s2c cidrs during spoke gateway update: %v", err)
+				return diag.Errorf("could not disable auto advertise s2c cidrs during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2453,12 +2471,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			var err error
 			detectionTime, err = client.GetTunnelDetectionTime("Controller")
 			if err != nil {
-				return fmt.Errorf("could not get default tunnel detection time during Spoke Gateway update: %v", err)
+				return diag.Errorf("could not get default tunnel detection time during Spoke Gateway update: %v", err)
 			}
 		}
-		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime)
+		err := client.ModifyTunnelDetectionTime(ctx, gateway.GwName, detectionTime)
 		if err != nil {
-			return fmt.Errorf("could not modify tunnel detection time during Spoke Gateway update: %v", err)
+			return diag.Errorf("could not modify tunnel detection time during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2483,7 +2501,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			!primaryRollbackSoftwareVersion && !haRollbackSoftwareVersion {
 			// Both Primary and HA have upgraded just their software_version
 			// so we can perform upgrade in parallel.
-			log.Printf("[INFO] Upgrading spoke gateway gw_name=%s ha/primary pair in parallel", gateway.GwName)
+			logInfo(ctx, "Upgrading spoke gateway ha/primary pair in parallel", map[string]interface{}{"gw_name": gateway.GwName})
 			swVersion := d.Get("software_version").(string)
 			imageVersion := d.Get("image_version").(string)
 			gw := &goaviatrix.Gateway{
@@ -2511,17 +2529,17 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			}()
 			wg.Wait()
 			if primaryErr != nil && haErr != nil {
-				return fmt.Errorf("could not upgrade primary and HA spoke gateway "+
+				return diag.Errorf("could not upgrade primary and HA spoke gateway "+
 					"software_version=%s ha_software_version=%s image_version=%s ha_image_version=%s:"+
 					"\n primaryErr: %v\n haErr: %v",
 					swVersion, haSwVersion, imageVersion, haImageVersion, primaryErr, haErr)
 			} else if primaryErr != nil {
-				return fmt.Errorf("could not upgrade primary spoke gateway software_version=%s: %v", swVersion, primaryErr)
+				return diag.Errorf("could not upgrade primary spoke gateway software_version=%s: %v", swVersion, primaryErr)
 			} else if haErr != nil {
-				return fmt.Errorf("could not upgrade HA spoke gateway ha_software_version=%s: %v", haSwVersion, haErr)
+				return diag.Errorf("could not upgrade HA spoke gateway ha_software_version=%s: %v", haSwVersion, haErr)
 			}
 		} else { // Only primary or only HA has changed, or image_version changed, or it is a software rollback
-			log.Printf("[INFO] Upgrading spoke gateway gw_name=%s ha or primary in serial", gateway.GwName)
+			logInfo(ctx, "Upgrading spoke gateway ha or primary in serial", map[string]interface{}{"gw_name": gateway.GwName})
 			if primaryHasVersionChange {
 				swVersion := d.Get("software_version").(string)
 				imageVersion := d.Get("image_version").(string)
@@ -2532,7 +2550,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.UpgradeGateway(gw)
 				if err != nil {
-					return fmt.Errorf("could not upgrade spoke gateway during update image_version=%s software_version=%s: %v", gw.ImageVersion, gw.SoftwareVersion, err)
+					return diag.Errorf("could not upgrade spoke gateway during update image_version=%s software_version=%s: %v", gw.ImageVersion, gw.SoftwareVersion, err)
 				}
 			}
 			if haHasVersionChange {
@@ -2545,7 +2563,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.UpgradeGateway(hagw)
 				if err != nil {
-					return fmt.Errorf("could not upgrade HA spoke gateway during update image_version=%s software_version=%s: %v", hagw.ImageVersion, hagw.SoftwareVersion, err)
+					return diag.Errorf("could not upgrade HA spoke gateway during update image_version=%s software_version=%s: %v", hagw.ImageVersion, hagw.SoftwareVersion, err)
 				}
 			}
 		}
@@ -2562,7 +2580,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		spokeGw.BgpManualSpokeAdvertiseCidrs = strings.Join(spokeBgpManualSpokeAdvertiseCidrs, ",")
 		err := client.SetSpokeBgpManualAdvertisedNetworks(spokeGw)
 		if err != nil {
-			return fmt.Errorf("failed to set spoke bgp manual advertise CIDRs during Spoke Gateway update: %s", err)
+			return diag.Errorf("failed to set spoke bgp manual advertise CIDRs during Spoke Gateway update: %s", err)
 		}
 	}
 
@@ -2573,7 +2591,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetBgpEcmpSpoke(gateway, enabled)
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp during Spoke Gateway update: %v", err)
+			return diag.Errorf("could not set bgp_ecmp during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2584,19 +2602,19 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_active_standby").(bool) {
 			if d.Get("enable_active_standby_preemptive").(bool) {
 				if err := client.EnableActiveStandbyPreemptiveSpoke(gateway); err != nil {
-					return fmt.Errorf("could not enable Preemptive Mode for Active-Standby during Spoke Gatway update: %v", err)
+					return diag.Errorf("could not enable Preemptive Mode for Active-Standby during Spoke Gatway update: %v", err)
 				}
 			} else {
 				if err := client.EnableActiveStandbySpoke(gateway); err != nil {
-					return fmt.Errorf("could not enable Active-Standby during Spoke Gateway update: %v", err)
+					return diag.Errorf("could not enable Active-Standby during Spoke Gateway update: %v", err)
 				}
 			}
 		} else {
 			if d.Get("enable_active_standby_preemptive").(bool) {
-				return fmt.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
+				return diag.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
 			}
 			if err := client.DisableActiveStandbySpoke(gateway); err != nil {
-				return fmt.Errorf("could not disable Active-Standby during Spoke Gateway update: %v", err)
+				return diag.Errorf("could not disable Active-Standby during Spoke Gateway update: %v", err)
 			}
 		}
 	}
@@ -2615,7 +2633,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			// Handle the case where prependASPath is empty here so that the API is not called twice
 			err := client.SetPrependASPathSpoke(gateway, nil)
 			if err != nil {
-				return fmt.Errorf("could not delete prepend_as_path during Spoke Gateway update: %v", err)
+				return diag.Errorf("could not delete prepend_as_path during Spoke Gateway update: %v", err)
 			}
 		}
 
@@ -2623,14 +2641,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			localAsNumber := d.Get("local_as_number").(string)
 			err := client.SetLocalASNumberSpoke(gateway, localAsNumber)
 			if err != nil {
-				return fmt.Errorf("could not set local_as_number: %v", err)
+				return diag.Errorf("could not set local_as_number: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(prependASPath) > 0 {
 			err := client.SetPrependASPathSpoke(gateway, prependASPath)
 			if err != nil {
-				return fmt.Errorf("could not set prepend_as_path during Spoke Gateway update: %v", err)
+				return diag.Errorf("could not set prepend_as_path during Spoke Gateway update: %v", err)
 			}
 		}
 	}
@@ -2642,14 +2660,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetBgpPollingTimeSpoke(gateway, strconv.Itoa(bgpPollingTime.(int)))
 		if err != nil {
-			return fmt.Errorf("could not update bgp polling time during Spoke Gateway update: %v", err)
+			return diag.Errorf("could not update bgp polling time during Spoke Gateway update: %v", err)
 		}
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(gateway.GwName, d.Get("bgp_hold_time").(int))
+		err := client.ChangeBgpHoldTime(ctx, gateway.GwName, d.Get("bgp_hold_time").(int))
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time during Spoke Gateway update: %v", err)
+			return diag.Errorf("could not change BGP Hold Time during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2657,7 +2675,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		disableRoutePropagation := d.Get("disable_route_propagation").(bool)
 		enableBgp := d.Get("enable_bgp").(bool)
 		if disableRoutePropagation && !enableBgp {
-			return fmt.Errorf("disable route propagation is not supported for Non-BGP Spoke during Spoke Gateway update")
+			return diag.Errorf("disable route propagation is not supported for Non-BGP Spoke during Spoke Gateway update")
 		}
 		gw := &goaviatrix.SpokeVpc{
 			GwName: d.Get("gw_name").(string),
@@ -2665,36 +2683,36 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if disableRoutePropagation {
 			err := client.DisableSpokeOnpremRoutePropagation(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
+				return diag.Errorf("failed to disable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
 			}
 		} else {
 			err := client.EnableSpokeOnpremRoutePropagation(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
+				return diag.Errorf("failed to enable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
 			}
 		}
 	}
 
 	if d.HasChange("rx_queue_size") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
+			return diag.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
 		}
 		gw := &goaviatrix.Gateway{
 			GwName:      gateway.GwName,
 			RxQueueSize: d.Get("rx_queue_size").(string),
 		}
-		err := client.SetRxQueueSize(gw)
+		err := client.SetRxQueueSize(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("could not modify rx queue size for spoke: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify rx queue size for spoke: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
 				GwName:      d.Get("gw_name").(string) + "-hagw",
 				RxQueueSize: d.Get("rx_queue_size").(string),
 			}
-			err := client.SetRxQueueSize(haGwRxQueueSize)
+			err := client.SetRxQueueSize(ctx, haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("could not modify rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("could not modify rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
@@ -2703,30 +2721,30 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_global_vpc").(bool) {
 			err := client.EnableGlobalVpc(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable global vpc during spoke gateway update: %v", err)
+				return diag.Errorf("could not enable global vpc during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableGlobalVpc(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable global vpc during spoke gateway update: %v", err)
+				return diag.Errorf("could not disable global vpc during spoke gateway update: %v", err)
 			}
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixSpokeGatewayRead(d, meta)
+	return resourceAviatrixSpokeGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
 		GwName:    d.Get("gw_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Spoke Gateway: %#v", gateway)
+	logInfo(ctx, "Deleting Aviatrix Spoke Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	//If HA is enabled, delete HA GW first.
 	if d.Get("manage_ha_gateway").(bool) {
@@ -2735,17 +2753,17 @@ func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}
 		if haSubnet != "" || haZone != "" {
 			//Delete HA Gw too
 			gateway.GwName += "-hagw"
-			err := client.DeleteGateway(gateway)
+			err := client.DeleteGateway(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
 			}
 		}
 	}
 	gateway.GwName = d.Get("gw_name").(string)
 
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGateway(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Spoke Gateway: %s", err)
 	}
 
 	return nil
@@ -0,0 +1,119 @@
+package aviatrix
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func TestResourceAviatrixSpokeGatewayRoundTrip(t *testing.T) {
+	testRoundTrip(t, roundTripCase{
+		resource: "aviatrix_spoke_gateway",
+		config: map[string]interface{}{
+			"cloud_type":   goaviatrix.AWS,
+			"account_name": roundTripAccount,
+			"vpc_id":       "vpc-0123456789",
+			"vpc_reg":      "us-east-1",
+			"gw_size":      "t3.small",
+			"subnet":       "10.2.0.0/24",
+		},
+		exclude: []string{
+			// the attributes of the other clouds
+			"availability_domain",
+			"fault_domain",
+			"ha_availability_domain",
+			"ha_fault_domain",
+			"azure_eip_name_resource_group",
+			"ha_azure_eip_name_resource_group",
+			"zone",
+			"ha_zone",
+			"enable_global_vpc",
+			"enable_bgp_over_lan",
+			"bgp_lan_interfaces_count",
+			"delete_spot",
+			// Private Mode is not enabled on the fake controller
+			"private_mode_lb_vpc_id",
+			"private_mode_subnet_zone",
+			"ha_private_mode_subnet_zone",
+			// the fake controller does not implement these features, and always allocates new EIPs
+			"insane_mode",
+			"insane_mode_az",
+			"ha_insane_mode_az",
+			"enable_private_oob",
+			"oob_management_subnet",
+			"oob_availability_zone",
+			"ha_oob_management_subnet",
+			"ha_oob_availability_zone",
+			"single_ip_snat",
+			"allocate_new_eip",
+			"eip",
+			"ha_eip",
+			"enable_encrypt_volume",
+			"customer_managed_keys",
+			// the versions are chosen by the controller and changed by upgrades
+			"image_version",
+			"software_version",
+			"ha_image_version",
+			"ha_software_version",
+		},
+		values: map[string]func(r *rand.Rand) interface{}{
+			"ha_subnet":                        oneOf("10.2.1.0/24"),
+			"ha_gw_size":                       oneOf("t3.small", "t3.medium"),
+			"customized_spoke_vpc_routes":      randomCIDRList(24),
+			"filtered_spoke_vpc_routes":        randomCIDRList(24),
+			"included_advertised_spoke_routes": randomCIDRList(24),
+			"spoke_bgp_manual_advertise_cidrs": randomCIDRSet(24),
+			"approved_learned_cidrs":           randomCIDRSet(24),
+			"learned_cidrs_approval_mode":      oneOf("gateway"),
+			"bgp_polling_time":                 func(r *rand.Rand) interface{} { return 10 + r.Intn(41) },
+			"bgp_hold_time":                    func(r *rand.Rand) interface{} { return 12 + r.Intn(349) },
+			"tunnel_detection_time":            func(r *rand.Rand) interface{} { return 20 + r.Intn(581) },
+			"rx_queue_size":                    oneOf("1K", "2K", "4K", "8K", "16K"),
+			"spot_price":                       oneOf("0.05", "0.1"),
+		},
+		fix: func(r *rand.Rand, config map[string]interface{}) {
+			// The HA gateway is managed by aviatrix_spoke_ha_gateway when manage_ha_gateway is false
+			if config["manage_ha_gateway"] == false {
+				delete(config, "ha_subnet")
+			}
+			if _, ok := config["ha_subnet"]; !ok {
+				delete(config, "ha_gw_size")
+				delete(config, "enable_active_standby")
+			} else if _, ok := config["ha_gw_size"]; !ok {
+				config["ha_gw_size"] = config["gw_size"]
+			}
+			// enable_spot_instance can only be true and requires spot_price
+			_, price := config["spot_price"]
+			if config["enable_spot_instance"] == true || price {
+				config["enable_spot_instance"] = true
+				if !price {
+					config["spot_price"] = "0.05"
+				}
+			} else {
+				delete(config, "enable_spot_instance")
+			}
+			// The BGP settings are only valid for BGP spoke gateways
+			if config["enable_bgp"] != true {
+				for _, k := range []string{"enable_preserve_as_path", "spoke_bgp_manual_advertise_cidrs", "enable_learned_cidrs_approval",
+					"learned_cidrs_approval_mode", "approved_learned_cidrs", "bgp_ecmp", "enable_active_standby", "disable_route_propagation",
+					"local_as_number", "prepend_as_path", "bgp_polling_time", "bgp_hold_time"} {
+					delete(config, k)
+				}
+			}
+			if _, ok := config["local_as_number"]; !ok {
+				delete(config, "prepend_as_path")
+			}
+			if config["enable_active_standby"] != true {
+				delete(config, "enable_active_standby_preemptive")
+			}
+			if config["enable_learned_cidrs_approval"] != true {
+				delete(config, "approved_learned_cidrs")
+			}
+			if config["enable_monitor_gateway_subnets"] != true {
+				delete(config, "monitor_exclude_list")
+			}
+		},
+		setup: createRoundTripAccount,
+	})
+}
@@ -2,7 +2,6 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,7 +61,7 @@ func marshalSpokeGatewaySubnetGroupInput(d *schema.ResourceData) *goaviatrix.Spo
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SpokeClient)
 
 	spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
 
@@ -92,13 +91,13 @@ func resourceAviatrixSpokeGatewaySubnetGroupReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SpokeClient)
 
 	name := d.Get("name").(string)
 
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("invalid ID, expected ID gw_name~name, instead got %s", d.Id())
@@ -137,7 +136,7 @@ func resourceAviatrixSpokeGatewaySubnetGroupUpdate(ctx context.Context, d *schem
 	flag := false
 	defer resourceAviatrixSpokeGatewaySubnetGroupReadIfRequired(ctx, d, meta, &flag)
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SpokeClient)
 
 	if d.HasChange("subnets") {
 		spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
@@ -151,7 +150,7 @@ func resourceAviatrixSpokeGatewaySubnetGroupUpdate(ctx context.Context, d *schem
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SpokeClient)
 
 	spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
 
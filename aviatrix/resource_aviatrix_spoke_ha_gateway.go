@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -13,12 +14,12 @@ import (
 
 func resourceAviatrixSpokeHaGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeHaGatewayCreate,
-		Read:   resourceAviatrixSpokeHaGatewayRead,
-		Update: resourceAviatrixSpokeHaGatewayUpdate,
-		Delete: resourceAviatrixSpokeHaGatewayDelete,
+		CreateContext: resourceAviatrixSpokeHaGatewayCreate,
+		ReadContext:   resourceAviatrixSpokeHaGatewayRead,
+		UpdateContext: resourceAviatrixSpokeHaGatewayUpdate,
+		DeleteContext: resourceAviatrixSpokeHaGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -148,8 +149,8 @@ func resourceAviatrixSpokeHaGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.SpokeHaGateway{
 		PrimaryGwName:      d.Get("primary_gw_name").(string),
@@ -167,7 +168,7 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface
 	}
 	gw, err := client.GetGateway(primaryGw)
 	if err != nil {
-		return fmt.Errorf("couldn't retrieve Aviatrix primary spoke gateway in spoke ha gateway creation: %s", err)
+		return diag.Errorf("couldn't retrieve Aviatrix primary spoke gateway in spoke ha gateway creation: %s", err)
 	}
 
 	if gateway.GwName == "" {
@@ -182,58 +183,58 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 		if gateway.Zone != "" || gateway.AvailabilityDomain != "" || gateway.FaultDomain != "" {
-			return fmt.Errorf("'zone', 'availability_domain' and 'fault_domain' are required to be empty for creating an AWS related cloud type spoke ha gateway")
+			return attributeErrorf("zone", "'zone', 'availability_domain' and 'fault_domain' are required to be empty for creating an AWS related cloud type spoke ha gateway")
 		}
 	}
 
 	azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 		if gateway.AvailabilityDomain != "" || gateway.FaultDomain != "" {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required to be empty for creating an Azure related cloud type spoke ha gateway")
+			return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are required to be empty for creating an Azure related cloud type spoke ha gateway")
 		}
 		if gateway.Zone != "" {
 			gateway.Subnet = fmt.Sprintf("%s~~%s~~", gateway.Subnet, gateway.Zone)
 		}
 		if gateway.Eip != "" {
 			if !azureEipNameOk {
-				return fmt.Errorf("'azure_eip_name_resource_group' must be set when 'eip' is set for Azure (8), AzureGov (32) or AzureChina (2048)")
+				return attributeErrorf("azure_eip_name_resource_group", "'azure_eip_name_resource_group' must be set when 'eip' is set for Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 			gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), gateway.Eip)
 		}
 	} else {
 		if azureEipNameOk {
-			return fmt.Errorf("'azure_eip_name_resource_group' only supports Azure clouds including Azure (8), AzureGov (32) or AzureChina (2048)")
+			return attributeErrorf("azure_eip_name_resource_group", "'azure_eip_name_resource_group' only supports Azure clouds including Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.GCPRelatedCloudTypes) {
 		if gateway.Zone == "" {
-			return fmt.Errorf("'zone' is required for creating an GCP spoke ha gateway")
+			return attributeErrorf("zone", "'zone' is required for creating an GCP spoke ha gateway")
 		}
 		if gateway.AvailabilityDomain != "" || gateway.FaultDomain != "" {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required to be empty for creating an GCP related cloud type spoke ha gateway")
+			return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are required to be empty for creating an GCP related cloud type spoke ha gateway")
 		}
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		if gateway.AvailabilityDomain == "" || gateway.FaultDomain == "" {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required for creating an OCI related cloud type spoke ha gateway")
+			return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are required for creating an OCI related cloud type spoke ha gateway")
 		}
 		if gateway.Zone != "" {
-			return fmt.Errorf("'zone' is required to be empty for creating an OCI related cloud type spoke ha gateway")
+			return attributeErrorf("zone", "'zone' is required to be empty for creating an OCI related cloud type spoke ha gateway")
 		}
 	}
 
 	if gateway.InsaneMode == "yes" {
 		if !goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|
 			goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return attributeErrorf("insane_mode", "insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 
 		if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 			insaneModeAz := d.Get("insane_mode_az").(string)
 			if insaneModeAz == "" {
-				return fmt.Errorf("'insane_mode_az' is required if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return attributeErrorf("insane_mode_az", "'insane_mode_az' is required if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 			var insaneModeSubnet []string
 			insaneModeSubnet = append(insaneModeSubnet, gateway.Subnet, insaneModeAz)
@@ -241,24 +242,25 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	spokeHaGwName, err := client.CreateSpokeHaGw(gateway)
+	spokeHaGwName, err := client.CreateSpokeHaGw(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Spoke HA Gateway: %s", err)
+		return diag.Errorf("failed to create Aviatrix Spoke HA Gateway: %s", err)
 	}
 
 	d.SetId(spokeHaGwName)
-	return resourceAviatrixSpokeHaGatewayRead(d, meta)
+	return resourceAviatrixSpokeHaGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -274,10 +276,10 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading spoke gateway %s: %#v", d.Get("gw_name").(string), gw)
+	logTrace(ctx, "Reading spoke gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Set("primary_gw_name", gw.PrimaryGwName)
 	d.Set("eip", gw.PublicIP)
@@ -306,7 +308,7 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}
 		if len(azureEip) == 3 {
 			d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke HA Gateway %s", gw.GwName)
+			diags = append(diags, attributeWarning("azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Spoke HA Gateway %s", gw.GwName), nil))
 		}
 	} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		d.Set("vpc_reg", gw.VpcRegion)
@@ -332,11 +334,11 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}
 		d.Set("fault_domain", gw.FaultDomain)
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
@@ -346,30 +348,30 @@ func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("gw_size") {
 		gateway.GwName = d.Get("gw_name").(string)
 		gateway.VpcSize = d.Get("gw_size").(string)
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGateway(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway %s: %s", gateway.GwName, err)
+			return diag.Errorf("failed to update Aviatrix Spoke HA Gateway %s: %s", gateway.GwName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixSpokeHaGatewayRead(d, meta)
+	return resourceAviatrixSpokeHaGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeHaGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
 		GwName:    d.Get("gw_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Spoke Ha Gateway: %#v", gateway)
+	logInfo(ctx, "Deleting Aviatrix Spoke Ha Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGateway(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Spoke HA Gateway %s : %s", gateway.GwName, err)
+		return diag.Errorf("failed to delete Aviatrix Spoke HA Gateway %s : %s", gateway.GwName, err)
 	}
 
 	return nil
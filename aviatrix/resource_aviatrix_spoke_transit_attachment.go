@@ -1,11 +1,11 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,12 +14,18 @@ import (
 
 func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeTransitAttachmentCreate,
-		Read:   resourceAviatrixSpokeTransitAttachmentRead,
-		Update: resourceAviatrixSpokeTransitAttachmentUpdate,
-		Delete: resourceAviatrixSpokeTransitAttachmentDelete,
+		CreateContext: resourceAviatrixSpokeTransitAttachmentCreate,
+		ReadContext:   resourceAviatrixSpokeTransitAttachmentRead,
+		UpdateContext: resourceAviatrixSpokeTransitAttachmentUpdate,
+		DeleteContext: resourceAviatrixSpokeTransitAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -92,43 +98,42 @@ func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := marshalSpokeTransitAttachmentInput(d)
 
 	spoke, err := client.GetGateway(&goaviatrix.Gateway{GwName: attachment.SpokeGwName})
 	if err != nil {
-		return fmt.Errorf("could not find spoke gateway: %s", err)
+		return diag.Errorf("could not find spoke gateway: %s", err)
 	}
 
 	if !spoke.EnableBgp && (len(attachment.SpokePrependAsPath) != 0 || len(attachment.TransitPrependAsPath) != 0) {
-		return fmt.Errorf("'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
+		return attributeErrorf("spoke_prepend_as_path", "'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
 	}
 
 	if attachment.NoMaxPerformance && attachment.InsaneModeTunnelNumber > 0 {
-		return fmt.Errorf("'tunnel_count' can only be specified with max performance enabled. Please set 'enable_max_performance' to true")
+		return attributeErrorf("tunnel_count", "'tunnel_count' can only be specified with max performance enabled. Please set 'enable_max_performance' to true")
 	}
 
 	d.SetId(attachment.SpokeGwName + "~" + attachment.TransitGwName)
 	flag := false
-	defer resourceAviatrixSpokeTransitAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx, d, meta, &flag)
 
 	try, maxTries, backoff := 0, 10, 1000*time.Millisecond
 	for {
 		try++
-		err := client.CreateSpokeTransitAttachment(attachment)
+		err := client.CreateSpokeTransitAttachment(ctx, attachment)
 		if err != nil {
 			if strings.Contains(err.Error(), "is not up") || strings.Contains(err.Error(), "is not ready") {
-				if try == maxTries {
-					return fmt.Errorf("could not attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
+				if try == maxTries || goaviatrix.SleepContext(ctx, backoff) != nil {
+					return diag.Errorf("could not attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
 				}
-				time.Sleep(backoff)
 				// Double the backoff time after each failed try
 				backoff *= 2
 				continue
 			}
-			return fmt.Errorf("failed to attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
+			return diag.Errorf("failed to attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
 		}
 		break
 	}
@@ -141,7 +146,7 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta i
 
 		err := client.EditTransitConnectionASPathPrepend(transGwPeering, attachment.SpokePrependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set spoke_prepend_as_path: %v", err)
+			return diag.Errorf("could not set spoke_prepend_as_path: %v", err)
 		}
 	}
 
@@ -153,33 +158,33 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta i
 
 		err := client.EditTransitConnectionASPathPrepend(transGwPeering, attachment.TransitPrependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set transit_prepend_as_path: %v", err)
+			return diag.Errorf("could not set transit_prepend_as_path: %v", err)
 		}
 	}
 
-	return resourceAviatrixSpokeTransitAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeTransitAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeTransitAttachmentRead(d, meta)
+		return resourceAviatrixSpokeTransitAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeGwName := d.Get("spoke_gw_name").(string)
 	transitGwName := d.Get("transit_gw_name").(string)
 	if spokeGwName == "" || transitGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no spoke_gw_name or transit_gw_name received", map[string]interface{}{"import_id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
-			return fmt.Errorf("import id is invalid, expecting spoke_gw_name~transit_gw_name, but received: %s", id)
+			return diag.Errorf("import id is invalid, expecting spoke_gw_name~transit_gw_name, but received: %s", id)
 		}
 		spokeGwName = parts[0]
 		transitGwName = parts[1]
@@ -196,7 +201,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("could not find spoke_transit_attachment: %v", err)
+		return diag.Errorf("could not find spoke_transit_attachment: %v", err)
 	}
 
 	d.Set("spoke_gw_name", attachment.SpokeGwName)
@@ -211,7 +216,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 
 		err = d.Set("route_tables", routeTables)
 		if err != nil {
-			return fmt.Errorf("could not set route_tables: %v", err)
+			return diag.Errorf("could not set route_tables: %v", err)
 		}
 	}
 
@@ -240,7 +245,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 
 			err = d.Set("spoke_prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set spoke_prepend_as_path: %v", err)
+				return diag.Errorf("could not set spoke_prepend_as_path: %v", err)
 			}
 		}
 
@@ -252,7 +257,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 
 			err = d.Set("transit_prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set transit_prepend_as_path: %v", err)
+				return diag.Errorf("could not set transit_prepend_as_path: %v", err)
 			}
 		}
 	} else {
@@ -264,11 +269,11 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	if !d.Get("spoke_bgp_enabled").(bool) && d.HasChanges("spoke_prepend_as_path", "transit_prepend_as_path") {
-		return fmt.Errorf("'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
+		return attributeErrorf("spoke_prepend_as_path", "'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
 	}
 
 	d.Partial(true)
@@ -284,7 +289,7 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta i
 
 		err := client.EditTransitConnectionASPathPrepend(transitGatewayPeering, getStringList(d, "spoke_prepend_as_path"))
 		if err != nil {
-			return fmt.Errorf("could not update spoke_prepend_as_path: %v", err)
+			return diag.Errorf("could not update spoke_prepend_as_path: %v", err)
 		}
 	}
 
@@ -296,7 +301,7 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta i
 
 		err := client.EditTransitConnectionASPathPrepend(transitGwPeering, getStringList(d, "transit_prepend_as_path"))
 		if err != nil {
-			return fmt.Errorf("could not update transit_prepend_as_path: %v", err)
+			return diag.Errorf("could not update transit_prepend_as_path: %v", err)
 		}
 	}
 
@@ -308,29 +313,29 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta i
 		}
 
 		if transitGatewayPeering.TunnelCount > 0 && !d.Get("enable_max_performance").(bool) {
-			return fmt.Errorf("'tunnel_count' can't be updated with max performance disabled")
+			return attributeErrorf("tunnel_count", "'tunnel_count' can't be updated with max performance disabled")
 		}
 
 		err := client.UpdateTransitGatewayPeeringTunnelCount(transitGatewayPeering)
 		if err != nil {
-			return fmt.Errorf("could not update tunnel_count for spoke transit attachment: %v : %v", spokeGwName+"~"+transitGwName, err)
+			return diag.Errorf("could not update tunnel_count for spoke transit attachment: %v : %v", spokeGwName+"~"+transitGwName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(spokeGwName + "~" + transitGwName)
-	return resourceAviatrixSpokeTransitAttachmentRead(d, meta)
+	return resourceAviatrixSpokeTransitAttachmentRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeTransitAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeTransitAttachment := &goaviatrix.SpokeTransitAttachment{
 		SpokeGwName:   d.Get("spoke_gw_name").(string),
 		TransitGwName: d.Get("transit_gw_name").(string),
 	}
-	if err := client.DeleteSpokeTransitAttachment(spokeTransitAttachment); err != nil {
-		return fmt.Errorf("could not detach spoke: %s from transit %s: %v", spokeTransitAttachment.SpokeGwName,
+	if err := client.DeleteSpokeTransitAttachment(ctx, spokeTransitAttachment); err != nil {
+		return diag.Errorf("could not detach spoke: %s from transit %s: %v", spokeTransitAttachment.SpokeGwName,
 			spokeTransitAttachment.TransitGwName, err)
 	}
 
@@ -1,20 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSumologicForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSumologicForwarderCreate,
-		Read:   resourceAviatrixSumologicForwarderRead,
-		Delete: resourceAviatrixSumologicForwarderDelete,
+		CreateContext: resourceAviatrixSumologicForwarderCreate,
+		ReadContext:   resourceAviatrixSumologicForwarderRead,
+		DeleteContext: resourceAviatrixSumologicForwarderDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,21 +66,21 @@ func resourceAviatrixSumologicForwarder() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSumologicForwarderCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSumologicForwarderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	_, err := client.GetSumologicForwarderStatus()
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the sumologic_forwarder is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the sumologic_forwarder is already enabled, please import to manage with Terraform")
 	} else {
-		return fmt.Errorf("the support for sumologic forwarder is deprecated")
+		return diag.Errorf("the support for sumologic forwarder is deprecated")
 	}
 }
-func resourceAviatrixSumologicForwarderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSumologicForwarderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if d.Id() != "sumologic_forwarder" {
-		return fmt.Errorf("invalid ID, expected ID \"sumologic_forwarder\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"sumologic_forwarder\", instead got %s", d.Id())
 	}
 
 	sumologicForwarderStatus, err := client.GetSumologicForwarderStatus()
@@ -88,7 +89,7 @@ func resourceAviatrixSumologicForwarderRead(d *schema.ResourceData, meta interfa
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get sumologic forwarder status: %v", err)
+		return diag.Errorf("could not get sumologic forwarder status: %v", err)
 	}
 
 	d.Set("access_id", sumologicForwarderStatus.AccessID)
@@ -103,11 +104,11 @@ func resourceAviatrixSumologicForwarderRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAviatrixSumologicForwarderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSumologicForwarderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.LoggingClient)
 
 	if err := client.DisableSumologicForwarder(); err != nil {
-		return fmt.Errorf("could not disable sumologic forwarder: %v", err)
+		return diag.Errorf("could not disable sumologic forwarder: %v", err)
 	}
 
 	return nil
@@ -155,7 +155,7 @@ func marshalTrafficClassifierInput(d *schema.ResourceData) *goaviatrix.PolicyLis
 }
 
 func resourceAviatrixTrafficClassifierCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	policyList := marshalTrafficClassifierInput(d)
 
@@ -180,7 +180,7 @@ func resourceAviatrixTrafficClassifierReadIfRequired(ctx context.Context, d *sch
 }
 
 func resourceAviatrixTrafficClassifierRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	tcResp, err := client.GetTrafficClassifier(ctx)
 	if err != nil {
@@ -230,7 +230,7 @@ func resourceAviatrixTrafficClassifierRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixTrafficClassifierDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.EdgeClient)
 
 	err := client.DeleteTrafficClassifier(ctx)
 	if err != nil {
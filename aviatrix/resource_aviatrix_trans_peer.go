@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixTransPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransPeerCreate,
-		Read:   resourceAviatrixTransPeerRead,
-		Delete: resourceAviatrixTransPeerDelete,
+		CreateContext: resourceAviatrixTransPeerCreate,
+		ReadContext:   resourceAviatrixTransPeerRead,
+		DeleteContext: resourceAviatrixTransPeerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,8 +41,8 @@ func resourceAviatrixTransPeer() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransPeerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.NetworkingClient)
 
 	transPeer := &goaviatrix.TransPeer{
 		Source:        d.Get("source").(string),
@@ -50,30 +50,30 @@ func resourceAviatrixTransPeerCreate(d *schema.ResourceData, meta interface{}) e
 		ReachableCidr: d.Get("reachable_cidr").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix transitive peering: %#v", transPeer)
+	logInfo(ctx, "Creating Aviatrix transitive peering", map[string]interface{}{"source": transPeer.Source, "nexthop": transPeer.Nexthop})
 
 	d.SetId(transPeer.Source + "~" + transPeer.Nexthop + "~" + transPeer.ReachableCidr)
 	flag := false
-	defer resourceAviatrixTransPeerReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTransPeerReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateTransPeer(transPeer)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Transitive peering: %s", err)
+		return diag.Errorf("failed to create Aviatrix Transitive peering: %s", err)
 	}
 
-	return resourceAviatrixTransPeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransPeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransPeerReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransPeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransPeerRead(d, meta)
+		return resourceAviatrixTransPeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.NetworkingClient)
 
 	sourceGw := d.Get("source").(string)
 	nestHopGw := d.Get("nexthop").(string)
@@ -81,8 +81,7 @@ func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta interface{}) err
 
 	if sourceGw == "" || nestHopGw == "" || reachableCIDR == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway names or reachable cidr received. "+
-			"Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no transit gateway names or reachable cidr received", map[string]interface{}{"import_id": id})
 		d.Set("source", strings.Split(id, "~")[0])
 		d.Set("nexthop", strings.Split(id, "~")[1])
 		d.Set("reachable_cidr", strings.Split(id, "~")[2])
@@ -100,7 +99,7 @@ func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Transitive peering: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Transitive peering: %s", err)
 	}
 
 	d.Set("source", transPeer.Source)
@@ -110,19 +109,19 @@ func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAviatrixTransPeerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.NetworkingClient)
 	transPeer := &goaviatrix.TransPeer{
 		Source:        d.Get("source").(string),
 		Nexthop:       d.Get("nexthop").(string),
 		ReachableCidr: d.Get("reachable_cidr").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix transpeer: %#v", transPeer)
+	logInfo(ctx, "Deleting Aviatrix transpeer", map[string]interface{}{"source": transPeer.Source, "nexthop": transPeer.Nexthop})
 
 	err := client.DeleteTransPeer(transPeer)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Transpeer: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Transpeer: %s", err)
 	}
 
 	return nil
@@ -1,87 +1,24 @@
-package test
+package aviatrix
 
 import (
-    "fmt"
-    "os"
-    "testing"
-
-    "github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
-    "github.com/gruntwork-io/terratest/modules/random"
-    "github.com/gruntwork-io/terratest/modules/terraform"
-    "github.com/stretchr/testify/assert"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccAviatrixTransPeer_basic(t *testing.T) {
-    // Define test inputs
-    sourceVPC := os.Getenv("AWS_VPC_ID")
-    region1 := os.Getenv("AWS_REGION")
-    subnet1 := os.Getenv("AWS_SUBNET")
-    nextHopVPC := os.Getenv("AWS_VPC_ID2")
-    region2 := os.Getenv("AWS_REGION2")
-    subnet2 := os.Getenv("AWS_SUBNET2")
-    reachableCIDR := "192.168.0.0/16"
-    rName := random.UniqueId()
-
-    terraformOptions := &terraform.Options{
-        TerraformDir: "../path/to/terraform/dir",
-        Vars: map[string]interface{}{
-            "account_name":       fmt.Sprintf("tfa-%s", rName),
-            "region1":            region1,
-            "region2":            region2,
-            "vpc_id1":            sourceVPC,
-            "vpc_id2":            nextHopVPC,
-            "subnet1":            subnet1,
-            "subnet2":            subnet2,
-            "aws_account_number": os.Getenv("AWS_ACCOUNT_NUMBER"),
-            "access_account_key": os.Getenv("AWS_ACCESS_KEY"),
-            "secret_account_key": os.Getenv("AWS_SECRET_KEY"),
-            "source":             fmt.Sprintf("tfg-%s", rName),
-            "nexthop":            fmt.Sprintf("tfg2-%s", rName),
-            "reachable_cidr":     reachableCIDR,
-        },
-    }
-
-    // Destroy infrastructure after testing
-    defer terraform.Destroy(t, terraformOptions)
-
-    // Apply the Terraform configuration
-    terraform.InitAndApply(t, terraformOptions)
-
-    // Get the Aviatrix client
-    client := goaviatrix.NewClient(os.Getenv("AVIATRIX_API_KEY"), os.Getenv("AVIATRIX_API_SECRET"), os.Getenv("AVIATRIX_CONTROLLER_IP"))
-
-    // Define expected TransPeer configuration
-    expectedTransPeer := &goaviatrix.TransPeer{
-        Source:        fmt.Sprintf("tfg-%s", rName),
-        Nexthop:       fmt.Sprintf("tfg2-%s", rName),
-        ReachableCidr: reachableCIDR,
-    }
-
-    // Check that the TransPeer was created successfully
-    assert.NoError(t, testAccTransPeerExists(t, client, expectedTransPeer))
-}
-
-func testAccTransPeerExists(t *testing.T, client *goaviatrix.Client, expectedTransPeer *goaviatrix.TransPeer) error {
-    foundTransPeer, err := client.GetTransPeer(expectedTransPeer)
-    if err != nil {
-        return err
-    }
-
-    if foundTransPeer.Source != expectedTransPeer.Source {
-        return fmt.Errorf("source Not found in created attributes")
-    }
-    if foundTransPeer.Nexthop != expectedTransPeer.Nexthop {
-        return fmt.Errorf("nexthop Not found in created attributes")
-    }
-    if foundTransPeer.ReachableCidr != expectedTransPeer.ReachableCidr {
-        return fmt.Errorf("reachable_cidr Not found in created attributes")
-    }
-
-    return nil
-}
-
 func preTransPeerCheck(t *testing.T, msgCommon string) {
-	preAvxTunnelCheck(t, msgCommon)
+	preGatewayCheck(t, msgCommon)
+
+	for _, key := range []string{"AWS_VPC_ID2", "AWS_REGION2", "AWS_SUBNET2"} {
+		if os.Getenv(key) == "" {
+			t.Fatal("Environment variable " + key + " is not set" + msgCommon)
+		}
+	}
 }
 
 func TestAccAviatrixTransPeer_basic(t *testing.T) {
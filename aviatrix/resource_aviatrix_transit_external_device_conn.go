@@ -1,13 +1,13 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"net"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,12 +16,12 @@ import (
 
 func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitExternalDeviceConnCreate,
-		Read:   resourceAviatrixTransitExternalDeviceConnRead,
-		Update: resourceAviatrixTransitExternalDeviceConnUpdate,
-		Delete: resourceAviatrixTransitExternalDeviceConnDelete,
+		CreateContext: resourceAviatrixTransitExternalDeviceConnCreate,
+		ReadContext:   resourceAviatrixTransitExternalDeviceConnRead,
+		UpdateContext: resourceAviatrixTransitExternalDeviceConnUpdate,
+		DeleteContext: resourceAviatrixTransitExternalDeviceConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -392,8 +392,8 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 		VpcID:                  d.Get("vpc_id").(string),
@@ -436,21 +436,21 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		externalDeviceConn.LocalTunnelCidr != "" ||
 		externalDeviceConn.BackupRemoteGatewayIP != "" ||
 		externalDeviceConn.BackupLocalTunnelCidr != "") && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_gateway_ip', 'local_tunnel_cidr', 'backup_remote_gateway_ip' and 'backup_local_tunnel_cidr' " +
+		return attributeErrorf("remote_gateway_ip", "'remote_gateway_ip', 'local_tunnel_cidr', 'backup_remote_gateway_ip' and 'backup_local_tunnel_cidr' "+
 			"cannot be set with 'tunnel_protocol' = 'LAN'. Please use the appropriate LAN attributes instead")
 	}
 	if (externalDeviceConn.RemoteLanIP != "" ||
 		externalDeviceConn.LocalLanIP != "" ||
 		externalDeviceConn.BackupRemoteLanIP != "" ||
 		externalDeviceConn.BackupLocalLanIP != "") && externalDeviceConn.TunnelProtocol != "LAN" {
-		return fmt.Errorf("'remote_lan_ip', 'local_lan_ip', 'backup_remote_lan_ip' and 'backup_local_lan_ip' " +
+		return attributeErrorf("remote_lan_ip", "'remote_lan_ip', 'local_lan_ip', 'backup_remote_lan_ip' and 'backup_local_lan_ip' "+
 			"can only be set with 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.RemoteLanIP == "" && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_lan_ip' is required when 'tunnel_protocol' = 'LAN'")
+		return attributeErrorf("remote_lan_ip", "'remote_lan_ip' is required when 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.RemoteGatewayIP == "" && externalDeviceConn.TunnelProtocol != "LAN" {
-		return fmt.Errorf("'remote_gateway_ip' is required when 'tunnel_protocol' != 'LAN'")
+		return attributeErrorf("remote_gateway_ip", "'remote_gateway_ip' is required when 'tunnel_protocol' != 'LAN'")
 	}
 
 	bgpLocalAsNum, err := strconv.Atoi(d.Get("bgp_local_as_num").(string))
@@ -487,9 +487,9 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	if externalDeviceConn.ConnectionType == "bgp" && externalDeviceConn.RemoteSubnet != "" {
-		return fmt.Errorf("'remote_subnet' is needed for connection type of 'static' not 'bgp'")
+		return attributeErrorf("remote_subnet", "'remote_subnet' is needed for connection type of 'static' not 'bgp'")
 	} else if externalDeviceConn.ConnectionType == "static" && (externalDeviceConn.BgpLocalAsNum != 0 || externalDeviceConn.BgpRemoteAsNum != 0) {
-		return fmt.Errorf("'bgp_local_as_num' and 'bgp_remote_as_num' are needed for connection type of 'bgp' not 'static'")
+		return attributeErrorf("bgp_local_as_num", "'bgp_local_as_num' and 'bgp_remote_as_num' are needed for connection type of 'bgp' not 'static'")
 	}
 
 	customAlgorithms := d.Get("custom_algorithms").(bool)
@@ -500,47 +500,47 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			externalDeviceConn.Phase2DhGroups == "" ||
 			externalDeviceConn.Phase1Encryption == "" ||
 			externalDeviceConn.Phase2Encryption == "" {
-			return fmt.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is enabled, please set all of the algorithm parameters")
 		} else if externalDeviceConn.Phase1Auth == goaviatrix.Phase1AuthDefault &&
 			externalDeviceConn.Phase2Auth == goaviatrix.Phase2AuthDefault &&
 			externalDeviceConn.Phase1DhGroups == goaviatrix.Phase1DhGroupDefault &&
 			externalDeviceConn.Phase2DhGroups == goaviatrix.Phase2DhGroupDefault &&
 			externalDeviceConn.Phase1Encryption == goaviatrix.Phase1EncryptionDefault &&
 			externalDeviceConn.Phase2Encryption == goaviatrix.Phase2EncryptionDefault {
-			return fmt.Errorf("custom_algorithms is enabled, cannot use default values for " +
+			return attributeErrorf("custom_algorithms", "custom_algorithms is enabled, cannot use default values for "+
 				"all six algorithm parameters. Please change the value of at least one of the six algorithm parameters")
 		}
 	} else {
 		if externalDeviceConn.Phase1Auth != "" || externalDeviceConn.Phase1DhGroups != "" ||
 			externalDeviceConn.Phase1Encryption != "" || externalDeviceConn.Phase2Auth != "" ||
 			externalDeviceConn.Phase2DhGroups != "" || externalDeviceConn.Phase2Encryption != "" {
-			return fmt.Errorf("custom_algorithms is not enabled, all algorithm fields should be left empty")
+			return attributeErrorf("custom_algorithms", "custom_algorithms is not enabled, all algorithm fields should be left empty")
 		}
 	}
 
 	if haEnabled {
 		if externalDeviceConn.TunnelProtocol == "LAN" {
 			if externalDeviceConn.BackupRemoteLanIP == "" {
-				return fmt.Errorf("ha is enabled and 'tunnel_protocol' = 'LAN', please specify 'backup_remote_lan_ip'")
+				return diag.Errorf("ha is enabled and 'tunnel_protocol' = 'LAN', please specify 'backup_remote_lan_ip'")
 			}
 		} else {
 			if externalDeviceConn.BackupRemoteGatewayIP == "" {
-				return fmt.Errorf("ha is enabled, please specify 'backup_remote_gateway_ip'")
+				return diag.Errorf("ha is enabled, please specify 'backup_remote_gateway_ip'")
 			}
 			remoteIP := strings.Split(externalDeviceConn.RemoteGatewayIP, ",")
 			if len(remoteIP) > 1 {
-				return fmt.Errorf("expected 'remote_gateway_ip' to contain only one valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
+				return diag.Errorf("expected 'remote_gateway_ip' to contain only one valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
 			}
 			ip := net.ParseIP(externalDeviceConn.RemoteGatewayIP)
 			if four := ip.To4(); four == nil {
-				return fmt.Errorf("expected 'remote_gateway_ip' to contain a valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
+				return diag.Errorf("expected 'remote_gateway_ip' to contain a valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
 			}
 			if externalDeviceConn.BackupRemoteGatewayIP == externalDeviceConn.RemoteGatewayIP {
-				return fmt.Errorf("expected 'backup_remote_gateway_ip' to contain a different valid IPv4 address than 'remote_gateway_ip'")
+				return diag.Errorf("expected 'backup_remote_gateway_ip' to contain a different valid IPv4 address than 'remote_gateway_ip'")
 			}
 		}
 		if externalDeviceConn.BackupBgpRemoteAsNum == 0 && externalDeviceConn.ConnectionType == "bgp" {
-			return fmt.Errorf("ha is enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num'")
+			return diag.Errorf("ha is enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num'")
 		}
 		if externalDeviceConn.BackupRemoteGatewayIP != "" {
 			externalDeviceConn.RemoteGatewayIP = externalDeviceConn.RemoteGatewayIP + "," + externalDeviceConn.BackupRemoteGatewayIP
@@ -548,31 +548,31 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		}
 	} else {
 		if backupDirectConnect {
-			return fmt.Errorf("ha is not enabled, please set 'backup_direct_connect' to false")
+			return diag.Errorf("ha is not enabled, please set 'backup_direct_connect' to false")
 		}
 		if externalDeviceConn.BackupPreSharedKey != "" || externalDeviceConn.BackupLocalTunnelCidr != "" ||
 			externalDeviceConn.BackupRemoteTunnelCidr != "" || externalDeviceConn.BackupRemoteGatewayIP != "" ||
 			externalDeviceConn.BackupRemoteLanIP != "" || externalDeviceConn.BackupLocalLanIP != "" {
-			return fmt.Errorf("ha is not enabled, please set 'backup_pre_shared_key', 'backup_local_tunnel_cidr', " +
+			return diag.Errorf("ha is not enabled, please set 'backup_pre_shared_key', 'backup_local_tunnel_cidr', " +
 				"'backup_remote_gateway_ip', 'backup_remote_tunnel_cidr', 'backup_remote_lan_ip' and 'backup_local_lan_ip' to empty")
 		}
 		if externalDeviceConn.BackupBgpRemoteAsNum != 0 && externalDeviceConn.ConnectionType == "bgp" {
-			return fmt.Errorf("ha is not enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num' to empty")
+			return diag.Errorf("ha is not enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num' to empty")
 		}
 	}
 
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if externalDeviceConn.ConnectionType != "bgp" && enableLearnedCIDRApproval {
-		return fmt.Errorf("'connection_type' must be 'bgp' if 'enable_learned_cidrs_approval' is set to true")
+		return attributeErrorf("connection_type", "'connection_type' must be 'bgp' if 'enable_learned_cidrs_approval' is set to true")
 	}
 	manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 	if externalDeviceConn.ConnectionType != "bgp" && len(manualBGPCidrs) != 0 {
-		return fmt.Errorf("'connection_type' must be 'bgp' if 'manual_bgp_advertised_cidrs' is not empty")
+		return attributeErrorf("connection_type", "'connection_type' must be 'bgp' if 'manual_bgp_advertised_cidrs' is not empty")
 	}
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
-		return fmt.Errorf("creating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("creating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	enableIkev2 := d.Get("enable_ikev2").(bool)
@@ -581,57 +581,57 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	if externalDeviceConn.ConnectionType != "bgp" && externalDeviceConn.TunnelProtocol != "IPsec" {
-		return fmt.Errorf("'tunnel_protocol' can not be set unless 'connection_type' is 'bgp'")
+		return attributeErrorf("tunnel_protocol", "'tunnel_protocol' can not be set unless 'connection_type' is 'bgp'")
 	}
 	greOrLan := externalDeviceConn.TunnelProtocol == "GRE" || externalDeviceConn.TunnelProtocol == "LAN"
 	if greOrLan && customAlgorithms {
-		return fmt.Errorf("custom algorithm paramters are not valid with 'tunnel_protocol' = GRE or LAN")
+		return diag.Errorf("custom algorithm paramters are not valid with 'tunnel_protocol' = GRE or LAN")
 	}
 	if greOrLan && enableIkev2 {
-		return fmt.Errorf("enable_ikev2 is not supported with 'tunnel_protocol' = GRE or LAN")
+		return attributeErrorf("enable_ikev2", "enable_ikev2 is not supported with 'tunnel_protocol' = GRE or LAN")
 	}
 	if greOrLan && externalDeviceConn.PreSharedKey != "" {
-		return fmt.Errorf("'pre_shared_key' is not valid with 'tunnel_protocol' = GRE or LAN")
+		return attributeErrorf("pre_shared_key", "'pre_shared_key' is not valid with 'tunnel_protocol' = GRE or LAN")
 	}
 	if externalDeviceConn.PeerVnetId != "" && (externalDeviceConn.ConnectionType != "bgp" || externalDeviceConn.TunnelProtocol != "LAN") {
-		return fmt.Errorf("'remote_vpc_name' is only valid for 'connection_type' = 'bgp' and 'tunnel_protocol' = 'LAN'")
+		return attributeErrorf("remote_vpc_name", "'remote_vpc_name' is only valid for 'connection_type' = 'bgp' and 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.TunnelProtocol == "LAN" {
 		if externalDeviceConn.DirectConnect == "true" || externalDeviceConn.BackupDirectConnect == "true" {
-			return fmt.Errorf("enabling 'direct_connect' or 'backup_direct_connect' is not allowed for BGP over LAN connections")
+			return diag.Errorf("enabling 'direct_connect' or 'backup_direct_connect' is not allowed for BGP over LAN connections")
 		}
 	}
 
 	phase1RemoteIdentifier := d.Get("phase1_remote_identifier").([]interface{})
 	ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 	if haEnabled && len(phase1RemoteIdentifier) != 0 && len(phase1RemoteIdentifier) != 2 {
-		return fmt.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled")
+		return diag.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled")
 	} else if !haEnabled && len(phase1RemoteIdentifier) > 1 {
-		return fmt.Errorf("please either set one phase 1 remote ID or none, when HA is disabled")
+		return diag.Errorf("please either set one phase 1 remote ID or none, when HA is disabled")
 	}
 
 	if _, ok := d.GetOk("prepend_as_path"); ok {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("'prepend_as_path' only supports 'bgp' connection. Please update 'connection_type' to 'bgp'")
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' only supports 'bgp' connection. Please update 'connection_type' to 'bgp'")
 		}
 	}
 
 	if d.Get("enable_bgp_lan_activemesh").(bool) {
 		if externalDeviceConn.ConnectionType != "bgp" || externalDeviceConn.TunnelProtocol != "LAN" {
-			return fmt.Errorf("'enable_bgp_lan_activemesh' only supports 'bgp' connection with 'LAN' tunnel protocol")
+			return attributeErrorf("enable_bgp_lan_activemesh", "'enable_bgp_lan_activemesh' only supports 'bgp' connection with 'LAN' tunnel protocol")
 		}
 		if externalDeviceConn.HAEnabled != "true" {
-			return fmt.Errorf("'enable_bgp_lan_activemesh' can only be enabled with Remote Gateway HA enabled")
+			return attributeErrorf("enable_bgp_lan_activemesh", "'enable_bgp_lan_activemesh' can only be enabled with Remote Gateway HA enabled")
 		}
 		externalDeviceConn.EnableBgpLanActiveMesh = true
 	}
 
 	if externalDeviceConn.BgpMd5Key != "" || externalDeviceConn.BackupBgpMd5Key != "" {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("BGP MD5 authentication key is only supported for BGP connection")
+			return diag.Errorf("BGP MD5 authentication key is only supported for BGP connection")
 		}
 		if externalDeviceConn.BackupBgpMd5Key != "" && !haEnabled {
-			return fmt.Errorf("couldn't configure backup BGP MD5 authentication key since HA is not enabled for BGP connection: %s", externalDeviceConn.ConnectionName)
+			return diag.Errorf("couldn't configure backup BGP MD5 authentication key since HA is not enabled for BGP connection: %s", externalDeviceConn.ConnectionName)
 		}
 
 		if externalDeviceConn.BgpMd5Key != "" {
@@ -643,7 +643,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 				bgpRemoteIp = strings.Split(externalDeviceConn.RemoteTunnelCidr, ",")
 			}
 			if len(md5KeyList) != len(bgpRemoteIp) {
-				return fmt.Errorf("can't apply BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
+				return diag.Errorf("can't apply BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
 			}
 		}
 
@@ -656,7 +656,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 				backupBgpRemoteIp = strings.Split(externalDeviceConn.BackupRemoteTunnelCidr, ",")
 			}
 			if len(backupMd5KeyList) != len(backupBgpRemoteIp) {
-				return fmt.Errorf("can't apply Backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
+				return diag.Errorf("can't apply Backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
 			}
 		}
 	}
@@ -664,7 +664,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	enableJumboFrame := d.Get("enable_jumbo_frame").(bool)
 	if enableJumboFrame {
 		if externalDeviceConn.ConnectionType != "bgp" || strings.ToUpper(externalDeviceConn.TunnelProtocol) != "GRE" {
-			return fmt.Errorf("jumbo frame is only supported on GRE tunnels under bgp connection")
+			return diag.Errorf("jumbo frame is only supported on GRE tunnels under bgp connection")
 		}
 	}
 
@@ -674,7 +674,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 
 	d.SetId(externalDeviceConn.ConnectionName + "~" + externalDeviceConn.VpcID)
 	flag := false
-	defer resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 
 	try, maxTries, backoff := 0, 8, 1000*time.Millisecond
 	for {
@@ -683,47 +683,47 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		if err != nil {
 			if strings.Contains(err.Error(), "is not up") {
 				if try == maxTries {
-					return fmt.Errorf("couldn't create Aviatrix transit external device connection: %s", err)
+					return diag.Errorf("couldn't create Aviatrix transit external device connection: %s", err)
 				}
 				time.Sleep(backoff)
 				// Double the backoff time after each failed try
 				backoff *= 2
 				continue
 			}
-			return fmt.Errorf("failed to create Aviatrix transit external device connection: %s", err)
+			return diag.Errorf("failed to create Aviatrix transit external device connection: %s", err)
 		}
 		break
 	}
 
 	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: externalDeviceConn.GwName})
 	if err != nil {
-		return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+		return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 	}
 	if d.Get("switch_to_ha_standby_gateway").(bool) && !transitAdvancedConfig.ActiveStandbyEnabled {
-		return fmt.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
+		return diag.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
 			"the transit gateway. Please enable Active-Standby Mode on the transit gateway and try again")
 	}
 
 	if d.Get("switch_to_ha_standby_gateway").(bool) {
 		if err := client.SwitchActiveTransitGateway(externalDeviceConn.GwName, externalDeviceConn.ConnectionName); err != nil {
-			return fmt.Errorf("could not switch active transit gateway to HA: %v", err)
+			return diag.Errorf("could not switch active transit gateway to HA: %v", err)
 		}
 	}
 
 	if d.Get("enable_event_triggered_ha").(bool) {
 		if err := client.EnableSite2CloudEventTriggeredHA(externalDeviceConn.VpcID, externalDeviceConn.ConnectionName); err != nil {
-			return fmt.Errorf("could not enable event triggered HA for external device conn after create: %v", err)
+			return diag.Errorf("could not enable event triggered HA for external device conn after create: %v", err)
 		}
 	}
 
 	if enableJumboFrame {
 		if err := client.EnableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-			return fmt.Errorf("could not enable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
+			return diag.Errorf("could not enable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
 		}
 	} else {
 		if externalDeviceConn.ConnectionType == "bgp" && strings.ToUpper(externalDeviceConn.TunnelProtocol) == "GRE" {
 			if err := client.DisableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("could not disable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
+				return diag.Errorf("could not disable jumbo frame for external device conn: %v after create: %v", externalDeviceConn.ConnectionName, err)
 			}
 		}
 	}
@@ -731,12 +731,12 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	if enableLearnedCIDRApproval {
 		err = client.EnableTransitConnectionLearnedCIDRApproval(externalDeviceConn.GwName, externalDeviceConn.ConnectionName)
 		if err != nil {
-			return fmt.Errorf("could not enable learned cidr approval: %v", err)
+			return diag.Errorf("could not enable learned cidr approval: %v", err)
 		}
 		if len(approvedCidrs) > 0 {
 			err = client.UpdateTransitConnectionPendingApprovedCidrs(externalDeviceConn.GwName, externalDeviceConn.ConnectionName, approvedCidrs)
 			if err != nil {
-				return fmt.Errorf("could not update transit external device conn approved cidrs after creation: %v", err)
+				return diag.Errorf("could not update transit external device conn approved cidrs after creation: %v", err)
 			}
 		}
 	}
@@ -744,7 +744,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	if len(manualBGPCidrs) > 0 {
 		err = client.EditTransitConnectionBGPManualAdvertiseCIDRs(externalDeviceConn.GwName, externalDeviceConn.ConnectionName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertised BGP cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertised BGP cidrs: %v", err)
 		}
 	}
 
@@ -766,7 +766,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -792,7 +792,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -804,7 +804,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 
 		err = client.EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
@@ -817,33 +817,33 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			s2c.Phase1LocalIdentifier = "private_ip"
 			err = client.EditSite2CloudPhase1LocalIdentifier(s2c)
 			if err != nil {
-				return fmt.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
+				return diag.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitExternalDeviceConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
+		return resourceAviatrixTransitExternalDeviceConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName := d.Get("connection_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 	if connectionName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no 'connection_name' or 'vpc_id' received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no 'connection_name' or 'vpc_id' received", map[string]interface{}{"import_id": id})
 		parts := strings.SplitN(id, "~", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("expected import ID in the form 'connection_name~vpc_id' instead got %q", id)
+			return diag.Errorf("expected import ID in the form 'connection_name~vpc_id' instead got %q", id)
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
@@ -856,14 +856,14 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 	}
 
 	conn, err := client.GetExternalDeviceConnDetail(externalDeviceConn)
-	log.Printf("[TRACE] Reading Aviatrix external device conn: %s : %#v", d.Get("connection_name").(string), externalDeviceConn)
+	logTrace(ctx, "Reading Aviatrix external device conn", map[string]interface{}{"connection_name": d.Get("connection_name").(string), "gw_name": externalDeviceConn.GwName, "vpc_id": externalDeviceConn.VpcID})
 
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix external device conn: %s, %#v", err, externalDeviceConn)
+		return diag.Errorf("couldn't find Aviatrix external device conn: %s, %#v", err, externalDeviceConn)
 	}
 
 	if conn != nil {
@@ -945,7 +945,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 
 		transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: externalDeviceConn.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+			return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 		}
 
 		activeGatewayType := "Primary"
@@ -962,7 +962,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 				d.Set("enable_learned_cidrs_approval", v.EnabledApproval == "yes")
 				err := d.Set("approved_cidrs", v.ApprovedLearnedCidrs)
 				if err != nil {
-					return fmt.Errorf("could not set 'approved_cidrs' in state: %v", err)
+					return diag.Errorf("could not set 'approved_cidrs' in state: %v", err)
 				}
 				break
 			}
@@ -979,7 +979,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 		}
 
 		if err := d.Set("manual_bgp_advertised_cidrs", conn.ManualBGPCidrs); err != nil {
-			return fmt.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
+			return diag.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
 		}
 		if conn.TunnelProtocol == "" {
 			d.Set("tunnel_protocol", "IPsec")
@@ -1013,7 +1013,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 
 			err = d.Set("prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set value for prepend_as_path: %v", err)
+				return diag.Errorf("could not set value for prepend_as_path: %v", err)
 			}
 		}
 	}
@@ -1022,30 +1022,30 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
-		return fmt.Errorf("updating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("updating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	gwName := d.Get("gw_name").(string)
 	connName := d.Get("connection_name").(string)
 	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: gwName})
 	if err != nil {
-		return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+		return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 	}
 	if d.Get("switch_to_ha_standby_gateway").(bool) && !transitAdvancedConfig.ActiveStandbyEnabled {
-		return fmt.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
+		return diag.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
 			"the transit gateway. Please enable Active-Standby Mode on the transit gateway and try again")
 	}
 
 	if d.HasChange("switch_to_ha_standby_gateway") {
 		if err := client.SwitchActiveTransitGateway(gwName, connName); err != nil {
-			return fmt.Errorf("could not switch active transit gateway: %v", err)
+			return diag.Errorf("could not switch active transit gateway: %v", err)
 		}
 	}
 
@@ -1054,12 +1054,12 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		if enableLearnedCIDRApproval {
 			err = client.EnableTransitConnectionLearnedCIDRApproval(gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable learned cidr approval: %v", err)
+				return diag.Errorf("could not enable learned cidr approval: %v", err)
 			}
 		} else {
 			err = client.DisableTransitConnectionLearnedCIDRApproval(gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable learned cidr approval: %v", err)
+				return diag.Errorf("could not disable learned cidr approval: %v", err)
 			}
 		}
 	}
@@ -1068,7 +1068,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 		err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertise manual cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertise manual cidrs: %v", err)
 		}
 	}
 
@@ -1077,12 +1077,12 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		if d.Get("enable_event_triggered_ha").(bool) {
 			err := client.EnableSite2CloudEventTriggeredHA(vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable event triggered HA for external device conn during update: %v", err)
+				return diag.Errorf("could not enable event triggered HA for external device conn during update: %v", err)
 			}
 		} else {
 			err := client.DisableSite2CloudEventTriggeredHA(vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable event triggered HA for external device conn during update: %v", err)
+				return diag.Errorf("could not disable event triggered HA for external device conn during update: %v", err)
 			}
 		}
 	}
@@ -1098,14 +1098,14 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 		if externalDeviceConn.EnableJumboFrame {
 			if externalDeviceConn.ConnectionType != "bgp" || strings.ToUpper(externalDeviceConn.TunnelProtocol) != "GRE" {
-				return fmt.Errorf("jumbo frame is only supported on GRE tunnels under BGP connection")
+				return diag.Errorf("jumbo frame is only supported on GRE tunnels under BGP connection")
 			}
 			if err := client.EnableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("could not enable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
+				return diag.Errorf("could not enable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
 			}
 		} else {
 			if err := client.DisableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("could not disable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
+				return diag.Errorf("could not disable jumbo frame for external device conn: %v during update: %v", externalDeviceConn.ConnectionName, err)
 			}
 		}
 	}
@@ -1113,7 +1113,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 	if d.HasChange("approved_cidrs") {
 		err := client.UpdateTransitConnectionPendingApprovedCidrs(gwName, connName, approvedCidrs)
 		if err != nil {
-			return fmt.Errorf("could not update transit external device conn learned cidrs during update: %v", err)
+			return diag.Errorf("could not update transit external device conn learned cidrs during update: %v", err)
 		}
 	}
 
@@ -1128,9 +1128,9 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		phase1RemoteIdentifier := d.Get("phase1_remote_identifier").([]interface{})
 		ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 		if haEnabled && len(phase1RemoteIdentifier) != 2 {
-			return fmt.Errorf("please either set two phase 1 remote IDs, when HA is enabled")
+			return diag.Errorf("please either set two phase 1 remote IDs, when HA is enabled")
 		} else if !haEnabled && len(phase1RemoteIdentifier) != 1 {
-			return fmt.Errorf("please set one phase 1 remote ID, when HA is disabled")
+			return diag.Errorf("please set one phase 1 remote ID, when HA is disabled")
 		}
 
 		var ph1RemoteId string
@@ -1159,7 +1159,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 
 		err = client.UpdateSite2Cloud(editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1170,7 +1170,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			ConnectionType: d.Get("connection_type").(string),
 		}
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("'prepend_as_path' only supports 'bgp' connection. Can't update 'prepend_as_path' for '%s' connection", externalDeviceConn.ConnectionType)
+			return attributeErrorf("prepend_as_path", "'prepend_as_path' only supports 'bgp' connection. Can't update 'prepend_as_path' for '%s' connection", externalDeviceConn.ConnectionType)
 		}
 
 		var prependASPath []string
@@ -1179,7 +1179,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 		err = client.EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path: %v", err)
+			return diag.Errorf("could not update prepend_as_path: %v", err)
 		}
 	}
 
@@ -1188,13 +1188,13 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		remoteSubnet := d.Get("remote_subnet").(string)
 		err = client.EditTransitConnectionRemoteSubnet(vpcID, connName, remoteSubnet)
 		if err != nil {
-			return fmt.Errorf("could not update transit external device conn remote subnet: %v", err)
+			return diag.Errorf("could not update transit external device conn remote subnet: %v", err)
 		}
 	}
 
 	if d.HasChange("bgp_md5_key") {
 		if d.Get("connection_type").(string) != "bgp" {
-			return fmt.Errorf("can't update BGP MD5 authentication key since it is only supported for BGP connection")
+			return diag.Errorf("can't update BGP MD5 authentication key since it is only supported for BGP connection")
 		}
 
 		oldKey, newKey := d.GetChange("bgp_md5_key")
@@ -1207,7 +1207,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			bgpRemoteIp = strings.Split(d.Get("remote_tunnel_cidr").(string), ",")
 		}
 		if newKey.(string) != "" && len(newKeyList) != len(bgpRemoteIp) {
-			return fmt.Errorf("can't update BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
+			return diag.Errorf("can't update BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
 		}
 		for i, v := range bgpRemoteIp {
 			bgpMd5Key := ""
@@ -1225,17 +1225,17 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			}
 			err := client.EditBgpMd5Key(editBgpMd5Key)
 			if err != nil {
-				return fmt.Errorf("failed to update BGP MD5 authentication key: %v", err)
+				return diag.Errorf("failed to update BGP MD5 authentication key: %v", err)
 			}
 		}
 	}
 
 	if d.HasChange("backup_bgp_md5_key") {
 		if d.Get("connection_type").(string) != "bgp" {
-			return fmt.Errorf("can't update backup BGP MD5 authentication key since it is only supported for BGP connection")
+			return diag.Errorf("can't update backup BGP MD5 authentication key since it is only supported for BGP connection")
 		}
 		if !d.Get("ha_enabled").(bool) {
-			return fmt.Errorf("can't update BGP backup MD5 authentication key since ha is not enabled")
+			return diag.Errorf("can't update BGP backup MD5 authentication key since ha is not enabled")
 		}
 
 		oldKey, newKey := d.GetChange("backup_bgp_md5_key")
@@ -1248,7 +1248,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			bgpRemoteIp = strings.Split(d.Get("backup_remote_tunnel_cidr").(string), ",")
 		}
 		if newKey.(string) != "" && len(newKeyList) != len(bgpRemoteIp) {
-			return fmt.Errorf("can't update backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
+			return diag.Errorf("can't update backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
 		}
 		for i, v := range bgpRemoteIp {
 			bgpMd5Key := ""
@@ -1266,7 +1266,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			}
 			err := client.EditBgpMd5Key(editBgpMd5Key)
 			if err != nil {
-				return fmt.Errorf("failed to update backup BGP MD5 authentication key: %v", err)
+				return diag.Errorf("failed to update backup BGP MD5 authentication key: %v", err)
 			}
 		}
 	}
@@ -1279,28 +1279,28 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 		err := client.EditSite2CloudPhase1LocalIdentifier(s2c)
 		if err != nil {
-			return fmt.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
+			return diag.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
+	return resourceAviatrixTransitExternalDeviceConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitExternalDeviceConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 		VpcID:          d.Get("vpc_id").(string),
 		ConnectionName: d.Get("connection_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix external device connection: %#v", externalDeviceConn)
+	logInfo(ctx, "Deleting Aviatrix external device connection", map[string]interface{}{"gw_name": externalDeviceConn.GwName, "connection_name": externalDeviceConn.ConnectionName, "vpc_id": externalDeviceConn.VpcID})
 
 	err := client.DeleteExternalDeviceConn(externalDeviceConn)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix external device connection: %s", err)
+		return diag.Errorf("failed to delete Aviatrix external device connection: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixTransitFireNetPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitFireNetPolicyCreate,
-		Read:   resourceAviatrixTransitFireNetPolicyRead,
-		Delete: resourceAviatrixTransitFireNetPolicyDelete,
+		CreateContext: resourceAviatrixTransitFireNetPolicyCreate,
+		ReadContext:   resourceAviatrixTransitFireNetPolicyRead,
+		DeleteContext: resourceAviatrixTransitFireNetPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,45 +35,45 @@ func resourceAviatrixTransitFireNetPolicy() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitFireNetPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitFireNetPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	transitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
 		TransitFireNetGatewayName: d.Get("transit_firenet_gateway_name").(string),
 		InspectedResourceName:     d.Get("inspected_resource_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix transit firenet policy: %#v", transitFireNetPolicy)
+	logInfo(ctx, "Creating Aviatrix transit firenet policy", map[string]interface{}{"transit_firenet_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName, "inspected_resource_name": transitFireNetPolicy.InspectedResourceName})
 
 	d.SetId(transitFireNetPolicy.TransitFireNetGatewayName + "~" + transitFireNetPolicy.InspectedResourceName)
 	flag := false
-	defer resourceAviatrixTransitFireNetPolicyReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateTransitFireNetPolicy(transitFireNetPolicy)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix transit firenet Policy: %s", err)
+		return diag.Errorf("failed to create Aviatrix transit firenet Policy: %s", err)
 	}
 
-	return resourceAviatrixTransitFireNetPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitFireNetPolicyReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitFireNetPolicyRead(d, meta)
+		return resourceAviatrixTransitFireNetPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitFireNetPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	transitFireNetGatewayName := d.Get("transit_firenet_gateway_name").(string)
 	inspectedResourceName := d.Get("inspected_resource_name").(string)
 
 	if transitFireNetGatewayName == "" || inspectedResourceName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit firenet name or inspected resource name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no transit firenet name or inspected resource name received", map[string]interface{}{"import_id": id})
 		d.Set("transit_firenet_gateway_name", strings.Split(id, "~")[0])
 		d.Set("inspected_resource_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -90,26 +90,26 @@ func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix transit gateway policy: %s", err)
+		return diag.Errorf("couldn't find Aviatrix transit gateway policy: %s", err)
 	}
 
 	d.SetId(transitFireNetPolicy.TransitFireNetGatewayName + "~" + transitFireNetPolicy.InspectedResourceName)
 	return nil
 }
 
-func resourceAviatrixTransitFireNetPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitFireNetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.SecurityClient)
 
 	transitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
 		TransitFireNetGatewayName: d.Get("transit_firenet_gateway_name").(string),
 		InspectedResourceName:     d.Get("inspected_resource_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix transit firenet policy %#v", transitFireNetPolicy)
+	logInfo(ctx, "Deleting Aviatrix transit firenet policy", map[string]interface{}{"transit_firenet_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName, "inspected_resource_name": transitFireNetPolicy.InspectedResourceName})
 
 	err := client.DeleteTransitFireNetPolicy(transitFireNetPolicy)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix transit firenet policy: %s", err)
+		return diag.Errorf("failed to delete Aviatrix transit firenet policy: %s", err)
 	}
 
 	return nil
@@ -1,276 +0,0 @@
-package test
-
-import (
-    "context"
-    "fmt"
-    "os"
-    "testing"
-
-    "github.com/Azure/go-autorest/autorest/azure/auth"
-    "github.com/gruntwork-io/terratest/modules/random"
-    "github.com/gruntwork-io/terratest/modules/terraform"
-)
-
-func TestTransitFireNetPolicy(t *testing.T) {
-    t.Parallel()
-
-    skipAcc := os.Getenv("SKIP_TRANSIT_FIRENET_POLICY")
-    if skipAcc == "yes" {
-        t.Skip("Skipping transit firenet policy tests as 'SKIP_TRANSIT_FIRENET_POLICY' is set")
-    }
-
-    skipAccAWS := os.Getenv("SKIP_TRANSIT_FIRENET_POLICY_AWS")
-    skipAccAZURE := os.Getenv("SKIP_TRANSIT_FIRENET_POLICY_AZURE")
-    if skipAcc == "yes" && skipAccAWS == "yes" && skipAccAZURE == "yes" {
-        t.Skip("Skipping transit firenet policy tests as 'SKIP_TRANSIT_FIRENET_POLICY_AWS' and 'SKIP_TRANSIT_FIRENET_POLICY_AZURE' are all set")
-    }
-
-    var terraformOptions *terraform.Options
-
-    if skipAccAWS != "yes" {
-        terraformOptions = configureTransitFireNetPolicy(t, "aws")
-        defer terraform.Destroy(t, terraformOptions)
-
-        terraform.InitAndApply(t, terraformOptions)
-
-        validateTransitFireNetPolicy(t, terraformOptions)
-    } else {
-        t.Log("Skipping transit firenet policy tests in AWS as 'SKIP_TRANSIT_FIRENET_POLICY_AWS' is set")
-    }
-
-    if skipAccAZURE != "yes" {
-        terraformOptions = configureTransitFireNetPolicy(t, "azure")
-        defer terraform.Destroy(t, terraformOptions)
-
-        terraform.InitAndApply(t, terraformOptions)
-
-        validateTransitFireNetPolicy(t, terraformOptions)
-    } else {
-        t.Log("Skipping transit firenet policy tests in Azure as 'SKIP_TRANSIT_FIRENET_POLICY_AZURE' is set")
-    }
-}
-
-func configureTransitFireNetPolicy(t *testing.T, cloudType string) *terraform.Options {
-    uniqueID := random.UniqueId()
-
-    terraformOptions := &terraform.Options{
-        TerraformDir: "../path/to/terraform/module",
-        Vars: map[string]interface{}{
-            "account_name": fmt.Sprintf("tfa-%s", uniqueID),
-            "cloud_type":   cloudType,
-        },
-    }
-
-    if cloudType == "aws" {
-        subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
-        tenantID := os.Getenv("ARM_TENANT_ID")
-        clientID := os.Getenv("ARM_CLIENT_ID")
-        clientSecret := os.Getenv("ARM_CLIENT_SECRET")
-        region := os.Getenv("AWS_REGION")
-        vpcID := os.Getenv("AWS_VPC_ID")
-        subnetID := os.Getenv("AWS_SUBNET")
-
-        terraformOptions.Vars["aws_account_number"] = os.Getenv("AWS_ACCOUNT_NUMBER")
-        terraformOptions.Vars["aws_iam"] = false
-        terraformOptions.Vars["aws_access_key"] = os.Getenv("AWS_ACCESS_KEY")
-        terraformOptions.Vars["aws_secret_key"] = os.Getenv("AWS_SECRET_KEY")
-        terraformOptions.Vars["gw_name"] = fmt.Sprintf("tfg-%s", uniqueID)
-        terraformOptions.Vars["vpc_id"] = vpcID
-        terraformOptions.Vars["vpc_reg"] = region
-        terraformOptions.Vars["gw_size"] = "c5.xlarge"
-        terraformOptions
-
-
-	if skipAccAWS != "yes" {
-		resourceName := "aviatrix_transit_firenet_policy.test"
-		msgCommonAws := ". Set 'SKIP_TRANSIT_FIRENET_POLICY_AWS' to 'yes' to skip transit firenet policy tests in AWS"
-		resource.Test(t, resource.TestCase{
-			PreCheck: func() {
-				testAccPreCheck(t)
-				preGatewayCheck(t, msgCommonAws)
-				preGateway2Check(t, msgCommonAws)
-			},
-			Providers:    testAccProviders,
-			CheckDestroy: testAccCheckTransitFireNetPolicyDestroy,
-			Steps: []resource.TestStep{
-				{
-					Config: testAccTransitFireNetPolicyConfigBasicAWS(rName),
-					Check: resource.ComposeTestCheckFunc(
-						testAccCheckTransitFireNetPolicyExists(resourceName, &transitFireNetPolicy),
-						resource.TestCheckResourceAttr(resourceName, "transit_firenet_gateway_name", fmt.Sprintf("tfg-%s", rName)),
-						resource.TestCheckResourceAttr(resourceName, "inspected_resource_name", fmt.Sprintf("SPOKE:tfg-aws-%s", rName)),
-					),
-				},
-				{
-					ResourceName:      resourceName,
-					ImportState:       true,
-					ImportStateVerify: true,
-				},
-			},
-		})
-	} else {
-		t.Log("Skipping transit firenet policy tests in AWS as 'SKIP_TRANSIT_FIRENET_POLICY_AWS' is set")
-	}
-
-	if skipAccAZURE != "yes" {
-		resourceName := "aviatrix_transit_firenet_policy.test"
-		msgCommonAZURE := ". Set 'SKIP_TRANSIT_FIRENET_POLICY_AZURE' to 'yes' to skip transit firenet policy tests in Azure"
-		resource.Test(t, resource.TestCase{
-			PreCheck: func() {
-				testAccPreCheck(t)
-				preGatewayCheckAZURE(t, msgCommonAZURE)
-				preGateway2CheckAZURE(t, msgCommonAZURE)
-			},
-			Providers:    testAccProviders,
-			CheckDestroy: testAccCheckTransitFireNetPolicyDestroy,
-			Steps: []resource.TestStep{
-				{
-					Config: testAccTransitFireNetPolicyConfigBasicAZURE(rName),
-					Check: resource.ComposeTestCheckFunc(
-						testAccCheckTransitFireNetPolicyExists(resourceName, &transitFireNetPolicy),
-						resource.TestCheckResourceAttr(resourceName, "transit_firenet_gateway_name", fmt.Sprintf("tfg-%s", rName)),
-						resource.TestCheckResourceAttr(resourceName, "inspected_resource_name", fmt.Sprintf("SPOKE:tfg-azure-%s", rName)),
-					),
-				},
-				{
-					ResourceName:      resourceName,
-					ImportState:       true,
-					ImportStateVerify: true,
-				},
-			},
-		})
-	} else {
-		t.Log("Skipping transit firenet policy tests in Azure as 'SKIP_TRANSIT_FIRENET_POLICY_AZURE' is set")
-	}
-}
-
-func testAccTransitFireNetPolicyConfigBasicAWS(rName string) string {
-	return fmt.Sprintf(`
-resource "aviatrix_account" "test_account" {
-	account_name       = "tfa-%s"
-	cloud_type         = 1
-	aws_account_number = "%s"
-	aws_iam            = false
-	aws_access_key     = "%s"
-	aws_secret_key     = "%s"
-}
-resource "aviatrix_transit_gateway" "test_transit_aws" {
-	cloud_type             = 1
-	account_name           = aviatrix_account.test_account.account_name
-	gw_name                = "tfg-%s"
-	vpc_id                 = "%s"
-	vpc_reg                = "%s"
-	gw_size                = "c5.xlarge"
-	subnet                 = "%s"
-	connected_transit      = true 
-	enable_transit_firenet = true
-}
-resource "aviatrix_spoke_gateway" "test_spoke_aws" {
-	cloud_type   = 1
-	account_name = aviatrix_account.test_account.account_name
-	gw_name      = "tfg-aws-%s"
-	vpc_id       = "%s"
-	vpc_reg      = "%s"
-	gw_size      = "t2.micro"
-	subnet       = "%s"
-	transit_gw   = aviatrix_transit_gateway.test_transit_aws.gw_name
-}
-resource "aviatrix_transit_firenet_policy" "test" {
-	transit_firenet_gateway_name = aviatrix_transit_gateway.test_transit_aws.gw_name
-	inspected_resource_name      = join(":", ["SPOKE", aviatrix_spoke_gateway.test_spoke_aws.gw_name])
-}
-	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
-		rName, os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), rName,
-		os.Getenv("AWS_VPC_ID2"), os.Getenv("AWS_REGION2"), os.Getenv("AWS_SUBNET2"))
-}
-
-func testAccTransitFireNetPolicyConfigBasicAZURE(rName string) string {
-	return fmt.Sprintf(`
-resource "aviatrix_account" "test_acc_azure" {
-	account_name        = "tfa-azure-%s"
-	cloud_type          = 8
-	arm_subscription_id = "%s"
-	arm_directory_id    = "%s"
-	arm_application_id  = "%s"
-	arm_application_key = "%s"
-}
-resource "aviatrix_transit_gateway" "test_transit_azure" {
-	cloud_type             = 8
-	account_name           = aviatrix_account.test_acc_azure.account_name
-	gw_name                = "tfg-%s"
-	vpc_id                 = "%s"
-	vpc_reg                = "%s"
-	gw_size                = "%s"
-	subnet                 = "%s"
-	connected_transit      = true 
-	enable_transit_firenet = true
-}
-resource "aviatrix_spoke_gateway" "test_spoke_azure" {
-	cloud_type   = 8
-	account_name = aviatrix_account.test_acc_azure.account_name
-	gw_name      = "tfg-azure-%s"
-	vpc_id       = "%s"
-	vpc_reg      = "%s"
-	gw_size      = "%s"
-	subnet       = "%s"
-	transit_gw   = aviatrix_transit_gateway.test_transit_azure.gw_name
-}
-resource "aviatrix_transit_firenet_policy" "test" {
-	transit_firenet_gateway_name = aviatrix_transit_gateway.test_transit_azure.gw_name
-	inspected_resource_name      = join(":", ["SPOKE", aviatrix_spoke_gateway.test_spoke_azure.gw_name])
-}
-	`, rName, os.Getenv("ARM_SUBSCRIPTION_ID"), os.Getenv("ARM_DIRECTORY_ID"), os.Getenv("ARM_APPLICATION_ID"),
-		os.Getenv("ARM_APPLICATION_KEY"), rName, os.Getenv("AZURE_VNET_ID"), os.Getenv("AZURE_REGION"),
-		os.Getenv("AZURE_GW_SIZE"), os.Getenv("AZURE_SUBNET"), rName,
-		os.Getenv("AZURE_VNET_ID2"), os.Getenv("AZURE_REGION2"),
-		os.Getenv("AZURE_GW_SIZE"), os.Getenv("AZURE_SUBNET2"))
-}
-
-func testAccCheckTransitFireNetPolicyExists(n string, transitFireNetPolicy *goaviatrix.TransitFireNetPolicy) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("transit firenet policy Not found: %s", n)
-		}
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("no transit firenet policy ID is set")
-		}
-
-		client := testAccProvider.Meta().(*goaviatrix.Client)
-
-		foundTransitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
-			TransitFireNetGatewayName: rs.Primary.Attributes["transit_firenet_gateway_name"],
-			InspectedResourceName:     rs.Primary.Attributes["inspected_resource_name"],
-		}
-
-		err := client.GetTransitFireNetPolicy(foundTransitFireNetPolicy)
-		if err != nil {
-			return fmt.Errorf("transit firenet policy not found")
-		}
-
-		*transitFireNetPolicy = *foundTransitFireNetPolicy
-		return nil
-	}
-}
-
-func testAccCheckTransitFireNetPolicyDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*goaviatrix.Client)
-
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aviatrix_transit_firenet_policy" {
-			continue
-		}
-
-		foundTransitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
-			TransitFireNetGatewayName: rs.Primary.Attributes["transit_firenet_gateway_name"],
-			InspectedResourceName:     rs.Primary.Attributes["inspected_resource_name"],
-		}
-
-		err := client.GetTransitFireNetPolicy(foundTransitFireNetPolicy)
-		if err != goaviatrix.ErrNotFound {
-			return fmt.Errorf("transit firenet policy still exists")
-		}
-	}
-
-	return nil
-}
@@ -2,15 +2,15 @@ package aviatrix
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -23,17 +23,27 @@ const (
 
 func resourceAviatrixTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitGatewayCreate,
-		Read:   resourceAviatrixTransitGatewayRead,
-		Update: resourceAviatrixTransitGatewayUpdate,
-		Delete: resourceAviatrixTransitGatewayDelete,
+		CreateContext: resourceAviatrixTransitGatewayCreate,
+		ReadContext:   resourceAviatrixTransitGatewayRead,
+		UpdateContext: resourceAviatrixTransitGatewayUpdate,
+		DeleteContext: resourceAviatrixTransitGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validateGatewayDiff(transitGatewayDiffRules),
+			setTagsAll(goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes),
+		),
 		SchemaVersion: 1,
 		MigrateState:  resourceAviatrixTransitGatewayMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultGatewayTimeout),
+			Update: schema.DefaultTimeout(defaultGatewayTimeout),
+			Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -473,6 +483,7 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the transit gateway.",
 			},
+			"tags_all": tagsAllSchema(),
 			"enable_spot_instance": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -696,8 +707,8 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.TransitVpc{
 		CloudType:                d.Get("cloud_type").(int),
@@ -738,7 +749,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	cloudType := d.Get("cloud_type").(int)
 	zone := d.Get("zone").(string)
 	if !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && zone != "" {
-		return fmt.Errorf("attribute 'zone' is only for use with Azure (8), Azure GOV (32) and Azure CHINA (2048)")
+		return attributeErrorf("zone", "attribute 'zone' is only for use with Azure (8), Azure GOV (32) and Azure CHINA (2048)")
 	}
 	if zone != "" {
 		// The API uses the same string field to hold both subnet and zone
@@ -749,10 +760,10 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		gateway.VpcID = d.Get("vpc_id").(string)
 		if gateway.VpcID == "" {
-			return fmt.Errorf("'vpc_id' cannot be empty for creating a transit gw")
+			return attributeErrorf("vpc_id", "'vpc_id' cannot be empty for creating a transit gw")
 		}
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
@@ -761,7 +772,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		// for gcp, rest api asks for "zone" rather than vpc region
 		gateway.Zone = d.Get("vpc_reg").(string)
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	insaneMode := d.Get("insane_mode").(bool)
@@ -769,14 +780,14 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		// Insane Mode encryption is not supported in China regions
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|
 			goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return attributeErrorf("insane_mode", "insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
 			if d.Get("insane_mode_az").(string) == "" {
-				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return attributeErrorf("insane_mode_az", "insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 			if d.Get("ha_subnet").(string) != "" && d.Get("ha_insane_mode_az").(string) == "" {
-				return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) clouds and ha_subnet is set")
+				return attributeErrorf("ha_insane_mode_az", "ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) clouds and ha_subnet is set")
 			}
 			// Append availability zone to subnet
 			var strs []string
@@ -790,10 +801,10 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	}
 
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain == "" || gateway.FaultDomain == "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
+		return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are required for OCI")
 	}
 	if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain != "" || gateway.FaultDomain != "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		return attributeErrorf("availability_domain", "'availability_domain' and 'fault_domain' are only valid for OCI")
 	}
 
 	haSubnet := d.Get("ha_subnet").(string)
@@ -802,39 +813,39 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	haFaultDomain := d.Get("ha_fault_domain").(string)
 
 	if haZone != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
+		return attributeErrorf("ha_zone", "'ha_zone' is only valid for GCP and Azure providers when enabling HA")
 	}
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-		return fmt.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
+		return attributeErrorf("ha_zone", "'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
 	}
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-		return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
+		return attributeErrorf("ha_subnet", "'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
 	}
 	haGwSize := d.Get("ha_gw_size").(string)
 	if haSubnet == "" && haZone == "" && haGwSize != "" {
-		return fmt.Errorf("'ha_gw_size' is only required if enabling HA")
+		return attributeErrorf("ha_gw_size", "'ha_gw_size' is only required if enabling HA")
 	}
 	if haGwSize == "" && haSubnet != "" {
-		return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+		return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 			"ha_subnet is set")
 	}
 	if haSubnet != "" {
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-			return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
+			return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
 		}
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-			return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+			return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 		}
 	}
 
 	enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
 	customerManagedKeys := d.Get("customer_managed_keys").(string)
 	if enableEncryptVolume && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768) providers")
+		return attributeErrorf("enable_encrypt_volume", "'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768) providers")
 	}
 	if customerManagedKeys != "" {
 		if !enableEncryptVolume {
-			return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+			return attributeErrorf("customer_managed_keys", "'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
 		}
 		gateway.CustomerManagedKeys = customerManagedKeys
 	}
@@ -846,49 +857,49 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	enableGatewayLoadBalancer := d.Get("enable_gateway_load_balancer").(bool)
 	enableTransitFireNet := d.Get("enable_transit_firenet").(bool)
 	if enableFireNet && enableTransitFireNet {
-		return fmt.Errorf("can't enable firenet function and transit firenet function at the same time")
+		return diag.Errorf("can't enable firenet function and transit firenet function at the same time")
 	}
 	lanVpcID := d.Get("lan_vpc_id").(string)
 	lanPrivateSubnet := d.Get("lan_private_subnet").(string)
 	// Transit FireNet function is not supported for AWS China or Azure China
 	if enableFireNet && goaviatrix.IsCloudType(cloudType, goaviatrix.AWSChina|goaviatrix.AzureChina) {
-		return fmt.Errorf("'enable_firenet' is not supported in AWSChina (1024) or AzureChina (2048)")
+		return attributeErrorf("enable_firenet", "'enable_firenet' is not supported in AWSChina (1024) or AzureChina (2048)")
 	}
 	if enableTransitFireNet {
 		// Transit FireNet function is not supported for Azure China
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes^goaviatrix.AzureChina|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("'enable_transit_firenet' is only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+			return attributeErrorf("enable_transit_firenet", "'enable_transit_firenet' is only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			gateway.EnableTransitFireNet = true
 		}
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 			if lanVpcID == "" || lanPrivateSubnet == "" {
-				return fmt.Errorf("'lan_vpc_id' and 'lan_private_subnet' are required when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
+				return attributeErrorf("lan_vpc_id", "'lan_vpc_id' and 'lan_private_subnet' are required when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
 			}
 			gateway.LanVpcID = lanVpcID
 			gateway.LanPrivateSubnet = lanPrivateSubnet
 		}
 	}
 	if (!enableTransitFireNet || !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes)) && (lanVpcID != "" || lanPrivateSubnet != "") {
-		return fmt.Errorf("'lan_vpc_id' and 'lan_private_subnet' are only valid when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
+		return attributeErrorf("lan_vpc_id", "'lan_vpc_id' and 'lan_private_subnet' are only valid when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
 	}
 	if enableGatewayLoadBalancer && !enableFireNet && !enableTransitFireNet {
-		return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
+		return attributeErrorf("enable_gateway_load_balancer", "'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
 	}
 	if enableGatewayLoadBalancer && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWS) {
-		return fmt.Errorf("'enable_gateway_load_balancer' is only supported by AWS (1)")
+		return attributeErrorf("enable_gateway_load_balancer", "'enable_gateway_load_balancer' is only supported by AWS (1)")
 	}
 	enableEgressTransitFireNet := d.Get("enable_egress_transit_firenet").(bool)
 	// Transit FireNet function is not supported for Azure China
 	if enableEgressTransitFireNet && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes^goaviatrix.AzureChina|goaviatrix.OCIRelatedCloudTypes) {
-		return fmt.Errorf("'enable_egress_transit_firenet' is only supported by AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_egress_transit_firenet", "'enable_egress_transit_firenet' is only supported by AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 	if enableEgressTransitFireNet && !enableTransitFireNet {
-		return fmt.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
+		return attributeErrorf("enable_egress_transit_firenet", "'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
 	}
 	if enableEgressTransitFireNet && connectedTransit {
-		return fmt.Errorf("'enable_egress_transit_firenet' requires 'connected_transit' to be set to false")
+		return attributeErrorf("enable_egress_transit_firenet", "'enable_egress_transit_firenet' requires 'connected_transit' to be set to false")
 	}
 
 	enableTransitPreserveAsPath := d.Get("enable_preserve_as_path").(bool)
@@ -899,11 +910,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	}
 
 	if learnedCidrsApproval && d.Get("learned_cidrs_approval_mode").(string) == "connection" {
-		return fmt.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
+		return attributeErrorf("enable_learned_cidrs_approval", "'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
 	}
 
 	if !learnedCidrsApproval && len(gateway.ApprovedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	enableMonitorSubnets := d.Get("enable_monitor_gateway_subnets").(bool)
@@ -913,21 +924,21 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	}
 	// Enable monitor gateway subnets does not work with AWSChina
 	if enableMonitorSubnets && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+		return attributeErrorf("enable_monitor_gateway_subnets", "'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return attributeErrorf("monitor_exclude_list", "'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 
 	bgpOverLan := d.Get("enable_bgp_over_lan").(bool)
 	if bgpOverLan && !(goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCP)) {
-		return fmt.Errorf("'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
+		return attributeErrorf("enable_bgp_over_lan", "'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
 	}
 	bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
 	if isCountSet && (!bgpOverLan || !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-		return fmt.Errorf("'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
+		return attributeErrorf("bgp_lan_interfaces_count", "'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
 	} else if !isCountSet && bgpOverLan && goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure transit: %s", gateway.GwName)
+		return diag.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure transit: %s", gateway.GwName)
 	}
 	var bgpLanVpcID []string
 	var bgpLanSpecifySubnet []string
@@ -944,22 +955,22 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		haBgpLanSpecifySubnet = append(haBgpLanSpecifySubnet, item["subnet"].(string))
 	}
 	if !bgpOverLan && len(bgpLanVpcID) != 0 {
-		return fmt.Errorf("'bgp_lan_interfaces' is only valid with enable_bgp_over_lan being set true")
+		return attributeErrorf("bgp_lan_interfaces", "'bgp_lan_interfaces' is only valid with enable_bgp_over_lan being set true")
 	}
 	if (!bgpOverLan || haSubnet == "") && len(haBgpLanVpcID) != 0 {
-		return fmt.Errorf("'ha_bgp_lan_interfaces' is only valid with enable_bgp_over_lan is set true and HA is enabled")
+		return attributeErrorf("ha_bgp_lan_interfaces", "'ha_bgp_lan_interfaces' is only valid with enable_bgp_over_lan is set true and HA is enabled")
 	}
 	if (len(bgpLanVpcID) != 0 || len(haBgpLanVpcID) != 0) && !(goaviatrix.IsCloudType(cloudType, goaviatrix.GCP)) {
-		return fmt.Errorf("'bgp_lan_interfaces' and 'ha_bgp_lan_interfaces' are only valid for GCP (4)")
+		return attributeErrorf("bgp_lan_interfaces", "'bgp_lan_interfaces' and 'ha_bgp_lan_interfaces' are only valid for GCP (4)")
 	}
 	if bgpOverLan {
 		gateway.BgpOverLan = true
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCP) {
 			if len(bgpLanVpcID) == 0 {
-				return fmt.Errorf("missing bgp_lan_interfaces for creating GCP transit gateway with BGP over LAN enabled")
+				return diag.Errorf("missing bgp_lan_interfaces for creating GCP transit gateway with BGP over LAN enabled")
 			}
 			if (haSubnet != "" || haZone != "") && len(haBgpLanVpcID) == 0 {
-				return fmt.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled")
+				return diag.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled")
 			}
 			gateway.BgpLanVpcID = strings.Join(bgpLanVpcID, ",")
 			gateway.BgpLanSpecifySubnet = strings.Join(bgpLanSpecifySubnet, ",")
@@ -976,32 +987,32 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 	if enablePrivateOob {
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256) AWSChina (1024),, AWS Top Secret (16384) or AWS Secret (32768)")
+			return attributeErrorf("enable_private_oob", "'enable_private_oob' is only valid for AWS (1), AWSGov (256) AWSChina (1024),, AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
 		if oobAvailabilityZone == "" {
-			return fmt.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
+			return attributeErrorf("oob_availability_zone", "\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
 		}
 
 		if oobManagementSubnet == "" {
-			return fmt.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
+			return attributeErrorf("oob_management_subnet", "\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
 		}
 
 		if haSubnet != "" {
 			if haOobAvailabilityZone == "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+				return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 			}
 
 			if haOobManagementSubnet == "" {
-				return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+				return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 			}
 		} else {
 			if haOobAvailabilityZone != "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+				return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 			}
 
 			if haOobManagementSubnet != "" {
-				return fmt.Errorf("\"ha_oob_management_sbunet\" must be empty if \"ha_subnet\" is empty")
+				return diag.Errorf("\"ha_oob_management_sbunet\" must be empty if \"ha_subnet\" is empty")
 			}
 		}
 
@@ -1010,52 +1021,52 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		gateway.OobManagementSubnet = oobManagementSubnet + "~~" + oobAvailabilityZone
 	} else {
 		if oobAvailabilityZone != "" {
-			return fmt.Errorf("\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("oob_availability_zone", "\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if oobManagementSubnet != "" {
-			return fmt.Errorf("\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("oob_management_subnet", "\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if haOobAvailabilityZone != "" {
-			return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+			return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if haOobManagementSubnet != "" {
-			return fmt.Errorf("\"ha_oob_management_sbunet\" must be empty if \"enable_private_oob\" is false")
+			return diag.Errorf("\"ha_oob_management_sbunet\" must be empty if \"enable_private_oob\" is false")
 		}
 	}
 
 	enableMultitierTransit := d.Get("enable_multi_tier_transit").(bool)
 	if enableMultitierTransit {
 		if d.Get("local_as_number") == "" {
-			return fmt.Errorf("local_as_number required to enable multi tier transit")
+			return attributeErrorf("local_as_number", "local_as_number required to enable multi tier transit")
 		}
 	}
 
-	_, tagsOk := d.GetOk("tags")
+	_, tagsOk := d.GetOk("tags_all")
 	if tagsOk {
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return errors.New("error creating transit gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("error creating transit gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTagsAll(d, gateway.CloudType)
 		if err != nil {
-			return fmt.Errorf("error creating tags for transit gateway: %v", err)
+			return diag.Errorf("error creating tags for transit gateway: %v", err)
 		}
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to add tags when creating transit gateway: %v", err)
+			return diag.Errorf("failed to add tags when creating transit gateway: %v", err)
 		}
 		gateway.TagJson = tagJson
 	}
 
 	enableActiveStandby := d.Get("enable_active_standby").(bool)
 	if haSubnet == "" && haZone == "" && enableActiveStandby {
-		return fmt.Errorf("could not configure Active-Standby as HA is not enabled")
+		return diag.Errorf("could not configure Active-Standby as HA is not enabled")
 	}
 	enableActiveStandbyPreemptive := d.Get("enable_active_standby_preemptive").(bool)
 	if !enableActiveStandby && enableActiveStandbyPreemptive {
-		return fmt.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
+		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
 	}
 
 	enableSpotInstance := d.Get("enable_spot_instance").(bool)
@@ -1063,11 +1074,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	deleteSpot := d.Get("delete_spot").(bool)
 	if enableSpotInstance {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+			return attributeErrorf("enable_spot_instance", "enable_spot_instance only supports AWS and Azure related cloud types")
 		}
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
-			return fmt.Errorf("delete_spot only supports Azure")
+			return attributeErrorf("delete_spot", "delete_spot only supports Azure")
 		}
 
 		gateway.EnableSpotInstance = true
@@ -1079,10 +1090,10 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 	rxQueueSize := d.Get("rx_queue_size").(string)
 	if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+		return attributeErrorf("rx_queue_size", "rx_queue_size only supports AWS related cloud types")
 	}
 
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 	if !enablePrivateOob && !privateModeInfo.EnablePrivateMode {
 		allocateNewEip := d.Get("allocate_new_eip").(bool)
 		if allocateNewEip {
@@ -1091,21 +1102,21 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			gateway.ReuseEip = "on"
 
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-				return fmt.Errorf("failed to create transit gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
+				return diag.Errorf("failed to create transit gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
 			}
 			if _, ok := d.GetOk("eip"); !ok {
-				return fmt.Errorf("failed to create transit gateway: 'eip' must be set when 'allocate_new_eip' is false")
+				return diag.Errorf("failed to create transit gateway: 'eip' must be set when 'allocate_new_eip' is false")
 			}
 			azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !azureEipNameOk {
-					return fmt.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), d.Get("eip").(string))
 			} else {
 				if azureEipNameOk {
-					return fmt.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				gateway.Eip = d.Get("eip").(string)
 			}
@@ -1117,34 +1128,34 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			gateway.Subnet = fmt.Sprintf("%s~~%s", gateway.Subnet, privateModeSubnetZone.(string))
 		} else {
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("%q must be set when creating a Transit Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
+				return attributeErrorf("private_mode_subnet_zone", "%q must be set when creating a Transit Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
 			}
 		}
 
 		if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
+				return diag.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
 			}
 
 			gateway.LbVpcId = d.Get("private_mode_lb_vpc_id").(string)
 		}
 	} else {
 		if _, ok := d.GetOk("private_mode_subnet_zone"); ok {
-			return fmt.Errorf("%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
+			return attributeErrorf("private_mode_subnet_zone", "%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
 		}
 		if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
-			return fmt.Errorf("%q is only valid on when Private Mode is enabled", "private_mode_lb_vpc_id")
+			return attributeErrorf("private_mode_lb_vpc_id", "%q is only valid on when Private Mode is enabled", "private_mode_lb_vpc_id")
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Transit Gateway: %#v", gateway)
+	logInfo(ctx, "Creating Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName, "account_name": gateway.AccountName, "vpc_id": gateway.VpcID})
 
 	d.SetId(gateway.GwName)
 	flag := false
-	defer resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag)
-	err := client.LaunchTransitVpc(gateway)
+	defer resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag)
+	err := client.LaunchTransitVpc(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Transit Gateway: %s", err)
+		return diag.Errorf("failed to create Aviatrix Transit Gateway: %s", err)
 	}
 
 	if !singleAZ {
@@ -1153,11 +1164,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			SingleAZ: "disabled",
 		}
 
-		log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+		logInfo(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
-		err := client.DisableSingleAZGateway(singleAZGateway)
+		err := client.DisableSingleAZGateway(ctx, singleAZGateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable single AZ GW HA: %s", err)
+			return diag.Errorf("failed to disable single AZ GW HA: %s", err)
 		}
 	}
 
@@ -1187,7 +1198,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 			if haZone == "" {
-				return fmt.Errorf("no ha_zone is provided for enabling Transit HA gateway: %s", transitHaGw.GwName)
+				return diag.Errorf("no ha_zone is provided for enabling Transit HA gateway: %s", transitHaGw.GwName)
 			}
 		} else if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 			if haZone != "" {
@@ -1201,7 +1212,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if privateModeInfo.EnablePrivateMode {
 			haPrivateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
 			if haPrivateModeSubnetZone == "" && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("%q must be set when creating a Transit HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
+				return attributeErrorf("ha_private_mode_subnet_zone", "%q must be set when creating a Transit HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
 			}
 			transitHaGw.Subnet = haSubnet + "~~" + haPrivateModeSubnetZone
 		}
@@ -1211,17 +1222,17 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			if transitHaGw.Eip != "" {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				transitHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), transitHaGw.Eip)
 			} else {
 				if haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
+					return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
 				}
 			}
 		} else {
 			if haAzureEipNameOk {
-				return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+				return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 		}
 
@@ -1230,19 +1241,19 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			transitHaGw.BgpLanSubnet = strings.Join(haBgpLanSpecifySubnet, ",")
 		}
 
-		log.Printf("[INFO] Enabling HA on Transit Gateway: %#v", haSubnet)
+		logInfo(ctx, "Enabling HA on Transit Gateway", map[string]interface{}{"ha_subnet": haSubnet})
 
-		_, err := client.CreateTransitHaGw(transitHaGw)
+		_, err := client.CreateTransitHaGw(ctx, transitHaGw)
 		if err != nil {
-			return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+			return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 		}
 
 		//Resize HA Gateway
-		log.Printf("[INFO]Resizing Transit HA Gateway: %#v", haGwSize)
+		logInfo(ctx, "Resizing Transit HA Gateway", map[string]interface{}{"gw_size": haGwSize})
 
 		if haGwSize != gateway.VpcSize {
 			if haGwSize == "" {
-				return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 					"ha_subnet is set")
 			}
 
@@ -1252,11 +1263,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				VpcSize:   d.Get("ha_gw_size").(string),
 			}
 
-			log.Printf("[INFO] Resizing Transit HA GAteway size to: %s ", haGateway.VpcSize)
+			logInfo(ctx, "Resizing Transit HA Gateway", map[string]interface{}{"gw_size": haGateway.VpcSize})
 
-			err = client.UpdateGateway(haGateway)
+			err = client.UpdateGateway(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
+				return diag.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
 			}
 		}
 	}
@@ -1264,32 +1275,32 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 	enableHybridConnection := d.Get("enable_hybrid_connection").(bool)
 	if enableHybridConnection {
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_hybrid_connection' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+			return attributeErrorf("enable_hybrid_connection", "'enable_hybrid_connection' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
-		err := client.AttachTransitGWForHybrid(gateway)
+		err := client.AttachTransitGWForHybrid(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to enable transit GW for Hybrid: %s", err)
+			return diag.Errorf("failed to enable transit GW for Hybrid: %s", err)
 		}
 	}
 
 	if connectedTransit {
-		err := client.EnableConnectedTransit(gateway)
+		err := client.EnableConnectedTransit(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to enable connected transit: %s", err)
+			return diag.Errorf("failed to enable connected transit: %s", err)
 		}
 	}
 
 	if enableFireNet {
 		if enableGatewayLoadBalancer {
-			err := client.EnableGatewayFireNetInterfacesWithGWLB(gateway)
+			err := client.EnableGatewayFireNetInterfacesWithGWLB(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
+				return diag.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
 			}
 		} else {
-			err := client.EnableGatewayFireNetInterfaces(gateway)
+			err := client.EnableGatewayFireNetInterfaces(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
+				return diag.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
 			}
 		}
 	}
@@ -1300,30 +1311,31 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			GwName: d.Get("gw_name").(string),
 		}
 
-		log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+		logInfo(ctx, "Enable VPC DNS Server", map[string]interface{}{"gw_name": gwVpcDnsServer.GwName})
 
-		err := client.EnableVpcDnsServer(gwVpcDnsServer)
+		err := client.EnableVpcDnsServer(ctx, gwVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+			return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 		}
 	} else if enableVpcDnsServer {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return attributeErrorf("enable_vpc_dns_server", "'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	enableAdvertiseTransitCidr := d.Get("enable_advertise_transit_cidr").(bool)
 	if enableAdvertiseTransitCidr {
-		err := client.EnableAdvertiseTransitCidr(gateway)
+		gateway.EnableAdvertiseTransitCidr = true
+		err := client.EnableAdvertiseTransitCidr(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to enable advertise transit CIDR: %s", err)
+			return diag.Errorf("failed to enable advertise transit CIDR: %s", err)
 		}
 	}
 
 	bgpManualSpokeAdvertiseCidrs := d.Get("bgp_manual_spoke_advertise_cidrs").(string)
 	if bgpManualSpokeAdvertiseCidrs != "" {
 		gateway.BgpManualSpokeAdvertiseCidrs = bgpManualSpokeAdvertiseCidrs
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gateway)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to set BGP Manual Spoke Advertise Cidrs: %s", err)
+			return diag.Errorf("failed to set BGP Manual Spoke Advertise Cidrs: %s", err)
 		}
 	}
 
@@ -1333,15 +1345,17 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			CustomizedSpokeVpcRoutes: strings.Split(customizedSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes of transit gateway: %s ", transitGateway.GwName)
-			err := client.EditGatewayCustomRoutes(transitGateway)
+			logInfo(ctx, "Editing customized routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
+			err := client.EditGatewayCustomRoutes(ctx, transitGateway)
 			if err == nil {
 				break
 			}
 			if i <= 10 && strings.Contains(err.Error(), "when it is down") {
-				time.Sleep(10 * time.Second)
+				if goaviatrix.SleepContext(ctx, 10*time.Second) != nil {
+					return diag.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				}
 			} else {
-				return fmt.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1352,15 +1366,17 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			FilteredSpokeVpcRoutes: strings.Split(filteredSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing filtered routes of transit gateway: %s ", transitGateway.GwName)
-			err := client.EditGatewayFilterRoutes(transitGateway)
+			logInfo(ctx, "Editing filtered routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
+			err := client.EditGatewayFilterRoutes(ctx, transitGateway)
 			if err == nil {
 				break
 			}
 			if i <= 10 && strings.Contains(err.Error(), "when it is down") {
-				time.Sleep(10 * time.Second)
+				if goaviatrix.SleepContext(ctx, 10*time.Second) != nil {
+					return diag.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				}
 			} else {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1371,15 +1387,17 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			AdvertisedSpokeRoutes: strings.Split(advertisedSpokeRoutesExclude, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes advertisement of transit gateway: %s ", transitGateway.GwName)
-			err := client.EditGatewayAdvertisedCidr(transitGateway)
+			logInfo(ctx, "Editing customized routes advertisement of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
+			err := client.EditGatewayAdvertisedCidr(ctx, transitGateway)
 			if err == nil {
 				break
 			}
 			if i <= 10 && strings.Contains(err.Error(), "when it is down") {
-				time.Sleep(10 * time.Second)
+				if goaviatrix.SleepContext(ctx, 10*time.Second) != nil {
+					return diag.Errorf("failed to edit advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				}
 			} else {
-				return fmt.Errorf("failed to edit advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1389,29 +1407,29 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			GwName: d.Get("gw_name").(string),
 		}
 		if enableGatewayLoadBalancer {
-			err := client.EnableTransitFireNetWithGWLB(gwTransitFireNet)
+			err := client.EnableTransitFireNetWithGWLB(ctx, gwTransitFireNet)
 			if err != nil {
-				return fmt.Errorf("failed to enable transit firenet with Gateway Load Balancer enabled: %v", err)
+				return diag.Errorf("failed to enable transit firenet with Gateway Load Balancer enabled: %v", err)
 			}
 		} else {
-			err := client.EnableTransitFireNet(gwTransitFireNet)
+			err := client.EnableTransitFireNet(ctx, gwTransitFireNet)
 			if err != nil {
-				return fmt.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+				return diag.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 			}
 		}
 	}
 
 	if val, ok := d.GetOk("bgp_polling_time"); ok {
-		err := client.SetBgpPollingTime(gateway, val.(string))
+		err := client.SetBgpPollingTime(ctx, gateway, val.(string))
 		if err != nil {
-			return fmt.Errorf("could not set bgp polling time: %v", err)
+			return diag.Errorf("could not set bgp polling time: %v", err)
 		}
 	}
 
 	if val, ok := d.GetOk("local_as_number"); ok {
-		err := client.SetLocalASNumber(gateway, val.(string))
+		err := client.SetLocalASNumber(ctx, gateway, val.(string))
 		if err != nil {
-			return fmt.Errorf("could not set local_as_number: %v", err)
+			return diag.Errorf("could not set local_as_number: %v", err)
 		}
 	}
 
@@ -1421,63 +1439,63 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		for _, v := range slice {
 			prependASPath = append(prependASPath, v.(string))
 		}
-		err := client.SetPrependASPath(gateway, prependASPath)
+		err := client.SetPrependASPath(ctx, gateway, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
 	if val, ok := d.GetOk("bgp_ecmp"); ok {
-		err := client.SetBgpEcmp(gateway, val.(bool))
+		err := client.SetBgpEcmp(ctx, gateway, val.(bool))
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp: %v", err)
+			return diag.Errorf("could not set bgp_ecmp: %v", err)
 		}
 	}
 
 	if d.Get("enable_segmentation").(bool) {
-		if err := client.EnableSegmentation(gateway); err != nil {
-			return fmt.Errorf("could not enable segmentation: %v", err)
+		if err := client.EnableSegmentation(ctx, gateway); err != nil {
+			return diag.Errorf("could not enable segmentation: %v", err)
 		}
 	}
 
 	if enableEgressTransitFireNet {
-		err := client.EnableEgressTransitFirenet(gateway)
+		err := client.EnableEgressTransitFirenet(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("could not enable egress transit firenet: %v", err)
+			return diag.Errorf("could not enable egress transit firenet: %v", err)
 		}
 	}
 
 	if enableTransitPreserveAsPath {
-		err := client.EnableTransitPreserveAsPath(gateway)
+		err := client.EnableTransitPreserveAsPath(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("could not enable transit preserve as path: %v", err)
+			return diag.Errorf("could not enable transit preserve as path: %v", err)
 		}
 	}
 
 	if enableActiveStandby {
 		if enableActiveStandbyPreemptive {
-			if err := client.EnableActiveStandbyPreemptive(gateway); err != nil {
-				return fmt.Errorf("could not enable Preemptive Mode for Active-Standby: %v", err)
+			if err := client.EnableActiveStandbyPreemptive(ctx, gateway); err != nil {
+				return diag.Errorf("could not enable Preemptive Mode for Active-Standby: %v", err)
 			}
 		} else {
-			if err := client.EnableActiveStandby(gateway); err != nil {
-				return fmt.Errorf("could not enable Active-Standby: %v", err)
+			if err := client.EnableActiveStandby(ctx, gateway); err != nil {
+				return diag.Errorf("could not enable Active-Standby: %v", err)
 			}
 		}
 	}
 
 	approvalMode := d.Get("learned_cidrs_approval_mode").(string)
 	if approvalMode != defaultLearnedCidrApprovalMode {
-		err := client.SetTransitLearnedCIDRsApprovalMode(gateway, approvalMode)
+		err := client.SetTransitLearnedCIDRsApprovalMode(ctx, gateway, approvalMode)
 		if err != nil {
-			return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", approvalMode, err)
+			return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", approvalMode, err)
 		}
 	}
 
 	if learnedCidrsApproval && len(gateway.ApprovedLearnedCidrs) != 0 {
-		err = client.UpdateTransitPendingApprovedCidrs(gateway)
+		err = client.UpdateTransitPendingApprovedCidrs(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("could not update approved CIDRs: %v", err)
+			return diag.Errorf("could not update approved CIDRs: %v", err)
 		}
 	}
 
@@ -1486,16 +1504,16 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		customizedTransitVpcRoutes = append(customizedTransitVpcRoutes, v.(string))
 	}
 	if len(customizedTransitVpcRoutes) != 0 {
-		err := client.UpdateTransitGatewayCustomizedVpcRoute(gateway.GwName, customizedTransitVpcRoutes)
+		err := client.UpdateTransitGatewayCustomizedVpcRoute(ctx, gateway.GwName, customizedTransitVpcRoutes)
 		if err != nil {
-			return fmt.Errorf("couldn't update transit gateway customized vpc route: %s", err)
+			return diag.Errorf("couldn't update transit gateway customized vpc route: %s", err)
 		}
 	}
 
 	if enableMonitorSubnets {
-		err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
+		err := client.EnableMonitorGatewaySubnets(ctx, gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -1504,9 +1522,9 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			GwName: d.Get("gw_name").(string),
 		}
 
-		err := client.DisableJumboFrame(gw)
+		err := client.DisableJumboFrame(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("could not disable jumbo frame for transit gateway: %v", err)
+			return diag.Errorf("could not disable jumbo frame for transit gateway: %v", err)
 		}
 	}
 
@@ -1514,45 +1532,45 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		gw := &goaviatrix.Gateway{
 			GwName: d.Get("gw_name").(string),
 		}
-		err := client.DisableGroGso(gw)
+		err := client.DisableGroGso(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("couldn't disable GRO/GSO on transit gateway: %s", err)
+			return diag.Errorf("couldn't disable GRO/GSO on transit gateway: %s", err)
 		}
 	}
 
 	if holdTime := d.Get("bgp_hold_time").(int); holdTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gateway.GwName, holdTime)
+		err := client.ChangeBgpHoldTime(ctx, gateway.GwName, holdTime)
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time after Transit Gateway creation: %v", err)
+			return diag.Errorf("could not change BGP Hold Time after Transit Gateway creation: %v", err)
 		}
 	}
 
 	if gateway.EnableSummarizeCidrToTgw {
-		err = client.EnableSummarizeCidrToTgw(gateway.GwName)
+		err = client.EnableSummarizeCidrToTgw(ctx, gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not enable summarize cidr to tgw: %v", err)
+			return diag.Errorf("could not enable summarize cidr to tgw: %v", err)
 		}
 	}
 
 	if enableMultitierTransit {
-		err = client.EnableMultitierTransit(gateway.GwName)
+		err = client.EnableMultitierTransit(ctx, gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not enable multi tier transit: %v", err)
+			return diag.Errorf("could not enable multi tier transit: %v", err)
 		}
 	}
 
 	enableS2CRxBalancing := d.Get("enable_s2c_rx_balancing").(bool)
 	if enableS2CRxBalancing {
-		err = client.EnableS2CRxBalancing(gateway.GwName)
+		err = client.EnableS2CRxBalancing(ctx, gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not enable S2C receive packet CPU re-balancing on transit %s: %v", gateway.GwName, err)
+			return diag.Errorf("could not enable S2C receive packet CPU re-balancing on transit %s: %v", gateway.GwName, err)
 		}
 	}
 
 	if detectionTime, ok := d.GetOk("tunnel_detection_time"); ok {
-		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime.(int))
+		err := client.ModifyTunnelDetectionTime(ctx, gateway.GwName, detectionTime.(int))
 		if err != nil {
-			return fmt.Errorf("could not set tunnel detection time during Transit Gateway creation: %v", err)
+			return diag.Errorf("could not set tunnel detection time during Transit Gateway creation: %v", err)
 		}
 	}
 
@@ -1561,43 +1579,44 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			GwName:      d.Get("gw_name").(string),
 			RxQueueSize: rxQueueSize,
 		}
-		err := client.SetRxQueueSize(gwRxQueueSize)
+		err := client.SetRxQueueSize(ctx, gwRxQueueSize)
 		if err != nil {
-			return fmt.Errorf("failed to set rx queue size for transit %s: %s", gateway.GwName, err)
+			return diag.Errorf("failed to set rx queue size for transit %s: %s", gateway.GwName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
 				GwName:      d.Get("gw_name").(string) + "-hagw",
 				RxQueueSize: rxQueueSize,
 			}
-			err := client.SetRxQueueSize(haGwRxQueueSize)
+			err := client.SetRxQueueSize(ctx, haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("failed to set rx queue size for transit ha %s : %s", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("failed to set rx queue size for transit ha %s : %s", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitGatewayReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitGatewayRead(d, meta)
+		return resourceAviatrixTransitGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
+	ignoreTagsConfig := client.IgnoreTags()
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"import_id": id})
 		d.Set("gw_name", id)
 		gwName = id
 		d.SetId(id)
@@ -1614,10 +1633,10 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", d.Get("gw_name").(string), gw)
+	logTrace(ctx, "Reading gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Set("cloud_type", gw.CloudType)
 	d.Set("account_name", gw.AccountName)
@@ -1648,7 +1667,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 	}
 	err = d.Set("prepend_as_path", prependAsPath)
 	if err != nil {
-		return fmt.Errorf("could not set prepend_as_path: %v", err)
+		return diag.Errorf("could not set prepend_as_path: %v", err)
 	}
 	d.Set("local_as_number", gw.LocalASNumber)
 	d.Set("bgp_ecmp", gw.BgpEcmp)
@@ -1671,7 +1690,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 				interfaces = append(interfaces, interfaceDict)
 			}
 			if err = d.Set("bgp_lan_interfaces", interfaces); err != nil {
-				return fmt.Errorf("could not set bgp_lan_interfaces into state: %v", err)
+				return diag.Errorf("could not set bgp_lan_interfaces into state: %v", err)
 			}
 		}
 
@@ -1684,20 +1703,20 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 				haInterfaces = append(haInterfaces, interfaceDict)
 			}
 			if err = d.Set("ha_bgp_lan_interfaces", haInterfaces); err != nil {
-				return fmt.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
+				return diag.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
 			}
 		}
 
 		bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
+			return diag.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
 		}
 		if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.BgpLanIpList); err != nil {
-			return fmt.Errorf("could not set bgp_lan_ip_list into state: %v", err)
+			return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 		}
 		if len(bgpLanIpInfo.HaBgpLanIpList) != 0 {
 			if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.HaBgpLanIpList); err != nil {
-				return fmt.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
+				return diag.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
 			}
 		} else {
 			d.Set("ha_bgp_lan_ip_list", nil)
@@ -1705,14 +1724,14 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 	} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && gw.EnableBgpOverLan {
 		bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
+			return diag.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
 		}
 		if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-			return fmt.Errorf("could not set bgp_lan_ip_list into state: %v", err)
+			return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 		}
 		if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 			if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-				return fmt.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
+				return diag.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
 			}
 		} else {
 			d.Set("ha_bgp_lan_ip_list", nil)
@@ -1751,18 +1770,18 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 	if gw.EnableLearnedCidrsApproval {
 		transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: gw.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+			return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 		}
 
 		if err = d.Set("approved_learned_cidrs", transitAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-			return fmt.Errorf("could not set approved_learned_cidrs into state: %v", err)
+			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 		}
 	} else {
 		d.Set("approved_learned_cidrs", nil)
 	}
 	d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 	if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-		return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+		return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 	}
 	d.Set("enable_multi_tier_transit", gw.EnableMultitierTransit)
 	d.Set("tunnel_detection_time", gw.TunnelDetectionTime)
@@ -1772,7 +1791,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		if len(azureEip) == 3 {
 			d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+			diags = append(diags, attributeWarning("azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName), nil))
 		}
 	}
 
@@ -1884,14 +1903,14 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 
 	lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
 	if err != nil && err != goaviatrix.ErrNotFound {
-		log.Printf("[WARN] Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err)
+		diags = append(diags, attributeWarning("lan_interface_cidr", fmt.Sprintf("could not get lan cidr for transit gateway %s", gw.GwName), err))
 	}
 	d.Set("lan_interface_cidr", lanCidr)
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTags(d, client, tags); err != nil {
+			diags = append(diags, attributeWarning("tags", "could not set tags into state", err))
 		}
 	}
 
@@ -1925,7 +1944,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 
 	enableGroGso, err := client.GetGroGsoStatus(gw)
 	if err != nil {
-		return fmt.Errorf("failed to get GRO/GSO status of transit gateway %s: %v", gw.GwName, err)
+		return diag.Errorf("failed to get GRO/GSO status of transit gateway %s: %v", gw.GwName, err)
 	}
 	d.Set("enable_gro_gso", enableGroGso)
 
@@ -1989,7 +2008,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 	d.Set("ha_security_group_id", gw.HaGw.GwSecurityGroupID)
 	lanCidr, err = client.GetTransitGatewayLanCidr(gw.HaGw.GwName)
 	if err != nil && err != goaviatrix.ErrNotFound {
-		log.Printf("[WARN] Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err)
+		diags = append(diags, attributeWarning("ha_lan_interface_cidr", fmt.Sprintf("could not get lan cidr for HA transit gateway %s", gw.HaGw.GwName), err))
 	}
 	d.Set("ha_lan_interface_cidr", lanCidr)
 
@@ -2019,15 +2038,15 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		if len(azureEip) == 3 {
 			d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+			diags = append(diags, attributeWarning("ha_azure_eip_name_resource_group", fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName), nil))
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
@@ -2038,67 +2057,67 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		GwName:    d.Get("gw_name").(string) + "-hagw",
 		VpcSize:   d.Get("ha_gw_size").(string),
 	}
-	log.Printf("[INFO] Updating Aviatrix Transit Gateway: %#v", gateway)
+	logInfo(ctx, "Updating Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	d.Partial(true)
 	if d.HasChange("ha_zone") {
 		haZone := d.Get("ha_zone").(string)
 		if haZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
+			return attributeErrorf("ha_zone", "'ha_zone' is only valid for GCP and Azure providers when enabling HA")
 		}
 	}
 	if d.HasChange("ha_zone") || d.HasChange("ha_subnet") {
 		haZone := d.Get("ha_zone").(string)
 		haSubnet := d.Get("ha_subnet").(string)
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
+			return attributeErrorf("ha_zone", "'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
 		}
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-			return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
+			return attributeErrorf("ha_subnet", "'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
 		}
 	}
 	if d.HasChange("allocate_new_eip") {
-		return fmt.Errorf("updating allocate_new_eip is not allowed")
+		return diag.Errorf("updating allocate_new_eip is not allowed")
 	}
 	if d.HasChange("eip") {
-		return fmt.Errorf("updating eip is not allowed")
+		return diag.Errorf("updating eip is not allowed")
 	}
 	if d.HasChange("ha_eip") {
 		o, n := d.GetChange("ha_eip")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("updating ha_eip is not allowed")
+			return diag.Errorf("updating ha_eip is not allowed")
 		}
 	}
 	if d.HasChange("azure_eip_name_resource_group") {
-		return fmt.Errorf("failed to update transit gateway: changing 'azure_eip_name_resource_group' is not allowed")
+		return diag.Errorf("failed to update transit gateway: changing 'azure_eip_name_resource_group' is not allowed")
 	}
 	if d.HasChange("ha_azure_eip_name_resource_group") {
 		o, n := d.GetChange("ha_azure_eip_name_resource_group")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("failed to update transit gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
+			return diag.Errorf("failed to update transit gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
 		}
 	}
 	if d.HasChange("lan_vpc_id") {
-		return fmt.Errorf("updating lan_vpc_id is not allowed")
+		return diag.Errorf("updating lan_vpc_id is not allowed")
 	}
 	if d.HasChange("lan_private_subnet") {
-		return fmt.Errorf("updating lan_private_subnet is not allowed")
+		return diag.Errorf("updating lan_private_subnet is not allowed")
 	}
 
 	// Transit FireNet function is not supported for AWS China and Azure China
 	if d.HasChange("enable_firenet") && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSChina|goaviatrix.AzureChina) {
-		return fmt.Errorf("editing 'enable_firenet' in AWSChina (1024) and AzureChina (2048) is not supported")
+		return diag.Errorf("editing 'enable_firenet' in AWSChina (1024) and AzureChina (2048) is not supported")
 	}
 	// Transit FireNet function is not supported for Azure China
 	if d.HasChange("enable_transit_firenet") && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("editing 'enable_transit_firenet' in GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) is not supported")
+		return diag.Errorf("editing 'enable_transit_firenet' in GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) is not supported")
 	}
 	if d.Get("enable_egress_transit_firenet").(bool) && !d.Get("enable_transit_firenet").(bool) {
-		return fmt.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
+		return attributeErrorf("enable_egress_transit_firenet", "'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
 	}
 	// Transit FireNet function is not supported for Azure China
 	if d.Get("enable_egress_transit_firenet").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes^goaviatrix.AzureChina|goaviatrix.OCIRelatedCloudTypes) {
-		return fmt.Errorf("'enable_egress_transit_firenet' is currently only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_egress_transit_firenet", "'enable_egress_transit_firenet' is currently only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
@@ -2106,32 +2125,32 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	approvedLearnedCidrs := getStringSet(d, "approved_learned_cidrs")
 
 	if learnedCidrsApproval && learnedCidrsApprovedMode == "connection" {
-		return fmt.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
+		return attributeErrorf("enable_learned_cidrs_approval", "'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
 	}
 	if !learnedCidrsApproval && len(approvedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return attributeErrorf("approved_learned_cidrs", "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if d.HasChange("enable_private_oob") {
-		return fmt.Errorf("updating enable_private_oob is not allowed")
+		return diag.Errorf("updating enable_private_oob is not allowed")
 	}
 
 	enablePrivateOob := d.Get("enable_private_oob").(bool)
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 
 	if !enablePrivateOob {
 		if d.HasChange("ha_oob_management_subnet") {
-			return fmt.Errorf("updating ha_oob_manage_subnet is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_manage_subnet is not allowed if private oob is disabled")
 		}
 
 		if d.HasChange("ha_oob_availability_zone") {
-			return fmt.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
 		}
 	}
 
 	if !privateModeInfo.EnablePrivateMode {
 		if d.HasChange("ha_private_mode_subnet_zone") {
-			return fmt.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
+			return diag.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
 		}
 	}
 
@@ -2144,20 +2163,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	}
 	if d.HasChange("ha_bgp_lan_interfaces") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-			return fmt.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-GCP Transit Gateway")
+			return diag.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-GCP Transit Gateway")
 		}
 		if !d.Get("enable_bgp_over_lan").(bool) {
-			return fmt.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-BGP over LAN GCP Transit Gateway")
+			return diag.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-BGP over LAN GCP Transit Gateway")
 		}
 		if !d.HasChange("ha_subnet") {
-			return fmt.Errorf("ha_bgp_lan_interfaces can only be updated along with BGP over LAN enabled GCP HA Transit Gateway's creation or deletion")
+			return attributeErrorf("ha_bgp_lan_interfaces", "ha_bgp_lan_interfaces can only be updated along with BGP over LAN enabled GCP HA Transit Gateway's creation or deletion")
 		}
 
 		newHaSubnet := d.Get("ha_subnet").(string)
 		if len(haBgpLanVpcID) == 0 && newHaSubnet != "" {
-			return fmt.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled in update")
+			return diag.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled in update")
 		} else if len(haBgpLanVpcID) != 0 && newHaSubnet == "" {
-			return fmt.Errorf("ha_bgp_lan_interfaces should be set empty for destroying GCP HA transit gateway with BGP over LAN enabled in update")
+			return attributeErrorf("ha_bgp_lan_interfaces", "ha_bgp_lan_interfaces should be set empty for destroying GCP HA transit gateway with BGP over LAN enabled in update")
 		}
 	}
 
@@ -2191,17 +2210,17 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				// No change will be detected when ha_eip is set to the empty string because it is computed.
 				// Instead, check ha_gw_size to detect when HA gateway is being deleted.
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				transitHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), haEip)
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
 		if !d.HasChange("ha_subnet") && d.HasChange("ha_insane_mode_az") {
-			return fmt.Errorf("ha_subnet must change if ha_insane_mode_az changes")
+			return attributeErrorf("ha_subnet", "ha_subnet must change if ha_insane_mode_az changes")
 		}
 
 		oldSubnet, newSubnet := d.GetChange("ha_subnet")
@@ -2219,10 +2238,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			haFaultDomain := d.Get("ha_fault_domain").(string)
 			if newSubnet != "" {
 				if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
+					return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
 				}
 				if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+					return attributeErrorf("ha_availability_domain", "'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 				}
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) {
@@ -2261,10 +2280,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				haSubnet := d.Get("ha_subnet").(string)
 
 				if insaneModeHaAz == "" && haSubnet != "" {
-					return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set for " +
+					return attributeErrorf("ha_insane_mode_az", "ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set for "+
 						"AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				} else if insaneModeHaAz != "" && haSubnet == "" {
-					return fmt.Errorf("ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set for " +
+					return attributeErrorf("ha_subnet", "ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set for "+
 						"AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				}
 
@@ -2275,10 +2294,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if (newHaGwEnabled || changeHaGw) && haGwSize == "" {
-			return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet or ha_zone is set")
 		} else if deleteHaGw && haGwSize != "" {
-			return fmt.Errorf("ha_gw_size must be empty if transit HA gateway is deleted")
+			return attributeErrorf("ha_gw_size", "ha_gw_size must be empty if transit HA gateway is deleted")
 		}
 
 		haOobManagementSubnet := d.Get("ha_oob_management_subnet").(string)
@@ -2287,19 +2306,19 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if enablePrivateOob {
 			if newHaGwEnabled || changeHaGw {
 				if haOobAvailabilityZone == "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 
 				if haOobManagementSubnet == "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 			} else if deleteHaGw {
 				if haOobAvailabilityZone != "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+					return attributeErrorf("ha_oob_availability_zone", "\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 				}
 
 				if haOobManagementSubnet != "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
+					return attributeErrorf("ha_oob_management_subnet", "\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
 				}
 			}
 		}
@@ -2307,7 +2326,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if privateModeInfo.EnablePrivateMode {
 			if newHaGwEnabled || changeHaGw {
 				if _, ok := d.GetOk("ha_private_mode_subnet_zone"); !ok && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-					return fmt.Errorf("%q is required when creating a Transit HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
+					return attributeErrorf("ha_private_mode_subnet_zone", "%q is required when creating a Transit HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
 				}
 
 				privateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
@@ -2316,9 +2335,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if newHaGwEnabled {
-			_, err := client.CreateTransitHaGw(transitHaGw)
+			_, err := client.CreateTransitHaGw(ctx, transitHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if d.Get("rx_queue_size").(string) != "" && !d.HasChange("rx_queue_size") {
@@ -2326,27 +2345,27 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 						GwName:      d.Get("gw_name").(string) + "-hagw",
 						RxQueueSize: d.Get("rx_queue_size").(string),
 					}
-					err := client.SetRxQueueSize(haGwRxQueueSize)
+					err := client.SetRxQueueSize(ctx, haGwRxQueueSize)
 					if err != nil {
-						return fmt.Errorf("could not set rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+						return diag.Errorf("could not set rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 					}
 				}
 			}
 		} else if deleteHaGw {
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGateway(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
 			}
 		} else if changeHaGw {
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGateway(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
 			}
 
 			transitHaGw.Eip = ""
-			_, err = client.CreateTransitHaGw(transitHaGw)
+			_, err = client.CreateTransitHaGw(ctx, transitHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 			}
 			newHaGwEnabled = true
 		}
@@ -2367,11 +2386,11 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			logInfo(ctx, "Enable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled {
@@ -2380,31 +2399,31 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
-			err := client.DisableSingleAZGateway(singleAZGateway)
+			logInfo(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
+			err := client.DisableSingleAZGateway(ctx, singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled {
 				singleAZGatewayHA := &goaviatrix.Gateway{
 					GwName: d.Get("gw_name").(string) + "-hagw",
 				}
-				err := client.DisableSingleAZGateway(singleAZGatewayHA)
+				err := client.DisableSingleAZGateway(ctx, singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("failed to update transit gateway: adding tags is only supported for AWS (1), Azure (8), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("failed to update transit gateway: adding tags is only supported for AWS (1), Azure (8), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		tags := &goaviatrix.Tags{
 			ResourceType: "gw",
@@ -2412,20 +2431,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			CloudType:    gateway.CloudType,
 		}
 
-		if d.HasChange("tags") {
-			tagsMap, err := extractTags(d, gateway.CloudType)
+		if d.HasChange("tags_all") {
+			tagsMap, err := extractTagsAll(d, gateway.CloudType)
 			if err != nil {
-				return fmt.Errorf("failed to update tags for transit gateway: %v", err)
+				return diag.Errorf("failed to update tags for transit gateway: %v", err)
 			}
 			tags.Tags = tagsMap
 			tagJson, err := TagsMapToJson(tagsMap)
 			if err != nil {
-				return fmt.Errorf("failed to update tags for transit gateway: %v", err)
+				return diag.Errorf("failed to update tags for transit gateway: %v", err)
 			}
 			tags.TagJson = tagJson
 			err = client.UpdateTags(tags)
 			if err != nil {
-				return fmt.Errorf("failed to update tags for transit gateway: %v", err)
+				return diag.Errorf("failed to update tags for transit gateway: %v", err)
 			}
 		}
 	}
@@ -2440,14 +2459,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		connectedTransit := d.Get("connected_transit").(bool)
 		if connectedTransit {
-			err := client.EnableConnectedTransit(transitGateway)
+			err := client.EnableConnectedTransit(ctx, transitGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable connected transit: %s", err)
+				return diag.Errorf("failed to enable connected transit: %s", err)
 			}
 		} else {
 			err := client.DisableConnectedTransit(transitGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable connected transit: %s", err)
+				return diag.Errorf("failed to disable connected transit: %s", err)
 			}
 		}
 
@@ -2459,9 +2478,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			old, _ := d.GetChange("gw_size")
 			primaryGwSize = old.(string)
 			gateway.VpcSize = d.Get("gw_size").(string)
-			err := client.UpdateGateway(gateway)
+			err := client.UpdateGateway(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
 			}
 		}
 
@@ -2478,17 +2497,17 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 					// If HA gateway does not exist, don't try to change HA gateway size and continue with the rest of the updates
 					// to the gateway
 					if err != goaviatrix.ErrNotFound {
-						return fmt.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
+						return diag.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
 					}
 				} else {
 					if haGateway.VpcSize == "" {
-						return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+						return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 							"ha_subnet or ha_zone is set")
 					}
-					err = client.UpdateGateway(haGateway)
-					log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+					err = client.UpdateGateway(ctx, haGateway)
+					logInfo(ctx, "Updating HA Gateway size", map[string]interface{}{"gw_size": haGateway.VpcSize})
 					if err != nil {
-						return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
+						return diag.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
 					}
 				}
 			}
@@ -2505,12 +2524,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if enableNat {
 			err := client.EnableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable 'single_ip' mode SNAT feature: %s", err)
+				return diag.Errorf("failed to enable 'single_ip' mode SNAT feature: %s", err)
 			}
 		} else {
 			err := client.DisableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable 'single_ip' mode SNAT: %s", err)
+				return diag.Errorf("failed to disable 'single_ip' mode SNAT: %s", err)
 			}
 		}
 
@@ -2527,20 +2546,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			enableHybridConnection := d.Get("enable_hybrid_connection").(bool)
 			if enableHybridConnection {
-				err := client.AttachTransitGWForHybrid(transitGateway)
+				err := client.AttachTransitGWForHybrid(ctx, transitGateway)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for Hybrid: %s", err)
+					return diag.Errorf("failed to enable transit GW for Hybrid: %s", err)
 				}
 			} else {
 				err := client.DetachTransitGWForHybrid(transitGateway)
 				if err != nil {
-					return fmt.Errorf("failed to disable transit GW for Hybrid: %s", err)
+					return diag.Errorf("failed to disable transit GW for Hybrid: %s", err)
 				}
 			}
 		}
 	} else {
 		if d.HasChange("enable_hybrid_connection") {
-			return fmt.Errorf("'enable_hybrid_connection' is only supported for AWS/AWSGov providers")
+			return attributeErrorf("enable_hybrid_connection", "'enable_hybrid_connection' is only supported for AWS/AWSGov providers")
 		}
 	}
 
@@ -2554,34 +2573,34 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if learnedCidrsApproval {
 				err := client.EnableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 				}
 			} else {
 				err := client.DisableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 				}
 			}
 			mode := d.Get("learned_cidrs_approval_mode").(string)
-			err := client.SetTransitLearnedCIDRsApprovalMode(gw, mode)
+			err := client.SetTransitLearnedCIDRsApprovalMode(ctx, gw, mode)
 			if err != nil {
-				return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
+				return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
 			}
 		} else {
 			mode := d.Get("learned_cidrs_approval_mode").(string)
-			err := client.SetTransitLearnedCIDRsApprovalMode(gw, mode)
+			err := client.SetTransitLearnedCIDRsApprovalMode(ctx, gw, mode)
 			if err != nil {
-				return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
+				return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
 			}
 			if learnedCidrsApproval {
 				err = client.EnableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 				}
 			} else {
 				err = client.DisableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 				}
 			}
 		}
@@ -2590,9 +2609,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			GwName: d.Get("gw_name").(string),
 		}
 		mode := d.Get("learned_cidrs_approval_mode").(string)
-		err := client.SetTransitLearnedCIDRsApprovalMode(gw, mode)
+		err := client.SetTransitLearnedCIDRsApprovalMode(ctx, gw, mode)
 		if err != nil {
-			return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
+			return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
 		}
 	} else if d.HasChange("enable_learned_cidrs_approval") {
 		gw := &goaviatrix.TransitVpc{
@@ -2602,13 +2621,13 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			gw.LearnedCidrsApproval = "yes"
 			err := client.EnableTransitLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			gw.LearnedCidrsApproval = "no"
 			err := client.DisableTransitLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
@@ -2619,9 +2638,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			ApprovedLearnedCidrs: approvedLearnedCidrs,
 		}
 
-		err := client.UpdateTransitPendingApprovedCidrs(gw)
+		err := client.UpdateTransitPendingApprovedCidrs(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("could not update approved CIDRs: %v", err)
+			return diag.Errorf("could not update approved CIDRs: %v", err)
 		}
 	}
 
@@ -2629,13 +2648,13 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	enableGatewayLoadBalancer := d.Get("enable_gateway_load_balancer").(bool)
 	enableTransitFireNet := d.Get("enable_transit_firenet").(bool)
 	if enableGatewayLoadBalancer && !enableFireNet && !enableTransitFireNet {
-		return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
+		return attributeErrorf("enable_gateway_load_balancer", "'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
 	}
 	if enableGatewayLoadBalancer && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWS) {
-		return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'cloud_type' = 1 (AWS)")
+		return attributeErrorf("enable_gateway_load_balancer", "'enable_gateway_load_balancer' is only valid when 'cloud_type' = 1 (AWS)")
 	}
 	if enableFireNet && enableTransitFireNet {
-		return fmt.Errorf("can't enable firenet function and transit firenet function at the same time")
+		return diag.Errorf("can't enable firenet function and transit firenet function at the same time")
 	}
 
 	if d.HasChange("enable_egress_transit_firenet") {
@@ -2643,7 +2662,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if !enableEgressTransitFirenet {
 			err := client.DisableEgressTransitFirenet(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not disable egress transit firenet: %v", err)
+				return diag.Errorf("could not disable egress transit firenet: %v", err)
 			}
 		}
 	}
@@ -2653,12 +2672,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if !enableTransitPreserveAsPath {
 			err := client.DisableTransitPreserveAsPath(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not disable Preserve AS Path during Transit Gateway update: %v", err)
+				return diag.Errorf("could not disable Preserve AS Path during Transit Gateway update: %v", err)
 			}
 		} else {
-			err := client.EnableTransitPreserveAsPath(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+			err := client.EnableTransitPreserveAsPath(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not enable Preserve AS Path during Transit Gateway update: %v", err)
+				return diag.Errorf("could not enable Preserve AS Path during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -2671,7 +2690,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if !enableFireNet {
 			err := client.DisableGatewayFireNetInterfaces(transitGW)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
+				return diag.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
 			}
 		}
 		if !enableTransitFireNet {
@@ -2680,19 +2699,19 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.DisableTransitFireNet(gwTransitFireNet)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+				return diag.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 			}
 		}
 		if enableFireNet {
 			if enableGatewayLoadBalancer {
-				err := client.EnableGatewayFireNetInterfacesWithGWLB(transitGW)
+				err := client.EnableGatewayFireNetInterfacesWithGWLB(ctx, transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
 				}
 			} else {
-				err := client.EnableGatewayFireNetInterfaces(transitGW)
+				err := client.EnableGatewayFireNetInterfaces(ctx, transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
 				}
 			}
 		}
@@ -2701,14 +2720,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				GwName: d.Get("gw_name").(string),
 			}
 			if enableGatewayLoadBalancer {
-				err := client.EnableTransitFireNetWithGWLB(gwTransitFireNet)
+				err := client.EnableTransitFireNetWithGWLB(ctx, gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			} else {
-				err := client.EnableTransitFireNet(gwTransitFireNet)
+				err := client.EnableTransitFireNet(ctx, gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			}
 		}
@@ -2719,20 +2738,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		if enableFireNet {
 			if enableGatewayLoadBalancer {
-				err := client.EnableGatewayFireNetInterfacesWithGWLB(transitGW)
+				err := client.EnableGatewayFireNetInterfacesWithGWLB(ctx, transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
 				}
 			} else {
-				err := client.EnableGatewayFireNetInterfaces(transitGW)
+				err := client.EnableGatewayFireNetInterfaces(ctx, transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
 				}
 			}
 		} else {
 			err := client.DisableGatewayFireNetInterfaces(transitGW)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
+				return diag.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
 			}
 		}
 	} else if d.HasChange("enable_transit_firenet") {
@@ -2741,14 +2760,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				GwName: d.Get("gw_name").(string),
 			}
 			if enableGatewayLoadBalancer {
-				err := client.EnableTransitFireNetWithGWLB(gwTransitFireNet)
+				err := client.EnableTransitFireNetWithGWLB(ctx, gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			} else {
-				err := client.EnableTransitFireNet(gwTransitFireNet)
+				err := client.EnableTransitFireNet(ctx, gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			}
 		} else {
@@ -2757,7 +2776,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.DisableTransitFireNet(gwTransitFireNet)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+				return diag.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 			}
 		}
 	} else if d.HasChange("enable_gateway_load_balancer") {
@@ -2765,11 +2784,11 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		// Due to the backend design it is not possible to disable or enable 'enable_gateway_load_balancer' without
 		// also disabling or enabling FireNet, so we force the user to disable or enable both at the same time.
 		if enableGatewayLoadBalancer {
-			return fmt.Errorf("can not enable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
+			return diag.Errorf("can not enable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
 				"already enabled. Changing from non-GWLB FireNet to GWLB FireNet requires 2 separate " +
 				"`terraform apply` steps, once to disable non-GWLB FireNet, then again to enable GWLB FireNet")
 		} else {
-			return fmt.Errorf("can not disable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
+			return diag.Errorf("can not disable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
 				"still enabled. Changing from GWLB FireNet to non-GWLB FireNet requires 2 separate " +
 				"`terraform apply` steps, once to disable GWLB FireNet, then again to enable non-GWLB FireNet")
 		}
@@ -2781,9 +2800,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			old, _ := d.GetChange("gw_size")
 			primaryGwSize = old.(string)
 			gateway.VpcSize = d.Get("gw_size").(string)
-			err := client.UpdateGateway(gateway)
+			err := client.UpdateGateway(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
 			}
 		}
 
@@ -2800,17 +2819,17 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 					// If HA gateway does not exist, don't try to change gateway size and continue with the rest of the updates
 					// to the gateway
 					if err != goaviatrix.ErrNotFound {
-						return fmt.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
+						return diag.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
 					}
 				} else {
 					if haGateway.VpcSize == "" {
-						return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+						return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 							"ha_subnet or ha_zone is set")
 					}
-					err = client.UpdateGateway(haGateway)
-					log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+					err = client.UpdateGateway(ctx, haGateway)
+					logInfo(ctx, "Updating HA Gateway size", map[string]interface{}{"gw_size": haGateway.VpcSize})
 					if err != nil {
-						return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
+						return diag.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
 					}
 				}
 			}
@@ -2820,9 +2839,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("enable_egress_transit_firenet") {
 		enableEgressTransitFirenet := d.Get("enable_egress_transit_firenet").(bool)
 		if enableEgressTransitFirenet {
-			err := client.EnableEgressTransitFirenet(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+			err := client.EnableEgressTransitFirenet(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not enable egress transit firenet: %v", err)
+				return diag.Errorf("could not enable egress transit firenet: %v", err)
 			}
 		}
 	}
@@ -2835,18 +2854,18 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 
 		enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
 		if enableVpcDnsServer {
-			err := client.EnableVpcDnsServer(gw)
+			err := client.EnableVpcDnsServer(ctx, gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 			}
 		} else {
 			err := client.DisableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to disable VPC DNS Server: %s", err)
 			}
 		}
 	} else if d.HasChange("enable_vpc_dns_server") {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
+		return attributeErrorf("enable_vpc_dns_server", "'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.HasChange("enable_advertise_transit_cidr") {
@@ -2856,15 +2875,15 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		enableAdvertiseTransitCidr := d.Get("enable_advertise_transit_cidr").(bool)
 		if enableAdvertiseTransitCidr {
 			transitGw.EnableAdvertiseTransitCidr = true
-			err := client.EnableAdvertiseTransitCidr(transitGw)
+			err := client.EnableAdvertiseTransitCidr(ctx, transitGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable advertise transit CIDR: %s", err)
+				return diag.Errorf("failed to enable advertise transit CIDR: %s", err)
 			}
 		} else {
 			transitGw.EnableAdvertiseTransitCidr = false
 			err := client.DisableAdvertiseTransitCidr(transitGw)
 			if err != nil {
-				return fmt.Errorf("failed to disable advertise transit CIDR: %s", err)
+				return diag.Errorf("failed to disable advertise transit CIDR: %s", err)
 			}
 		}
 	}
@@ -2875,16 +2894,16 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		bgpManualSpokeAdvertiseCidrs := d.Get("bgp_manual_spoke_advertise_cidrs").(string)
 		transitGw.BgpManualSpokeAdvertiseCidrs = bgpManualSpokeAdvertiseCidrs
-		err := client.SetBgpManualSpokeAdvertisedNetworks(transitGw)
+		err := client.SetBgpManualSpokeAdvertisedNetworks(ctx, transitGw)
 		if err != nil {
-			return fmt.Errorf("failed to set bgp manual spoke advertise CIDRs: %s", err)
+			return diag.Errorf("failed to set bgp manual spoke advertise CIDRs: %s", err)
 		}
 	}
 
 	if d.HasChange("enable_encrypt_volume") {
 		if d.Get("enable_encrypt_volume").(bool) {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+				return attributeErrorf("enable_encrypt_volume", "'enable_encrypt_volume' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              d.Get("gw_name").(string),
@@ -2892,7 +2911,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.EnableEncryptVolume(gwEncVolume)
 			if err != nil {
-				return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
+				return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
 			}
 
 			haSubnet := d.Get("ha_subnet").(string)
@@ -2905,14 +2924,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
 				if err != nil {
-					return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
+					return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
 				}
 			}
 		} else {
-			return fmt.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
+			return diag.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
 		}
 	} else if d.HasChange("customer_managed_keys") {
-		return fmt.Errorf("updating customer_managed_keys only is not allowed")
+		return diag.Errorf("updating customer_managed_keys only is not allowed")
 	}
 
 	if d.HasChange("customized_spoke_vpc_routes") {
@@ -2924,10 +2943,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				GwName:                   d.Get("gw_name").(string),
 				CustomizedSpokeVpcRoutes: newRouteList,
 			}
-			err := client.EditGatewayCustomRoutes(transitGateway)
-			log.Printf("[INFO] Customizeing routes of transit gateway: %s ", transitGateway.GwName)
+			err := client.EditGatewayCustomRoutes(ctx, transitGateway)
+			logInfo(ctx, "Customizing routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2941,10 +2960,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				GwName:                 d.Get("gw_name").(string),
 				FilteredSpokeVpcRoutes: newRouteList,
 			}
-			err := client.EditGatewayFilterRoutes(transitGateway)
-			log.Printf("[INFO] Editing filtered spoke vpc routes of transit gateway: %s ", transitGateway.GwName)
+			err := client.EditGatewayFilterRoutes(ctx, transitGateway)
+			logInfo(ctx, "Editing filtered spoke vpc routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2958,10 +2977,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				GwName:                d.Get("gw_name").(string),
 				AdvertisedSpokeRoutes: newRouteList,
 			}
-			err := client.EditGatewayAdvertisedCidr(transitGateway)
-			log.Printf("[INFO] Editing excluded advertised spoke vpc routes of transit gateway: %s ", transitGateway.GwName)
+			err := client.EditGatewayAdvertisedCidr(ctx, transitGateway)
+			logInfo(ctx, "Editing excluded advertised spoke vpc routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit excluded advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit excluded advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2971,9 +2990,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		gateway := &goaviatrix.TransitVpc{
 			GwName: d.Get("gw_name").(string),
 		}
-		err := client.SetBgpPollingTime(gateway, bgpPollingTime)
+		err := client.SetBgpPollingTime(ctx, gateway, bgpPollingTime)
 		if err != nil {
-			return fmt.Errorf("could not update bgp polling time: %v", err)
+			return diag.Errorf("could not update bgp polling time: %v", err)
 		}
 	}
 
@@ -2989,24 +3008,24 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(prependASPath) == 0 {
 			// prependASPath must be deleted from the controller before local_as_number can be changed
 			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gateway, nil)
+			err := client.SetPrependASPath(ctx, gateway, nil)
 			if err != nil {
-				return fmt.Errorf("could not delete prepend_as_path during Transit Gateway update: %v", err)
+				return diag.Errorf("could not delete prepend_as_path during Transit Gateway update: %v", err)
 			}
 		}
 
 		if d.HasChange("local_as_number") {
 			localAsNumber := d.Get("local_as_number").(string)
-			err := client.SetLocalASNumber(gateway, localAsNumber)
+			err := client.SetLocalASNumber(ctx, gateway, localAsNumber)
 			if err != nil {
-				return fmt.Errorf("could not set local_as_number during Transit Gateway update: %v", err)
+				return diag.Errorf("could not set local_as_number during Transit Gateway update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(prependASPath) > 0 {
-			err := client.SetPrependASPath(gateway, prependASPath)
+			err := client.SetPrependASPath(ctx, gateway, prependASPath)
 			if err != nil {
-				return fmt.Errorf("could not set prepend_as_path during Transit Gateway update: %v", err)
+				return diag.Errorf("could not set prepend_as_path during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3016,9 +3035,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		gateway := &goaviatrix.TransitVpc{
 			GwName: d.Get("gw_name").(string),
 		}
-		err := client.SetBgpEcmp(gateway, enabled)
+		err := client.SetBgpEcmp(ctx, gateway, enabled)
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp: %v", err)
+			return diag.Errorf("could not set bgp_ecmp: %v", err)
 		}
 	}
 
@@ -3028,12 +3047,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			GwName: d.Get("gw_name").(string),
 		}
 		if enabled {
-			if err := client.EnableSegmentation(gateway); err != nil {
-				return fmt.Errorf("could not enable segmentation: %v", err)
+			if err := client.EnableSegmentation(ctx, gateway); err != nil {
+				return diag.Errorf("could not enable segmentation: %v", err)
 			}
 		} else {
 			if err := client.DisableSegmentation(gateway); err != nil {
-				return fmt.Errorf("could not disable segmentation: %v", err)
+				return diag.Errorf("could not disable segmentation: %v", err)
 			}
 		}
 	}
@@ -3044,20 +3063,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		if d.Get("enable_active_standby").(bool) {
 			if d.Get("enable_active_standby_preemptive").(bool) {
-				if err := client.EnableActiveStandbyPreemptive(gateway); err != nil {
-					return fmt.Errorf("could not enable Preemptive Mode for Active-Standby during Transit Gatway update: %v", err)
+				if err := client.EnableActiveStandbyPreemptive(ctx, gateway); err != nil {
+					return diag.Errorf("could not enable Preemptive Mode for Active-Standby during Transit Gatway update: %v", err)
 				}
 			} else {
-				if err := client.EnableActiveStandby(gateway); err != nil {
-					return fmt.Errorf("could not enable Active-Standby during Transit Gateway update: %v", err)
+				if err := client.EnableActiveStandby(ctx, gateway); err != nil {
+					return diag.Errorf("could not enable Active-Standby during Transit Gateway update: %v", err)
 				}
 			}
 		} else {
 			if d.Get("enable_active_standby_preemptive").(bool) {
-				return fmt.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
+				return diag.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
 			}
 			if err := client.DisableActiveStandby(gateway); err != nil {
-				return fmt.Errorf("could not disable Active-Standby during Transit Gateway update: %v", err)
+				return diag.Errorf("could not disable Active-Standby during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3068,9 +3087,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			customizedTransitVpcRoutes = append(customizedTransitVpcRoutes, v.(string))
 		}
 
-		err := client.UpdateTransitGatewayCustomizedVpcRoute(gateway.GwName, customizedTransitVpcRoutes)
+		err := client.UpdateTransitGatewayCustomizedVpcRoute(ctx, gateway.GwName, customizedTransitVpcRoutes)
 		if err != nil {
-			return fmt.Errorf("couldn't update transit gateway customized vpc route: %s", err)
+			return diag.Errorf("couldn't update transit gateway customized vpc route: %s", err)
 		}
 	}
 
@@ -3080,28 +3099,28 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		excludedInstances = append(excludedInstances, v.(string))
 	}
 	if !monitorGatewaySubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return attributeErrorf("monitor_exclude_list", "'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 	if d.HasChange("enable_monitor_gateway_subnets") {
 		if monitorGatewaySubnets {
-			err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
+			err := client.EnableMonitorGatewaySubnets(ctx, gateway.GwName, excludedInstances)
 			if err != nil {
-				return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+				return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 			}
 		} else {
 			err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable monitor gateway subnets: %v", err)
+				return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 			}
 		}
 	} else if d.HasChange("monitor_exclude_list") {
 		err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not disable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 		}
-		err = client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
+		err = client.EnableMonitorGatewaySubnets(ctx, gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -3109,12 +3128,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_jumbo_frame").(bool) {
 			err := client.EnableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable jumbo frame for transit gateway when updating: %v", err)
+				return diag.Errorf("could not enable jumbo frame for transit gateway when updating: %v", err)
 			}
 		} else {
-			err := client.DisableJumboFrame(gateway)
+			err := client.DisableJumboFrame(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable jumbo frame for transit gateway when updating: %v", err)
+				return diag.Errorf("could not disable jumbo frame for transit gateway when updating: %v", err)
 			}
 		}
 	}
@@ -3123,33 +3142,33 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_gro_gso").(bool) {
 			err := client.EnableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable GRO/GSO on transit gateway when updating: %s", err)
+				return diag.Errorf("couldn't enable GRO/GSO on transit gateway when updating: %s", err)
 			}
 		} else {
-			err := client.DisableGroGso(gateway)
+			err := client.DisableGroGso(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable GRO/GSO on transit gateway when updating: %s", err)
+				return diag.Errorf("couldn't disable GRO/GSO on transit gateway when updating: %s", err)
 			}
 		}
 	}
 
 	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(gateway.GwName, d.Get("bgp_hold_time").(int))
+		err := client.ChangeBgpHoldTime(ctx, gateway.GwName, d.Get("bgp_hold_time").(int))
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time during Transit Gateway update: %v", err)
+			return diag.Errorf("could not change BGP Hold Time during Transit Gateway update: %v", err)
 		}
 	}
 
 	if d.HasChange("enable_transit_summarize_cidr_to_tgw") {
 		if d.Get("enable_transit_summarize_cidr_to_tgw").(bool) {
-			err := client.EnableSummarizeCidrToTgw(gateway.GwName)
+			err := client.EnableSummarizeCidrToTgw(ctx, gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable summarize cidr to tgw when updating: %v", err)
+				return diag.Errorf("could not enable summarize cidr to tgw when updating: %v", err)
 			}
 		} else {
 			err := client.DisableSummarizeCidrToTgw(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable summarize cidr to tgw when updating: %v", err)
+				return diag.Errorf("could not disable summarize cidr to tgw when updating: %v", err)
 			}
 		}
 	}
@@ -3157,30 +3176,30 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("enable_multi_tier_transit") {
 		if d.Get("enable_multi_tier_transit").(bool) {
 			if d.Get("local_as_number") == "" {
-				return fmt.Errorf("local_as_number required to enable multi tier transit")
+				return attributeErrorf("local_as_number", "local_as_number required to enable multi tier transit")
 			}
-			err := client.EnableMultitierTransit(gateway.GwName)
+			err := client.EnableMultitierTransit(ctx, gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable multi tier transit when updating: %v", err)
+				return diag.Errorf("could not enable multi tier transit when updating: %v", err)
 			}
 		} else {
 			err := client.DisableMultitierTransit(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable multi tier transit when updating: %v", err)
+				return diag.Errorf("could not disable multi tier transit when updating: %v", err)
 			}
 		}
 	}
 
 	if d.HasChange("enable_s2c_rx_balancing") {
 		if d.Get("enable_s2c_rx_balancing").(bool) {
-			err := client.EnableS2CRxBalancing(gateway.GwName)
+			err := client.EnableS2CRxBalancing(ctx, gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable S2C receive packet CPU re-balancing during Transit Gateway update: %v", err)
+				return diag.Errorf("could not enable S2C receive packet CPU re-balancing during Transit Gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableS2CRxBalancing(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable S2C receive packet CPU re-balancing during Transit Gateway update: %v", err)
+				return diag.Errorf("could not disable S2C receive packet CPU re-balancing during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3194,12 +3213,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			var err error
 			detectionTime, err = client.GetTunnelDetectionTime("Controller")
 			if err != nil {
-				return fmt.Errorf("could not get default tunnel detection time during Transit Gateway update: %v", err)
+				return diag.Errorf("could not get default tunnel detection time during Transit Gateway update: %v", err)
 			}
 		}
-		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime)
+		err := client.ModifyTunnelDetectionTime(ctx, gateway.GwName, detectionTime)
 		if err != nil {
-			return fmt.Errorf("could not modify tunnel detection time during Transit Gateway update: %v", err)
+			return diag.Errorf("could not modify tunnel detection time during Transit Gateway update: %v", err)
 		}
 	}
 
@@ -3224,7 +3243,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			!primaryRollbackSoftwareVersion && !haRollbackSoftwareVersion {
 			// Both Primary and HA have upgraded just their software_version
 			// so we can perform upgrade in parallel.
-			log.Printf("[INFO] Upgrading transit gateway gw_name=%s ha/primary pair in parallel", gateway.GwName)
+			logInfo(ctx, "Upgrading transit gateway ha/primary pair in parallel", map[string]interface{}{"gw_name": gateway.GwName})
 			swVersion := d.Get("software_version").(string)
 			imageVersion := d.Get("image_version").(string)
 			gw := &goaviatrix.Gateway{
@@ -3252,17 +3271,17 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}()
 			wg.Wait()
 			if primaryErr != nil && haErr != nil {
-				return fmt.Errorf("could not upgrade primary and HA transit gateway "+
+				return diag.Errorf("could not upgrade primary and HA transit gateway "+
 					"software_version=%s ha_software_version=%s image_version=%s ha_image_version=%s:"+
 					"\n primaryErr: %v\n haErr: %v",
 					swVersion, haSwVersion, imageVersion, haImageVersion, primaryErr, haErr)
 			} else if primaryErr != nil {
-				return fmt.Errorf("could not upgrade primary transit gateway software_version=%s: %v", swVersion, primaryErr)
+				return diag.Errorf("could not upgrade primary transit gateway software_version=%s: %v", swVersion, primaryErr)
 			} else if haErr != nil {
-				return fmt.Errorf("could not upgrade HA transit gateway ha_software_version=%s: %v", haSwVersion, haErr)
+				return diag.Errorf("could not upgrade HA transit gateway ha_software_version=%s: %v", haSwVersion, haErr)
 			}
 		} else { // Only primary or only HA has changed, or image_version changed, or it is a software rollback
-			log.Printf("[INFO] Upgrading transit gateway gw_name=%s ha or primary in serial", gateway.GwName)
+			logInfo(ctx, "Upgrading transit gateway ha or primary in serial", map[string]interface{}{"gw_name": gateway.GwName})
 			if primaryHasVersionChange {
 				swVersion := d.Get("software_version").(string)
 				imageVersion := d.Get("image_version").(string)
@@ -3273,7 +3292,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.UpgradeGateway(gw)
 				if err != nil {
-					return fmt.Errorf("could not upgrade transit gateway during update image_version=%s software_version=%s: %v", gw.ImageVersion, gw.SoftwareVersion, err)
+					return diag.Errorf("could not upgrade transit gateway during update image_version=%s software_version=%s: %v", gw.ImageVersion, gw.SoftwareVersion, err)
 				}
 			}
 			if haHasVersionChange {
@@ -3286,7 +3305,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.UpgradeGateway(hagw)
 				if err != nil {
-					return fmt.Errorf("could not upgrade HA transit gateway during update image_version=%s software_version=%s: %v", hagw.ImageVersion, hagw.SoftwareVersion, err)
+					return diag.Errorf("could not upgrade HA transit gateway during update image_version=%s software_version=%s: %v", hagw.ImageVersion, hagw.SoftwareVersion, err)
 				}
 			}
 		}
@@ -3294,24 +3313,24 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 
 	if d.HasChange("rx_queue_size") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
+			return diag.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
 		}
 		gw := &goaviatrix.Gateway{
 			GwName:      gateway.GwName,
 			RxQueueSize: d.Get("rx_queue_size").(string),
 		}
-		err := client.SetRxQueueSize(gw)
+		err := client.SetRxQueueSize(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("could not modify rx queue size for transit: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify rx queue size for transit: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
 				GwName:      d.Get("gw_name").(string) + "-hagw",
 				RxQueueSize: d.Get("rx_queue_size").(string),
 			}
-			err := client.SetRxQueueSize(haGwRxQueueSize)
+			err := client.SetRxQueueSize(ctx, haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("could not modify rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("could not modify rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
@@ -3319,22 +3338,22 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChanges("enable_bgp_over_lan", "bgp_lan_interfaces_count") {
 		if d.HasChange("enable_bgp_over_lan") {
 			if !d.Get("enable_bgp_over_lan").(bool) {
-				return fmt.Errorf("disabling BGP over LAN during update is not supported for transit: %s", gateway.GwName)
+				return diag.Errorf("disabling BGP over LAN during update is not supported for transit: %s", gateway.GwName)
 			}
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("enabling BGP over LAN during update is only supported for Azure transit")
+				return diag.Errorf("enabling BGP over LAN during update is only supported for Azure transit")
 			}
 			if _, ok := d.GetOk("bgp_lan_interfaces_count"); !ok {
-				return fmt.Errorf("please specify 'bgp_lan_interfaces_count' to enable BGP over LAN during update for Azure transit: %s", gateway.GwName)
+				return diag.Errorf("please specify 'bgp_lan_interfaces_count' to enable BGP over LAN during update for Azure transit: %s", gateway.GwName)
 			}
 		}
 		if d.HasChange("bgp_lan_interfaces_count") {
 			if !d.Get("enable_bgp_over_lan").(bool) || !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("could not update BGP over LAN interface count since it only supports BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
+				return diag.Errorf("could not update BGP over LAN interface count since it only supports BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 			oldCount, newCount := d.GetChange("bgp_lan_interfaces_count")
 			if oldCount.(int) > newCount.(int) {
-				return fmt.Errorf("deleting BGP over LAN interface during update is not supported for transit: %s", gateway.GwName)
+				return diag.Errorf("deleting BGP over LAN interface during update is not supported for transit: %s", gateway.GwName)
 			}
 		}
 		gw := &goaviatrix.Gateway{
@@ -3343,7 +3362,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		err := client.ChangeBgpOverLanIntfCnt(gw)
 		if err != nil {
-			return fmt.Errorf("could not modify BGP over LAN interface count for transit: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify BGP over LAN interface count for transit: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" {
 			haGw := &goaviatrix.Gateway{
@@ -3352,30 +3371,30 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.ChangeBgpOverLanIntfCnt(haGw)
 			if err != nil {
-				return fmt.Errorf("could not modify BGP over LAN interface count for transit ha: %s during gateway update: %v", haGw.GwName, err)
+				return diag.Errorf("could not modify BGP over LAN interface count for transit ha: %s during gateway update: %v", haGw.GwName, err)
 			}
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixTransitGatewayRead(d, meta)
+	return resourceAviatrixTransitGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
 		GwName:    d.Get("gw_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Gateway: %#v", gateway)
+	logInfo(ctx, "Deleting Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	enableEgressTransitFirenet := d.Get("enable_egress_transit_firenet").(bool)
 	if enableEgressTransitFirenet {
 		err := client.DisableEgressTransitFirenet(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not disable egress transit firenet: %v", err)
+			return diag.Errorf("could not disable egress transit firenet: %v", err)
 		}
 	}
 
@@ -3383,7 +3402,7 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 	if enableTransitPreserveAsPath {
 		err := client.DisableTransitPreserveAsPath(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not disable transit preserve as path: %v", err)
+			return diag.Errorf("could not disable transit preserve as path: %v", err)
 		}
 	}
 
@@ -3396,7 +3415,7 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 
 		err := client.DisableGatewayFireNetInterfaces(gw)
 		if err != nil {
-			return fmt.Errorf("failed to disable Aviatrix Transit Gateway for FireNet Interfaces: %s", err)
+			return diag.Errorf("failed to disable Aviatrix Transit Gateway for FireNet Interfaces: %s", err)
 		}
 	}
 
@@ -3404,12 +3423,12 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 	if enableTransitFireNet && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
 		err := client.DisableTransitFireNet(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
+			return diag.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
 		}
 	} else if enableTransitFireNet && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 		err := client.IsTransitFireNetReadyToBeDisabled(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
+			return diag.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
 		}
 	}
 
@@ -3423,23 +3442,22 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 
 		for {
 			try++
-			err := client.DeleteGateway(gateway)
+			err := client.DeleteGateway(ctx, gateway)
 			if err != nil {
-				if strings.Contains(err.Error(), "not found") {
+				if goaviatrix.IsNotFound(err) {
 					break
 				}
 
 				if !strings.Contains(err.Error(), "EOF") {
-					return fmt.Errorf("failed to delete Aviatrix Transit Gateway HA gateway: %s", err)
+					return diag.Errorf("failed to delete Aviatrix Transit Gateway HA gateway: %s", err)
 				}
 			} else {
 				break
 			}
 
-			if try == maxTries {
-				return fmt.Errorf("failed to delete Aviatrix Transit Gateway HA gateway: %s", err)
+			if try == maxTries || goaviatrix.SleepContext(ctx, backoff) != nil {
+				return diag.Errorf("failed to delete Aviatrix Transit Gateway HA gateway: %s", err)
 			}
-			time.Sleep(backoff)
 			// Double the backoff time after each failed try
 			backoff *= 2
 		}
@@ -3447,9 +3465,9 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 
 	gateway.GwName = d.Get("gw_name").(string)
 
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGateway(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Transit Gateway: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Transit Gateway: %s", err)
 	}
 
 	return nil
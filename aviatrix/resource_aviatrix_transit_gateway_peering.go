@@ -1,23 +1,29 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitGatewayPeeringCreate,
-		Read:   resourceAviatrixTransitGatewayPeeringRead,
-		Update: resourceAviatrixTransitGatewayPeeringUpdate,
-		Delete: resourceAviatrixTransitGatewayPeeringDelete,
+		CreateContext: resourceAviatrixTransitGatewayPeeringCreate,
+		ReadContext:   resourceAviatrixTransitGatewayPeeringRead,
+		UpdateContext: resourceAviatrixTransitGatewayPeeringUpdate,
+		DeleteContext: resourceAviatrixTransitGatewayPeeringDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Update: schema.DefaultTimeout(defaultAttachmentTimeout),
+			Delete: schema.DefaultTimeout(defaultAttachmentTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -140,8 +146,8 @@ func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	var gw1Cidrs []string
 	for _, cidr := range d.Get("gateway1_excluded_network_cidrs").([]interface{}) {
@@ -178,14 +184,14 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta in
 	}
 
 	if transitGatewayPeering.PrivateIPPeering == "yes" && transitGatewayPeering.InsaneModeOverInternet {
-		return fmt.Errorf("enable_peering_over_private_network conflicts with enable_insane_mode_encryption_over_internet")
+		return attributeErrorf("enable_peering_over_private_network", "enable_peering_over_private_network conflicts with enable_insane_mode_encryption_over_internet")
 	}
 
 	if d.Get("enable_single_tunnel_mode").(bool) {
 		if transitGatewayPeering.PrivateIPPeering == "yes" {
 			transitGatewayPeering.SingleTunnel = "yes"
 		} else {
-			return fmt.Errorf("enable_single_tunnel_mode is only valid when enable_peering_over_private_network is set to true")
+			return attributeErrorf("enable_single_tunnel_mode", "enable_single_tunnel_mode is only valid when enable_peering_over_private_network is set to true")
 		}
 	}
 
@@ -194,23 +200,23 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta in
 		if transitGatewayPeering.InsaneModeOverInternet {
 			transitGatewayPeering.TunnelCount = tunnelCount
 		} else {
-			return fmt.Errorf("tunnel_count is only valid when enable_insane_mode_encryption_over_internet is set to true")
+			return attributeErrorf("tunnel_count", "tunnel_count is only valid when enable_insane_mode_encryption_over_internet is set to true")
 		}
 	} else {
 		if transitGatewayPeering.InsaneModeOverInternet {
-			return fmt.Errorf("enable_insane_mode_encryption_over_internet being set to true requires valid tunnel_count")
+			return attributeErrorf("enable_insane_mode_encryption_over_internet", "enable_insane_mode_encryption_over_internet being set to true requires valid tunnel_count")
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+	logInfo(ctx, "Creating Aviatrix Transit Gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 
 	d.SetId(transitGatewayPeering.TransitGatewayName1 + "~" + transitGatewayPeering.TransitGatewayName2)
 	flag := false
-	defer resourceAviatrixTransitGatewayPeeringReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateTransitGatewayPeering(transitGatewayPeering)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Transit Gateway peering: %s", err)
+		return diag.Errorf("failed to create Aviatrix Transit Gateway peering: %s", err)
 	}
 
 	if _, ok := d.GetOk("prepend_as_path1"); ok {
@@ -225,7 +231,7 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta in
 
 		err = client.EditTransitConnectionASPathPrepend(transGwPeering, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path1: %v", err)
+			return diag.Errorf("could not set prepend_as_path1: %v", err)
 		}
 	}
 
@@ -241,33 +247,33 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta in
 
 		err = client.EditTransitConnectionASPathPrepend(transGwPeering, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path2: %v", err)
+			return diag.Errorf("could not set prepend_as_path2: %v", err)
 		}
 	}
 
-	return resourceAviatrixTransitGatewayPeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitGatewayPeeringReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitGatewayPeeringRead(d, meta)
+		return resourceAviatrixTransitGatewayPeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	transitGwName1 := d.Get("transit_gateway_name1").(string)
 	transitGwName2 := d.Get("transit_gateway_name2").(string)
 
 	if transitGwName1 == "" || transitGwName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway names received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no transit gateway names received", map[string]interface{}{"import_id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid import id expected transit_gateway_name1~transit_gateway_name2")
+			return diag.Errorf("invalid import id expected transit_gateway_name1~transit_gateway_name2")
 		}
 		d.Set("transit_gateway_name1", parts[0])
 		d.Set("transit_gateway_name2", parts[1])
@@ -285,28 +291,28 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get transit peering details: %v", err)
+		return diag.Errorf("could not get transit peering details: %v", err)
 	}
 
 	gw1CidrsFromConfig := getStringList(d, "gateway1_excluded_network_cidrs")
 	err = setConfigValueIfEquivalent(d, "gateway1_excluded_network_cidrs", gw1CidrsFromConfig, transitGatewayPeering.Gateway1ExcludedCIDRsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway1_excluded_network_cidrs to state: %v", err)
+		return diag.Errorf("could not write gateway1_excluded_network_cidrs to state: %v", err)
 	}
 	gw2CidrsFromConfig := getStringList(d, "gateway2_excluded_network_cidrs")
 	err = setConfigValueIfEquivalent(d, "gateway2_excluded_network_cidrs", gw2CidrsFromConfig, transitGatewayPeering.Gateway2ExcludedCIDRsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway2_excluded_network_cidrs to state: %v", err)
+		return diag.Errorf("could not write gateway2_excluded_network_cidrs to state: %v", err)
 	}
 	gw1TgwsFromConfig := getStringList(d, "gateway1_excluded_tgw_connections")
 	err = setConfigValueIfEquivalent(d, "gateway1_excluded_tgw_connections", gw1TgwsFromConfig, transitGatewayPeering.Gateway1ExcludedTGWConnectionsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway1_excluded_tgw_connections to state: %v", err)
+		return diag.Errorf("could not write gateway1_excluded_tgw_connections to state: %v", err)
 	}
 	gw2TgwsFromConfig := getStringList(d, "gateway2_excluded_tgw_connections")
 	err = setConfigValueIfEquivalent(d, "gateway2_excluded_tgw_connections", gw2TgwsFromConfig, transitGatewayPeering.Gateway2ExcludedTGWConnectionsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway2_excluded_tgw_connections to state: %v", err)
+		return diag.Errorf("could not write gateway2_excluded_tgw_connections to state: %v", err)
 	}
 
 	if transitGatewayPeering.PrependAsPath1 != "" {
@@ -317,7 +323,7 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 
 		err = d.Set("prepend_as_path1", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path1: %v", err)
+			return diag.Errorf("could not set prepend_as_path1: %v", err)
 		}
 	}
 	if transitGatewayPeering.PrependAsPath2 != "" {
@@ -328,7 +334,7 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 
 		err = d.Set("prepend_as_path2", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path2: %v", err)
+			return diag.Errorf("could not set prepend_as_path2: %v", err)
 		}
 	}
 
@@ -349,8 +355,8 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	d.Partial(true)
 
@@ -382,10 +388,10 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta in
 		transitGatewayPeering.Gateway1ExcludedTGWConnections = strings.Join(gw1Tgws, ",")
 		transitGatewayPeering.Gateway2ExcludedTGWConnections = strings.Join(gw2Tgws, ",")
 
-		log.Printf("[INFO] Updating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+		logInfo(ctx, "Updating Aviatrix Transit Gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 		err := client.UpdateTransitGatewayPeering(transitGatewayPeering)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Transit Gateway peering: %s", err)
+			return diag.Errorf("failed to update Aviatrix Transit Gateway peering: %s", err)
 		}
 	}
 
@@ -397,7 +403,7 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta in
 
 		err := client.EditTransitConnectionASPathPrepend(transitGatewayPeering, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path1: %v", err)
+			return diag.Errorf("could not update prepend_as_path1: %v", err)
 		}
 
 	}
@@ -413,29 +419,29 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta in
 		}
 		err := client.EditTransitConnectionASPathPrepend(transitGwPeering, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path2: %v", err)
+			return diag.Errorf("could not update prepend_as_path2: %v", err)
 		}
 
 	}
 
 	d.Partial(false)
 	d.SetId(transitGatewayPeering.TransitGatewayName1 + "~" + transitGatewayPeering.TransitGatewayName2)
-	return resourceAviatrixTransitGatewayPeeringRead(d, meta)
+	return resourceAviatrixTransitGatewayPeeringRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitGatewayPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.TransitClient)
 
 	transitGatewayPeering := &goaviatrix.TransitGatewayPeering{
 		TransitGatewayName1: d.Get("transit_gateway_name1").(string),
 		TransitGatewayName2: d.Get("transit_gateway_name2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+	logInfo(ctx, "Deleting Aviatrix Transit Gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 
 	err := client.DeleteTransitGatewayPeering(transitGatewayPeering)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Transit Gateway peering: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Transit Gateway peering: %s", err)
 	}
 
 	return nil
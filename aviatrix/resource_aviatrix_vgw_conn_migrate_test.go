@@ -1,7 +1,6 @@
-package aviatrix_test
+package aviatrix
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -11,21 +10,21 @@ import (
 func TestAviatrixVGWConnMigrateState(t *testing.T) {
 	t.Run("Migrate VGW Conn State v0 to v1", func(t *testing.T) {
 		// Define the initial state (v0)
-		initialState := terraform.InstanceState{
+		initialState := &terraform.InstanceState{
 			ID:         "test-vgw-conn",
 			Attributes: map[string]string{"enable_advertise_transit_cidr": "true"},
 		}
 
 		// Migrate the state
-		migratedState, err := resourceAviatrixVGWConnMigrateState(0, &initialState, nil)
+		migratedState, err := resourceAviatrixVGWConnMigrateState(0, initialState, nil)
 		assert.NoError(t, err)
 
 		// Assert the migrated state (v1)
-		expectedState := terraform.InstanceState{
+		expectedState := &terraform.InstanceState{
 			ID:         "test-vgw-conn",
 			Attributes: map[string]string{"enable_advertise_transit_cidr": "false"},
 		}
-		assert.Equal(t, expectedState, *migratedState)
+		assert.Equal(t, expectedState, migratedState)
 	})
 
 	t.Run("Unsupported schema version", func(t *testing.T) {
@@ -1,22 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVpcCreate,
-		Read:   resourceAviatrixVpcRead,
-		Update: resourceAviatrixVpcUpdate,
-		Delete: resourceAviatrixVpcDelete,
+		CreateContext: resourceAviatrixVpcCreate,
+		ReadContext:   resourceAviatrixVpcRead,
+		UpdateContext: resourceAviatrixVpcUpdate,
+		DeleteContext: resourceAviatrixVpcDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -227,8 +227,8 @@ func resourceAviatrixVpc() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		CloudType:              d.Get("cloud_type").(int),
@@ -242,30 +242,30 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 		PrivateModeSubnets:     d.Get("private_mode_subnets").(bool),
 	}
 	if vpc.Region == "" && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("please specifiy 'region'")
+		return diag.Errorf("please specifiy 'region'")
 	} else if vpc.Region != "" && vpc.CloudType == goaviatrix.GCP {
-		return fmt.Errorf("please specify 'region' in 'subnets' for GCP provider")
+		return diag.Errorf("please specify 'region' in 'subnets' for GCP provider")
 	}
 
 	if vpc.Cidr == "" && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'cidr'")
+		return diag.Errorf("please specify 'cidr'")
 	} else if vpc.Cidr != "" && goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'cidr' in 'subnets' for GCP provider")
+		return diag.Errorf("please specify 'cidr' in 'subnets' for GCP provider")
 	}
 	if vpc.SubnetSize != 0 && vpc.NumOfSubnetPairs != 0 {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
+			return diag.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 		}
 	} else if vpc.SubnetSize != 0 || vpc.NumOfSubnetPairs != 0 {
 		if goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("please specify both 'subnet_size' and 'num_of_subnet_pairs' to enable advanced options")
+			return diag.Errorf("please specify both 'subnet_size' and 'num_of_subnet_pairs' to enable advanced options")
 		} else {
-			return fmt.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
+			return diag.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 		}
 	}
 	if vpc.EnablePrivateOobSubnet {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("advanced option('enable_private_oob_subnet') is only supported by AWS (1), AWSGov (256), and AWSChina (1024)")
+			return diag.Errorf("advanced option('enable_private_oob_subnet') is only supported by AWS (1), AWSGov (256), and AWSChina (1024)")
 		}
 	}
 
@@ -273,34 +273,34 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	aviatrixFireNetVpc := d.Get("aviatrix_firenet_vpc").(bool)
 
 	if aviatrixTransitVpc && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
-		return fmt.Errorf("currently 'aviatrix_transit_vpc' is only supported by AWS (1), AWSGov (256), AWSChina (1024) and Alibaba Cloud (8192)")
+		return diag.Errorf("currently 'aviatrix_transit_vpc' is only supported by AWS (1), AWSGov (256), AWSChina (1024) and Alibaba Cloud (8192)")
 	}
 	if aviatrixFireNetVpc && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-		return fmt.Errorf("currently'aviatrix_firenet_vpc' is only supported by AWS (1), Azure (8), OCI (16), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
+		return diag.Errorf("currently'aviatrix_firenet_vpc' is only supported by AWS (1), Azure (8), OCI (16), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 	}
 	if aviatrixTransitVpc && aviatrixFireNetVpc {
-		return fmt.Errorf("vpc cannot be aviatrix transit vpc and aviatrix firenet vpc at the same time")
+		return diag.Errorf("vpc cannot be aviatrix transit vpc and aviatrix firenet vpc at the same time")
 	}
 
 	nativeGwlb := d.Get("enable_native_gwlb").(bool)
 	if nativeGwlb && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWS) {
-		return fmt.Errorf("'enable_native_gwlb' is only valid with cloud_type = 1 (AWS)")
+		return attributeErrorf("enable_native_gwlb", "'enable_native_gwlb' is only valid with cloud_type = 1 (AWS)")
 	}
 
 	if aviatrixTransitVpc {
 		vpc.AviatrixTransitVpc = "yes"
-		log.Printf("[INFO] Creating a new Aviatrix Transit VPC: %#v", vpc)
+		logInfo(ctx, "Creating a new Aviatrix Transit VPC", map[string]interface{}{"account_name": vpc.AccountName, "name": vpc.Name, "vpc_id": vpc.VpcID})
 	} else {
 		vpc.AviatrixTransitVpc = "no"
 	}
 	if aviatrixFireNetVpc {
 		vpc.AviatrixFireNetVpc = "yes"
-		log.Printf("[INFO] Creating a new Aviatrix FireNet VPC: %#v", vpc)
+		logInfo(ctx, "Creating a new Aviatrix FireNet VPC", map[string]interface{}{"account_name": vpc.AccountName, "name": vpc.Name, "vpc_id": vpc.VpcID})
 	} else {
 		vpc.AviatrixFireNetVpc = "no"
 	}
 	if !aviatrixTransitVpc && !aviatrixFireNetVpc {
-		log.Printf("[INFO] Creating a new VPC: %#v", vpc)
+		logInfo(ctx, "Creating a new VPC", map[string]interface{}{"account_name": vpc.AccountName, "name": vpc.Name, "vpc_id": vpc.VpcID})
 	}
 
 	if goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
@@ -316,15 +316,15 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 				vpc.Subnets = append(vpc.Subnets, subnetInfo)
 			}
 		} else {
-			return fmt.Errorf("subnets is required to be non-empty for GCP provider")
+			return diag.Errorf("subnets is required to be non-empty for GCP provider")
 		}
 	} else if _, ok := d.GetOk("subnets"); ok {
-		return fmt.Errorf("subnets is required to be empty for providers other than GCP")
+		return diag.Errorf("subnets is required to be empty for providers other than GCP")
 	}
 
 	if resourceGroup, ok := d.GetOk("resource_group"); ok {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("error creating vpc: resource_group is required to be empty for providers other than Azure (8), AzureGov (32) and AzureChina (2048)")
+			return diag.Errorf("error creating vpc: resource_group is required to be empty for providers other than Azure (8), AzureGov (32) and AzureChina (2048)")
 		}
 		vpc.ResourceGroup = resourceGroup.(string)
 	}
@@ -332,40 +332,40 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	err := client.CreateVpc(vpc)
 	if err != nil {
 		if vpc.AviatrixTransitVpc == "yes" {
-			return fmt.Errorf("failed to create a new Aviatrix Transit VPC: %s", err)
+			return diag.Errorf("failed to create a new Aviatrix Transit VPC: %s", err)
 		}
-		return fmt.Errorf("failed to create a new VPC: %s", err)
+		return diag.Errorf("failed to create a new VPC: %s", err)
 	}
 
 	d.SetId(vpc.Name)
 
 	// We intentionally call the Read function early here to load 'vpc_id' for later use
-	err = resourceAviatrixVpcRead(d, meta)
-	if err != nil {
-		return err
+	if diags := resourceAviatrixVpcRead(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 	vpc.VpcID = d.Get("vpc_id").(string)
 
 	if nativeGwlb {
 		err = client.EnableNativeAwsGwlbFirenet(vpc)
 		if err != nil {
-			return fmt.Errorf("could not enable native AWS Gwlb: %v", err)
+			return diag.Errorf("could not enable native AWS Gwlb: %v", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	vpcName := d.Get("name").(string)
 	if vpcName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no vpc names received", map[string]interface{}{"import_id": id})
 		d.Set("name", id)
 		d.SetId(id)
-		return resourceAviatrixVpcRead(d, meta)
+		return resourceAviatrixVpcRead(ctx, d, meta)
 	}
 
 	vpc := &goaviatrix.Vpc{
@@ -378,10 +378,10 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find VPC: %s", err)
+		return diag.Errorf("couldn't find VPC: %s", err)
 	}
 
-	log.Printf("[INFO] Found VPC: %#v", vpc)
+	logInfo(ctx, "Found VPC", map[string]interface{}{"account_name": vpc.AccountName, "name": vpc.Name, "vpc_id": vpc.VpcID})
 
 	d.Set("cloud_type", vC.CloudType)
 	d.Set("account_name", vC.AccountName)
@@ -423,7 +423,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		acc, err := client.GetAccount(account)
 		if err != nil {
 			if err != goaviatrix.ErrNotFound {
-				return fmt.Errorf("aviatrix Account: %s", err)
+				return diag.Errorf("aviatrix Account: %s", err)
 			}
 		}
 
@@ -496,7 +496,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("subnets", subnetsFromFile); err != nil {
-		log.Printf("[WARN] Error setting 'subnets' for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("subnets", "could not set subnets into state", err))
 	}
 
 	var privateSubnets []map[string]interface{}
@@ -509,7 +509,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		privateSubnets = append(privateSubnets, subnetInfo)
 	}
 	if err := d.Set("private_subnets", privateSubnets); err != nil {
-		log.Printf("[WARN] Error setting 'private_subnets' for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("private_subnets", "could not set private_subnets into state", err))
 	}
 
 	var publicSubnets []map[string]interface{}
@@ -522,7 +522,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		publicSubnets = append(publicSubnets, subnetInfo)
 	}
 	if err := d.Set("public_subnets", publicSubnets); err != nil {
-		log.Printf("[WARN] Error setting 'public_subnets' for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("public_subnets", "could not set public_subnets into state", err))
 	}
 
 	d.SetId(vC.Name)
@@ -532,7 +532,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		if err == goaviatrix.ErrNotFound {
 			d.Set("enable_native_gwlb", false)
 		} else if err != nil {
-			return fmt.Errorf("could not get FireNet details to read enable_native_gwlb: %v", err)
+			return diag.Errorf("could not get FireNet details to read enable_native_gwlb: %v", err)
 		} else {
 			d.Set("enable_native_gwlb", firenetDetail.NativeGwlb)
 		}
@@ -545,11 +545,11 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		rtbs, err = getAllRouteTables(vpc, client)
 
 		if err != nil {
-			return fmt.Errorf("could not get vpc route table ids: %v", err)
+			return diag.Errorf("could not get vpc route table ids: %v", err)
 		}
 
 		if err := d.Set("route_tables", rtbs); err != nil {
-			log.Printf("[WARN] Error setting route tables for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("route_tables", "could not set route_tables into state", err))
 		}
 	} else {
 		d.Set("route_tables", []string{})
@@ -558,13 +558,13 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		availabilityDomains, err := client.ListOciVpcAvailabilityDomains(vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI availability domains: %v", err)
+			return diag.Errorf("could not get OCI availability domains: %v", err)
 		}
 		d.Set("availability_domains", availabilityDomains)
 
 		faultDomains, err := client.ListOciVpcFaultDomains(vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI fault domains: %v", err)
+			return diag.Errorf("could not get OCI fault domains: %v", err)
 		}
 		d.Set("fault_domains", faultDomains)
 	} else {
@@ -574,11 +574,11 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("private_mode_subnets", vC.PrivateModeSubnets)
 
-	return nil
+	return diags
 }
 
-func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		AccountName: d.Get("account_name").(string),
@@ -591,12 +591,12 @@ func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 		if nativeGwlb {
 			err := client.EnableNativeAwsGwlbFirenet(vpc)
 			if err != nil {
-				return fmt.Errorf("could not enable native AWS gwlb firenet: %v", err)
+				return diag.Errorf("could not enable native AWS gwlb firenet: %v", err)
 			}
 		} else {
 			err := client.DisableNativeAwsGwlbFirenet(vpc)
 			if err != nil {
-				return fmt.Errorf("could not disable native AWS gwlb firenet: %v", err)
+				return diag.Errorf("could not disable native AWS gwlb firenet: %v", err)
 			}
 		}
 	}
@@ -604,8 +604,8 @@ func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAviatrixVpcDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		AccountName: d.Get("account_name").(string),
@@ -613,18 +613,18 @@ func resourceAviatrixVpcDelete(d *schema.ResourceData, meta interface{}) error {
 		VpcID:       d.Get("vpc_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting VPC: %#v", vpc)
+	logInfo(ctx, "Deleting VPC", map[string]interface{}{"account_name": vpc.AccountName, "name": vpc.Name, "vpc_id": vpc.VpcID})
 
 	if d.Get("enable_native_gwlb").(bool) {
 		err := client.DisableNativeAwsGwlbFirenet(vpc)
 		if err != nil {
-			return fmt.Errorf("could not disable native AWS gwlb: %v", err)
+			return diag.Errorf("could not disable native AWS gwlb: %v", err)
 		}
 	}
 
 	err := client.DeleteVpc(vpc)
 	if err != nil {
-		return fmt.Errorf("failed to delete VPC: %s", err)
+		return diag.Errorf("failed to delete VPC: %s", err)
 	}
 
 	return nil
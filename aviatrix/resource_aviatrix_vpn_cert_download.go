@@ -1,20 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixVPNCertDownload() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVPNCertDownloadCreate,
-		Read:   resourceAviatrixVPNCertDownloadRead,
-		Update: resourceAviatrixVPNCertDownloadCreate,
-		Delete: resourceAviatrixVPNCertDownloadDelete,
+		CreateContext: resourceAviatrixVPNCertDownloadCreate,
+		ReadContext:   resourceAviatrixVPNCertDownloadRead,
+		UpdateContext: resourceAviatrixVPNCertDownloadCreate,
+		DeleteContext: resourceAviatrixVPNCertDownloadDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,8 +37,8 @@ func resourceAviatrixVPNCertDownload() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVPNCertDownloadCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNCertDownloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	downloadEnabled := d.Get("download_enabled").(bool)
 	var endpoints []string
@@ -48,35 +49,35 @@ func resourceAviatrixVPNCertDownloadCreate(d *schema.ResourceData, meta interfac
 		var vpnCertDownload goaviatrix.VPNCertDownload
 
 		if len(endpoints) != 1 {
-			return fmt.Errorf("currently only one SAML endpoint is supported for this feature." +
+			return diag.Errorf("currently only one SAML endpoint is supported for this feature." +
 				" Please pass a single endpoint for the \"saml_endpoints\" argument ")
 		}
 		vpnCertDownload.SAMLEndpoint = endpoints[0]
 
 		err := client.EnableVPNCertDownload(&vpnCertDownload)
 		if err != nil {
-			return fmt.Errorf("enabling VPN Certificate Download failed due to : %v", err)
+			return diag.Errorf("enabling VPN Certificate Download failed due to : %v", err)
 
 		}
 	} else {
 		if len(endpoints) != 0 {
-			return fmt.Errorf("argument \"saml_endpoints\" must be unset to disable the cert download")
+			return diag.Errorf("argument \"saml_endpoints\" must be unset to disable the cert download")
 		}
 		err := client.DisableVPNCertDownload()
 		if err != nil {
-			return fmt.Errorf("Disabling VPN Certificate Download failed due to : %v", err)
+			return diag.Errorf("Disabling VPN Certificate Download failed due to : %v", err)
 
 		}
 	}
 	d.SetId("vpn_cert_download")
-	return resourceAviatrixVPNCertDownloadRead(d, meta)
+	return resourceAviatrixVPNCertDownloadRead(ctx, d, meta)
 }
 
-func resourceAviatrixVPNCertDownloadRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNCertDownloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 	vpnCertDownloadStatus, err := client.GetVPNCertDownloadStatus()
 	if err != nil {
-		return fmt.Errorf("retrieving VPN Certificate Download status failed due to : %v", err)
+		return diag.Errorf("retrieving VPN Certificate Download status failed due to : %v", err)
 	}
 	d.SetId("vpn_cert_download")
 	d.Set("download_enabled", vpnCertDownloadStatus.Results.Status)
@@ -85,11 +86,11 @@ func resourceAviatrixVPNCertDownloadRead(d *schema.ResourceData, meta interface{
 }
 
 // for now, deleting gcp account will not delete the credential file
-func resourceAviatrixVPNCertDownloadDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNCertDownloadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 	err := client.DisableVPNCertDownload()
 	if err != nil {
-		return fmt.Errorf("disabling VPN Certificate Download failed due to : %v", err)
+		return diag.Errorf("disabling VPN Certificate Download failed due to : %v", err)
 
 	}
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixProfileCreate,
-		Read:   resourceAviatrixProfileRead,
-		Update: resourceAviatrixProfileUpdate,
-		Delete: resourceAviatrixProfileDelete,
+		CreateContext: resourceAviatrixProfileCreate,
+		ReadContext:   resourceAviatrixProfileRead,
+		UpdateContext: resourceAviatrixProfileUpdate,
+		DeleteContext: resourceAviatrixProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -82,10 +82,10 @@ func resourceAviatrixProfile() *schema.Resource {
 	}
 }
 
-func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
-	log.Printf("[INFO] Creating Aviatrix Profile: %v %T", d.Get("users"), d.Get("users"))
+	logInfo(ctx, "Creating Aviatrix Profile", map[string]interface{}{"users": d.Get("users")})
 
 	profile := &goaviatrix.Profile{
 		Name:     d.Get("name").(string),
@@ -93,7 +93,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		Policy:   make([]goaviatrix.ProfileRule, 0),
 	}
 	if profile.Name == "" {
-		return fmt.Errorf("profile name can't be empty string")
+		return diag.Errorf("profile name can't be empty string")
 	}
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
@@ -103,11 +103,11 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return attributeErrorf("manage_user_attachment", "'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Profile with users: %v", profile.UserList)
+	logInfo(ctx, "Creating Aviatrix Profile with users", map[string]interface{}{"user_list": profile.UserList})
 
 	names := d.Get("policy").([]interface{})
 	for _, domain := range names {
@@ -121,41 +121,42 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.ValidateProfileRule(profileRule)
 			if err != nil {
-				return fmt.Errorf("policy validation failed: %v", err)
+				return diag.Errorf("policy validation failed: %v", err)
 			}
 			profile.Policy = append(profile.Policy, *profileRule)
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Profile with Policy: %v", profile.Policy)
+	logInfo(ctx, "Creating Aviatrix Profile with Policy", map[string]interface{}{"policy": profile.Policy})
 
 	d.SetId(profile.Name)
 	flag := false
-	defer resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateProfile(profile)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 	}
 
-	return resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	return resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixProfileReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixProfileReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixProfileRead(d, meta)
+		return resourceAviatrixProfileRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
+	var diags diag.Diagnostics
 
 	profileName := d.Get("name").(string)
 	if profileName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no profile name received. Import Id is %s", id)
+		logDebug(ctx, "Looks like an import, no profile name received", map[string]interface{}{"import_id": id})
 		d.Set("name", id)
 		d.Set("manage_user_attachment", true)
 		d.SetId(id)
@@ -168,11 +169,11 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 
 	profileBase, errBase := client.GetProfileBasePolicy(profile)
 	if errBase != nil {
-		return fmt.Errorf("can't get profile base policy for profile: %s", profile.Name)
+		return diag.Errorf("can't get profile base policy for profile: %s", profile.Name)
 	}
 	d.Set("base_rule", profileBase.BaseRule)
 
-	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
+	logInfo(ctx, "Reading Aviatrix Profile", map[string]interface{}{"name": profile.Name})
 	profile, err := client.GetProfile(profile)
 
 	if err != nil {
@@ -180,10 +181,10 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find profile: %s", err)
+		return diag.Errorf("couldn't find profile: %s", err)
 	}
 	d.Set("name", profile.Name)
-	log.Printf("[TRACE] Profile policy %v", profile.Policy)
+	logTrace(ctx, "Profile policy", map[string]interface{}{"policy": profile.Policy})
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
 	if manageUserAttachment {
@@ -196,10 +197,10 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 			d.Set("users", users)
 		} else {
 			d.Set("users", profile.UserList)
-			log.Printf("[TRACE] Profile userlistnew %v", profile.UserList)
+			logTrace(ctx, "Profile users", map[string]interface{}{"user_list": profile.UserList})
 		}
 	}
-	log.Printf("[TRACE] Profile policy %v", profile.Policy)
+	logTrace(ctx, "Profile policy", map[string]interface{}{"policy": profile.Policy})
 
 	var Policies []map[string]interface{}
 	if profile != nil {
@@ -213,17 +214,17 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if err := d.Set("policy", Policies); err != nil {
-			log.Printf("[WARN] Error setting policy for (%s): %s", d.Id(), err)
+			diags = append(diags, attributeWarning("policy", "could not set policy into state", err))
 		}
 	}
-	log.Printf("[INFO] Generated policies: %v", Policies)
+	logInfo(ctx, "Generated policies", map[string]interface{}{"policies": Policies})
 
 	d.SetId(profile.Name)
-	return nil
+	return diags
 }
 
-func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	profile := &goaviatrix.Profile{
 		Name: d.Get("name").(string),
@@ -244,7 +245,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 		for _, user := range d.Get("users").([]interface{}) {
 			profile.UserList = append(profile.UserList, user.(string))
 		}
-		log.Printf("[INFO] Creating Aviatrix Profile with users: %v", profile.UserList)
+		logInfo(ctx, "Creating Aviatrix Profile with users", map[string]interface{}{"user_list": profile.UserList})
 	}
 	names := d.Get("policy").([]interface{})
 	for _, domain := range names {
@@ -257,23 +258,23 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.ValidateProfileRule(profileRule)
 		if err != nil {
-			return fmt.Errorf("policy validation failed: %v", err)
+			return diag.Errorf("policy validation failed: %v", err)
 		}
 		profile.Policy = append(profile.Policy, *profileRule)
 	}
 
-	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
+	logInfo(ctx, "Reading Aviatrix Profile", map[string]interface{}{"name": profile.Name})
 
 	if d.HasChange("name") {
-		return fmt.Errorf("cannot change name of a profile")
+		return diag.Errorf("cannot change name of a profile")
 	}
 	if d.HasChange("base_rule") {
-		return fmt.Errorf("cannot change base rule of a profile")
+		return diag.Errorf("cannot change base rule of a profile")
 	}
 	if manageUserAttachment {
 		if d.HasChange("users") {
 			oldU, newU := d.GetChange("users")
-			log.Printf("[INFO] Users to be attached : %#v %#v ", oldU, newU)
+			logInfo(ctx, "Updating Profile users", map[string]interface{}{"old_users": oldU, "new_users": newU})
 
 			if oldU == nil {
 				oldU = new([]interface{})
@@ -287,32 +288,32 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 			newUserList := goaviatrix.ExpandStringList(newString)
 			//Attach all the newly added Users
 			toAddUsers := goaviatrix.Difference(newUserList, oldUserList)
-			log.Printf("[INFO] Users to be attached : %#v", toAddUsers)
+			logInfo(ctx, "Users to be attached", map[string]interface{}{"attached_users": toAddUsers})
 			profile.UserList = toAddUsers
 			err := client.AttachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to attach User : %s", err)
+				return diag.Errorf("failed to attach User : %s", err)
 			}
 			//Detach all the removed Users
 			toDelGws := goaviatrix.Difference(oldUserList, newUserList)
-			log.Printf("[INFO] Users to be detached : %#v", toDelGws)
+			logInfo(ctx, "Users to be detached", map[string]interface{}{"detached_users": toDelGws})
 			profile.UserList = toDelGws
 			err = client.DetachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to detach user : %s", err)
+				return diag.Errorf("failed to detach user : %s", err)
 			}
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return attributeErrorf("manage_user_attachment", "'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
-	log.Printf("[INFO] Checking for policy changes")
+	logInfo(ctx, "Checking for policy changes")
 	if d.HasChange("policy") {
 		err := client.UpdateProfilePolicy(profile)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+			return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 		}
 	}
 
@@ -320,26 +321,26 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAviatrixProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	profile := &goaviatrix.Profile{
 		Name: d.Get("name").(string),
 	}
-	log.Printf("[INFO] Deleting Aviatrix Profile: %#v", profile)
+	logInfo(ctx, "Deleting Aviatrix Profile", map[string]interface{}{"name": profile.Name})
 	if _, ok := d.GetOk("users"); ok {
-		log.Printf("[INFO] Found users: %#v", d.Get("users"))
+		logInfo(ctx, "Found users", map[string]interface{}{"users": d.Get("users")})
 
 		profile.UserList = goaviatrix.ExpandStringList(d.Get("users").([]interface{}))
 		err := client.DetachUsers(profile)
 		if err != nil {
-			return fmt.Errorf("failed to detach Users: %s", err)
+			return diag.Errorf("failed to detach Users: %s", err)
 		}
 	}
 
 	err := client.DeleteProfile(profile)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Profile: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixVPNUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVPNUserCreate,
-		Read:   resourceAviatrixVPNUserRead,
-		Update: resourceAviatrixVPNUserUpdate,
-		Delete: resourceAviatrixVPNUserDelete,
+		CreateContext: resourceAviatrixVPNUserCreate,
+		ReadContext:   resourceAviatrixVPNUserRead,
+		UpdateContext: resourceAviatrixVPNUserUpdate,
+		DeleteContext: resourceAviatrixVPNUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -81,8 +81,8 @@ func resourceAviatrixVPNUser() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	vpnUser := &goaviatrix.VPNUser{
 		VpcID:        d.Get("vpc_id").(string),
@@ -95,32 +95,32 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	if vpnUser.DnsName == "" {
 		if vpnUser.VpcID == "" && vpnUser.GwName == "" {
-			return fmt.Errorf("please set 'vpc_id' and 'gw_name', or 'dns_name' alone")
+			return diag.Errorf("please set 'vpc_id' and 'gw_name', or 'dns_name' alone")
 		} else if vpnUser.VpcID == "" || vpnUser.GwName == "" {
-			return fmt.Errorf("please set both 'vpc_id' and 'gw_name'")
+			return diag.Errorf("please set both 'vpc_id' and 'gw_name'")
 		}
 	} else {
 		if vpnUser.VpcID != "" || vpnUser.GwName != "" {
-			return fmt.Errorf("DNS is enabled. Please set 'vpc_id' and 'gw_name' to be empty")
+			return diag.Errorf("DNS is enabled. Please set 'vpc_id' and 'gw_name' to be empty")
 		}
 		vpnUser.DnsEnabled = true
 	}
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
 	if !manageUserAttachment && len(d.Get("profiles").([]interface{})) != 0 {
-		return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
+		return attributeErrorf("manage_user_attachment", "'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix VPN User: %#v", vpnUser)
+	logInfo(ctx, "Creating Aviatrix VPN User", map[string]interface{}{"gw_name": vpnUser.GwName, "user_name": vpnUser.UserName, "vpc_id": vpnUser.VpcID})
 
 	d.SetId(vpnUser.UserName)
 
 	flag := false
-	defer resourceAviatrixVPNUserReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixVPNUserReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateVPNUser(vpnUser)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix VPNUser: %s", err)
+		return diag.Errorf("failed to create Aviatrix VPNUser: %s", err)
 	}
 
 	if manageUserAttachment && len(d.Get("profiles").([]interface{})) != 0 {
@@ -131,29 +131,29 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) err
 			profile.UserList = append(profile.UserList, vpnUser.UserName)
 			err := client.AttachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to attach User(%s) to Profile(%s) due to: %s", vpnUser.UserName, profile.Name, err)
+				return diag.Errorf("failed to attach User(%s) to Profile(%s) due to: %s", vpnUser.UserName, profile.Name, err)
 			}
 		}
 	}
 
-	return resourceAviatrixVPNUserReadIfRequired(d, meta, &flag)
+	return resourceAviatrixVPNUserReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixVPNUserReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixVPNUserReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixVPNUserRead(d, meta)
+		return resourceAviatrixVPNUserRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	userName := d.Get("user_name").(string)
 	if userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, user_name is empty. Id is %s", id)
+		logDebug(ctx, "Looks like an import, user_name is empty", map[string]interface{}{"import_id": id})
 		d.Set("user_name", id)
 		d.Set("manage_user_attachment", true)
 		d.SetId(id)
@@ -168,10 +168,10 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix VPNUser: %s", err)
+		return diag.Errorf("couldn't find Aviatrix VPNUser: %s", err)
 	}
 
-	log.Printf("[TRACE] Reading vpn_user %s: %#v", userName, vu)
+	logTrace(ctx, "Reading vpn_user", map[string]interface{}{"user_name": userName, "gw_name": vu.GwName, "vpc_id": vu.VpcID})
 
 	if vu != nil {
 		if vu.DnsEnabled {
@@ -196,12 +196,12 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 			if len(goaviatrix.Difference(profiles, profileFromRead)) == 0 && len(goaviatrix.Difference(profileFromRead, profiles)) == 0 {
 				err := d.Set("profiles", profiles)
 				if err != nil {
-					return fmt.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
+					return diag.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
 				}
 			} else {
 				err := d.Set("profiles", profileFromRead)
 				if err != nil {
-					return fmt.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
+					return diag.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
 				}
 			}
 		}
@@ -210,8 +210,8 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	vpnUser := &goaviatrix.VPNUser{
 		UserName: d.Get("user_name").(string),
@@ -249,7 +249,7 @@ func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) err
 				profile.UserList = []string{vpnUser.UserName}
 				err := client.AttachUsers(profile)
 				if err != nil {
-					return fmt.Errorf("failed to attach User: %s", err)
+					return diag.Errorf("failed to attach User: %s", err)
 				}
 			}
 			toDelProfiles := goaviatrix.Difference(oldProfileList, newProfileList)
@@ -260,22 +260,22 @@ func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) err
 				profile.UserList = []string{vpnUser.UserName}
 				err := client.DetachUsers(profile)
 				if err != nil {
-					return fmt.Errorf("failed to detach User: %s", err)
+					return diag.Errorf("failed to detach User: %s", err)
 				}
 			}
 		}
 	} else {
 		if len(d.Get("profiles").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
+			return attributeErrorf("manage_user_attachment", "'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixVPNUserRead(d, meta)
+	return resourceAviatrixVPNUserRead(ctx, d, meta)
 }
 
-func resourceAviatrixVPNUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	vpnUser := &goaviatrix.VPNUser{
 		UserName: d.Get("user_name").(string),
@@ -286,11 +286,11 @@ func resourceAviatrixVPNUserDelete(d *schema.ResourceData, meta interface{}) err
 		vpnUser.DnsEnabled = true
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix VPNUser: %#v", vpnUser)
+	logInfo(ctx, "Deleting Aviatrix VPNUser", map[string]interface{}{"gw_name": vpnUser.GwName, "user_name": vpnUser.UserName, "vpc_id": vpnUser.VpcID})
 
 	err := client.DeleteVPNUser(vpnUser)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix VPNUser: %s", err)
+		return diag.Errorf("failed to delete Aviatrix VPNUser: %s", err)
 	}
 
 	return nil
@@ -1,22 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixVPNUserAccelerator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVPNUserAcceleratorCreate,
-		Read:   resourceAviatrixVPNUserAcceleratorRead,
-		Delete: resourceAviatrixVPNUserAcceleratorDelete,
+		CreateContext: resourceAviatrixVPNUserAcceleratorCreate,
+		ReadContext:   resourceAviatrixVPNUserAcceleratorRead,
+		DeleteContext: resourceAviatrixVPNUserAcceleratorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -30,17 +30,17 @@ func resourceAviatrixVPNUserAccelerator() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVPNUserAcceleratorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserAcceleratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	elb := d.Get("elb_name").(string)
 	// compare if elb is in elb list for current elbs
 	elbList, err := client.GetVpnUserAccelerator()
 	if err != nil {
-		return fmt.Errorf("unable to read endpoint list for User Accelerator due to %v", err)
+		return diag.Errorf("unable to read endpoint list for User Accelerator due to %v", err)
 	}
 	if goaviatrix.Contains(elbList, elb) {
-		return fmt.Errorf("elb is already included in the VPN User Accelerator. Import into terraform rather than create a new resource")
+		return diag.Errorf("elb is already included in the VPN User Accelerator. Import into terraform rather than create a new resource")
 	} else {
 		elbList = append(elbList, elb)
 		elbListStrings := strings.Join(elbList, "\",\"")
@@ -48,9 +48,9 @@ func resourceAviatrixVPNUserAcceleratorCreate(d *schema.ResourceData, meta inter
 		xlr := &goaviatrix.VpnUserXlr{
 			Endpoints: str,
 		}
-		log.Printf("[DEBUG] Endpoint list: %s", xlr.Endpoints)
+		logDebug(ctx, "Endpoint list", map[string]interface{}{"endpoints": xlr.Endpoints})
 
-		log.Printf("[INFO] Creating User Accelerator.")
+		logInfo(ctx, "Creating User Accelerator")
 		var err error
 		for i := 0; ; i++ {
 			err = client.UpdateVpnUserAccelerator(xlr)
@@ -60,30 +60,30 @@ func resourceAviatrixVPNUserAcceleratorCreate(d *schema.ResourceData, meta inter
 			if i <= 10 && (strings.Contains(err.Error(), "Endpoint not found") || strings.Contains(err.Error(), "not active")) {
 				time.Sleep(60 * time.Second)
 			} else {
-				return fmt.Errorf("failed to create Vpn User Accelerator: %s", err)
+				return diag.Errorf("failed to create Vpn User Accelerator: %s", err)
 			}
 		}
 	}
 
 	d.SetId(elb)
-	return resourceAviatrixVPNUserAcceleratorRead(d, meta)
+	return resourceAviatrixVPNUserAcceleratorRead(ctx, d, meta)
 }
 
-func resourceAviatrixVPNUserAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserAcceleratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	elbName := d.Get("elb_name").(string)
 	if elbName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no elb name received. Import id is %s", id)
+		logDebug(ctx, "Looks like an import, no elb name received", map[string]interface{}{"import_id": id})
 		d.Set("elb_name", id)
 		elbName = id
 		d.SetId(id)
 	}
 
-	log.Printf("[DEBUG] elbName: %s", elbName)
+	logDebug(ctx, "ELB name", map[string]interface{}{"elb_name": elbName})
 
-	log.Printf("[INFO] Reading User Accelerator LB List")
+	logInfo(ctx, "Reading User Accelerator LB List")
 
 	elbList, err := client.GetVpnUserAccelerator()
 	if err != nil {
@@ -91,10 +91,10 @@ func resourceAviatrixVPNUserAcceleratorRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("unable to read endpoint list for User Accelerator due to %v", err)
+		return diag.Errorf("unable to read endpoint list for User Accelerator due to %v", err)
 	}
 
-	log.Printf("[DEBUG] elbList: %s", elbList)
+	logDebug(ctx, "ELB list", map[string]interface{}{"elb_list": elbList})
 
 	if elbList != nil {
 		if goaviatrix.Contains(elbList, elbName) {
@@ -108,15 +108,15 @@ func resourceAviatrixVPNUserAcceleratorRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAviatrixVPNUserAcceleratorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserAcceleratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.VPNClient)
 
 	elbName := d.Get("elb_name").(string)
 	toDelete := []string{elbName}
 
 	elbList, err := client.GetVpnUserAccelerator()
 	if err != nil {
-		return fmt.Errorf("unable to read endpoint list for User Accelerator due to %v", err)
+		return diag.Errorf("unable to read endpoint list for User Accelerator due to %v", err)
 	}
 	if elbList != nil {
 		xlr := &goaviatrix.VpnUserXlr{}
@@ -133,7 +133,7 @@ func resourceAviatrixVPNUserAcceleratorDelete(d *schema.ResourceData, meta inter
 
 		err := client.UpdateVpnUserAccelerator(xlr)
 		if err != nil {
-			return fmt.Errorf("unable to remove elb in Vpn User Accelerator due to %v", err)
+			return diag.Errorf("unable to remove elb in Vpn User Accelerator due to %v", err)
 		}
 	}
 
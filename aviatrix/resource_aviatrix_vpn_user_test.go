@@ -1,11 +1,11 @@
 package aviatrix
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -31,7 +31,7 @@ func testAccCheckAviatrixVPNUserDestroy(s *terraform.State) error {
 			UserName: rs.Primary.ID,
 		}
 
-		err := client.GetVPNUser(vpnUser)
+		_, err := client.GetVPNUser(vpnUser)
 		if err == nil {
 			return fmt.Errorf("VPN User still exists: %s", rs.Primary.ID)
 		}
@@ -40,8 +40,8 @@ func testAccCheckAviatrixVPNUserDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccCheckAviatrixVPNUserSteps(resourceName string) []*resource.TestStep {
-	return []*resource.TestStep{
+func testAccCheckAviatrixVPNUserSteps(resourceName string) []resource.TestStep {
+	return []resource.TestStep{
 		{
 			Config: testAccAviatrixVPNUserConfig,
 			Check: resource.ComposeTestCheckFunc(
@@ -94,7 +94,7 @@ func marshalWebGroupInput(d *schema.ResourceData) (*goaviatrix.WebGroup, error)
 }
 
 func resourceAviatrixWebGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	webGroup, err := marshalWebGroupInput(d)
 	if err != nil {
@@ -121,7 +121,7 @@ func resourceAviatrixWebGroupReadIfRequired(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixWebGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -161,7 +161,7 @@ func resourceAviatrixWebGroupRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAviatrixWebGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -182,7 +182,7 @@ func resourceAviatrixWebGroupUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixWebGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.SecurityClient)
 
 	uuid := d.Id()
 	err := client.DeleteWebGroup(ctx, uuid)
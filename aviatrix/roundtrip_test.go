@@ -0,0 +1,392 @@
+package aviatrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+// roundTripCase describes the configurations of a resource generated by testRoundTrip.
+type roundTripCase struct {
+	// resource is the name of the resource, e.g. "aviatrix_transit_gateway"
+	resource string
+	// config are the attributes set in every configuration, e.g. the account and gateways
+	// created by setup
+	config map[string]interface{}
+	// exclude are the optional attributes that are never set, with "block.attribute" for the
+	// attributes of nested blocks. Every other optional attribute of the schema may be set, and
+	// the fake controller must store and return it.
+	exclude []string
+	// values generate the attributes whose valid values cannot be derived from their schema,
+	// by attribute path as in exclude
+	values map[string]func(r *rand.Rand) interface{}
+	// fix is called on every generated configuration to apply the rules between attributes
+	// that are not in the schema, e.g. by removing an attribute that requires another one
+	fix func(r *rand.Rand, config map[string]interface{})
+	// setup creates the objects the configurations depend on in the fake controller
+	setup func(t *testing.T, client *goaviatrix.Client)
+}
+
+// roundTripConfigs is the number of configurations generated for each resource, which can be set
+// with AVIATRIX_ROUNDTRIP_CONFIGS.
+const roundTripConfigs = 20
+
+// testRoundTrip generates random configurations of the resource, creates the resource of each
+// configuration against a fake controller, refreshes it and checks that the configuration has no
+// diff with the refreshed state. A diff is a mismatch between how the resource expands the
+// configuration and how it flattens the controller response, which shows as a perpetual diff in
+// terraform plan. Computed attributes that are not in the configuration are ignored.
+//
+// The configurations are generated from the seed logged by the test, set AVIATRIX_ROUNDTRIP_SEED
+// to generate the same configurations again.
+func testRoundTrip(t *testing.T, tc roundTripCase) {
+	r, ok := Provider().ResourcesMap[tc.resource]
+	if !ok {
+		t.Fatalf("resource %s does not exist", tc.resource)
+	}
+
+	seed := time.Now().UnixNano()
+	if v := os.Getenv("AVIATRIX_ROUNDTRIP_SEED"); v != "" {
+		var err error
+		if seed, err = strconv.ParseInt(v, 10, 64); err != nil {
+			t.Fatalf("invalid AVIATRIX_ROUNDTRIP_SEED: %v", err)
+		}
+	}
+	configs := roundTripConfigs
+	if v := os.Getenv("AVIATRIX_ROUNDTRIP_CONFIGS"); v != "" {
+		var err error
+		if configs, err = strconv.Atoi(v); err != nil {
+			t.Fatalf("invalid AVIATRIX_ROUNDTRIP_CONFIGS: %v", err)
+		}
+	}
+	t.Logf("generating %d configurations of %s with AVIATRIX_ROUNDTRIP_SEED=%d", configs, tc.resource, seed)
+
+	g := &configGenerator{
+		rand:    rand.New(rand.NewSource(seed)),
+		exclude: make(map[string]bool),
+		values:  tc.values,
+	}
+	for _, path := range tc.exclude {
+		g.exclude[path] = true
+	}
+	for i := 0; i < configs; i++ {
+		config, err := g.config(r.Schema, tc.config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tc.fix != nil {
+			tc.fix(g.rand, config)
+		}
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			roundTrip(t, r, tc.setup, config)
+		})
+	}
+}
+
+func roundTrip(t *testing.T, r *schema.Resource, setup func(t *testing.T, client *goaviatrix.Client), config map[string]interface{}) {
+	b, _ := json.MarshalIndent(config, "", "  ")
+	t.Logf("configuration:\n%s", b)
+
+	server := fakecontroller.New()
+	defer server.Close()
+	client, err := server.NewClient()
+	if err != nil {
+		t.Fatalf("could not login to the fake controller: %v", err)
+	}
+	if setup != nil {
+		setup(t, client)
+	}
+
+	ctx := context.Background()
+	rc := terraform.NewResourceConfigRaw(config)
+	if diags := r.Validate(rc); diags.HasError() {
+		t.Fatalf("invalid configuration, fix the generated values: %s", diagnosticsString(diags))
+	}
+	plan, err := r.Diff(ctx, nil, rc, client)
+	if err != nil {
+		t.Fatalf("plan error = %v", err)
+	}
+	state, diags := r.Apply(ctx, nil, plan, client)
+	if diags.HasError() {
+		t.Fatalf("create error = %s", diagnosticsString(diags))
+	}
+	state, diags = r.RefreshWithoutUpgrade(ctx, state, client)
+	if diags.HasError() {
+		t.Fatalf("read error = %s", diagnosticsString(diags))
+	}
+	if state == nil || state.ID == "" {
+		t.Fatal("the resource was not found after it was created")
+	}
+
+	plan, err = r.Diff(ctx, state, rc, client)
+	if err != nil {
+		t.Fatalf("plan after refresh error = %v", err)
+	}
+	if plan.Empty() {
+		return
+	}
+	var diffs []string
+	for k, attr := range plan.Attributes {
+		diffs = append(diffs, fmt.Sprintf("%s: state %q, configuration %q", k, attr.Old, attr.New))
+	}
+	sort.Strings(diffs)
+	t.Errorf("the refreshed state does not match the configuration:\n%s", strings.Join(diffs, "\n"))
+}
+
+// diagnosticsString returns the errors of diags, with the attribute they are reported against.
+func diagnosticsString(diags diag.Diagnostics) string {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		msg := d.Summary
+		if d.Detail != "" {
+			msg += ": " + d.Detail
+		}
+		if len(d.AttributePath) > 0 {
+			if step, ok := d.AttributePath[0].(cty.GetAttrStep); ok {
+				msg = fmt.Sprintf("%s: %s", step.Name, msg)
+			}
+		}
+		msgs = append(msgs, msg)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// configGenerator generates random configurations from a resource schema.
+type configGenerator struct {
+	rand    *rand.Rand
+	exclude map[string]bool
+	values  map[string]func(r *rand.Rand) interface{}
+}
+
+// config returns a configuration with the given attributes, the required attributes of the schema
+// and a random subset of the optional attributes.
+func (g *configGenerator) config(s map[string]*schema.Schema, fixed map[string]interface{}) (map[string]interface{}, error) {
+	config := make(map[string]interface{})
+	for k, v := range fixed {
+		config[k] = v
+	}
+	if err := g.block(config, s, ""); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// block adds the attributes of a block to config, except the ones already set.
+func (g *configGenerator) block(config map[string]interface{}, s map[string]*schema.Schema, prefix string) error {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attr := s[name]
+		path := prefix + name
+		if _, ok := config[name]; ok {
+			continue
+		}
+		if !attr.Required && !(g.optional(path, attr) && g.rand.Intn(2) == 0) {
+			continue
+		}
+		if conflictsWith(config, attr.ConflictsWith) {
+			continue
+		}
+		v, err := g.value(path, attr)
+		if err != nil {
+			return err
+		}
+		config[name] = v
+	}
+	return nil
+}
+
+// optional reports whether an attribute that is not required may be set. Deprecated attributes are
+// never set, the attributes that replace them are.
+func (g *configGenerator) optional(path string, s *schema.Schema) bool {
+	return s.Optional && s.Deprecated == "" && !g.exclude[path]
+}
+
+// conflictsWith reports whether one of the top level attributes is set.
+func conflictsWith(config map[string]interface{}, attributes []string) bool {
+	for _, attr := range attributes {
+		if _, ok := config[attr]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// value returns a random valid value of an attribute.
+func (g *configGenerator) value(path string, s *schema.Schema) (interface{}, error) {
+	if gen, ok := g.values[path]; ok {
+		return gen(g.rand), nil
+	}
+
+	switch s.Type {
+	case schema.TypeBool:
+		return g.rand.Intn(2) == 0, nil
+	case schema.TypeInt:
+		return g.validated(path, s, []interface{}{1 + g.rand.Intn(100), 64512 + g.rand.Intn(1000), 1 + g.rand.Intn(65535)})
+	case schema.TypeFloat:
+		return g.validated(path, s, []interface{}{float64(g.rand.Intn(90)) + 0.5})
+	case schema.TypeString:
+		octet := 1 + g.rand.Intn(250)
+		return g.validated(path, s, []interface{}{
+			fmt.Sprintf("rt-%s", g.token(8)),
+			fmt.Sprintf("10.%d.0.0/16", octet),
+			fmt.Sprintf("10.%d.0.%d", octet, 1+g.rand.Intn(250)),
+			strconv.Itoa(64512 + g.rand.Intn(1000)),
+		})
+	case schema.TypeMap:
+		m := make(map[string]interface{})
+		for i := 0; i <= g.rand.Intn(2); i++ {
+			m["key-"+g.token(4)] = "value-" + g.token(4)
+		}
+		return m, nil
+	case schema.TypeList, schema.TypeSet:
+		n := s.MinItems
+		if max := 3; s.MaxItems == 0 || s.MaxItems > max {
+			n += g.rand.Intn(max - n + 1)
+		} else {
+			n += g.rand.Intn(s.MaxItems - n + 1)
+		}
+		if n == 0 && s.Required {
+			n = 1
+		}
+		items := make([]interface{}, 0, n)
+		seen := make(map[string]bool)
+		for len(items) < n {
+			var item interface{}
+			switch elem := s.Elem.(type) {
+			case *schema.Resource:
+				block := make(map[string]interface{})
+				if err := g.block(block, elem.Schema, path+"."); err != nil {
+					return nil, err
+				}
+				item = block
+			case *schema.Schema:
+				v, err := g.value(path, elem)
+				if err != nil {
+					return nil, err
+				}
+				item = v
+			default:
+				return nil, fmt.Errorf("%s: unsupported element %T, add a generator to values", path, s.Elem)
+			}
+			// The elements of a set must be unique
+			if key := fmt.Sprint(item); !seen[key] {
+				seen[key] = true
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("%s: unsupported type %s, add a generator to values", path, s.Type)
+}
+
+// validated returns the first candidate accepted by the validation of the attribute.
+func (g *configGenerator) validated(path string, s *schema.Schema, candidates []interface{}) (interface{}, error) {
+	for _, v := range candidates {
+		if s.ValidateFunc != nil {
+			if _, errs := s.ValidateFunc(v, path); len(errs) > 0 {
+				continue
+			}
+		}
+		if s.ValidateDiagFunc != nil {
+			if diags := s.ValidateDiagFunc(v, cty.GetAttrPath(path)); diags.HasError() {
+				continue
+			}
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("%s: no valid value could be generated, add a generator to values", path)
+}
+
+func (g *configGenerator) token(n int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = letters[g.rand.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+// oneOf returns a generator of one of the values.
+func oneOf(values ...interface{}) func(r *rand.Rand) interface{} {
+	return func(r *rand.Rand) interface{} {
+		return values[r.Intn(len(values))]
+	}
+}
+
+// randomCIDR returns a generator of /prefix CIDRs in 10.0.0.0/8.
+func randomCIDR(prefix int) func(r *rand.Rand) interface{} {
+	return func(r *rand.Rand) interface{} {
+		ip := net.IPv4(10, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		return (&net.IPNet{IP: ip.Mask(net.CIDRMask(prefix, 32)), Mask: net.CIDRMask(prefix, 32)}).String()
+	}
+}
+
+// randomCIDRList returns a generator of comma separated lists of one to three /prefix CIDRs.
+func randomCIDRList(prefix int) func(r *rand.Rand) interface{} {
+	return func(r *rand.Rand) interface{} {
+		var cidrs []string
+		for _, cidr := range randomCIDRSet(prefix)(r).([]interface{}) {
+			cidrs = append(cidrs, cidr.(string))
+		}
+		return strings.Join(cidrs, ",")
+	}
+}
+
+// randomCIDRSet returns a generator of sets of one to three /prefix CIDRs.
+func randomCIDRSet(prefix int) func(r *rand.Rand) interface{} {
+	return func(r *rand.Rand) interface{} {
+		n := 1 + r.Intn(3)
+		cidrs := make([]interface{}, 0, n)
+		seen := make(map[string]bool)
+		for len(cidrs) < n {
+			if cidr := randomCIDR(prefix)(r).(string); !seen[cidr] {
+				seen[cidr] = true
+				cidrs = append(cidrs, cidr)
+			}
+		}
+		return cidrs
+	}
+}
+
+// roundTripAccount is the AWS account created by createRoundTripAccount.
+const roundTripAccount = "aws-account"
+
+// createRoundTripAccount creates the AWS account roundTripAccount in the fake controller.
+func createRoundTripAccount(t *testing.T, client *goaviatrix.Client) {
+	t.Helper()
+	err := client.CreateAccount(&goaviatrix.Account{
+		AccountName:      roundTripAccount,
+		CloudType:        goaviatrix.AWS,
+		AwsAccountNumber: "123456789012",
+		AwsIam:           "false",
+		AwsAccessKey:     "access-key",
+		AwsSecretKey:     "secret-key",
+	})
+	if err != nil {
+		t.Fatalf("could not create the account: %v", err)
+	}
+}
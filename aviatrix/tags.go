@@ -0,0 +1,59 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// tagsAllSchema is the schema of tags_all, the resource tags merged with the provider default_tags.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "A map of all tags of the resource, including the tags inherited from the provider default_tags.",
+	}
+}
+
+// setTagsAll returns a CustomizeDiffFunc that plans tags_all as the provider default tags merged
+// with the resource tags, the resource tags win on conflict. The default tags are only added
+// when the resource cloud_type is one of cloudTypes.
+func setTagsAll(cloudTypes int) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(goaviatrix.GatewayClient)
+		if !ok {
+			return nil
+		}
+
+		if !d.NewValueKnown("tags") || !d.NewValueKnown("cloud_type") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		tags := make(goaviatrix.KeyValueTags)
+		for k, v := range d.Get("tags").(map[string]interface{}) {
+			tags[k] = fmt.Sprint(v)
+		}
+		if goaviatrix.IsCloudType(d.Get("cloud_type").(int), cloudTypes) {
+			tags = client.DefaultTags().MergeTags(tags)
+		}
+		return d.SetNew("tags_all", map[string]string(tags))
+	}
+}
+
+// setTags sets tags_all to the tags read from the controller, and tags to the same tags without the
+// default tags echoed back by the controller, so that the default tags do not show up as drift.
+func setTags(d *schema.ResourceData, client goaviatrix.GatewayClient, tags goaviatrix.KeyValueTags) error {
+	configured := make(goaviatrix.KeyValueTags)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		configured[k] = fmt.Sprint(v)
+	}
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(client.DefaultTags(), configured)); err != nil {
+		return err
+	}
+	return d.Set("tags_all", tags)
+}
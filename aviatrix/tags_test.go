@@ -0,0 +1,33 @@
+package aviatrix
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func TestSetTags(t *testing.T) {
+	client := &goaviatrix.Client{
+		DefaultTagsConfig: expandProviderDefaultTags([]interface{}{
+			map[string]interface{}{"tags": map[string]interface{}{"owner": "network", "cost-center": "1234"}},
+		}),
+	}
+	d := schema.TestResourceDataRaw(t, resourceAviatrixGateway().Schema, map[string]interface{}{
+		"tags": map[string]interface{}{"name": "gw", "owner": "security"},
+	})
+
+	remote := goaviatrix.KeyValueTags{"name": "gw", "owner": "security", "cost-center": "1234"}
+	if err := setTags(d, client, remote); err != nil {
+		t.Fatalf("setTags() error = %v", err)
+	}
+
+	if got, want := d.Get("tags"), map[string]interface{}{"name": "gw", "owner": "security"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tags = %v, want %v", got, want)
+	}
+	if got, want := d.Get("tags_all"), map[string]interface{}{"name": "gw", "owner": "security", "cost-center": "1234"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tags_all = %v, want %v", got, want)
+	}
+}
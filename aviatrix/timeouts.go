@@ -0,0 +1,12 @@
+package aviatrix
+
+import (
+	"time"
+)
+
+// Default timeouts of the long-running resources. Launching a gateway, enabling its HA gateway
+// or onboarding an edge device can take more than 20 minutes.
+const (
+	defaultGatewayTimeout    = 60 * time.Minute
+	defaultAttachmentTimeout = 30 * time.Minute
+)
@@ -0,0 +1,74 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+func TestResourceTimeout(t *testing.T) {
+	server := fakecontroller.New()
+	defer server.Close()
+	client, err := server.NewClient()
+	if err != nil {
+		t.Fatalf("could not login to the fake controller: %v", err)
+	}
+	createRoundTripAccount(t, client)
+
+	ctx := context.Background()
+	err = client.LaunchTransitVpc(ctx, &goaviatrix.TransitVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: roundTripAccount,
+		GwName:      "transit",
+		VpcID:       "vpc-1",
+		VpcRegion:   "us-east-1",
+		VpcSize:     "c5.xlarge",
+		Subnet:      "10.1.0.0/24",
+		Transit:     true,
+	})
+	if err != nil {
+		t.Fatalf("could not create the transit gateway: %v", err)
+	}
+	err = client.LaunchSpokeVpc(ctx, &goaviatrix.SpokeVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: roundTripAccount,
+		GwName:      "spoke",
+		VpcID:       "vpc-2",
+		VpcRegion:   "us-east-1",
+		VpcSize:     "t3.small",
+		Subnet:      "10.2.0.0/24",
+	})
+	if err != nil {
+		t.Fatalf("could not create the spoke gateway: %v", err)
+	}
+	// The attachment is retried until the spoke gateway is up, which it never is
+	server.HandleAction("attach_spoke_to_transit_gw", func(r *fakecontroller.Request) (interface{}, error) {
+		return nil, fakecontroller.Errorf("Gateway spoke is not up.")
+	})
+
+	r := resourceAviatrixSpokeTransitAttachment()
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"spoke_gw_name":   "spoke",
+		"transit_gw_name": "transit",
+		"timeouts":        map[string]interface{}{"create": "100ms"},
+	})
+	plan, err := r.Diff(ctx, nil, rc, client)
+	if err != nil {
+		t.Fatalf("plan error = %v", err)
+	}
+
+	start := time.Now()
+	_, diags := r.Apply(ctx, nil, plan, client)
+	if !diags.HasError() {
+		t.Fatal("create of an attachment to a spoke gateway that is not up succeeded")
+	}
+	// Without the timeout the create retries for minutes
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("create returned after %s, want it to stop when the create timeout expires", elapsed)
+	}
+}
@@ -1,9 +1,9 @@
 package aviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -44,11 +44,6 @@ func DiffSuppressFuncString(k, old, new string, d *schema.ResourceData) bool {
 	return goaviatrix.Equivalent(oldValue, newValue)
 }
 
-func captureErr(fn func(*schema.ResourceData, interface{}) error,
-	d *schema.ResourceData, meta interface{}, err *error) {
-	*err = fn(d, meta)
-}
-
 func DiffSuppressFuncIgnoreSpaceInString(k, old, new string, d *schema.ResourceData) bool {
 	var oldValue []string
 	var newValue []string
@@ -122,7 +117,17 @@ var (
 )
 
 func extractTags(d *schema.ResourceData, cloudType int) (map[string]string, error) {
-	tags, ok := d.GetOk("tags")
+	return extractTagsAttribute(d, "tags", cloudType)
+}
+
+// extractTagsAll returns the tags planned in tags_all, i.e. the resource tags merged with the
+// provider default tags.
+func extractTagsAll(d *schema.ResourceData, cloudType int) (map[string]string, error) {
+	return extractTagsAttribute(d, "tags_all", cloudType)
+}
+
+func extractTagsAttribute(d *schema.ResourceData, attribute string, cloudType int) (map[string]string, error) {
+	tags, ok := d.GetOk(attribute)
 	if !ok {
 		return nil, nil
 	}
@@ -239,23 +244,23 @@ func DiffSuppressFuncDistributedFirewallingPolicyPortRangeHi(k, old, new string,
 }
 
 // sortVersion sorts the firewall_image_version list
-func sortVersion(versionList []string, i, j int, imageName string) bool {
+func sortVersion(ctx context.Context, versionList []string, i, j int, imageName string) bool {
 	if strings.Contains(imageName, "CloudGuard Next-Gen Firewall") {
-		return compareCheckpointVersion(versionList[i], versionList[j], "_")
+		return compareCheckpointVersion(ctx, versionList[i], versionList[j], "_")
 	} else if strings.Contains(imageName, "Check Point CloudGuard IaaS") &&
 		(strings.Contains(imageName, "Next-Gen Firewall with Threat Prevention") ||
 			strings.Contains(imageName, "All-In-One") ||
 			strings.Contains(imageName, "Firewall & Threat Prevention")) {
-		return compareCheckpointVersion(versionList[i], versionList[j], "-")
+		return compareCheckpointVersion(ctx, versionList[i], versionList[j], "-")
 	} else if strings.Contains(imageName, "Palo Alto Networks VM-Series Bundle") ||
 		strings.Contains(imageName, "Palo Alto Networks VM-Series Next Generation Firewall") {
 		version1 := checkPAVMVersionFormat(versionList[i])
 		version2 := checkPAVMVersionFormat(versionList[j])
-		return compareVersion(version1, version2)
+		return compareVersion(ctx, version1, version2)
 	} else {
 		version1 := checkVersionFormat(versionList[i])
 		version2 := checkVersionFormat(versionList[j])
-		return compareVersion(version1, version2)
+		return compareVersion(ctx, version1, version2)
 	}
 }
 
@@ -272,14 +277,14 @@ func sortSize(sizeList []string, i, j int) bool {
 }
 
 // compareCheckpointVersion compares firewall_image_version format like: R81.10-335.883 && R81.10_rev1.0
-func compareCheckpointVersion(version1, version2, flag string) bool {
+func compareCheckpointVersion(ctx context.Context, version1, version2, flag string) bool {
 	versionArray1 := strings.Split(version1, flag)
 	versionArray2 := strings.Split(version2, flag)
 	reg := regexp.MustCompile("[^0-9.-]+")
 	if reg.ReplaceAllString(versionArray1[0], "") == reg.ReplaceAllString(versionArray2[0], "") {
-		return compareVersion(reg.ReplaceAllString(versionArray1[1], ""), reg.ReplaceAllString(versionArray2[1], ""))
+		return compareVersion(ctx, reg.ReplaceAllString(versionArray1[1], ""), reg.ReplaceAllString(versionArray2[1], ""))
 	}
-	return compareVersion(reg.ReplaceAllString(versionArray1[0], ""), reg.ReplaceAllString(versionArray2[0], ""))
+	return compareVersion(ctx, reg.ReplaceAllString(versionArray1[0], ""), reg.ReplaceAllString(versionArray2[0], ""))
 }
 
 // checkPAVMVersionFormat check version list include the PA-VM- format version and Semantic Version, will remove PA-VM- to compare
@@ -291,15 +296,15 @@ func checkPAVMVersionFormat(version string) string {
 }
 
 // compareVersion compares two Semantic Versions
-func compareVersion(version1, version2 string) bool {
+func compareVersion(ctx context.Context, version1, version2 string) bool {
 	v1, err := version.NewVersion(version1)
 	if err != nil {
-		log.Printf("unsupported firewall image version format: %s\n", version1)
+		logWarn(ctx, "Unsupported firewall image version format", map[string]interface{}{"version": version1})
 		return false
 	}
 	v2, err := version.NewVersion(version2)
 	if err != nil {
-		log.Printf("unsupported firewall image version format: %s\n", version2)
+		logWarn(ctx, "Unsupported firewall image version format", map[string]interface{}{"version": version2})
 		return false
 	}
 	return v1.GreaterThan(v2)
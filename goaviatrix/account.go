@@ -5,12 +5,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type Account struct {
-	CID                                   string `form:"CID,omitempty"`
 	Action                                string `form:"action,omitempty"`
 	AccountName                           string `form:"account_name,omitempty" json:"account_name,omitempty"`
 	CloudType                             int    `form:"cloud_type,omitempty" json:"cloud_type,omitempty"`
@@ -87,7 +84,6 @@ type Account struct {
 }
 
 type EdgeAccount struct {
-	CID                 string `json:"CID,omitempty"`
 	Action              string `json:"action,omitempty"`
 	AccountName         string `json:"account_name,omitempty"`
 	CloudType           int    `json:"cloud_type,omitempty"`
@@ -107,14 +103,12 @@ type AccountListResp struct {
 }
 
 func (c *Client) CreateAccount(account *Account) error {
-	account.CID = c.CID
 	account.Action = "setup_account_profile"
 	return c.PostAPI(account.Action, account, DuplicateBasicCheck)
 }
 
 func (c *Client) CreateGCPAccount(account *Account) error {
 	params := map[string]string{
-		"CID":                 c.CID,
 		"action":              "setup_account_profile",
 		"account_name":        account.AccountName,
 		"cloud_type":          strconv.Itoa(account.CloudType),
@@ -134,7 +128,6 @@ func (c *Client) CreateGCPAccount(account *Account) error {
 
 func (c *Client) CreateOCIAccount(account *Account) error {
 	params := map[string]string{
-		"CID":                c.CID,
 		"action":             "setup_account_profile",
 		"account_name":       account.AccountName,
 		"cloud_type":         strconv.Itoa(account.CloudType),
@@ -156,7 +149,6 @@ func (c *Client) CreateOCIAccount(account *Account) error {
 
 func (c *Client) CreateAWSTSAccount(account *Account) error {
 	params := map[string]string{
-		"CID":                       c.CID,
 		"action":                    "setup_account_profile",
 		"account_name":              account.AccountName,
 		"cloud_type":                strconv.Itoa(account.CloudType),
@@ -188,7 +180,6 @@ func (c *Client) CreateAWSTSAccount(account *Account) error {
 
 func (c *Client) CreateAWSSAccount(account *Account) error {
 	params := map[string]string{
-		"CID":                      c.CID,
 		"action":                   "setup_account_profile",
 		"account_name":             account.AccountName,
 		"cloud_type":               strconv.Itoa(account.CloudType),
@@ -220,7 +211,6 @@ func (c *Client) CreateAWSSAccount(account *Account) error {
 
 func (c *Client) GetAccount(account *Account) (*Account, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_accounts",
 	}
 
@@ -232,23 +222,21 @@ func (c *Client) GetAccount(account *Account) (*Account, error) {
 	accList := resp.Results.AccountList
 	for i := range accList {
 		if accList[i].AccountName == account.AccountName {
-			log.Infof("Found Aviatrix Account %s", account.AccountName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 			return &accList[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix account %s", account.AccountName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) UpdateAccount(account *Account) error {
-	account.CID = c.CID
 	account.Action = "edit_account_profile"
 	return c.PostAPI(account.Action, account, BasicCheck)
 }
 
 func (c *Client) UpdateGCPAccount(account *Account) error {
 	params := map[string]string{
-		"CID":                 c.CID,
 		"action":              "edit_account_profile",
 		"account_name":        account.AccountName,
 		"cloud_type":          strconv.Itoa(account.CloudType),
@@ -267,7 +255,6 @@ func (c *Client) UpdateGCPAccount(account *Account) error {
 
 func (c *Client) UpdateAWSTSAccount(account *Account, fileChanges map[string]bool) error {
 	params := map[string]string{
-		"CID":                       c.CID,
 		"action":                    "edit_account_profile",
 		"account_name":              account.AccountName,
 		"cloud_type":                strconv.Itoa(account.CloudType),
@@ -311,7 +298,6 @@ func (c *Client) UpdateAWSTSAccount(account *Account, fileChanges map[string]boo
 
 func (c *Client) UpdateAWSSAccount(account *Account, fileChanges map[string]bool) error {
 	params := map[string]string{
-		"CID":                      c.CID,
 		"action":                   "edit_account_profile",
 		"account_name":             account.AccountName,
 		"cloud_type":               strconv.Itoa(account.CloudType),
@@ -354,20 +340,17 @@ func (c *Client) UpdateAWSSAccount(account *Account, fileChanges map[string]bool
 }
 
 func (c *Client) DeleteAccount(account *Account) error {
-	account.CID = c.CID
 	account.Action = "delete_account_profile"
 	return c.PostAPI(account.Action, account, BasicCheck)
 }
 
 func (c *Client) UploadOciApiPrivateKeyFile(account *Account) error {
-	account.CID = c.CID
 	account.Action = "upload_file"
 	return c.PostAPI(account.Action, account, BasicCheck)
 }
 
 func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_account_audit_records",
 	}
 
@@ -397,7 +380,6 @@ func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
 }
 
 func (c *Client) CreateEdgeAccount(edgeAccount *EdgeAccount) error {
-	edgeAccount.CID = c.CID
 	edgeAccount.Action = "setup_account_profile"
 	if edgeAccount.CloudType == EDGEEQUINIX {
 		edgeAccount.EdgeEquinixUsername = "no-reply@aviatrix.com"
@@ -407,7 +389,6 @@ func (c *Client) CreateEdgeAccount(edgeAccount *EdgeAccount) error {
 }
 
 func (c *Client) UpdateEdgeAccount(edgeAccount *EdgeAccount) error {
-	edgeAccount.CID = c.CID
 	edgeAccount.Action = "edit_account_profile"
 	return c.PostAPIContext2(context.Background(), nil, edgeAccount.Action, edgeAccount, BasicCheck)
 }
@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 )
 
 type AccountUser struct {
-	CID         string `form:"CID,omitempty"`
 	Action      string `form:"action,omitempty"`
 	UserName    string `form:"username,omitempty" json:"user_name,omitempty"`
 	AccountName string `form:"account_name,omitempty" json:"acct_names,omitempty"`
@@ -14,7 +13,6 @@ type AccountUser struct {
 }
 
 type AccountUserEdit struct {
-	CID         string `form:"CID,omitempty"`
 	Action      string `form:"action,omitempty"`
 	UserName    string `form:"username,omitempty" json:"user_name,omitempty"`
 	AccountName string `form:"account_name,omitempty" json:"account_name,omitempty"`
@@ -31,14 +29,12 @@ type AccountUserListResp struct {
 }
 
 func (c *Client) CreateAccountUser(user *AccountUser) error {
-	user.CID = c.CID
 	user.Action = "add_account_user"
 	return c.PostAPI(user.Action, user, BasicCheck)
 }
 
 func (c *Client) GetAccountUser(user *AccountUser) (*AccountUser, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_account_users",
 	}
 	var data AccountUserListResp
@@ -49,22 +45,20 @@ func (c *Client) GetAccountUser(user *AccountUser) (*AccountUser, error) {
 	users := data.AccountUserList
 	for i := range users {
 		if users[i].UserName == user.UserName {
-			log.Infof("Found Aviatrix user account %s", user.UserName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix user account", map[string]interface{}{"username": user.UserName})
 			return &users[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix user account %s", user.UserName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix user account", map[string]interface{}{"username": user.UserName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) UpdateAccountUserObject(user *AccountUserEdit) error {
-	user.CID = c.CID
 	user.Action = "edit_account_user"
 	return c.PostAPI(user.Action, user, BasicCheck)
 }
 
 func (c *Client) DeleteAccountUser(user *AccountUser) error {
-	user.CID = c.CID
 	user.Action = "delete_account_user"
 	return c.PostAPI(user.Action, user, BasicCheck)
 }
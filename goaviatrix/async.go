@@ -0,0 +1,206 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultAsyncPollInterval is the time between two check_task_status calls.
+	DefaultAsyncPollInterval = 10 * time.Second
+	// DefaultAsyncPollTimeout is how long an async task is polled when the context has no deadline.
+	DefaultAsyncPollTimeout = 60 * time.Minute
+)
+
+// AsyncPollConfig controls how the status of an async task is polled.
+type AsyncPollConfig struct {
+	// Interval is the time between two status checks. Default: DefaultAsyncPollInterval.
+	Interval time.Duration
+	// Timeout is the maximum time to wait for the task. The context deadline is used when it is
+	// earlier. Default: DefaultAsyncPollTimeout.
+	Timeout time.Duration
+	// FailWithoutReason reports a task that is not done and has no reason as failed instead of in
+	// progress, for actions like set_cert_domain that always return REQUEST_IN_PROGRESS meanwhile.
+	FailWithoutReason bool
+}
+
+// AsyncTaskTimeoutError is returned when an async task did not finish in time. The RequestID can
+// be used to inspect or resume waiting for the task with WaitForAsyncTask.
+type AsyncTaskTimeoutError struct {
+	Action    string
+	RequestID string
+	Waited    time.Duration
+	// LastStatus is the last progress message reported by the controller, if any
+	LastStatus string
+	// Err is the context error when the wait was cancelled
+	Err error
+}
+
+func (e *AsyncTaskTimeoutError) Error() string {
+	msg := fmt.Sprintf("waited %s but async action %s (request ID %q) never finished", e.Waited.Round(time.Second), e.Action, e.RequestID)
+	if e.Err != nil && errors.Is(e.Err, context.Canceled) {
+		msg = fmt.Sprintf("stopped waiting for async action %s (request ID %q) after %s", e.Action, e.RequestID, e.Waited.Round(time.Second))
+	}
+	if e.LastStatus != "" {
+		msg += fmt.Sprintf(", last status: %s", e.LastStatus)
+	}
+	return msg + ". Please manually verify the task status"
+}
+
+func (e *AsyncTaskTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// AsyncTaskStatus is the status of an async task returned by check_task_status.
+type AsyncTaskStatus struct {
+	// Done is set when the task finished successfully
+	Done bool
+	// Result is the result of a finished task, or the progress message of a running task
+	Result string
+}
+
+// CheckAsyncTask returns the status of the async task with the given request ID. An error is
+// returned if the task failed.
+func (c *Client) CheckAsyncTask(ctx context.Context, requestID string) (*AsyncTaskStatus, error) {
+	status, _, err := c.checkAsyncTask(ctx, requestID, false)
+	return status, err
+}
+
+// checkAsyncTask returns the status of the async task. transient is set when the error is likely
+// temporary, e.g. the controller is restarting, and the status should be checked again.
+// failWithoutReason is AsyncPollConfig.FailWithoutReason.
+func (c *Client) checkAsyncTask(ctx context.Context, requestID string, failWithoutReason bool) (status *AsyncTaskStatus, transient bool, err error) {
+	form := map[string]string{
+		"action":     "check_task_status",
+		"request_id": requestID,
+	}
+	resp, err := c.PostContext(ctx, c.baseURL, form)
+	if err != nil {
+		// Could be transient HTTP error, e.g. EOF error
+		return nil, true, fmt.Errorf("HTTP POST check_task_status failed: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("reading check_task_status response failed: %v", err)
+	}
+
+	var data struct {
+		Return bool        `json:"return"`
+		Result interface{} `json:"results"`
+		Reason string      `json:"reason"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		err = fmt.Errorf("decode check_task_status failed: %v\n Body: %s", err, body)
+		transient := strings.Contains(string(body), "502 Proxy Error") || strings.Contains(string(body), "503 Service Unavailable")
+		return nil, transient, err
+	}
+
+	result, _ := data.Result.(string)
+	if !data.Return {
+		if (data.Reason != "" || failWithoutReason) && data.Reason != "REQUEST_IN_PROGRESS" {
+			return nil, false, annotateAPIError(newAPIError("check_task_status", "Post", data.Reason), resp)
+		}
+		// Not done yet
+		return &AsyncTaskStatus{Result: result}, false, nil
+	}
+	return &AsyncTaskStatus{Done: true, Result: result}, false, nil
+}
+
+// WaitForAsyncTask polls the async task with the given request ID until it finishes, the poll
+// timeout expires or ctx is done. The result of a finished task is checked with checkFunc.
+// An *AsyncTaskTimeoutError is returned if the task did not finish in time.
+func (c *Client) WaitForAsyncTask(ctx context.Context, action, requestID string, checkFunc CheckAPIResponseFunc, poll AsyncPollConfig) error {
+	interval := poll.Interval
+	if interval <= 0 {
+		interval = DefaultAsyncPollInterval
+	}
+	timeout := poll.Timeout
+	if timeout <= 0 {
+		timeout = DefaultAsyncPollTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	logCtx := tflog.SubsystemSetField(c.logContext(ctx), LogSubsystem, "action", action)
+	logCtx = tflog.SubsystemSetField(logCtx, LogSubsystem, "request_id", requestID)
+
+	start := time.Now()
+	var lastStatus string
+	for {
+		status, transient, err := c.checkAsyncTask(waitCtx, requestID, poll.FailWithoutReason)
+		switch {
+		case waitCtx.Err() != nil:
+			// Handled below
+		case err != nil && !transient:
+			var apiErr *APIError
+			if errors.As(err, &apiErr) {
+				// Report the failure of the task as a failure of the action
+				apiErr.Action, apiErr.Method = action, "POST"
+				return apiErr
+			}
+			return fmt.Errorf("rest API %s POST failed: %v", action, err)
+		case err != nil:
+			logWarn(logCtx, "check_task_status failed, retrying", map[string]interface{}{
+				"error": err.Error(),
+			})
+		case status.Done:
+			// Async API is done, return result of checkFunc
+			return checkFunc(action, "Post", status.Result, true)
+		case status.Result != "" && status.Result != lastStatus:
+			lastStatus = status.Result
+			logInfo(logCtx, "Async action in progress", map[string]interface{}{
+				"status": status.Result,
+			})
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-waitCtx.Done():
+			t.Stop()
+			// Waited for too long and async API never finished
+			return &AsyncTaskTimeoutError{
+				Action:     action,
+				RequestID:  requestID,
+				Waited:     time.Since(start),
+				LastStatus: lastStatus,
+				Err:        waitCtx.Err(),
+			}
+		case <-t.C:
+		}
+	}
+}
+
+// startAsyncTask posts an async action and returns the request ID of the created task.
+func (c *Client) startAsyncTask(ctx context.Context, action string, i interface{}) (string, error) {
+	resp, err := c.PostContext(ctx, c.baseURL, i)
+	if err != nil {
+		return "", fmt.Errorf("HTTP POST %s failed: %w", action, err)
+	}
+	var data struct {
+		Return bool   `json:"return"`
+		Result string `json:"results"`
+		Reason string `json:"reason"`
+	}
+
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(resp.Body)
+	resp.Body.Close()
+	bodyString := buf.String()
+	if err = json.NewDecoder(strings.NewReader(bodyString)).Decode(&data); err != nil {
+		return "", fmt.Errorf("Json Decode %s failed %v\n Body: %s", action, err, bodyString)
+	}
+	if !data.Return {
+		logDebug(c.logContext(ctx), "Failed to initiate async action", map[string]interface{}{
+			"action": action,
+		})
+		return "", annotateAPIError(newAPIError(action, "POST", data.Reason), resp)
+	}
+	return data.Result, nil
+}
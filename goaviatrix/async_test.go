@@ -0,0 +1,120 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newAsyncTestClient(t *testing.T, checks []string) *Client {
+	t.Helper()
+	calls := 0
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("could not parse form: %v", err)
+		}
+		if r.Form.Get("action") != "check_task_status" {
+			fmt.Fprint(w, `{"return":true,"results":"req-1"}`)
+			return
+		}
+		if r.Form.Get("request_id") != "req-1" {
+			t.Errorf("check_task_status request_id = %q, want %q", r.Form.Get("request_id"), "req-1")
+		}
+		if calls >= len(checks) {
+			calls = len(checks) - 1
+		}
+		fmt.Fprint(w, checks[calls])
+		calls++
+	})
+}
+
+func TestPostAsyncAPIContextWithPoll(t *testing.T) {
+	inProgress := `{"return":false,"reason":"REQUEST_IN_PROGRESS","results":"creating gateway"}`
+
+	tests := []struct {
+		name        string
+		checks      []string
+		wantErr     bool
+		wantTimeout bool
+	}{
+		{
+			"done",
+			[]string{inProgress, inProgress, `{"return":true,"results":"done"}`},
+			false,
+			false,
+		},
+		{
+			"failed",
+			[]string{inProgress, `{"return":false,"reason":"gateway creation failed"}`},
+			true,
+			false,
+		},
+		{
+			"timeout",
+			[]string{inProgress},
+			true,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newAsyncTestClient(t, tt.checks)
+			poll := AsyncPollConfig{Interval: time.Millisecond, Timeout: 50 * time.Millisecond}
+
+			err := client.PostAsyncAPIContextWithPoll(context.Background(), "test_action", map[string]string{"action": "test_action"}, BasicCheck, poll)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PostAsyncAPIContextWithPoll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var timeoutErr *AsyncTaskTimeoutError
+			if errors.As(err, &timeoutErr) != tt.wantTimeout {
+				t.Fatalf("PostAsyncAPIContextWithPoll() error = %v, want AsyncTaskTimeoutError %v", err, tt.wantTimeout)
+			}
+			if tt.wantTimeout && (timeoutErr.RequestID != "req-1" || timeoutErr.LastStatus != "creating gateway") {
+				t.Errorf("AsyncTaskTimeoutError = %+v, want request ID %q and last status %q", timeoutErr, "req-1", "creating gateway")
+			}
+		})
+	}
+}
+
+func TestPostAsyncAPIContextCancel(t *testing.T) {
+	client := newAsyncTestClient(t, []string{`{"return":false,"reason":"REQUEST_IN_PROGRESS"}`})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := client.PostAsyncAPIContext(ctx, "test_action", map[string]string{"action": "test_action"}, BasicCheck)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("PostAsyncAPIContext() error = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("PostAsyncAPIContext() returned after %s, want it to stop when the context is cancelled", elapsed)
+	}
+}
+
+func TestCreateGatewayContextDeadline(t *testing.T) {
+	client := newAsyncTestClient(t, []string{`{"return":false,"reason":"REQUEST_IN_PROGRESS"}`})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := client.CreateGateway(ctx, &Gateway{GwName: "gw"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("CreateGateway() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSetCertDomainFailsWithoutReason(t *testing.T) {
+	client := newAsyncTestClient(t, []string{`{"return":false}`})
+
+	err := client.SetCertDomain(context.Background(), "example.com")
+	if err == nil || err.Error() != "rest API set_cert_domain POST failed: " {
+		t.Fatalf("SetCertDomain() error = %v, want the failure of set_cert_domain", err)
+	}
+}
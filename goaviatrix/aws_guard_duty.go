@@ -24,7 +24,6 @@ func (acc *AwsGuardDutyAccount) ID() string {
 func (c *Client) UpdateAwsGuardDutyPollInterval(scanningInterval int) error {
 	data := map[string]string{
 		"action":   "update_aws_guard_duty_poll_interval",
-		"CID":      c.CID,
 		"interval": strconv.Itoa(scanningInterval),
 	}
 	checkFunc := func(action, method, reason string, ret bool) error {
@@ -40,7 +39,6 @@ func (c *Client) UpdateAwsGuardDutyPollInterval(scanningInterval int) error {
 func (c *Client) EnableAwsGuardDuty(account *AwsGuardDutyAccount) error {
 	data := map[string]string{
 		"action":       "enable_aws_guard_duty",
-		"CID":          c.CID,
 		"account_name": account.AccountName,
 		"region":       account.Region,
 	}
@@ -56,7 +54,6 @@ func (c *Client) EnableAwsGuardDuty(account *AwsGuardDutyAccount) error {
 func (c *Client) DisableAwsGuardDuty(account *AwsGuardDutyAccount) error {
 	data := map[string]string{
 		"action":       "disable_aws_guard_duty",
-		"CID":          c.CID,
 		"account_name": account.AccountName,
 		"region":       account.Region,
 	}
@@ -66,7 +63,6 @@ func (c *Client) DisableAwsGuardDuty(account *AwsGuardDutyAccount) error {
 func (c *Client) UpdateAwsGuardDutyExcludedIPs(account *AwsGuardDutyAccount) error {
 	data := map[string]string{
 		"action":       "update_aws_guard_duty_excluded_ips",
-		"CID":          c.CID,
 		"account_name": account.AccountName,
 		"region":       account.Region,
 	}
@@ -108,7 +104,6 @@ type ListAwsGuardDutyIntervalInfo struct {
 func (c *Client) GetAwsGuardDuty() (*AwsGuardDuty, error) {
 	formData := map[string]string{
 		"action": "list_aws_guard_duty",
-		"CID":    c.CID,
 	}
 	var data ListAwsGuardDutyResp
 	err := c.GetAPI(&data, formData["action"], formData, BasicCheck)
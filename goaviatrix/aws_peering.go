@@ -2,6 +2,7 @@ package goaviatrix
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/url"
@@ -9,13 +10,11 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	log "github.com/sirupsen/logrus"
 )
 
 // AWSPeer simple struct to hold aws_peer details
 type AWSPeer struct {
 	Action       string `form:"action,omitempty"`
-	CID          string `form:"CID,omitempty"`
 	AccountName1 string `form:"peer1_account_name,omitempty"`
 	AccountName2 string `form:"peer2_account_name,omitempty"`
 	VpcID1       string `form:"peer1_vpc_id,omitempty"`
@@ -55,7 +54,6 @@ type AwsVpcInfo struct {
 }
 
 func (c *Client) CreateAWSPeer(awsPeer *AWSPeer) (string, error) {
-	awsPeer.CID = c.CID
 	awsPeer.Action = "create_aws_peering"
 	resp, err := c.Post(c.baseURL, awsPeer)
 	if err != nil {
@@ -83,7 +81,6 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 		return nil, errors.New(("url Parsing failed for list_aws_peerings ") + err.Error())
 	}
 	listAwsPeering := url.Values{}
-	listAwsPeering.Add("CID", c.CID)
 	listAwsPeering.Add("action", "list_aws_peerings")
 	Url.RawQuery = listAwsPeering.Encode()
 	resp, err := c.Get(Url.String(), nil)
@@ -100,7 +97,11 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 		return nil, errors.New("Json Decode list_aws_peerings failed: " + err.Error() + "\n Body: " + bodyString)
 	}
 	if !data.Return {
-		log.Errorf("Couldn't find AWS peering between VPCs %s and %s: %s", awsPeer.VpcID1, awsPeer.VpcID2, data.Reason)
+		logError(c.logContext(context.Background()), "Couldn't find AWS peering", map[string]interface{}{
+			"vpc_id1": awsPeer.VpcID1,
+			"vpc_id2": awsPeer.VpcID2,
+			"reason":  data.Reason,
+		})
 		return nil, errors.New("Rest API list_aws_peerings Get failed: " + data.Reason)
 	}
 	for i := range data.Results.PairLists {
@@ -118,7 +119,10 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 			return awsPeer, nil
 		}
 	}
-	log.Errorf("No AWS peering between VPC %s and %s is present.", awsPeer.VpcID1, awsPeer.VpcID2)
+	logError(c.logContext(context.Background()), "No AWS peering between the VPCs is present", map[string]interface{}{
+		"vpc_id1": awsPeer.VpcID1,
+		"vpc_id2": awsPeer.VpcID2,
+	})
 	return nil, ErrNotFound
 }
 
@@ -127,7 +131,6 @@ func (c *Client) UpdateAWSPeer(awsPeer *AWSPeer) error {
 }
 
 func (c *Client) DeleteAWSPeer(awsPeer *AWSPeer) error {
-	awsPeer.CID = c.CID
 	awsPeer.Action = "delete_aws_peering"
 	resp, err := c.Post(c.baseURL, awsPeer)
 	if err != nil {
@@ -1,18 +1,16 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // AwsTGW simple struct to hold aws_tgw details
 type AWSTgw struct {
 	Action                    string               `form:"action,omitempty"`
-	CID                       string               `form:"CID,omitempty"`
 	Name                      string               `form:"tgw_name,omitempty"`
 	CloudType                 int                  `form:"cloud_type,omitempty" json:"cloud_type,omitempty"`
 	AccountName               string               `form:"account_name,omitempty"`
@@ -153,16 +151,14 @@ type AttachmentDetail struct {
 	GwName  string `json:"avx_gw_name"`
 }
 
-func (c *Client) CreateAWSTgw(awsTgw *AWSTgw) error {
-	awsTgw.CID = c.CID
+func (c *Client) CreateAWSTgw(ctx context.Context, awsTgw *AWSTgw) error {
 	awsTgw.Action = "add_aws_tgw"
 	awsTgw.Async = true
-	return c.PostAsyncAPI(awsTgw.Action, awsTgw, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgw.Action, awsTgw, BasicCheck)
 }
 
 func (c *Client) GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_route_domain_names",
 		"tgw_name": awsTgw.Name,
 	}
@@ -182,7 +178,6 @@ func (c *Client) GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error) {
 		}
 
 		form = map[string]string{
-			"CID":               c.CID,
 			"action":            "view_route_domain_details",
 			"tgw_name":          awsTgw.Name,
 			"route_domain_name": dm,
@@ -218,7 +213,6 @@ func (c *Client) GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error) {
 
 			if dm != "Aviatrix_Edge_Domain" {
 				form = map[string]string{
-					"CID":             c.CID,
 					"action":          "list_attachment_route_table_details",
 					"tgw_name":        awsTgw.Name,
 					"attachment_name": attachedVPCs[i].VPCId,
@@ -280,7 +274,6 @@ func (c *Client) GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error) {
 
 func (c *Client) IsFirewallSecurityDomain(tgwName string, domainName string) (bool, error) {
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "view_route_domain_details",
 		"tgw_name":          tgwName,
 		"route_domain_name": domainName,
@@ -300,10 +293,9 @@ func (c *Client) IsFirewallSecurityDomain(tgwName string, domainName string) (bo
 	return false, ErrNotFound
 }
 
-func (c *Client) DeleteAWSTgw(awsTgw *AWSTgw) error {
-	awsTgw.CID = c.CID
+func (c *Client) DeleteAWSTgw(ctx context.Context, awsTgw *AWSTgw) error {
 	awsTgw.Action = "delete_aws_tgw"
-	return c.PostAPI(awsTgw.Action, awsTgw, BasicCheck)
+	return c.PostAPIContext(ctx, awsTgw.Action, awsTgw, BasicCheck)
 }
 
 func (c *Client) ValidateAWSTgwDomains(domainsAll []string, domainConnAll [][]string, attachedVPCAll [][]string,
@@ -417,13 +409,12 @@ func (c *Client) ValidateAWSTgwDomains(domainsAll []string, domainConnAll [][]st
 	return domainsToCreate, domainConnPolicy, domainConnRemove, nil
 }
 
-func (c *Client) AttachAviatrixTransitGWToAWSTgw(awsTgw *AWSTgw, gateway *Gateway, SecurityDomainName string) error {
+func (c *Client) AttachAviatrixTransitGWToAWSTgw(ctx context.Context, awsTgw *AWSTgw, gateway *Gateway, SecurityDomainName string) error {
 	transitGw, err := c.GetGateway(gateway)
 	if err != nil {
 		return fmt.Errorf("could not get transit gateway to attach to AWS TGW: %v", err)
 	}
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "attach_vpc_to_tgw",
 		"region":            awsTgw.Region,
 		"vpc_account_name":  transitGw.AccountName,
@@ -434,16 +425,15 @@ func (c *Client) AttachAviatrixTransitGWToAWSTgw(awsTgw *AWSTgw, gateway *Gatewa
 		"route_domain_name": SecurityDomainName,
 		"async":             "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) DetachAviatrixTransitGWFromAWSTgw(awsTgw *AWSTgw, gateway *Gateway, SecurityDomainName string) error {
+func (c *Client) DetachAviatrixTransitGWFromAWSTgw(ctx context.Context, awsTgw *AWSTgw, gateway *Gateway, SecurityDomainName string) error {
 	transitGw, err := c.GetGateway(gateway)
 	if err != nil {
 		return fmt.Errorf("could not get transit gateway to detach from AWS TGW: %v", err)
 	}
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "detach_vpc_from_tgw",
 		"tgw_name": awsTgw.Name,
 		"vpc_name": transitGw.VpcID,
@@ -458,12 +448,11 @@ func (c *Client) DetachAviatrixTransitGWFromAWSTgw(awsTgw *AWSTgw, gateway *Gate
 		}
 		return nil
 	}
-	return c.PostAsyncAPI(form["action"], form, check)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, check)
 }
 
-func (c *Client) AttachVpcToAWSTgw(awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error {
+func (c *Client) AttachVpcToAWSTgw(ctx context.Context, awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error {
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "attach_vpc_to_tgw",
 		"region":            awsTgw.Region,
 		"vpc_account_name":  vpcSolo.AccountName,
@@ -487,24 +476,22 @@ func (c *Client) AttachVpcToAWSTgw(awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDoma
 	if vpcSolo.CustomizedRoutes != "" {
 		form["route_table_list"] = vpcSolo.RouteTables
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) DetachVpcFromAWSTgw(awsTgw *AWSTgw, vpcID string) error {
+func (c *Client) DetachVpcFromAWSTgw(ctx context.Context, awsTgw *AWSTgw, vpcID string) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "detach_vpc_from_tgw",
 		"tgw_name": awsTgw.Name,
 		"vpc_name": vpcID,
 		"async":    "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetTransitGwFromVpcID(awsTgw *AWSTgw, gateway *Gateway) (*Gateway, error) {
 	var data ListAwsTgwAttachmentAPIResp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_all_tgw_attachments",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -518,20 +505,19 @@ func (c *Client) GetTransitGwFromVpcID(awsTgw *AWSTgw, gateway *Gateway) (*Gatew
 			return gateway, nil
 		}
 	}
-	log.Errorf("Couldn't find transit gateway attached to vpc %s", gateway.VpcID)
+	logError(c.logContext(context.Background()), "Couldn't find transit gateway attached to VPC", map[string]interface{}{"vpc_id": gateway.VpcID})
 	return nil, ErrNotFound
 }
 
 func (c *Client) ListTgwDetails(awsTgw *AWSTgw) (*AWSTgw, error) {
 	var data TGWInfoResp
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_tgw_details",
 		"tgw_name": awsTgw.Name,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -566,7 +552,6 @@ func (c *Client) ListTgwDetails(awsTgw *AWSTgw) (*AWSTgw, error) {
 func (c *Client) IsVpcAttachedToTgw(awsTgw *AWSTgw, vpcSolo *VPCSolo) (bool, error) {
 	var data listAttachedVpcNamesResp
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_attached_vpc_names_to_route_domain",
 		"tgw_name": awsTgw.Name,
 	}
@@ -587,7 +572,6 @@ func (c *Client) IsVpcAttachedToTgw(awsTgw *AWSTgw, vpcSolo *VPCSolo) (bool, err
 func (c *Client) GetAttachmentRouteTableDetails(tgwName string, attachmentName string) (*AttachmentRouteTableDetails, error) {
 	var data AttachmentRouteTableDetailsAPIResp
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "list_attachment_route_table_details",
 		"tgw_name":        tgwName,
 		"attachment_name": attachmentName,
@@ -602,7 +586,6 @@ func (c *Client) GetAttachmentRouteTableDetails(tgwName string, attachmentName s
 func (c *Client) UpdateTGWCidrs(tgwName string, cidrs []string) error {
 	data := map[string]string{
 		"action":    "update_tgw_cidrs",
-		"CID":       c.CID,
 		"tgw_name":  tgwName,
 		"cidr_list": strings.Join(cidrs, ","),
 	}
@@ -612,7 +595,6 @@ func (c *Client) UpdateTGWCidrs(tgwName string, cidrs []string) error {
 func (c *Client) UpdateTGWInspectionMode(tgwName, inspectionMode string) error {
 	data := map[string]string{
 		"action":   "edit_aws_tgw_inspection_mode",
-		"CID":      c.CID,
 		"tgw_name": tgwName,
 		"mode":     inspectionMode,
 	}
@@ -8,7 +8,6 @@ import (
 
 type AwsTgwConnect struct {
 	Action                  string `form:"action"`
-	CID                     string `form:"CID"`
 	TgwName                 string `form:"tgw_name" json:"tgw_name"`
 	ConnectionName          string `form:"connection_name" json:"connection_name"`
 	TransportAttachmentID   string `form:"transport_vpc_id" json:"transport_attachment_id"`
@@ -25,7 +24,6 @@ func (a *AwsTgwConnect) ID() string {
 
 type AwsTgwConnectPeer struct {
 	Action              string   `form:"action"`
-	CID                 string   `form:"CID"`
 	TgwName             string   `form:"tgw_name" json:"tgw_name"`
 	ConnectAttachmentID string   `form:"connect_attachment_id" json:"connect_attachment_id"`
 	ConnectPeerName     string   `form:"connect_peer_name" json:"connect_peer_name"`
@@ -44,14 +42,12 @@ func (a *AwsTgwConnectPeer) ID() string {
 
 func (c *Client) AttachTGWConnectToTGW(ctx context.Context, connect *AwsTgwConnect) error {
 	connect.Action = "attach_tgw_connect_to_tgw"
-	connect.CID = c.CID
 	connect.Async = true
 	return c.PostAsyncAPIContext(ctx, connect.Action, connect, BasicCheck)
 }
 
 func (c *Client) DetachTGWConnectFromTGW(ctx context.Context, connect *AwsTgwConnect) error {
 	connect.Action = "detach_tgw_connect_from_tgw"
-	connect.CID = c.CID
 	connect.Async = true
 	return c.PostAsyncAPIContext(ctx, connect.Action, connect, BasicCheck)
 }
@@ -59,7 +55,6 @@ func (c *Client) DetachTGWConnectFromTGW(ctx context.Context, connect *AwsTgwCon
 func (c *Client) GetTGWConnect(ctx context.Context, connect *AwsTgwConnect) (*AwsTgwConnect, error) {
 	form := map[string]string{
 		"action":          "get_tgw_connect_by_connection_name",
-		"CID":             c.CID,
 		"connection_name": connect.ConnectionName,
 		"tgw_name":        connect.TgwName,
 	}
@@ -87,21 +82,18 @@ func (c *Client) GetTGWConnect(ctx context.Context, connect *AwsTgwConnect) (*Aw
 
 func (c *Client) CreateTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) error {
 	peer.Action = "create_tgw_connect_peer"
-	peer.CID = c.CID
 	peer.InsideIPCidrsString = strings.Join(peer.InsideIPCidrs, ",")
 	return c.PostAPIContext(ctx, peer.Action, peer, BasicCheck)
 }
 
 func (c *Client) DeleteTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) error {
 	peer.Action = "delete_tgw_connect_peer"
-	peer.CID = c.CID
 	return c.PostAPIContext(ctx, peer.Action, peer, BasicCheck)
 }
 
 func (c *Client) GetTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) (*AwsTgwConnectPeer, error) {
 	form := map[string]string{
 		"action":            "get_tgw_connect_peer_by_connect_peer_name",
-		"CID":               c.CID,
 		"connection_name":   peer.ConnectionName,
 		"tgw_name":          peer.TgwName,
 		"connect_peer_name": peer.ConnectPeerName,
@@ -1,14 +1,13 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type AwsTgwDirectConnect struct {
-	CID                      string `form:"CID,omitempty"`
 	Action                   string `form:"action,omitempty"`
 	TgwName                  string `form:"tgw_name,omitempty"`
 	DirectConnectAccountName string `form:"directconnect_account_name,omitempty"`
@@ -16,7 +15,7 @@ type AwsTgwDirectConnect struct {
 	DxGatewayName            string `form:"directconnect_gateway_name,omitempty"`
 	SecurityDomainName       string `form:"route_domain_name,omitempty"`
 	AllowedPrefix            string `form:"allowed_prefix,omitempty"`
-	DirectConnectID          string `form:"directconnect_id, omitempty"`
+	DirectConnectID          string `form:"directconnect_id"`
 	LearnedCidrsApproval     string `form:"learned_cidrs_approval,omitempty"`
 	Async                    bool   `form:"async,omitempty"`
 }
@@ -36,17 +35,15 @@ type AwsTgwDirectConnResp struct {
 	Reason  string                 `json:"reason"`
 }
 
-func (c *Client) CreateAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) error {
-	awsTgwDirectConnect.CID = c.CID
+func (c *Client) CreateAwsTgwDirectConnect(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	awsTgwDirectConnect.Action = "attach_direct_connect_to_tgw"
 	awsTgwDirectConnect.Async = true
-	return c.PostAsyncAPI(awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) (*AwsTgwDirectConnect, error) {
 	var data AwsTgwDirectConnResp
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_all_tgw_attachments",
 		"tgw_name": awsTgwDirectConnect.TgwName,
 	}
@@ -61,7 +58,7 @@ func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect
 			awsTgwDirectConnect.SecurityDomainName = allAwsTgwDirectConn[i].SecurityDomainName
 			awsTgwDirectConnect.AllowedPrefix = strings.Join(allAwsTgwDirectConn[i].AllowedPrefix, ",")
 			awsTgwDirectConnect.LearnedCidrsApproval = allAwsTgwDirectConn[i].LearnedCidrsApproval
-			log.Debugf("Found Aws Tgw Direct Conn: %#v", awsTgwDirectConnect)
+			logDebug(c.logContext(context.Background()), "Found AWS TGW Direct Connect", map[string]interface{}{"direct_connect": fmt.Sprintf("%#v", awsTgwDirectConnect)})
 			return awsTgwDirectConnect, nil
 		}
 	}
@@ -69,20 +66,17 @@ func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect
 }
 
 func (c *Client) UpdateDirectConnAllowedPrefix(awsTgwDirectConnect *AwsTgwDirectConnect) error {
-	awsTgwDirectConnect.CID = c.CID
 	awsTgwDirectConnect.Action = "update_tgw_directconnect_allowed_prefix"
 	return c.PostAPI(awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
 }
 
 func (c *Client) DeleteAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) error {
-	awsTgwDirectConnect.CID = c.CID
 	awsTgwDirectConnect.Action = "detach_directconnect_from_tgw"
 	return c.PostAPI(awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
 }
 
 func (c *Client) EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "enable_learned_cidrs_approval",
 		"tgw_name":               awsTgwDirectConnect.TgwName,
 		"attachment_name":        awsTgwDirectConnect.DxGatewayName,
@@ -93,7 +87,6 @@ func (c *Client) EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *Aw
 
 func (c *Client) DisableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "disable_learned_cidrs_approval",
 		"tgw_name":               awsTgwDirectConnect.TgwName,
 		"attachment_name":        awsTgwDirectConnect.DxGatewayName,
@@ -1,16 +1,14 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	log "github.com/sirupsen/logrus"
 )
 
 type AwsTgwPeering struct {
 	Action   string `form:"action,omitempty"`
-	CID      string `form:"CID,omitempty"`
 	TgwName1 string `form:"tgw_name1,omitempty" json:"tgw_name1,omitempty"`
 	TgwName2 string `form:"tgw_name2,omitempty" json:"tgw_name2,omitempty"`
 	Async    bool   `form:"async,omitempty"`
@@ -22,23 +20,21 @@ type AwsTgwPeeringAPIResp struct {
 	Reason  string   `json:"reason"`
 }
 
-func (c *Client) CreateAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
-	awsTgwPeering.CID = c.CID
+func (c *Client) CreateAwsTgwPeering(ctx context.Context, awsTgwPeering *AwsTgwPeering) error {
 	awsTgwPeering.Action = "add_tgw_peering"
 	awsTgwPeering.Async = true
-	return c.PostAsyncAPI(awsTgwPeering.Action, awsTgwPeering, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwPeering.Action, awsTgwPeering, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 	var data AwsTgwPeeringAPIResp
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_peered_tgw_names",
 		"tgw_name": awsTgwPeering.TgwName1,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -50,7 +46,10 @@ func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 		return err
 	}
 	if len(data.Results) == 0 {
-		log.Errorf("Aws tgw peering with tgw: %s and tgw: %s not found", awsTgwPeering.TgwName1, awsTgwPeering.TgwName2)
+		logError(c.logContext(context.Background()), "AWS TGW peering not found", map[string]interface{}{
+			"tgw_name1": awsTgwPeering.TgwName1,
+			"tgw_name2": awsTgwPeering.TgwName2,
+		})
 		return ErrNotFound
 	}
 	peeringList := data.Results
@@ -62,11 +61,10 @@ func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 	return ErrNotFound
 }
 
-func (c *Client) DeleteAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
-	awsTgwPeering.CID = c.CID
+func (c *Client) DeleteAwsTgwPeering(ctx context.Context, awsTgwPeering *AwsTgwPeering) error {
 	awsTgwPeering.Action = "delete_tgw_peering"
 	awsTgwPeering.Async = true
-	return c.PostAsyncAPI(awsTgwPeering.Action, awsTgwPeering, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwPeering.Action, awsTgwPeering, BasicCheck)
 }
 
 func DiffSuppressFuncAwsTgwPeeringTgwName1(k, old, new string, d *schema.ResourceData) bool {
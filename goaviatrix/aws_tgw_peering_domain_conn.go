@@ -1,15 +1,14 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type DomainConn struct {
 	Action      string `form:"action,omitempty"`
-	CID         string `form:"CID,omitempty"`
 	TgwName1    string `form:"tgw_name1,omitempty" json:"tgw_name1,omitempty"`
 	DomainName1 string
 	TgwName2    string `form:"tgw_name2,omitempty" json:"tgw_name2,omitempty"`
@@ -28,29 +27,27 @@ type ConnectedRouteDomainDetail struct {
 	Egress                  string   `json:"egress"`
 }
 
-func (c *Client) CreateDomainConn(domainConn *DomainConn) error {
+func (c *Client) CreateDomainConn(ctx context.Context, domainConn *DomainConn) error {
 	form := map[string]string{
-		"CID":                           c.CID,
 		"action":                        "add_connection_between_route_domains",
 		"tgw_name":                      domainConn.TgwName1,
 		"source_route_domain_name":      domainConn.DomainName1,
 		"destination_route_domain_name": domainConn.TgwName2 + ":" + domainConn.DomainName2,
 		"async":                         "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetDomainConn(domainConn *DomainConn) error {
 	var data ListConnectedRouteDomainsResp
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "list_connected_route_domains",
 		"tgw_name":          domainConn.TgwName1,
 		"route_domain_name": domainConn.DomainName1,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -70,16 +67,15 @@ func (c *Client) GetDomainConn(domainConn *DomainConn) error {
 	return ErrNotFound
 }
 
-func (c *Client) DeleteDomainConn(domainConn *DomainConn) error {
+func (c *Client) DeleteDomainConn(ctx context.Context, domainConn *DomainConn) error {
 	form := map[string]string{
-		"CID":                           c.CID,
 		"action":                        "delete_connection_between_route_domains",
 		"tgw_name":                      domainConn.TgwName1,
 		"source_route_domain_name":      domainConn.DomainName1,
 		"destination_route_domain_name": domainConn.TgwName2 + ":" + domainConn.DomainName2,
 		"async":                         "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func DiffSuppressFuncAwsTgwPeeringDomainConnTgwName1(k, old, new string, d *schema.ResourceData) bool {
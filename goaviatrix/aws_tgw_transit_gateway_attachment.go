@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -8,7 +9,6 @@ import (
 
 type AwsTgwTransitGwAttachment struct {
 	Action             string `form:"action,omitempty"`
-	CID                string `form:"CID,omitempty"`
 	TgwName            string `form:"tgw_name"`
 	Region             string `form:"region"`
 	SecurityDomainName string `form:"security_domain_name"`
@@ -39,9 +39,8 @@ type AttachmentInfo struct {
 	EnableGlobalAccelerator bool            `json:"enable_acceleration"`
 }
 
-func (c *Client) CreateAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
+func (c *Client) CreateAwsTgwTransitGwAttachment(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "attach_vpc_to_tgw",
 		"region":            awsTgwTransitGwAttachment.Region,
 		"vpc_account_name":  awsTgwTransitGwAttachment.VpcAccountName,
@@ -51,19 +50,18 @@ func (c *Client) CreateAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsT
 		"gateway_name":      awsTgwTransitGwAttachment.TransitGatewayName,
 		"async":             "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) (*AwsTgwTransitGwAttachment, error) {
 	var data TgwAttachmentResp
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_tgw_details",
 		"tgw_name": awsTgwTransitGwAttachment.TgwName,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -86,9 +84,8 @@ func (c *Client) GetAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwT
 	return nil, ErrNotFound
 }
 
-func (c *Client) DeleteAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
+func (c *Client) DeleteAwsTgwTransitGwAttachment(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "detach_vpc_from_tgw",
 		"tgw_name": awsTgwTransitGwAttachment.TgwName,
 		"vpc_name": awsTgwTransitGwAttachment.VpcID,
@@ -103,5 +100,5 @@ func (c *Client) DeleteAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsT
 		}
 		return nil
 	}
-	return c.PostAsyncAPI(form["action"], form, check)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, check)
 }
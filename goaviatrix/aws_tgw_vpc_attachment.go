@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,7 +9,6 @@ import (
 
 type AwsTgwVpcAttachment struct {
 	Action                       string `form:"action,omitempty"`
-	CID                          string `form:"CID,omitempty"`
 	TgwName                      string `form:"tgw_name"`
 	Region                       string `form:"region"`
 	SecurityDomainName           string `form:"security_domain_name"`
@@ -28,9 +28,8 @@ type DomainListResp struct {
 	Reason  string   `json:"reason,omitempty"`
 }
 
-func (c *Client) CreateAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+func (c *Client) CreateAwsTgwVpcAttachment(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "attach_vpc_to_tgw",
 		"region":            awsTgwVpcAttachment.Region,
 		"vpc_account_name":  awsTgwVpcAttachment.VpcAccountName,
@@ -55,12 +54,11 @@ func (c *Client) CreateAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachm
 	if awsTgwVpcAttachment.RouteTables != "" {
 		form["route_table_list"] = awsTgwVpcAttachment.RouteTables
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "connect_firenet_with_tgw",
 		"vpc_id":      awsTgwVpcAttachment.VpcID,
 		"tgw_name":    awsTgwVpcAttachment.TgwName,
@@ -148,25 +146,23 @@ func (c *Client) GetAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment
 	return aTVA, nil
 }
 
-func (c *Client) DeleteAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+func (c *Client) DeleteAwsTgwVpcAttachment(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "detach_vpc_from_tgw",
 		"tgw_name": awsTgwVpcAttachment.TgwName,
 		"vpc_name": awsTgwVpcAttachment.VpcID,
 		"async":    "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) DeleteAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+func (c *Client) DeleteAwsTgwVpcAttachmentForFireNet(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disconnect_firenet_with_tgw",
 		"vpc_id": awsTgwVpcAttachment.VpcID,
 		"async":  "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwDetail(awsTgw *AWSTgw) (*AWSTgw, error) {
@@ -181,7 +177,6 @@ func (c *Client) GetAwsTgwDetail(awsTgw *AWSTgw) (*AWSTgw, error) {
 func (c *Client) GetAwsTgwDomain(awsTgw *AWSTgw, sDM string) error {
 	var data DomainListResp
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_route_domain_names",
 		"tgw_name": awsTgw.Name,
 	}
@@ -203,7 +198,6 @@ func (c *Client) GetAwsTgwDomain(awsTgw *AWSTgw, sDM string) error {
 func (c *Client) GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error) {
 	var data RouteDomainAPIResp
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "view_route_domain_details",
 		"tgw_name":          awsTgwVpcAttachment.TgwName,
 		"route_domain_name": awsTgwVpcAttachment.SecurityDomainName,
@@ -227,7 +221,6 @@ func (c *Client) GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment *AwsTgwVp
 
 func (c *Client) EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "edit_tgw_spoke_vpc_customized_routes",
 		"tgw_name":   awsTgwVpcAttachment.TgwName,
 		"vpc_id":     awsTgwVpcAttachment.VpcID,
@@ -238,7 +231,6 @@ func (c *Client) EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment *AwsTgwVpcA
 
 func (c *Client) EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "update_customized_route_advertisement",
 		"tgw_name":        awsTgwVpcAttachment.TgwName,
 		"attachment_name": awsTgwVpcAttachment.VpcID,
@@ -250,7 +242,6 @@ func (c *Client) EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment
 func (c *Client) UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	params := map[string]string{
 		"action":          "update_firewall_attachment_access_from_onprem",
-		"CID":             c.CID,
 		"tgw_name":        awsTgwVpcAttachment.TgwName,
 		"attachment_name": awsTgwVpcAttachment.VpcID,
 		"edge_attachment": awsTgwVpcAttachment.EdgeAttachment,
@@ -266,7 +257,6 @@ func (c *Client) UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment *A
 func (c *Client) GetFirenetManagementDetails(awsTgwVpcAttachment *AwsTgwVpcAttachment) ([]string, error) {
 	params := map[string]string{
 		"action":          "get_tgw_attachment_details",
-		"CID":             c.CID,
 		"tgw_name":        awsTgwVpcAttachment.TgwName,
 		"attachment_name": awsTgwVpcAttachment.VpcID,
 	}
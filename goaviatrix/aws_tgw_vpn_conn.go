@@ -1,13 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // VGWConn simple struct to hold VGW Connection details
@@ -15,7 +14,6 @@ type AwsTgwVpnConn struct {
 	Action               string `form:"action,omitempty"`
 	TgwName              string `form:"tgw_name,omitempty"`
 	RouteDomainName      string `form:"route_domain_name,omitempty"`
-	CID                  string `form:"CID,omitempty"`
 	ConnName             string `form:"connection_name,omitempty"`
 	PublicIP             string `form:"public_ip,omitempty"`
 	OnpremASN            string `form:"onprem_asn,omitempty"`
@@ -84,7 +82,6 @@ type GetAwsTgwVpnConnVpnIdResp struct {
 func (c *Client) CreateAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (string, error) {
 	var data AwsTgwVpnConnCreateResp
 	form := map[string]string{
-		"CID":                        c.CID,
 		"action":                     "attach_edge_vpn_to_tgw",
 		"tgw_name":                   awsTgwVpnConn.TgwName,
 		"route_domain_name":          awsTgwVpnConn.RouteDomainName,
@@ -120,7 +117,6 @@ func (c *Client) CreateAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (string, erro
 func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn, error) {
 	var data AwsTgwVpnConnResp
 	form := map[string]string{
-		"CID":           c.CID,
 		"action":        "list_all_tgw_attachments",
 		"tgw_name":      awsTgwVpnConn.TgwName,
 		"resource_type": "vpn",
@@ -172,7 +168,7 @@ func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn,
 			}
 			awsTgwVpnConn.OnpremASN = asnString
 
-			log.Debugf("Found AwsTgwVpnConn: %#v", awsTgwVpnConn)
+			logDebug(c.logContext(context.Background()), "Found AWS TGW VPN connection", map[string]interface{}{"vpn_connection": fmt.Sprintf("%#v", awsTgwVpnConn)})
 
 			return awsTgwVpnConn, nil
 		}
@@ -181,16 +177,14 @@ func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn,
 	return nil, ErrNotFound
 }
 
-func (c *Client) DeleteAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) error {
-	awsTgwVpnConn.CID = c.CID
+func (c *Client) DeleteAwsTgwVpnConn(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error {
 	awsTgwVpnConn.Action = "detach_vpn_from_tgw"
 	awsTgwVpnConn.Async = true
-	return c.PostAsyncAPI(awsTgwVpnConn.Action, awsTgwVpnConn, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwVpnConn.Action, awsTgwVpnConn, BasicCheck)
 }
 
 func (c *Client) EnableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVpnConn) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "enable_learned_cidrs_approval",
 		"tgw_name":               awsTgwVpnConn.TgwName,
 		"attachment_name":        awsTgwVpnConn.VpnID,
@@ -201,7 +195,6 @@ func (c *Client) EnableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVp
 
 func (c *Client) DisableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVpnConn) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "disable_learned_cidrs_approval",
 		"tgw_name":               awsTgwVpnConn.TgwName,
 		"attachment_name":        awsTgwVpnConn.VpnID,
@@ -213,7 +206,6 @@ func (c *Client) DisableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwV
 func (c *Client) GetAwsTgwVpnTunnelData(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConnEdit, error) {
 	params := map[string]string{
 		"action":          "list_attachment_route_table_details",
-		"CID":             c.CID,
 		"tgw_name":        awsTgwVpnConn.TgwName,
 		"attachment_name": awsTgwVpnConn.VpnID,
 	}
@@ -2,7 +2,6 @@ package goaviatrix
 
 type AzurePeer struct {
 	Action       string `form:"action,omitempty"`
-	CID          string `form:"CID,omitempty"`
 	AccountName1 string `form:"req_account_name,omitempty"`
 	AccountName2 string `form:"acc_account_name,omitempty"`
 	VNet1        string `form:"req_vpc_id,omitempty"`
@@ -20,7 +19,6 @@ type AzurePeerAPIResp struct {
 }
 
 func (c *Client) CreateAzurePeer(azurePeer *AzurePeer) error {
-	azurePeer.CID = c.CID
 	azurePeer.Action = "arm_peer_vnet_pair"
 	return c.PostAPI(azurePeer.Action, azurePeer, BasicCheck)
 }
@@ -28,7 +26,6 @@ func (c *Client) CreateAzurePeer(azurePeer *AzurePeer) error {
 func (c *Client) GetAzurePeer(azurePeer *AzurePeer) (*AzurePeer, error) {
 	var data map[string]interface{}
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_arm_peer_vnet_pairs",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -72,7 +69,6 @@ func (c *Client) GetAzurePeer(azurePeer *AzurePeer) (*AzurePeer, error) {
 
 func (c *Client) DeleteAzurePeer(azurePeer *AzurePeer) error {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "arm_unpeer_vnet_pair",
 		"vpc_name1": azurePeer.VNet1,
 		"vpc_name2": azurePeer.VNet2,
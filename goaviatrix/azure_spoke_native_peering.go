@@ -5,7 +5,6 @@ import (
 )
 
 type AzureSpokeNativePeering struct {
-	CID                string `form:"CID,omitempty"`
 	Action             string `form:"action,omitempty"`
 	TransitGatewayName string `form:"transit_gateway_name,omitempty"`
 	SpokeAccountName   string `form:"account_name,omitempty"`
@@ -27,7 +26,6 @@ type AzureSpokeNativePeeringEdit struct {
 }
 
 func (c *Client) CreateAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) error {
-	azureSpokeNativePeering.CID = c.CID
 	azureSpokeNativePeering.Action = "attach_arm_native_spoke_to_transit"
 	return c.PostAPI(azureSpokeNativePeering.Action, azureSpokeNativePeering, BasicCheck)
 }
@@ -35,7 +33,6 @@ func (c *Client) CreateAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpo
 func (c *Client) GetAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) (*AzureSpokeNativePeering, error) {
 	var data AzureSpokeNativePeeringAPIResp
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               "list_arm_native_spokes",
 		"transit_gateway_name": azureSpokeNativePeering.TransitGatewayName,
 		"details":              "true",
@@ -66,7 +63,6 @@ func (c *Client) GetAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeN
 
 func (c *Client) DeleteAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) error {
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               "detach_arm_native_spoke_to_transit",
 		"transit_gateway_name": azureSpokeNativePeering.TransitGatewayName,
 		"spoke_name":           azureSpokeNativePeering.SpokeAccountName + ":" + strings.Replace(azureSpokeNativePeering.SpokeVpcID, ".", "-", -1),
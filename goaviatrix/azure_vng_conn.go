@@ -1,7 +1,6 @@
 package goaviatrix
 
 type AzureVngConn struct {
-	CID                string
 	PrimaryGatewayName string
 	ConnectionName     string
 }
@@ -17,7 +16,6 @@ type AzureVngConnResp struct {
 func (c *Client) ConnectAzureVng(r *AzureVngConn) error {
 	params := map[string]string{
 		"action":               "attach_vng_to_transit_gateway",
-		"CID":                  c.CID,
 		"primary_gateway_name": r.PrimaryGatewayName,
 		"connection_name":      r.ConnectionName,
 	}
@@ -28,7 +26,6 @@ func (c *Client) ConnectAzureVng(r *AzureVngConn) error {
 func (c *Client) GetAzureVngConnStatus(connectionName string) (*AzureVngConnResp, error) {
 	params := map[string]string{
 		"action": "list_vnets_with_vng",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -56,7 +53,6 @@ func (c *Client) GetAzureVngConnStatus(connectionName string) (*AzureVngConnResp
 func (c *Client) DisconnectAzureVng(vpcId string, connectionName string) error {
 	params := map[string]string{
 		"action":          "disconnect_transit_gw",
-		"CID":             c.CID,
 		"vpc_id":          vpcId,
 		"connection_name": connectionName,
 	}
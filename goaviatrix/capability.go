@@ -0,0 +1,110 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+)
+
+// Feature is a controller feature that is only supported by some controller versions.
+type Feature string
+
+const (
+	FeatureEdgeVmSelfmanaged                           Feature = "edge_vm_selfmanaged"
+	FeatureEdgePlatform                                Feature = "edge_platform"
+	FeatureEdgeManualBgpAdvertisedCidrs                Feature = "edge_manual_bgp_advertised_cidrs"
+	FeatureCopilotDeployment                           Feature = "copilot_deployment"
+	FeatureDistributedFirewallingOriginCertEnforcement Feature = "distributed_firewalling_origin_cert_enforcement"
+	FeatureDistributedFirewallingProxyCa               Feature = "distributed_firewalling_proxy_ca"
+)
+
+// featureMinVersions is the registry of the minimum controller version of each feature, the
+// controller supported by the provider release that added it. A version without build, e.g.
+// "7.1", matches any build of that version. Features of the oldest supported version are not
+// registered, the provider rejects older controllers.
+var featureMinVersions = map[Feature]string{
+	// R3.1.1
+	FeatureEdgeVmSelfmanaged:                           "7.1.1794",
+	FeatureEdgePlatform:                                "7.1.1794",
+	FeatureEdgeManualBgpAdvertisedCidrs:                "7.1.1794",
+	FeatureCopilotDeployment:                           "7.1.1794",
+	FeatureDistributedFirewallingOriginCertEnforcement: "7.1.1794",
+	FeatureDistributedFirewallingProxyCa:               "7.1.1794",
+}
+
+// FeatureMinVersion returns the minimum controller version of feature, or "" if the feature is
+// supported by all versions.
+func FeatureMinVersion(feature Feature) string {
+	return featureMinVersions[feature]
+}
+
+// Capabilities are the features supported by a controller version.
+type Capabilities struct {
+	Version *AviatrixVersion
+}
+
+// NewCapabilities returns the capabilities of the controller version, e.g. "7.1.1794".
+func NewCapabilities(version string) (*Capabilities, error) {
+	_, v, err := ParseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return &Capabilities{Version: v}, nil
+}
+
+// Supports reports whether the controller supports feature.
+func (c *Capabilities) Supports(feature Feature) (bool, error) {
+	minVersion := FeatureMinVersion(feature)
+	if minVersion == "" {
+		return true, nil
+	}
+	return c.AtLeast(minVersion)
+}
+
+// AtLeast reports whether the controller version is minVersion or later. A version without
+// build, e.g. "7.1", matches any build of that version.
+func (c *Capabilities) AtLeast(minVersion string) (bool, error) {
+	_, min, err := ParseVersion(minVersion)
+	if err != nil {
+		return false, fmt.Errorf("invalid minimum version %q: %v", minVersion, err)
+	}
+	// CompareSoftwareVersions considers a version with build lower than the same version without
+	cmp, err := CompareSoftwareVersions(c.Version.String(min.HasBuild && c.Version.HasBuild), minVersion)
+	if err != nil {
+		return false, err
+	}
+	return cmp >= 0, nil
+}
+
+// Require returns an error naming the attribute if the controller does not support feature.
+func (c *Capabilities) Require(feature Feature, attribute string) error {
+	supported, err := c.Supports(feature)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		minVersion := FeatureMinVersion(feature)
+		if _, min, err := ParseVersion(minVersion); err == nil && !min.HasBuild {
+			minVersion += ".x"
+		}
+		return fmt.Errorf("%s requires controller >= %s, the controller version is %s", attribute, minVersion, c.Version.String(c.Version.HasBuild))
+	}
+	return nil
+}
+
+// Capabilities returns the capabilities of the controller. The controller version is only
+// requested once per client.
+func (c *Client) Capabilities(ctx context.Context) (*Capabilities, error) {
+	c.capabilitiesMu.Lock()
+	defer c.capabilitiesMu.Unlock()
+
+	if c.capabilities != nil {
+		return c.capabilities, nil
+	}
+	_, version, err := c.GetCurrentVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the controller version: %v", err)
+	}
+	c.capabilities = &Capabilities{Version: version}
+	logDebug(c.logContext(ctx), "Controller capabilities loaded", map[string]interface{}{"version": version.String(version.HasBuild)})
+	return c.capabilities, nil
+}
@@ -0,0 +1,75 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCapabilities(t *testing.T) {
+	tests := []struct {
+		version    string
+		minVersion string
+		want       bool
+	}{
+		{"7.1.1794", "7.1", true},
+		{"7.1", "7.1", true},
+		{"7.0.2239", "7.1", false},
+		{"7.2.4820", "7.1", true},
+		{"6.9.308", "7.1", false},
+		{"7.1.1794", "7.1.2000", false},
+		{"7.1.2131", "7.1.2000", true},
+		{"7.1-patch.1794", "7.1", true},
+	}
+
+	for _, tt := range tests {
+		capabilities, err := NewCapabilities(tt.version)
+		if err != nil {
+			t.Fatalf("NewCapabilities(%q) error = %v", tt.version, err)
+		}
+		got, err := capabilities.AtLeast(tt.minVersion)
+		if err != nil {
+			t.Fatalf("AtLeast(%q) error = %v", tt.minVersion, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s AtLeast(%q) = %v, want %v", tt.version, tt.minVersion, got, tt.want)
+		}
+	}
+
+	old, _ := NewCapabilities("7.1.1000")
+	err := old.Require(FeatureEdgePlatform, "aviatrix_edge_platform")
+	if err == nil || !strings.Contains(err.Error(), "aviatrix_edge_platform requires controller >= 7.1.1794,") {
+		t.Errorf("Require() error = %v, want a minimum version error", err)
+	}
+	latest, _ := NewCapabilities("7.1.2131")
+	if err := latest.Require(FeatureEdgePlatform, "aviatrix_edge_platform"); err != nil {
+		t.Errorf("Require() error = %v, want nil", err)
+	}
+	if supported, _ := old.Supports(Feature("unknown")); !supported {
+		t.Error("Supports() of a feature without minimum version = false, want true")
+	}
+}
+
+func TestClientCapabilities(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"return":true,"results":{"current_version":"UserConnect-7.1.1794"}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		capabilities, err := client.Capabilities(context.Background())
+		if err != nil {
+			t.Fatalf("Capabilities() error = %v", err)
+		}
+		if supported, _ := capabilities.Supports(FeatureEdgePlatform); !supported {
+			t.Errorf("7.1.1794 does not support %s", FeatureEdgePlatform)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("controller version requested %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,390 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects whether a Recorder records the interactions with the controller or replays
+// them from a cassette.
+type CassetteMode int
+
+const (
+	// CassetteReplay serves the responses recorded in the cassette. A request that was not
+	// recorded fails without being sent.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends the requests to the controller and records the sanitized interactions.
+	CassetteRecord
+)
+
+// Cassette is a sanitized recording of the interactions with a controller, in the order they
+// happened.
+type Cassette struct {
+	Interactions []*CassetteInteraction `json:"interactions"`
+}
+
+// CassetteInteraction is one recorded request and the response of the controller.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a sanitized request. The controller host and the request headers are not
+// recorded. Only one of Form and JSON is set, depending on the encoding of the body.
+type CassetteRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Action string          `json:"action,omitempty"`
+	Query  url.Values      `json:"query,omitempty"`
+	Form   url.Values      `json:"form,omitempty"`
+	JSON   json.RawMessage `json:"json,omitempty"`
+}
+
+// CassetteResponse is a sanitized response. A JSON body is recorded in JSON, any other body in
+// Body.
+type CassetteResponse struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type,omitempty"`
+	JSON        json.RawMessage `json:"json,omitempty"`
+	Body        string          `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper for Client.HTTPClient that records the interactions with the
+// controller to a cassette file, or replays them from one so that tests run without a controller.
+//
+// Secrets are scrubbed before an interaction is recorded, the same way the WireLogger redacts
+// them, so the CID, API token and passwords sent with the requests and returned by the controller
+// are never written to the cassette. Replacements can be used to replace any other value, e.g. an
+// account number or a VPC ID, by a placeholder.
+//
+// When replaying, a request is sanitized the same way and served the response of the first unused
+// interaction with the same method, path, action and parameters. The interactions are played in
+// order, so the same request can return different responses, e.g. while a gateway is launched.
+type Recorder struct {
+	// Mode is CassetteReplay or CassetteRecord
+	Mode CassetteMode
+	// Path is the cassette file
+	Path string
+	// Transport sends the requests in record mode, http.DefaultTransport if nil
+	Transport http.RoundTripper
+	// RedactKeys are redacted in addition to the secrets redacted by the WireLogger
+	RedactKeys []string
+	// Replacements replace their key by their value in every recorded string, e.g. a real
+	// account number by a placeholder
+	Replacements map[string]string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	played   []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In replay mode the cassette is loaded
+// and must exist. In record mode it is written by Save.
+func NewRecorder(path string, mode CassetteMode, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		Mode:      mode,
+		Path:      path,
+		Transport: transport,
+		cassette:  &Cassette{},
+	}
+	if mode == CassetteRecord {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read cassette: %w", err)
+	}
+	if err := json.Unmarshal(b, r.cassette); err != nil {
+		return nil, fmt.Errorf("could not decode cassette %s: %w", path, err)
+	}
+	r.played = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := r.sanitizeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Mode == CassetteReplay {
+		return r.replay(req, recorded)
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	response := CassetteResponse{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if v, ok := decodeJSON(body); ok {
+		response.JSON, err = json.Marshal(r.sanitizeJSON(v))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		response.Body = r.replace(string(body))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &CassetteInteraction{
+		Request:  *recorded,
+		Response: response,
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *CassetteRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var closest *CassetteRequest
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] {
+			continue
+		}
+		if interaction.Request.matches(recorded) {
+			r.played[i] = true
+			return interaction.Response.httpResponse(req), nil
+		}
+		if closest == nil && interaction.Request.Method == recorded.Method && interaction.Request.Action == recorded.Action {
+			closest = &interaction.Request
+		}
+	}
+
+	msg := fmt.Sprintf("cassette %s has no unused interaction for %s %s", r.Path, recorded.Method, recorded.Path)
+	if recorded.Action != "" {
+		msg += fmt.Sprintf(" action %q", recorded.Action)
+	}
+	if closest != nil {
+		msg += ": " + closest.diff(recorded)
+	}
+	return nil, fmt.Errorf("%s", msg)
+}
+
+// Unplayed returns the interactions of a replayed cassette that were not requested.
+func (r *Recorder) Unplayed() []*CassetteInteraction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unplayed []*CassetteInteraction
+	for i, played := range r.played {
+		if !played {
+			unplayed = append(unplayed, r.cassette.Interactions[i])
+		}
+	}
+	return unplayed
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.Mode != CassetteRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.Path, append(b, '\n'), 0o644)
+}
+
+// sanitizeRequest returns the request as recorded in a cassette, without consuming its body.
+func (r *Recorder) sanitizeRequest(req *http.Request) (*CassetteRequest, error) {
+	body, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := &CassetteRequest{
+		Method: req.Method,
+		Path:   r.replace(req.URL.Path),
+		Action: requestAction(req, body),
+	}
+	if query := req.URL.Query(); len(query) > 0 {
+		recorded.Query = r.sanitizeValues(query)
+	}
+	if len(body) == 0 {
+		return recorded, nil
+	}
+	if strings.Contains(req.Header.Get("Content-Type"), "json") {
+		v, ok := decodeJSON(body)
+		if !ok {
+			return nil, fmt.Errorf("could not decode the JSON body of %s %s", req.Method, req.URL.Path)
+		}
+		recorded.JSON, err = json.Marshal(r.sanitizeJSON(v))
+		if err != nil {
+			return nil, err
+		}
+	} else if strings.Contains(req.Header.Get("Content-Type"), "x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("could not decode the form of %s %s: %w", req.Method, req.URL.Path, err)
+		}
+		recorded.Form = r.sanitizeValues(values)
+	}
+	return recorded, nil
+}
+
+func (r *Recorder) redactor() *WireLogger {
+	return &WireLogger{RedactKeys: r.RedactKeys}
+}
+
+func (r *Recorder) sanitizeValues(values url.Values) url.Values {
+	values = r.redactor().redactValues(values)
+	for k, vs := range values {
+		for i := range vs {
+			vs[i] = r.replace(vs[i])
+		}
+		values[k] = vs
+	}
+	return values
+}
+
+func (r *Recorder) sanitizeJSON(v interface{}) interface{} {
+	v = r.redactor().redactJSON(v)
+	var replace func(v interface{}) interface{}
+	replace = func(v interface{}) interface{} {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			for k, child := range val {
+				val[k] = replace(child)
+			}
+		case []interface{}:
+			for i, child := range val {
+				val[i] = replace(child)
+			}
+		case string:
+			return r.replace(val)
+		}
+		return v
+	}
+	return replace(v)
+}
+
+// replace applies the Replacements to s, the longest values first.
+func (r *Recorder) replace(s string) string {
+	if len(r.Replacements) == 0 {
+		return s
+	}
+	olds := make([]string, 0, len(r.Replacements))
+	for old := range r.Replacements {
+		if old != "" {
+			olds = append(olds, old)
+		}
+	}
+	sort.Slice(olds, func(i, j int) bool { return len(olds[i]) > len(olds[j]) })
+	for _, old := range olds {
+		s = strings.ReplaceAll(s, old, r.Replacements[old])
+	}
+	return s
+}
+
+// decodeJSON decodes a JSON body keeping the numbers as they were sent.
+func decodeJSON(body []byte) (interface{}, bool) {
+	if !json.Valid(body) {
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+func (c *CassetteRequest) matches(other *CassetteRequest) bool {
+	return c.diff(other) == ""
+}
+
+// diff describes how other differs from c, or returns "" if they match.
+func (c *CassetteRequest) diff(other *CassetteRequest) string {
+	switch {
+	case c.Method != other.Method:
+		return fmt.Sprintf("method is %s, recorded %s", other.Method, c.Method)
+	case c.Path != other.Path:
+		return fmt.Sprintf("path is %s, recorded %s", other.Path, c.Path)
+	case c.Action != other.Action:
+		return fmt.Sprintf("action is %q, recorded %q", other.Action, c.Action)
+	}
+	if d := diffValues("query", c.Query, other.Query); d != "" {
+		return d
+	}
+	if d := diffValues("form", c.Form, other.Form); d != "" {
+		return d
+	}
+	if len(c.JSON) == 0 && len(other.JSON) == 0 {
+		return ""
+	}
+	want, _ := decodeJSON(c.JSON)
+	got, _ := decodeJSON(other.JSON)
+	if !reflect.DeepEqual(want, got) {
+		return fmt.Sprintf("JSON body is %s, recorded %s", other.JSON, c.JSON)
+	}
+	return ""
+}
+
+func diffValues(name string, want, got url.Values) string {
+	keys := make(map[string]bool)
+	for k := range want {
+		keys[k] = true
+	}
+	for k := range got {
+		keys[k] = true
+	}
+	var diffs []string
+	for k := range keys {
+		if !reflect.DeepEqual(want[k], got[k]) {
+			diffs = append(diffs, fmt.Sprintf("%s parameter %q is %q, recorded %q", name, k, got[k], want[k]))
+		}
+	}
+	sort.Strings(diffs)
+	return strings.Join(diffs, ", ")
+}
+
+func (c *CassetteResponse) httpResponse(req *http.Request) *http.Response {
+	body := []byte(c.Body)
+	if len(c.JSON) > 0 {
+		body = c.JSON
+	}
+	header := http.Header{}
+	if c.ContentType != "" {
+		header.Set("Content-Type", c.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.StatusCode, http.StatusText(c.StatusCode)),
+		StatusCode:    c.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
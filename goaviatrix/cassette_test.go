@@ -0,0 +1,191 @@
+package goaviatrix
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newCassetteClient returns a client logged in through the cassette testdata/cassettes/<name>.json.
+//
+// The cassette is replayed unless AVIATRIX_RECORD_CASSETTES is set, in which case it is recorded
+// again against the controller set by AVIATRIX_CONTROLLER_IP, AVIATRIX_USERNAME and
+// AVIATRIX_PASSWORD. Every interaction of a replayed cassette must be requested by the test.
+func newCassetteClient(t *testing.T, name string) (*Client, *Recorder) {
+	t.Helper()
+	path := filepath.Join("testdata", "cassettes", name+".json")
+
+	controllerIP, username, password := "controller.example.com", "admin", "password"
+	mode := CassetteReplay
+	if os.Getenv("AVIATRIX_RECORD_CASSETTES") != "" {
+		mode = CassetteRecord
+		controllerIP = os.Getenv("AVIATRIX_CONTROLLER_IP")
+		username = os.Getenv("AVIATRIX_USERNAME")
+		password = os.Getenv("AVIATRIX_PASSWORD")
+		if controllerIP == "" || username == "" || password == "" {
+			t.Fatal("AVIATRIX_CONTROLLER_IP, AVIATRIX_USERNAME and AVIATRIX_PASSWORD must be set to record cassettes")
+		}
+	}
+
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+		},
+	}
+	rec, err := NewRecorder(path, mode, transport)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	// The username is not a secret, but is specific to the controller the cassette is recorded with
+	rec.RedactKeys = []string{"username"}
+	rec.Replacements = map[string]string{}
+	t.Cleanup(func() {
+		if err := rec.Save(); err != nil {
+			t.Errorf("could not save cassette: %v", err)
+		}
+		if mode == CassetteReplay && !t.Failed() {
+			for _, interaction := range rec.Unplayed() {
+				t.Errorf("cassette %s: %s %s action %q was not requested", path, interaction.Request.Method, interaction.Request.Path, interaction.Request.Action)
+			}
+		}
+	})
+
+	client, err := NewClient(username, password, controllerIP, &http.Client{Transport: rec}, nil)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client, rec
+}
+
+// cassetteValue returns the value of the environment variable env when recording a cassette, which
+// is then recorded as placeholder. The placeholder is returned when replaying, or when env is not
+// set.
+func cassetteValue(rec *Recorder, env, placeholder string) string {
+	value := os.Getenv(env)
+	if rec.Mode != CassetteRecord || value == "" {
+		return placeholder
+	}
+	rec.Replacements[value] = placeholder
+	return value
+}
+
+func TestRecorder(t *testing.T) {
+	var actions []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		actions = append(actions, r.Form.Get("action"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "get_vpn_user":
+			fmt.Fprint(w, `{"return":true,"results":{"vpc_id":"vpc-real","psk":"secret-psk","bytes":123456789012}}`)
+		default:
+			fmt.Fprint(w, `{"return":false,"reason":"unknown action"}`)
+		}
+	})
+	client.setCID("secret-cid")
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := NewRecorder(path, CassetteRecord, client.HTTPClient.Transport)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	rec.Replacements = map[string]string{"vpc-real": "vpc-placeholder"}
+	client.HTTPClient.Transport = rec
+
+	form := map[string]string{
+		"action":   "get_vpn_user",
+		"vpc_id":   "vpc-real",
+		"password": "secret-password",
+	}
+	var recorded map[string]interface{}
+	if err := client.GetAPI(&recorded, form["action"], form, BasicCheck); err != nil {
+		t.Fatalf("GetAPI() while recording error = %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	cassette, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(cassette), "secret") || strings.Contains(string(cassette), "vpc-real") {
+		t.Errorf("cassette is not sanitized: %s", cassette)
+	}
+	if !strings.Contains(string(cassette), "123456789012") {
+		t.Errorf("cassette does not keep the numbers as they were sent: %s", cassette)
+	}
+
+	rec, err = NewRecorder(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	client.HTTPClient.Transport = rec
+	client.setCID("another-cid")
+
+	form["vpc_id"] = "vpc-placeholder"
+	form["password"] = "another-password"
+	var replayed struct {
+		Results struct {
+			VpcID string `json:"vpc_id"`
+			Bytes int64  `json:"bytes"`
+		} `json:"results"`
+	}
+	if err := client.GetAPI(&replayed, form["action"], form, BasicCheck); err != nil {
+		t.Fatalf("GetAPI() while replaying error = %v", err)
+	}
+	if replayed.Results.VpcID != "vpc-placeholder" || replayed.Results.Bytes != 123456789012 {
+		t.Errorf("replayed results = %+v, want the recorded results", replayed.Results)
+	}
+	if len(actions) != 1 {
+		t.Errorf("the controller received %d requests, want 1", len(actions))
+	}
+	if unplayed := rec.Unplayed(); len(unplayed) != 0 {
+		t.Errorf("Unplayed() = %d interactions, want none", len(unplayed))
+	}
+
+	err = client.GetAPI(&replayed, form["action"], form, BasicCheck)
+	if err == nil || !strings.Contains(err.Error(), "no unused interaction") {
+		t.Errorf("replaying an interaction twice: error = %v, want no unused interaction", err)
+	}
+}
+
+func TestRecorderReplayMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := `{"interactions":[{
+		"request":{"method":"POST","path":"/v2/api","action":"add_site2cloud","form":{"action":["add_site2cloud"],"connection_name":["s2c"],"tunnel_type":["route"]}},
+		"response":{"status_code":200,"content_type":"application/json","json":{"return":true,"results":"created"}}
+	}]}`
+	if err := os.WriteFile(path, []byte(cassette), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := NewRecorder(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	client := &Client{
+		HTTPClient:   &http.Client{Transport: rec},
+		ControllerIP: "controller.example.com",
+		baseURL:      "https://controller.example.com/v2/api",
+	}
+
+	form := map[string]string{
+		"action":          "add_site2cloud",
+		"connection_name": "s2c",
+		"tunnel_type":     "policy",
+	}
+	err = client.PostAPIContext(context.Background(), form["action"], form, BasicCheck)
+	if err == nil || !strings.Contains(err.Error(), `form parameter "tunnel_type" is ["policy"], recorded ["route"]`) {
+		t.Errorf("PostAPIContext() with another form: error = %v, want the form difference", err)
+	}
+
+	form["tunnel_type"] = "route"
+	if err := client.PostAPIContext(context.Background(), form["action"], form, BasicCheck); err != nil {
+		t.Errorf("PostAPIContext() with the recorded form: error = %v", err)
+	}
+}
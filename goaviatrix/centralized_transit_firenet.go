@@ -3,7 +3,6 @@ package goaviatrix
 import "context"
 
 type CentralizedTransitFirenet struct {
-	CID             string `form:"CID,omitempty"`
 	Action          string `form:"action,omitempty"`
 	PrimaryGwName   string `form:"primary_gw_name,omitempty"`
 	SecondaryGwName string `form:"secondary_gw_name,omitempty"`
@@ -12,7 +11,6 @@ type CentralizedTransitFirenet struct {
 func (c *Client) GetPrimaryFireNet(ctx context.Context) ([]string, error) {
 	form := map[string]string{
 		"action": "list_primary_firenet",
-		"CID":    c.CID,
 	}
 
 	type PrimaryFirenetList struct {
@@ -41,7 +39,6 @@ func (c *Client) GetPrimaryFireNet(ctx context.Context) ([]string, error) {
 func (c *Client) GetSecondaryFireNet(ctx context.Context) ([]string, error) {
 	form := map[string]string{
 		"action": "list_secondary_firenet",
-		"CID":    c.CID,
 	}
 
 	type SecondaryFirenetList struct {
@@ -70,7 +67,6 @@ func (c *Client) GetSecondaryFireNet(ctx context.Context) ([]string, error) {
 
 func (c *Client) CreateCentralizedTransitFireNet(ctx context.Context, firenetAttachment *CentralizedTransitFirenet) error {
 	firenetAttachment.Action = "attach_centralized_firenet"
-	firenetAttachment.CID = c.CID
 
 	return c.PostAPIContext(ctx, firenetAttachment.Action, firenetAttachment, BasicCheck)
 }
@@ -78,7 +74,6 @@ func (c *Client) CreateCentralizedTransitFireNet(ctx context.Context, firenetAtt
 func (c *Client) GetCentralizedTransitFireNet(ctx context.Context, centralizedTransitFirenet *CentralizedTransitFirenet) error {
 	form := map[string]string{
 		"action":      "list_transit_firenet",
-		"CID":         c.CID,
 		"centralized": "true",
 	}
 
@@ -124,7 +119,6 @@ func (c *Client) GetCentralizedTransitFireNet(ctx context.Context, centralizedTr
 
 func (c *Client) DeleteCentralizedTransitFireNet(ctx context.Context, firenetAttachment *CentralizedTransitFirenet) error {
 	firenetAttachment.Action = "detach_centralized_firenet"
-	firenetAttachment.CID = c.CID
 
 	return c.PostAPIContext(ctx, firenetAttachment.Action, firenetAttachment, BasicCheck)
 }
@@ -12,7 +12,6 @@ type HTTPSCertConfig struct {
 func (c *Client) ImportNewHTTPSCerts(certConfig *HTTPSCertConfig) error {
 	data := map[string]string{
 		"action": "import_new_https_certs",
-		"CID":    c.CID,
 	}
 
 	var files []File
@@ -61,7 +60,6 @@ func (c *Client) ImportNewHTTPSCerts(certConfig *HTTPSCertConfig) error {
 func (c *Client) DisableImportedHTTPSCerts() error {
 	data := map[string]string{
 		"action": "disable_imported_certificate",
-		"CID":    c.CID,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
@@ -78,7 +76,6 @@ type GetHTTPSCertsStatus struct {
 func (c *Client) GetHTTPSCertsStatus() (bool, error) {
 	data := map[string]string{
 		"action": "get_https_certs_status",
-		"CID":    c.CID,
 	}
 	var respData GetHTTPSCertsStatusResp
 	err := c.GetAPI(&respData, data["action"], data, BasicCheck)
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
 	"strings"
 )
@@ -10,7 +11,7 @@ func (c *Client) ControllerVersionValidation(supportedVersions []string) error {
 		return errors.New("supportedVersions is not provided")
 	}
 
-	currentVersion, _, err := c.GetCurrentVersion()
+	currentVersion, _, err := c.GetCurrentVersion(context.Background())
 	if err != nil {
 		return err
 	}
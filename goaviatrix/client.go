@@ -8,18 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"mime/multipart"
 	"net/http"
-	"net/textproto"
 	"net/url"
-	"os"
-	"reflect"
 	"strings"
-	"time"
+	"sync"
 
-	"github.com/ajg/form"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // LoginResp represents the response object from the `login` action
@@ -38,27 +32,56 @@ type APIResp struct {
 
 // APIRequest represents the basic fields for any request
 type APIRequest struct {
-	CID    string `form:"CID,omitempty" json:"CID" url:"CID"`
 	Action string `form:"action,omitempty" json:"action" url:"action"`
 }
 
+// ClientInterface is the whole API of the Client, made of the domain interfaces in
+// client_interfaces.go. Resources should depend on the narrowest interface they need.
+//
 //go:generate moq -rm -out client_mock.go . ClientInterface
 type ClientInterface interface {
-	DeleteAccount(account *Account) error
-	GetAccount(account *Account) (*Account, error)
-	AuditAccount(ctx context.Context, account *Account) error
-}
+	AccountClient
+	ControllerClient
+	LoggingClient
+	GatewayClient
+	TransitClient
+	SpokeClient
+	EdgeClient
+	SecurityClient
+	SegmentationClient
+	Site2CloudClient
+	AWSTgwClient
+	NetworkingClient
+	VPNClient
+}
+
+var _ ClientInterface = (*Client)(nil)
 
 // Client for accessing the Aviatrix Controller
 type Client struct {
-	ClientInterface
-	HTTPClient       *http.Client
-	Username         string
-	Password         string
+	HTTPClient *http.Client
+	Username   string
+	Password   string
+	// CID is the current session. The client adds it to every request, read it with CurrentCID
+	// while requests may be in flight.
 	CID              string
 	ControllerIP     string
 	baseURL          string
 	IgnoreTagsConfig *IgnoreTagsConfig
+	// DefaultTagsConfig are the tags added to every taggable resource, see WithDefaultTags
+	DefaultTagsConfig *DefaultTagsConfig
+	RetryPolicy       *RetryPolicy
+	limiter           *requestLimiter
+	logCtx            context.Context
+	sessionCache      *SessionCache
+	// loginMu serializes re-logins, cidMu guards CID
+	loginMu sync.Mutex
+	cidMu   sync.RWMutex
+
+	capabilitiesMu sync.Mutex
+	capabilities   *Capabilities
+
+	readOnly bool
 }
 
 type GetApiTokenResp struct {
@@ -78,9 +101,9 @@ func (c *Client) GetApiToken() (string, error) {
 	apiToken["action"] = "get_api_token"
 	apiToken["log_enable"] = true
 
-	log.Infof("Getting API token...")
+	logInfo(c.logContext(context.Background()), "Getting API token")
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
-	resp, err := c.Get(Url, apiToken)
+	resp, err := c.requestWithoutSession(context.Background(), "GET", Url, apiToken)
 	if err != nil {
 		return "", err
 	}
@@ -91,7 +114,6 @@ func (c *Client) GetApiToken() (string, error) {
 	if !data.Return {
 		return "", errors.New(data.Reason)
 	}
-	log.Tracef("Token is '%s'.", data.Results.ApiToken)
 	return data.Results.ApiToken, nil
 }
 
@@ -116,7 +138,7 @@ func (c *Client) Login() error {
 	account["password"] = c.Password
 
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
-	log.Infof("Parsed Aviatrix login: %s", account["username"])
+	logInfo(c.logContext(context.Background()), "Logging in to the Aviatrix controller", map[string]interface{}{"username": c.Username})
 	resp, err := c.RequestContextLogin(context.Background(), "POST", Url, account, ApiToken)
 	if err != nil {
 		return err
@@ -128,7 +150,8 @@ func (c *Client) Login() error {
 	if !data.Return {
 		return errors.New(data.Reason)
 	}
-	c.CID = data.CID
+	c.setCID(data.CID)
+	c.cacheSession()
 	return nil
 }
 
@@ -138,8 +161,8 @@ func (c *Client) LoginForCloudn() error {
 	account["username"] = c.Username
 	account["password"] = c.Password
 
-	log.Infof("Parsed Aviatrix login: %s", account["username"])
-	resp, err := c.Post(c.baseURL, account)
+	logInfo(c.logContext(context.Background()), "Logging in to the Aviatrix controller", map[string]interface{}{"username": c.Username})
+	resp, err := c.requestWithoutSession(context.Background(), "POST", c.baseURL, account)
 	if err != nil {
 		return err
 	}
@@ -150,11 +173,13 @@ func (c *Client) LoginForCloudn() error {
 	if !data.Return {
 		return errors.New(data.Reason)
 	}
-	log.Tracef("CID is '%s'.", data.CID)
-	c.CID = data.CID
+	c.setCID(data.CID)
 	return nil
 }
 
+// ClientOption configures optional behaviour of a Client.
+type ClientOption func(*Client)
+
 // NewClient creates a Client object using the arguments provided.
 // Arguments:
 //
@@ -162,6 +187,8 @@ func (c *Client) LoginForCloudn() error {
 //	password - the controller password
 //	controllerIP - the controller IP/host
 //	HTTPClient - the http client object
+//	ignoreTagsConfig - the tags to ignore across all resources
+//	opts - optional client settings, e.g. WithRetryPolicy
 //
 // Returns:
 //
@@ -171,16 +198,42 @@ func (c *Client) LoginForCloudn() error {
 // See Also:
 //
 //	init()
-func NewClient(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig) (*Client, error) {
+func NewClient(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig, opts ...ClientOption) (*Client, error) {
 	client := &Client{Username: username, Password: password, HTTPClient: HTTPClient, ControllerIP: controllerIP, IgnoreTagsConfig: ignoreTagsConfig}
+	for _, opt := range opts {
+		opt(client)
+	}
 	return client.init(controllerIP)
 }
 
-func NewClientForCloudn(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig) (*Client, error) {
+func NewClientForCloudn(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig, opts ...ClientOption) (*Client, error) {
 	client := &Client{Username: username, Password: password, HTTPClient: HTTPClient, ControllerIP: controllerIP, IgnoreTagsConfig: ignoreTagsConfig}
+	for _, opt := range opts {
+		opt(client)
+	}
 	return client.initForCloudn(controllerIP)
 }
 
+// ControllerAddress returns the controller IP or hostname the client is connected to.
+func (c *Client) ControllerAddress() string {
+	return c.ControllerIP
+}
+
+// Credentials returns the username and password the client logs in with.
+func (c *Client) Credentials() (username, password string) {
+	return c.Username, c.Password
+}
+
+// IgnoreTags returns the tags ignored across all resources, or nil.
+func (c *Client) IgnoreTags() *IgnoreTagsConfig {
+	return c.IgnoreTagsConfig
+}
+
+// DefaultTags returns the tags added to every taggable resource, or nil.
+func (c *Client) DefaultTags() *DefaultTagsConfig {
+	return c.DefaultTagsConfig
+}
+
 // init initializes the new client with the given controller IP/host.  Logs
 // in to the controller and sets up the http client.
 // Arguments:
@@ -199,13 +252,10 @@ func (c *Client) init(controllerIP string) (*Client, error) {
 	c.baseURL = "https://" + controllerIP + "/v2/api"
 
 	if c.HTTPClient == nil {
-		tr := &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		}
-		c.HTTPClient = &http.Client{Transport: tr}
+		c.HTTPClient = c.defaultHTTPClient()
+	}
+	if c.loadCachedSession() {
+		return c, nil
 	}
 	if err := c.Login(); err != nil {
 		return nil, err
@@ -222,13 +272,7 @@ func (c *Client) initForCloudn(controllerIP string) (*Client, error) {
 	c.baseURL = "https://" + controllerIP + "/v1/api"
 
 	if c.HTTPClient == nil {
-		tr := &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		}
-		c.HTTPClient = &http.Client{Transport: tr}
+		c.HTTPClient = c.defaultHTTPClient()
 	}
 	if err := c.LoginForCloudn(); err != nil {
 		return nil, err
@@ -237,6 +281,19 @@ func (c *Client) initForCloudn(controllerIP string) (*Client, error) {
 	return c, nil
 }
 
+// defaultHTTPClient returns the HTTP client used when NewClient is not given one. For
+// compatibility it does not verify the controller certificate, which is logged as a warning.
+func (c *Client) defaultHTTPClient() *http.Client {
+	logWarn(c.logContext(context.Background()), "No HTTP client configured, the controller SSL certificate is not verified")
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+		},
+	}
+	return &http.Client{Transport: tr}
+}
+
 func (c *Client) Get(path string, i interface{}) (*http.Response, error) {
 	return c.Request("GET", path, i)
 }
@@ -260,9 +317,10 @@ func (c *Client) PostContext(ctx context.Context, path string, i interface{}) (*
 type CheckAPIResponseFunc func(action, method, reason string, ret bool) error
 
 // BasicCheck will only verify that the Return field was set to true
+// A failure is returned as an *APIError
 var BasicCheck CheckAPIResponseFunc = func(action, method, reason string, ret bool) error {
 	if !ret {
-		return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
+		return newAPIError(action, method, reason)
 	}
 	return nil
 }
@@ -271,7 +329,7 @@ var BasicCheck CheckAPIResponseFunc = func(action, method, reason string, ret bo
 // If the Return is false and Reason contains "already exists", it will return a DuplicateError
 var DuplicateBasicCheck CheckAPIResponseFunc = func(action, method, reason string, ret bool) error {
 	if !ret {
-		err := fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
+		err := newAPIError(action, method, reason)
 		if strings.Contains(strings.ToLower(reason), "already exists") {
 			return DuplicateError{
 				Err: err,
@@ -291,7 +349,7 @@ func (c *Client) PostAPI(action string, d interface{}, checkFunc CheckAPIRespons
 func (c *Client) PostAPIContext(ctx context.Context, action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
 	resp, err := c.PostContext(ctx, c.baseURL, d)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %q failed: %v", action, err)
+		return fmt.Errorf("HTTP POST %q failed: %w", action, err)
 	}
 	return checkAPIResp(resp, action, checkFunc)
 }
@@ -301,7 +359,7 @@ func (c *Client) PostAPIContext1(ctx context.Context, action string, d interface
 	Url := fmt.Sprintf("https://%s/v1/api", c.ControllerIP)
 	resp, err := c.PostContext(ctx, Url, d)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %q failed: %v", action, err)
+		return fmt.Errorf("HTTP POST %q failed: %w", action, err)
 	}
 	return checkAPIResp(resp, action, checkFunc)
 }
@@ -310,7 +368,7 @@ func (c *Client) PostAPIContext1(ctx context.Context, action string, d interface
 func (c *Client) PostAPIDownloadContext(ctx context.Context, action string, d interface{}, checkFunc CheckAPIResponseFunc) (io.ReadCloser, error) {
 	resp, err := c.PostContext(ctx, c.baseURL, d)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP POST %q failed: %v", action, err)
+		return nil, fmt.Errorf("HTTP POST %q failed: %w", action, err)
 	}
 
 	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
@@ -331,7 +389,7 @@ func (c *Client) PostAPIWithResponse(v interface{}, action string, d interface{}
 func (c *Client) PostAPIContextWithResponse(ctx context.Context, v interface{}, action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
 	resp, err := c.PostContext(ctx, c.baseURL, d)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %q failed: %v", action, err)
+		return fmt.Errorf("HTTP POST %q failed: %w", action, err)
 	}
 	return checkAndReturnAPIResp(resp, v, "POST", action, checkFunc)
 }
@@ -344,7 +402,7 @@ func (c *Client) PostFileAPI(params map[string]string, files []File, checkFunc C
 	}
 	resp, err := c.PostFile(c.baseURL, params, files)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %q failed: %v", params["action"], err)
+		return fmt.Errorf("HTTP POST %q failed: %w", params["action"], err)
 	}
 	return checkAPIResp(resp, params["action"], checkFunc)
 }
@@ -357,7 +415,7 @@ func (c *Client) PostFileAPIContext(ctx context.Context, params map[string]strin
 	}
 	resp, err := c.PostFileContext(ctx, c.baseURL, params, files)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %q failed: %v", params["action"], err)
+		return fmt.Errorf("HTTP POST %q failed: %w", params["action"], err)
 	}
 	return checkAPIResp(resp, params["action"], checkFunc)
 }
@@ -366,72 +424,22 @@ func (c *Client) PostAsyncAPI(action string, i interface{}, checkFunc CheckAPIRe
 	return c.PostAsyncAPIContext(context.Background(), action, i, checkFunc)
 }
 
+// PostAsyncAPIContext posts an async action and waits for the task to finish with the default
+// poll interval. The wait is bounded by the ctx deadline, or DefaultAsyncPollTimeout.
 func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc) error {
-	log.Printf("[DEBUG] Post AsyncAPI %s: %v", action, i)
-	resp, err := c.PostContext(ctx, c.baseURL, i)
-	if err != nil {
-		return fmt.Errorf("HTTP POST %s failed: %v", action, err)
-	}
-	var data struct {
-		Return bool   `json:"return"`
-		Result string `json:"results"`
-		Reason string `json:"reason"`
-	}
-
-	buf := new(bytes.Buffer)
-	buf.ReadFrom(resp.Body)
-	resp.Body.Close()
-	bodyString := buf.String()
-	bodyIoCopy := strings.NewReader(bodyString)
-	if err = json.NewDecoder(bodyIoCopy).Decode(&data); err != nil {
-		return fmt.Errorf("Json Decode %s failed %v\n Body: %s", action, err, bodyString)
-	}
-	if !data.Return {
-		return fmt.Errorf("rest API %s POST failed to initiate async action: %s", action, data.Reason)
-	}
+	return c.PostAsyncAPIContextWithPoll(ctx, action, i, checkFunc, AsyncPollConfig{})
+}
 
-	requestID := data.Result
-	form := map[string]string{
-		"action":     "check_task_status",
-		"CID":        c.CID,
-		"request_id": requestID,
+// PostAsyncAPIContextWithPoll posts an async action and polls the task status as configured by poll
+// until it finishes, the timeout expires or ctx is done.
+func (c *Client) PostAsyncAPIContextWithPoll(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc, poll AsyncPollConfig) error {
+	logDebug(c.logContext(ctx), "Starting async action", map[string]interface{}{"action": action})
+	requestID, err := c.startAsyncTask(ctx, action, i)
+	if err != nil {
+		return err
 	}
 
-	const maxPoll = 360
-	sleepDuration := time.Second * 10
-	var j int
-	for ; j < maxPoll; j++ {
-		resp, err = c.PostContext(ctx, c.baseURL, form)
-		if err != nil {
-			// Could be transient HTTP error, e.g. EOF error
-			time.Sleep(sleepDuration)
-			continue
-		}
-		buf = new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		err = json.Unmarshal(buf.Bytes(), &data)
-		if err != nil {
-			if strings.Contains(buf.String(), "502 Proxy Error") || strings.Contains(buf.String(), "503 Service Unavailable") {
-				time.Sleep(sleepDuration)
-				continue
-			}
-			return fmt.Errorf("decode check_task_status failed: %v\n Body: %s", err, buf.String())
-		}
-		if !data.Return {
-			if data.Reason != "" && data.Reason != "REQUEST_IN_PROGRESS" {
-				return fmt.Errorf("rest API %s POST failed: %s", action, data.Reason)
-			}
-
-			// Not done yet
-			time.Sleep(sleepDuration)
-			continue
-		}
-
-		// Async API is done, return result of checkFunc
-		return checkFunc(action, "Post", data.Result, data.Return)
-	}
-	// Waited for too long and async API never finished
-	return fmt.Errorf("waited %s but upgrade never finished. Please manually verify the upgrade status", maxPoll*sleepDuration)
+	return c.WaitForAsyncTask(ctx, action, requestID, checkFunc, poll)
 }
 
 // checkAPIResp will decode the response and check for any errors with the provided checkFunc
@@ -444,10 +452,13 @@ func checkAPIResp(resp *http.Response, action string, checkFunc CheckAPIResponse
 	}
 	body := b.String()
 	if err = json.Unmarshal([]byte(body), &data); err != nil {
+		if failed(resp) {
+			return unexpectedResponseError(resp, "Post", action, "", body)
+		}
 		return fmt.Errorf("json Decode %q failed: %v\n Body: %s", action, err, body)
 	}
 
-	return checkFunc(action, "Post", data.Reason, data.Return)
+	return annotateAPIError(checkFunc(action, "Post", data.Reason, data.Return), resp)
 }
 
 // checkAndReturnAPIResp will decode the response and check for any errors with the provided checkFunc.
@@ -462,10 +473,13 @@ func checkAndReturnAPIResp(resp *http.Response, v interface{}, method, action st
 	bodyString := buf.String()
 
 	if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&data); err != nil {
+		if failed(resp) {
+			return unexpectedResponseError(resp, method, action, "", bodyString)
+		}
 		return fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
 	}
 	if err := checkFunc(action, method, data.Reason, data.Return); err != nil {
-		return err
+		return annotateAPIError(err, resp)
 	}
 	if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&v); err != nil {
 		return fmt.Errorf("Json Decode failed: %v\n Body: %s", err, bodyString)
@@ -481,7 +495,7 @@ func (c *Client) GetAPI(v interface{}, action string, d map[string]string, check
 }
 
 // GetAPIContext makes a GET request to the Aviatrix API
-// If the GET request fails we will retry
+// Failed GET requests are retried according to the client RetryPolicy
 // First, we decode into the generic APIResp struct, then check for errors
 // If no errors, we will decode into the user defined structure that is passed in
 func (c *Client) GetAPIContext(ctx context.Context, v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error {
@@ -490,27 +504,9 @@ func (c *Client) GetAPIContext(ctx context.Context, v interface{}, action string
 		return fmt.Errorf("could not url encode values for action %q: %v", action, err)
 	}
 
-	try, maxTries, backoff := 0, 5, 500*time.Millisecond
-	var resp *http.Response
-	for {
-		try++
-		resp, err = c.GetContext(ctx, Url, nil)
-		if err == nil {
-			break
-		}
-
-		log.WithFields(log.Fields{
-			"try":    try,
-			"action": action,
-			"err":    err.Error(),
-		}).Warnf("HTTP GET request failed")
-
-		if try == maxTries {
-			return fmt.Errorf("HTTP Get %s failed: %v", action, err)
-		}
-		time.Sleep(backoff)
-		// Double the backoff time after each failed try
-		backoff *= 2
+	resp, err := c.GetContext(ctx, Url, nil)
+	if err != nil {
+		return fmt.Errorf("HTTP Get %s failed: %w", action, err)
 	}
 
 	buf := new(bytes.Buffer)
@@ -518,10 +514,13 @@ func (c *Client) GetAPIContext(ctx context.Context, v interface{}, action string
 	bodyString := buf.String()
 	var data APIResp
 	if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&data); err != nil {
+		if failed(resp) {
+			return unexpectedResponseError(resp, "Get", action, "", bodyString)
+		}
 		return fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
 	}
 	if err := checkFunc(action, "Get", data.Reason, data.Return); err != nil {
-		return err
+		return annotateAPIError(err, resp)
 	}
 	if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&v); err != nil {
 		return fmt.Errorf("Json Decode failed: %v\n Body: %s", err, bodyString)
@@ -544,12 +543,22 @@ func (c *Client) urlEncode(d map[string]string) (string, error) {
 
 // Put issues an HTTP PUT request with the given interface form-encoded.
 func (c *Client) Put(path string, i interface{}) (*http.Response, error) {
-	return c.Request("PUT", path, i)
+	return c.PutContext(context.Background(), path, i)
+}
+
+// PutContext issues an HTTP PUT request with the given interface form-encoded.
+func (c *Client) PutContext(ctx context.Context, path string, i interface{}) (*http.Response, error) {
+	return c.RequestContext(ctx, "PUT", path, i)
 }
 
-// Delete issues an HTTP DELETE request.
+// Delete issues an HTTP DELETE request with the given interface encoded as query parameters.
 func (c *Client) Delete(path string, i interface{}) (*http.Response, error) {
-	return c.Request("GET", path, i)
+	return c.DeleteContext(context.Background(), path, i)
+}
+
+// DeleteContext issues an HTTP DELETE request with the given interface encoded as query parameters.
+func (c *Client) DeleteContext(ctx context.Context, path string, i interface{}) (*http.Response, error) {
+	return c.RequestContext(ctx, "DELETE", path, i)
 }
 
 type File struct {
@@ -562,313 +571,220 @@ type File struct {
 
 // PostFile will encode the files and parameters with multipart form encoding.
 func (c *Client) PostFile(path string, params map[string]string, files []File) (*http.Response, error) {
-	body, contentType, err := encodeMultipartFormData(params, files)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	return c.HTTPClient.Do(req)
+	return c.PostFileContext(context.Background(), path, params, files)
 }
 
 // PostFileContext will encode the files and parameters with multipart form encoding.
 func (c *Client) PostFileContext(ctx context.Context, path string, params map[string]string, files []File) (*http.Response, error) {
-	body, contentType, err := encodeMultipartFormData(params, files)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	return c.HTTPClient.Do(req)
-}
-
-func encodeMultipartFormData(params map[string]string, files []File) (*bytes.Buffer, string, error) {
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-
-	// Encode the files
-	for _, f := range files {
-		if !f.UseFileContent {
-			if f.Path == "" {
-				continue
-			}
-
-			file, err := os.Open(f.Path)
-			if err != nil {
-				return nil, "", err
-			}
-			fileContents, err := ioutil.ReadAll(file)
-			if err != nil {
-				return nil, "", err
-			}
-			fi, err := file.Stat()
-			if err != nil {
-				return nil, "", err
-			}
-			_ = file.Close()
-			part, err := createFormFile(f.ParamName, fi.Name(), http.DetectContentType(fileContents), writer)
-			if err != nil {
-				return nil, "", err
-			}
-			_, _ = part.Write(fileContents)
-		} else {
-			fileContents := []byte(f.FileContent)
-
-			part, err := createFormFile(f.ParamName, f.FileName, http.DetectContentType(fileContents), writer)
-			if err != nil {
-				return nil, "", err
-			}
-			_, _ = part.Write(fileContents)
-		}
-	}
-
-	// Encode the other params
-	for key, val := range params {
-		_ = writer.WriteField(key, val)
-	}
-	err := writer.Close()
-	if err != nil {
-		return nil, "", err
-	}
-
-	return body, writer.FormDataContentType(), nil
-}
-
-func createFormFile(fieldname, filename, fileContentType string, w *multipart.Writer) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition",
-		fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
-			escapeQuotes(fieldname), escapeQuotes(filename)))
-	h.Set("Content-Type", fileContentType)
-	return w.CreatePart(h)
-}
-
-var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
-
-func escapeQuotes(s string) string {
-	return quoteEscaper.Replace(s)
+	return c.do(ctx, &apiCall{
+		verb:       "POST",
+		url:        path,
+		newRequest: multipartRequest(params, files).withCID(cidPayload).build,
+	})
 }
 
 // Request makes an HTTP request with the given interface being encoded as
-// form data.
+// form data, or as query parameters for GET and DELETE requests.
 func (c *Client) Request(verb string, path string, i interface{}) (*http.Response, error) {
 	return c.RequestContext(context.Background(), verb, path, i)
 }
 
 // RequestContext makes an HTTP request with the given interface being encoded as
-// form data.
+// form data, or as query parameters for GET and DELETE requests.
 func (c *Client) RequestContext(ctx context.Context, verb string, path string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var data *APIResp
-	var resp *http.Response
-
-	for {
-		try++
+	return c.do(ctx, &apiCall{
+		verb:           verb,
+		url:            path,
+		newRequest:     formRequest(i).withCID(cidPayload).build,
+		sessionExpired: sessionExpiredV1,
+	})
+}
 
-		if i != nil {
-			body, err := form.EncodeToValues(i, true)
+// RequestContextLogin sends the login request with the given API token. Like
+// requestWithoutSession, it does not send the CID or renew an expired session.
+func (c *Client) RequestContextLogin(ctx context.Context, verb string, path string, i interface{}, token string) (*http.Response, error) {
+	return c.do(ctx, &apiCall{
+		verb:       verb,
+		url:        path,
+		newRequest: formRequest(i).withHeader("X-Access-Key", token).build,
+	})
+}
+
+// requestWithoutSession sends a form request that is part of logging in. The CID is not sent and
+// an expired session is not renewed, which would log in again while relogin holds the login lock.
+func (c *Client) requestWithoutSession(ctx context.Context, verb string, path string, i interface{}) (*http.Response, error) {
+	return c.do(ctx, &apiCall{
+		verb:       verb,
+		url:        path,
+		newRequest: formRequest(i).build,
+	})
+}
+
+// apiCall describes a single logical request sent through the client request pipeline.
+type apiCall struct {
+	verb string
+	url  string
+	// newRequest builds the request for one attempt with the CID of the current session. It is
+	// called again for every retry so the body is re-encoded and the latest CID is used.
+	newRequest func(ctx context.Context, verb, path, cid string) (*http.Request, error)
+	// sessionExpired inspects the buffered response and reports whether the CID the request was
+	// sent with has expired, along with the reason returned by the controller.
+	sessionExpired func(resp *http.Response, body, cid string) (bool, string, error)
+}
+
+// do sends the call to the controller, retrying according to the client RetryPolicy.
+// Every v1, v2 and v2.5 request goes through here. The response body is buffered so that
+// callers can read it again.
+func (c *Client) do(ctx context.Context, call *apiCall) (*http.Response, error) {
+	policy := c.retryPolicy()
+	maxAttempts := policy.maxAttempts()
+	ctx = c.logContext(ctx)
+	logCtx := ctx
+
+	// relogged is set once the expired session has been renewed, which does not count as a try
+	relogged := false
+	for try, sent := 1, 0; ; sent++ {
+		cid := c.CurrentCID()
+		req, err := call.newRequest(ctx, call.verb, call.url, cid)
+		if err != nil {
+			return nil, err
+		}
+		if sent == 0 {
+			body, err := peekRequestBody(req)
 			if err != nil {
 				return nil, err
 			}
-			reader := strings.NewReader(body.Encode())
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			action := requestAction(req, body)
+			if err := c.checkReadOnly(req, action); err != nil {
+				if req.Body != nil {
+					// Stops the writer of a streamed body
+					req.Body.Close()
+				}
+				return nil, err
 			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
+			logCtx = tflog.SubsystemSetField(logCtx, LogSubsystem, "method", call.verb)
+			logCtx = tflog.SubsystemSetField(logCtx, LogSubsystem, "action", action)
+			logTrace(logCtx, "Sending HTTP request", map[string]interface{}{
+				"body": redactForLog(req.Header.Get("Content-Type"), body),
+			})
 		}
 
+		release, err := c.limiter.acquire(ctx)
 		if err != nil {
+			if req.Body != nil {
+				// Stops the writer of a streamed body
+				req.Body.Close()
+			}
 			return nil, err
 		}
-
-		resp, err = c.HTTPClient.Do(req)
+		resp, err := c.HTTPClient.Do(req)
 		if err != nil {
-			return resp, err
-		}
-
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		resp.Body.Close()
-
-		// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
-		resp.Body = io.NopCloser(buf)
-
-		if strings.Contains(resp.Header.Get("Content-Type"), "json") {
-			bodyString := buf.String()
-			data = new(APIResp)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(data); err != nil {
-				return resp, fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
-			}
-
-			// Any error not related to expired CID should return
-			if !(strings.Contains(data.Reason, "CID is invalid") || strings.Contains(data.Reason, "Invalid session. Please login again.")) {
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+			release()
+			if ctx.Err() != nil || try >= maxAttempts || !policy.retryableTransportError(call.verb) {
 				return resp, err
 			}
-
-			// update the CID value in the object passed
-			if i != nil {
-				// Update CID in POST body
-				v := reflect.ValueOf(i)
-				if v.Kind() == reflect.Map {
-					v.SetMapIndex(reflect.ValueOf("CID"), reflect.ValueOf(c.CID))
-				} else {
-					s := v.Elem()
-					f := s.FieldByName("CID")
-					if f.IsValid() && f.CanSet() {
-						f.SetString(c.CID)
-					}
-				}
-			} else {
-				// Update CID in GET URL
-				Url, err := url.Parse(path)
-				if err != nil {
-					return resp, fmt.Errorf("failed to parse url: %v", err)
-				}
-				query := Url.Query()
-				query["CID"] = []string{c.CID}
-				Url.RawQuery = query.Encode()
-				path = Url.String()
-			}
-
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", data.Reason)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			return resp, nil
-		}
-	}
-}
-
-func (c *Client) RequestContextLogin(ctx context.Context, verb string, path string, i interface{}, token string) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var data *APIResp
-	var resp *http.Response
-
-	for {
-		try++
-
-		if i != nil {
-			buf := new(bytes.Buffer)
-			if err = form.NewEncoder(buf).Encode(i); err != nil {
+			logWarn(logCtx, "HTTP request failed, retrying", map[string]interface{}{
+				"try":   try,
+				"error": err.Error(),
+			})
+			if err := policy.wait(ctx, try); err != nil {
 				return nil, err
 			}
-			body := buf.String()
-			log.Tracef("%s %s Body: %s", verb, path, body)
-
-			reader := strings.NewReader(body)
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-				req.Header.Add("X-Access-Key", token)
-			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
-		}
-
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
+			try++
+			continue
 		}
 
 		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
+		_, err = buf.ReadFrom(resp.Body)
 		resp.Body.Close()
+		release()
 
 		// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
 		resp.Body = io.NopCloser(buf)
-
-		if strings.Contains(resp.Header.Get("Content-Type"), "json") {
-			bodyString := buf.String()
-			data = new(APIResp)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(data); err != nil {
-				return resp, fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
-			}
-
-			// Any error not related to expired CID should return
-			if !(strings.Contains(data.Reason, "CID is invalid") || strings.Contains(data.Reason, "Invalid session. Please login again.")) {
+		if err != nil {
+			return resp, fmt.Errorf("reading response body failed: %v", err)
+		}
+		bodyString := buf.String()
+		logTrace(logCtx, "Received HTTP response", map[string]interface{}{
+			"try":    try,
+			"status": resp.StatusCode,
+		})
+
+		if try < maxAttempts && policy.retryableStatus(call.verb, resp.StatusCode) {
+			logWarn(logCtx, "HTTP request failed with retryable status, retrying", map[string]interface{}{
+				"try":    try,
+				"status": resp.StatusCode,
+			})
+			if err := policy.waitResponse(ctx, try, resp); err != nil {
 				return resp, err
 			}
+			try++
+			continue
+		}
 
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+		if call.sessionExpired != nil {
+			expired, reason, err := call.sessionExpired(resp, bodyString, cid)
+			if err != nil {
 				return resp, err
 			}
+			if expired {
+				logWarn(logCtx, "HTTP request failed with expired CID", map[string]interface{}{
+					"try": try,
+				})
 
-			// update the CID value in the object passed
-			if i != nil {
-				// Update CID in POST body
-				v := reflect.ValueOf(i)
-				if v.Kind() == reflect.Map {
-					v.SetMapIndex(reflect.ValueOf("CID"), reflect.ValueOf(c.CID))
-				} else {
-					s := v.Elem()
-					f := s.FieldByName("CID")
-					if f.IsValid() && f.CanSet() {
-						f.SetString(c.CID)
-					}
+				if relogged {
+					return resp, fmt.Errorf("%v", reason)
 				}
-			} else {
-				// Update CID in GET URL
-				Url, err := url.Parse(path)
-				if err != nil {
-					return resp, fmt.Errorf("failed to parse url: %v", err)
+
+				logTrace(logCtx, "CID invalid or expired, trying to login again")
+				if err := c.relogin(cid); err != nil {
+					return resp, err
 				}
-				query := Url.Query()
-				query["CID"] = []string{c.CID}
-				Url.RawQuery = query.Encode()
-				path = Url.String()
+				relogged = true
+				continue
 			}
+		}
 
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", data.Reason)
+		if try < maxAttempts && policy.retryableReason(responseReason(bodyString)) {
+			logWarn(logCtx, "HTTP request failed with retryable reason, retrying", map[string]interface{}{
+				"try":    try,
+				"reason": responseReason(bodyString),
+			})
+			if err := policy.wait(ctx, try); err != nil {
+				return resp, err
 			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			return resp, nil
+			try++
+			continue
 		}
+
+		return resp, nil
+	}
+}
+
+// sessionExpiredV1 detects an expired CID in a v1 or v2 form API response.
+func sessionExpiredV1(resp *http.Response, body, cid string) (bool, string, error) {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return false, "", nil
+	}
+	data := new(APIResp)
+	if err := json.NewDecoder(strings.NewReader(body)).Decode(data); err != nil {
+		return false, "", fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, body)
+	}
+	expired := strings.Contains(data.Reason, "CID is invalid") || strings.Contains(data.Reason, "Invalid session. Please login again.")
+	return expired, data.Reason, nil
+}
+
+// responseReason returns the controller reason (v1/v2) or message (v2.5) of a response body, if any.
+func responseReason(body string) string {
+	var data struct {
+		Reason  interface{} `json:"reason"`
+		Message string      `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return ""
+	}
+	if reason, ok := data.Reason.(string); ok && reason != "" {
+		return reason
 	}
+	return data.Message
 }
@@ -7,12 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
-	"reflect"
 	"strings"
-	"time"
-
-	log "github.com/sirupsen/logrus"
 )
 
 func checkAndReturnAPIResp2(resp *http.Response, v interface{}, method, action string, checkFunc CheckAPIResponseFunc) error {
@@ -25,10 +20,13 @@ func checkAndReturnAPIResp2(resp *http.Response, v interface{}, method, action s
 	bodyString := buf.String()
 
 	if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&data); err != nil {
+		if failed(resp) {
+			return unexpectedResponseError(resp, method, action, "", bodyString)
+		}
 		return fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
 	}
 	if err := checkFunc(action, method, data.Reason, data.Return); err != nil {
-		return err
+		return annotateAPIError(err, resp)
 	}
 
 	if v != nil {
@@ -50,7 +48,7 @@ func (c *Client) PostAPIContext2Download(ctx context.Context, action string, d i
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
 	resp, err := c.RequestContext2(ctx, "POST", Url, d)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP %s %q failed: %v", "POST", Url, err)
+		return nil, fmt.Errorf("HTTP %s %q failed: %w", "POST", Url, err)
 	}
 
 	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
@@ -67,7 +65,7 @@ func (c *Client) PostAPIContext2Form(ctx context.Context, action string, d inter
 	url += "2/api"
 	resp, err := c.PostContext(ctx, url, d)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %q failed: %v", action, err)
+		return fmt.Errorf("HTTP POST %q failed: %w", action, err)
 	}
 	return checkAPIResp(resp, action, checkFunc)
 }
@@ -82,113 +80,31 @@ func (c *Client) DoAPIContext2(ctx context.Context, verb string, v interface{},
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
 	resp, err := c.RequestContext2(ctx, verb, Url, d)
 	if err != nil {
-		return fmt.Errorf("HTTP %s %q failed: %v", verb, Url, err)
+		return fmt.Errorf("HTTP %s %q failed: %w", verb, Url, err)
 	}
 
 	return checkAndReturnAPIResp2(resp, v, verb, action, checkFunc)
 }
 
 func (c *Client) RequestContext2(ctx context.Context, verb string, path string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var data *APIResp
-	var resp *http.Response
-
-	for {
-		try++
-
-		if i != nil {
-			body, err := json.Marshal(i)
-			if err != nil {
-				return nil, err
-			}
-			log.Tracef("%s %s Body: %s", verb, path, body)
-			reader := bytes.NewReader(body)
-
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/json")
-			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
-		}
-
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		resp.Body.Close()
-
-		// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
-		resp.Body = io.NopCloser(buf)
-
-		if strings.Contains(resp.Header.Get("Content-Type"), "json") {
-			bodyString := buf.String()
-			data = new(APIResp)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(data); err != nil {
-				return resp, fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
-			}
-
-			// Any error not related to expired CID should return
-			if !(strings.Contains(data.Reason, fmt.Sprintf("Session %s expired", c.CID))) {
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-
-			// update the CID value in the object passed
-			if i != nil {
-				// Update CID in POST body
-				v := reflect.ValueOf(i)
-				if v.Kind() == reflect.Map {
-					v.SetMapIndex(reflect.ValueOf("CID"), reflect.ValueOf(c.CID))
-				} else {
-					s := v.Elem()
-					f := s.FieldByName("CID")
-					if f.IsValid() && f.CanSet() {
-						f.SetString(c.CID)
-					}
-				}
-			} else {
-				// Update CID in GET URL
-				Url, err := url.Parse(path)
-				if err != nil {
-					return resp, fmt.Errorf("failed to parse url: %v", err)
-				}
-				query := Url.Query()
-				query["CID"] = []string{c.CID}
-				Url.RawQuery = query.Encode()
-				path = Url.String()
-			}
-
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+	return c.do(ctx, &apiCall{
+		verb:           verb,
+		url:            path,
+		newRequest:     jsonRequest(i).withCID(cidPayload).build,
+		sessionExpired: sessionExpiredV2,
+	})
+}
 
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", data.Reason)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			return resp, nil
-		}
+// sessionExpiredV2 detects an expired CID in a v2 JSON API response.
+func sessionExpiredV2(resp *http.Response, body, cid string) (bool, string, error) {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return false, "", nil
+	}
+	data := new(APIResp)
+	if err := json.NewDecoder(strings.NewReader(body)).Decode(data); err != nil {
+		return false, "", fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, body)
 	}
+	return strings.Contains(data.Reason, fmt.Sprintf("Session %s expired", cid)), data.Reason, nil
 }
 
 func (c *Client) PostAPIContext2HaGw(ctx context.Context, v interface{}, action string, d interface{}, checkFunc CheckAPIResponseFunc) (string, error) {
@@ -199,7 +115,7 @@ func (c *Client) DoAPIContext2HaGw(ctx context.Context, verb string, v interface
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
 	resp, err := c.RequestContext2(ctx, verb, Url, d)
 	if err != nil {
-		return "", fmt.Errorf("HTTP %s %q failed: %v", verb, Url, err)
+		return "", fmt.Errorf("HTTP %s %q failed: %w", verb, Url, err)
 	}
 
 	return checkAndReturnAPIResp2HaGw(resp, v, verb, action, checkFunc)
@@ -218,7 +134,7 @@ func checkAndReturnAPIResp2HaGw(resp *http.Response, v interface{}, method, acti
 		return "", fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
 	}
 	if err := checkFunc(action, method, data.Reason, data.Return); err != nil {
-		return "", err
+		return "", annotateAPIError(err, resp)
 	}
 
 	if v != nil {
@@ -238,7 +154,7 @@ func (c *Client) DoAPIContext2WithResult(ctx context.Context, verb string, v int
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
 	resp, err := c.RequestContext2(ctx, verb, Url, d)
 	if err != nil {
-		return "", fmt.Errorf("HTTP %s %q failed: %v", verb, Url, err)
+		return "", fmt.Errorf("HTTP %s %q failed: %w", verb, Url, err)
 	}
 
 	return checkAndReturnAPIResp2WithResult(resp, v, verb, action, checkFunc)
@@ -263,7 +179,7 @@ func checkAndReturnAPIResp2WithResult(resp *http.Response, v interface{}, method
 		return "", fmt.Errorf("Json Decode into standard format failed: %v\n Body: %s", err, bodyString)
 	}
 	if err := checkFunc(action, method, data.Reason, data.Return); err != nil {
-		return "", err
+		return "", annotateAPIError(err, resp)
 	}
 
 	if v != nil {
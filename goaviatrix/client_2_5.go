@@ -5,17 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
-
-	log "github.com/sirupsen/logrus"
 )
 
-type APIError struct {
-	Message string
+// errorResp25 is the body of a failed v2.5 API response
+type errorResp25 struct {
+	Message string `json:"message"`
 }
 
 func checkAndReturnAPIResp25(resp *http.Response, v interface{}, method, path string) error {
@@ -26,12 +23,18 @@ func checkAndReturnAPIResp25(resp *http.Response, v interface{}, method, path st
 	}
 	bodyString := buf.String()
 
-	if resp.StatusCode >= 300 || resp.StatusCode < 200 {
-		var apiError APIError
-		if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&apiError); err != nil {
-			return fmt.Errorf("Json Decode failed: %v\n Body: %s", err, bodyString)
+	if failed(resp) {
+		var errResp errorResp25
+		if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&errResp); err != nil {
+			return unexpectedResponseError(resp, method, "", path, bodyString)
 		}
-		return fmt.Errorf("HTTP %s %q failed: %v\n", method, path, apiError.Message)
+		return annotateAPIError(&APIError{
+			Path:       path,
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Reason:     errResp.Message,
+			Class:      ClassifyError(resp.StatusCode, errResp.Message),
+		}, resp)
 	}
 
 	if v != nil {
@@ -63,27 +66,9 @@ func (c *Client) GetAPIContext25(ctx context.Context, v interface{}, path string
 		return fmt.Errorf("could not url encode values for path %q: %v", path, err)
 	}
 
-	try, maxTries, backoff := 0, 5, 500*time.Millisecond
-	var resp *http.Response
-	for {
-		try++
-		resp, err = c.RequestContext25(ctx, "GET", Url, nil)
-		if err == nil {
-			break
-		}
-
-		log.WithFields(log.Fields{
-			"try":    try,
-			"action": path,
-			"err":    err.Error(),
-		}).Warnf("HTTP GET request failed")
-
-		if try == maxTries {
-			return fmt.Errorf("HTTP Get %s failed: %v", path, err)
-		}
-		time.Sleep(backoff)
-		// Double the backoff time after each failed try
-		backoff *= 2
+	resp, err := c.RequestContext25(ctx, "GET", Url, nil)
+	if err != nil {
+		return fmt.Errorf("HTTP Get %s failed: %w", path, err)
 	}
 
 	return checkAndReturnAPIResp25(resp, v, "GET", path)
@@ -105,91 +90,35 @@ func (c *Client) DoAPIContext25(ctx context.Context, verb string, v interface{},
 	Url := fmt.Sprintf("https://%s/v2.5/api/%s", c.ControllerIP, path)
 	resp, err := c.RequestContext25(ctx, verb, Url, d)
 	if err != nil {
-		return fmt.Errorf("HTTP %s %q failed: %v", verb, path, err)
+		return fmt.Errorf("HTTP %s %q failed: %w", verb, path, err)
 	}
 
 	return checkAndReturnAPIResp25(resp, v, verb, path)
 }
 
 func (c *Client) RequestContext25(ctx context.Context, verb string, Url string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, Url)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var apiError *APIError
-	var resp *http.Response
-
-	if i != nil {
-		body, err := json.Marshal(i)
-		if err != nil {
-			return nil, err
-		}
-		log.Tracef("%s %s Body: %s", verb, Url, body)
-		reader := bytes.NewReader(body)
+	return c.do(ctx, &apiCall{
+		verb:           verb,
+		url:            Url,
+		newRequest:     jsonRequest(i).withCID(cidAuthorization).build,
+		sessionExpired: sessionExpired25,
+	})
+}
 
-		req, err = http.NewRequestWithContext(ctx, verb, Url, reader)
-		if err == nil {
-			req.Header.Set("Content-Type", "application/json")
-		}
-	} else {
-		req, err = http.NewRequestWithContext(ctx, verb, Url, nil)
+// sessionExpired25 detects an expired CID in a v2.5 API response.
+func sessionExpired25(resp *http.Response, body, cid string) (bool, string, error) {
+	if resp.StatusCode != 403 {
+		return false, "", nil
 	}
 
-	if err != nil {
-		return nil, err
+	apiError := new(errorResp25)
+	if err := json.NewDecoder(strings.NewReader(body)).Decode(apiError); err != nil {
+		return false, "", fmt.Errorf("Json Decode into error message failed: %v\n Body: %s", err, body)
 	}
-
-	for {
-		try++
-
-		// Set CID as Authorization header for v2.5
-		req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		if resp.StatusCode == 403 {
-			buf := new(bytes.Buffer)
-			buf.ReadFrom(resp.Body)
-			resp.Body.Close()
-
-			// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
-			resp.Body = io.NopCloser(buf)
-
-			bodyString := buf.String()
-			apiError = new(APIError)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(apiError); err != nil {
-				return resp, fmt.Errorf("Json Decode into error message failed: %v\n Body: %s", err, bodyString)
-			}
-
-			if !strings.Contains(apiError.Message, "Invalid CID") {
-				log.Debugf("[DEBUG] API Response Error: %s\n", apiError.Message)
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", apiError.Message)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			log.Debugf("[DEBUG] HTTP Response: %v\n", resp)
-			return resp, err
-		}
+	if !strings.Contains(apiError.Message, "Invalid CID") {
+		return false, apiError.Message, nil
 	}
+	return true, apiError.Message, nil
 }
 
 // PostFileContext25 will encode the files and parameters with multipart form encoding.
@@ -201,80 +130,17 @@ func (c *Client) DoAPIFileContext25(ctx context.Context, verb string, path strin
 	Url := fmt.Sprintf("https://%s/v2.5/api/%s", c.ControllerIP, path)
 	resp, err := c.RequestFileContext25(ctx, verb, Url, params, files)
 	if err != nil {
-		return fmt.Errorf("HTTP %s %q failed: %v", verb, path, err)
+		return fmt.Errorf("HTTP %s %q failed: %w", verb, path, err)
 	}
 
 	return checkAndReturnAPIResp25(resp, nil, verb, path)
 }
 
 func (c *Client) RequestFileContext25(ctx context.Context, verb string, Url string, params map[string]string, files []File) (*http.Response, error) {
-	log.Tracef("%s %s", verb, Url)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var apiError *APIError
-	var resp *http.Response
-
-	body, contentType, err := encodeMultipartFormData(params, files)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err = http.NewRequestWithContext(ctx, verb, Url, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	for {
-		try++
-
-		// Set CID as Authorization header for v2.5
-		req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		if resp.StatusCode == 403 {
-			buf := new(bytes.Buffer)
-			buf.ReadFrom(resp.Body)
-			resp.Body.Close()
-
-			// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
-			resp.Body = io.NopCloser(buf)
-
-			bodyString := buf.String()
-			apiError = new(APIError)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(apiError); err != nil {
-				return resp, fmt.Errorf("Json Decode into error message failed: %v\n Body: %s", err, bodyString)
-			}
-
-			if !strings.Contains(apiError.Message, "Invalid CID") {
-				log.Debugf("[DEBUG] API Response Error: %s\n", apiError.Message)
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", apiError.Message)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			log.Debugf("[DEBUG] HTTP Response: %v\n", resp)
-			return resp, err
-		}
-	}
+	return c.do(ctx, &apiCall{
+		verb:           verb,
+		url:            Url,
+		newRequest:     multipartRequest(params, files).withCID(cidAuthorization).build,
+		sessionExpired: sessionExpired25,
+	})
 }
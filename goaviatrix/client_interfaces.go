@@ -0,0 +1,876 @@
+package goaviatrix
+
+import (
+	"context"
+	"time"
+)
+
+// The API of the Client is split by domain, so that resources depend on and tests mock only the
+// part of the client they use. ClientInterface is the whole API.
+//
+//go:generate moq -rm -out account_client_mock.go . AccountClient
+//go:generate moq -rm -out controller_client_mock.go . ControllerClient
+//go:generate moq -rm -out logging_client_mock.go . LoggingClient
+//go:generate moq -rm -out gateway_client_mock.go . GatewayClient
+//go:generate moq -rm -out transit_client_mock.go . TransitClient
+//go:generate moq -rm -out spoke_client_mock.go . SpokeClient
+//go:generate moq -rm -out edge_client_mock.go . EdgeClient
+//go:generate moq -rm -out security_client_mock.go . SecurityClient
+//go:generate moq -rm -out segmentation_client_mock.go . SegmentationClient
+//go:generate moq -rm -out site2cloud_client_mock.go . Site2CloudClient
+//go:generate moq -rm -out aws_tgw_client_mock.go . AWSTgwClient
+//go:generate moq -rm -out networking_client_mock.go . NetworkingClient
+//go:generate moq -rm -out vpn_client_mock.go . VPNClient
+
+// BaseClient is embedded in every domain interface.
+type BaseClient interface {
+	ControllerAddress() string
+}
+
+// AccountClient manages cloud accounts, account users and RBAC groups.
+type AccountClient interface {
+	BaseClient
+
+	CreateAccount(account *Account) error
+	CreateGCPAccount(account *Account) error
+	CreateOCIAccount(account *Account) error
+	CreateAWSTSAccount(account *Account) error
+	CreateAWSSAccount(account *Account) error
+	GetAccount(account *Account) (*Account, error)
+	UpdateAccount(account *Account) error
+	UpdateGCPAccount(account *Account) error
+	UpdateAWSTSAccount(account *Account, fileChanges map[string]bool) error
+	UpdateAWSSAccount(account *Account, fileChanges map[string]bool) error
+	DeleteAccount(account *Account) error
+	UploadOciApiPrivateKeyFile(account *Account) error
+	AuditAccount(ctx context.Context, account *Account) error
+	CreateEdgeAccount(edgeAccount *EdgeAccount) error
+	UpdateEdgeAccount(edgeAccount *EdgeAccount) error
+
+	CreateAccountUser(user *AccountUser) error
+	GetAccountUser(user *AccountUser) (*AccountUser, error)
+	UpdateAccountUserObject(user *AccountUserEdit) error
+	DeleteAccountUser(user *AccountUser) error
+
+	CreatePermissionGroup(rbacGroup *RbacGroup) error
+	GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error)
+	DeletePermissionGroup(rbacGroup *RbacGroup) error
+	EnableLocalLoginForRBACGroup(GroupName string) error
+	DisableLocalLoginForRBACGroup(GroupName string) error
+	GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse, error)
+
+	CreateRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error
+	GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error)
+	DeleteRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error
+
+	CreateRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error
+	GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) (*RbacGroupPermissionAttachment, error)
+	DeleteRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error
+
+	CreateRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) error
+	GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error)
+	DeleteRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) error
+}
+
+// ControllerClient manages the controller settings, version, certificates, private mode and CoPilot.
+type ControllerClient interface {
+	BaseClient
+
+	Credentials() (username, password string)
+
+	EnableHttpAccess() error
+	DisableHttpAccess() error
+	GetHttpAccessEnabled() (string, error)
+	EnableExceptionRule() error
+	DisableExceptionRule() error
+	GetExceptionRuleStatus() (bool, error)
+	EnableSecurityGroupManagement(account string) error
+	DisableSecurityGroupManagement() error
+	GetSecurityGroupManagementStatus() (*SecurityGroupInfo, error)
+	EnableCloudnBackupConfig(cloudnBackupConfiguration *CloudnBackupConfiguration) error
+	DisableCloudnBackupConfig() error
+	GetCloudnBackupConfig() (*CloudnBackupConfiguration, error)
+	GetControllerVpcDnsServerStatus() (bool, error)
+	SetControllerVpcDnsServer(enabled bool) error
+	SetEmailExceptionNotification(ctx context.Context, enabled bool) error
+	GetEmailExceptionNotificationStatus(ctx context.Context) (bool, error)
+	SetCertDomain(ctx context.Context, certDomain string) error
+	GetCertDomain(ctx context.Context) (*CertDomainConfig, error)
+	GetGatewayCount(ctx context.Context) (int, error)
+	GetSleepTime(ctx context.Context) (time.Duration, error)
+	PostAsyncAPIContextSetCertDomain(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc) error
+
+	CreateControllerAccessAllowList(ctx context.Context, allowList *AllowList) error
+	GetControllerAccessAllowList(ctx context.Context) (*AllowList, error)
+	UpdateControllerAccessAllowList(ctx context.Context, allowList *AllowList) error
+	DeleteControllerAccessAllowList(ctx context.Context) error
+
+	SetControllerBgpMaxAsLimit(ctx context.Context, maxAsLimit int) error
+	DisableControllerBgpMaxAsLimit(ctx context.Context) error
+	GetControllerBgpMaxAsLimit(ctx context.Context) (int, error)
+
+	ConfigNotificationEmails(ctx context.Context, emailConfiguration *EmailConfiguration) error
+	GetNotificationEmails(ctx context.Context) (*EmailConfiguration, error)
+	SetStatusChangeNotificationInterval(emailConfiguration *EmailConfiguration) error
+
+	GetControllerMetadata(ctx context.Context) (*ControllerMetadata, error)
+
+	EnablePrivateMode(ctx context.Context) error
+	DisablePrivateMode(ctx context.Context) error
+	UpdatePrivateModeCopilot(ctx context.Context, copilotId string) error
+	UpdatePrivateModeControllerProxies(ctx context.Context, proxies []string) error
+	GetPrivateModeInfo(ctx context.Context) (*ControllerPrivateModeConfig, error)
+	GetPrivateModeProxies(ctx context.Context, lbVpcId string) ([]*PrivateModeMulticloudProxy, error)
+
+	EnablePrivateOob() error
+	GetPrivateOobState() (bool, error)
+	DisablePrivateOob() error
+
+	AsyncUpgrade(version *Version, upgradeGateways bool) error
+	UpgradeGateway(gateway *Gateway) error
+	GetCurrentVersion(ctx context.Context) (string, *AviatrixVersion, error)
+	GetVersionInfo() (*VersionInfo, error)
+	GetLatestVersion() (string, error)
+	GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error)
+
+	Capabilities(ctx context.Context) (*Capabilities, error)
+
+	CurrentCID() string
+
+	ImportNewHTTPSCerts(certConfig *HTTPSCertConfig) error
+	DisableImportedHTTPSCerts() error
+	GetHTTPSCertsStatus() (bool, error)
+
+	CreateProxyConfig(proxyConfig *ProxyConfig) error
+	GetProxyConfig() (*ProxyConfig, error)
+	DeleteProxyConfig() error
+
+	EnableCopilotAssociation(ctx context.Context, addr string) error
+	DisableCopilotAssociation(ctx context.Context) error
+	GetCopilotAssociationStatus(ctx context.Context) (*CopilotAssociationStatus, error)
+
+	CreateCopilotSimple(ctx context.Context, copilotDeployment *CopilotSimpleDeployment) error
+	CreateCopilotFaultTolerant(ctx context.Context, copilotFaultTolerantDeployment *CopilotFaultTolerantDeployment) error
+	DeleteCopilotSimple(ctx context.Context) error
+	DeleteCopilotFaultTolerant(ctx context.Context) error
+
+	EnableCopilotSecurityGroupManagement(ctx context.Context, copilotSecurityGroupManagementConfig *CopilotSecurityGroupManagementConfig) error
+	GetCopilotSecurityGroupManagementConfig(ctx context.Context) (*CopilotSecurityGroupManagementConfig, error)
+	DisableCopilotSecurityGroupManagement(ctx context.Context) error
+
+	CreatePrivateModeControllerLoadBalancer(ctx context.Context, privateModeLb *PrivateModeLb) error
+	CreatePrivateModeMulticloudLoadBalancer(ctx context.Context, privateModeLb *PrivateModeLb) error
+	UpdatePrivateModeMulticloudProxies(ctx context.Context, privateModeLb *PrivateModeLb) error
+	GetPrivateModeLoadBalancer(ctx context.Context, loadBalancerVpcId string) (*PrivateModeLbRead, error)
+	DeletePrivateModeLoadBalancer(ctx context.Context, loadBalancerVpcId string) error
+
+	CreatePrivateModeMulticloudEndpoint(ctx context.Context, privateModeMulticloudEndpoint *PrivateModeMulticloudEndpoint) error
+	GetPrivateModeMulticloudEndpoint(ctx context.Context, vpcId string) (*PrivateModeMultiCloudEndpointRead, error)
+	DeletePrivateModeMulticloudEndpoint(ctx context.Context, vpcId string) error
+
+	UpdateGlobalVpcTaggingSettings(ctx context.Context, globalVpcTaggingSettings *GlobalVpcTaggingSettings) error
+	GetGlobalVpcTaggingSettings(ctx context.Context) (*GlobalVpcTaggingSettings, error)
+
+	CreateGlobalVpcExcludedInstance(ctx context.Context, globalVpcExcludedInstance *GlobalVpcExcludedInstance) (string, error)
+	GetGlobalVpcExcludedInstance(ctx context.Context, uuid string) (*GlobalVpcExcludedInstance, error)
+	UpdateGlobalVpcExcludedInstance(ctx context.Context, globalVpcExcludedInstance *GlobalVpcExcludedInstance, uuid string) error
+	DeleteGlobalVpcExcludedInstance(ctx context.Context, uuid string) error
+}
+
+// LoggingClient manages the log and metric forwarders of the controller and gateways.
+type LoggingClient interface {
+	BaseClient
+
+	EnableCloudwatchAgent(r *CloudwatchAgent) error
+	GetCloudwatchAgentStatus() (*CloudwatchAgentResp, error)
+	DisableCloudwatchAgent() error
+
+	EnableDatadogAgent(r *DatadogAgent) error
+	GetDatadogAgentStatus() (*DatadogAgentResp, error)
+	DisableDatadogAgent() error
+
+	GetFilebeatForwarderStatus() (*FilebeatForwarderResp, error)
+	DisableFilebeatForwarder() error
+
+	EnableNetflowAgent(r *NetflowAgent) error
+	GetNetflowAgentStatus() (*NetflowAgentResp, error)
+	DisableNetflowAgent() error
+
+	EnableRemoteSyslog(r *RemoteSyslog) error
+	GetRemoteSyslogStatus(idx int) (*RemoteSyslogResp, error)
+	DisableRemoteSyslog(idx int) error
+	GetAPIRemoteSyslog(v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error
+
+	GetSplunkLoggingStatus() (*SplunkLoggingResp, error)
+	DisableSplunkLogging() error
+
+	GetSumologicForwarderStatus() (*SumologicForwarderResp, error)
+	DisableSumologicForwarder() error
+}
+
+// GatewayClient manages gateways and their tags, certificates and keepalive settings.
+type GatewayClient interface {
+	BaseClient
+
+	IgnoreTags() *IgnoreTagsConfig
+	DefaultTags() *DefaultTagsConfig
+
+	CreateGateway(ctx context.Context, gateway *Gateway) error
+	CreatePublicSubnetFilteringGateway(ctx context.Context, gateway *Gateway) error
+	DeletePublicSubnetFilteringGateway(gateway *Gateway) error
+	EnablePublicSubnetFilteringHAGateway(ctx context.Context, gateway *Gateway) error
+	GetPublicSubnetFilteringGatewayDetails(gateway *Gateway) (*PublicSubnetFilteringGatewayDetails, error)
+	EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error
+	EnableGuardDutyEnforcement(gateway *Gateway) error
+	DisableGuardDutyEnforcement(gateway *Gateway) error
+	EnableSingleAZGateway(gateway *Gateway) error
+	EnablePeeringHaGateway(ctx context.Context, gateway *Gateway) error
+	DisableSingleAZGateway(ctx context.Context, gateway *Gateway) error
+	GetGateway(gateway *Gateway) (*Gateway, error)
+	GetTransitGatewayList(ctx context.Context) ([]Gateway, error)
+	GetSpokeGatewayList(ctx context.Context) ([]Gateway, error)
+	GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error)
+	UpdateGateway(ctx context.Context, gateway *Gateway) error
+	DeleteGateway(ctx context.Context, gateway *Gateway) error
+	EnableSNat(gateway *Gateway) error
+	EnableCustomizedSNat(gateway *Gateway) error
+	DisableSNat(gateway *Gateway) error
+	DisableCustomSNat(gateway *Gateway) error
+	UpdateDNat(gateway *Gateway) error
+	UpdateVpnCidr(gateway *Gateway) error
+	UpdateMaxVpnConn(gateway *Gateway) error
+	SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error
+	EnableVpcDnsServer(ctx context.Context, gateway *Gateway) error
+	DisableVpcDnsServer(gateway *Gateway) error
+	EnableVpnNat(gateway *Gateway) error
+	DisableVpnNat(gateway *Gateway) error
+	EditDesignatedGateway(gateway *Gateway) error
+	EnableEncryptVolume(gateway *Gateway) error
+	EditGatewayCustomRoutes(ctx context.Context, gateway *Gateway) error
+	EditGatewayFilterRoutes(ctx context.Context, gateway *Gateway) error
+	EditGatewayAdvertisedCidr(ctx context.Context, gateway *Gateway) error
+	EnableTransitFireNet(ctx context.Context, gateway *Gateway) error
+	EnableTransitFireNetWithGWLB(ctx context.Context, gateway *Gateway) error
+	DisableTransitFireNet(gateway *Gateway) error
+	IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error
+	EnableSegmentation(ctx context.Context, transitGateway *TransitVpc) error
+	DisableSegmentation(transitGateway *TransitVpc) error
+	IsSegmentationEnabled(transitGateway *TransitVpc) (bool, error)
+	EnableEgressTransitFirenet(ctx context.Context, transitGateway *TransitVpc) error
+	DisableEgressTransitFirenet(transitGateway *TransitVpc) error
+	EnableMonitorGatewaySubnets(ctx context.Context, gwName string, excludedInstances []string) error
+	DisableMonitorGatewaySubnets(gwName string) error
+	EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
+	DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
+	GetVPNConfigList(gateway *Gateway) ([]VPNConfig, error)
+	EnableActiveStandby(ctx context.Context, transitGateway *TransitVpc) error
+	DisableActiveStandby(transitGateway *TransitVpc) error
+	SwitchActiveTransitGateway(gwName, connName string) error
+	GetTransitGatewayLanCidr(gatewayName string) (string, error)
+	GetFqdnGatewayInfo(gateway *Gateway) (*FQDNGatwayInfo, error)
+	UpdateTransitGatewayCustomizedVpcRoute(ctx context.Context, gateway string, customizedTransitVpcRoutes []string) error
+	EnableJumboFrame(gateway *Gateway) error
+	DisableJumboFrame(ctx context.Context, gateway *Gateway) error
+	GetJumboFrameStatus(gateway *Gateway) (bool, error)
+	EnablePrivateVpcDefaultRoute(gw *Gateway) error
+	DisablePrivateVpcDefaultRoute(gw *Gateway) error
+	EnableSkipPublicRouteUpdate(gw *Gateway) error
+	DisableSkipPublicRouteUpdate(gw *Gateway) error
+	GetTunnelDetectionTime(entity string) (int, error)
+	ModifyTunnelDetectionTime(ctx context.Context, entity string, detectionTime int) error
+	EnableActiveStandbyPreemptive(ctx context.Context, transitGateway *TransitVpc) error
+	SetRxQueueSize(ctx context.Context, gateway *Gateway) error
+	ChangeBgpOverLanIntfCnt(gateway *Gateway) error
+	EnableGroGso(gateway *Gateway) error
+	DisableGroGso(ctx context.Context, gateway *Gateway) error
+	GetGroGsoStatus(gateway *Gateway) (bool, error)
+
+	ConfigureGatewayCertificate(ctx context.Context, gwCert *GatewayCertificate) error
+	DisableGatewayCertificate(ctx context.Context) error
+	GetGatewayCertificateStatus(ctx context.Context) (string, error)
+
+	SetGatewayKeepaliveConfig(ctx context.Context, speed string) error
+	GetGatewayKeepaliveConfig(ctx context.Context) (string, error)
+
+	AddTags(tags *Tags) error
+	GetTags(tags *Tags) ([]string, error)
+	DeleteTags(tags *Tags) error
+	UpdateTags(tags *Tags) error
+
+	CreatePeriodicPing(pp *PeriodicPing) error
+	GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error)
+	DeletePeriodicPing(pp *PeriodicPing) error
+}
+
+// TransitClient manages transit gateways, their peerings and external connections.
+type TransitClient interface {
+	BaseClient
+
+	LaunchTransitVpc(ctx context.Context, gateway *TransitVpc) error
+	EnableHaTransitGateway(ctx context.Context, gateway *TransitVpc) error
+	EnableHaTransitVpc(ctx context.Context, gateway *TransitVpc) error
+	AttachTransitGWForHybrid(ctx context.Context, gateway *TransitVpc) error
+	DetachTransitGWForHybrid(gateway *TransitVpc) error
+	EnableConnectedTransit(ctx context.Context, gateway *TransitVpc) error
+	DisableConnectedTransit(gateway *TransitVpc) error
+	EnableGatewayFireNetInterfaces(ctx context.Context, gateway *TransitVpc) error
+	DisableGatewayFireNetInterfaces(gateway *TransitVpc) error
+	EnableGatewayFireNetInterfacesWithGWLB(ctx context.Context, gateway *TransitVpc) error
+	EnableAdvertiseTransitCidr(ctx context.Context, transitGw *TransitVpc) error
+	DisableAdvertiseTransitCidr(transitGw *TransitVpc) error
+	SetBgpManualSpokeAdvertisedNetworks(ctx context.Context, transitGw *TransitVpc) error
+	EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error
+	DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error
+	UpdateTransitPendingApprovedCidrs(ctx context.Context, gateway *TransitVpc) error
+	SetBgpPollingTime(ctx context.Context, transitGateway *TransitVpc, newPollingTime string) error
+	SetPrependASPath(ctx context.Context, transitGateway *TransitVpc, prependASPath []string) error
+	SetLocalASNumber(ctx context.Context, transitGateway *TransitVpc, localASNumber string) error
+	SetBgpEcmp(ctx context.Context, transitGateway *TransitVpc, enabled bool) error
+	GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error)
+	SetTransitLearnedCIDRsApprovalMode(ctx context.Context, gw *TransitVpc, mode string) error
+	EnableTransitConnectionLearnedCIDRApproval(gwName, connName string) error
+	DisableTransitConnectionLearnedCIDRApproval(gwName, connName string) error
+	UpdateTransitConnectionPendingApprovedCidrs(gwName, connName string, approvedCidrs []string) error
+	EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error
+	ChangeBgpHoldTime(ctx context.Context, gwName string, holdTime int) error
+	EnableSummarizeCidrToTgw(ctx context.Context, gwName string) error
+	DisableSummarizeCidrToTgw(gwName string) error
+	EnableMultitierTransit(ctx context.Context, gwName string) error
+	DisableMultitierTransit(gwName string) error
+	EditTransitConnectionRemoteSubnet(vpcId, connName, remoteSubnet string) error
+	GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error)
+	EnableS2CRxBalancing(ctx context.Context, gwName string) error
+	DisableS2CRxBalancing(gwName string) error
+	EnableTransitPreserveAsPath(ctx context.Context, transitGateway *TransitVpc) error
+	DisableTransitPreserveAsPath(transitGateway *TransitVpc) error
+
+	CreateTransitHaGw(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error)
+
+	CreateTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error
+	GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error
+	GetTransitGatewayPeeringDetails(transitGatewayPeering *TransitGatewayPeering) (*TransitGatewayPeering, error)
+	UpdateTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error
+	UpdateTransitGatewayPeeringTunnelCount(transitGatewayPeering *TransitGatewayPeeringEdit) error
+	DeleteTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error
+	EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error
+
+	CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn) (*ExternalDeviceConn, error)
+	DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+	EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error
+	EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	GetEdgeExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn) (*ExternalDeviceConn, error)
+
+	CreateCloudnTransitGatewayAttachment(ctx context.Context, attachment *CloudnTransitGatewayAttachment) error
+	GetCloudnTransitGatewayAttachment(ctx context.Context, connName string) (*CloudnTransitGatewayAttachmentResp, error)
+	EnableJumboFrameOnConnectionToCloudn(ctx context.Context, connName, vpcID string) error
+	DisableJumboFrameOnConnectionToCloudn(ctx context.Context, connName, vpcID string) error
+	EditCloudnTransitGatewayAttachmentASPathPrepend(ctx context.Context, attachment *CloudnTransitGatewayAttachment, prependASPath []string) error
+	GetDeviceAttachmentVpcID(connectionName string) (string, error)
+	DeleteDeviceAttachment(ctx context.Context, connectionName string) error
+	GetAPIContextCloudnTransitGatewayAttachment(ctx context.Context, v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error
+
+	CreateVGWConn(vgwConn *VGWConn) error
+	GetVGWConn(vgwConn *VGWConn) (*VGWConn, error)
+	UpdateVGWConn(vgwConn *VGWConn) error
+	DeleteVGWConn(vgwConn *VGWConn) error
+	GetVGWConnDetail(vgwConn *VGWConn) (*VGWConn, error)
+	EditVgwConnectionASPathPrepend(vgwConn *VGWConn, prependASPath []string) error
+
+	ConnectAzureVng(r *AzureVngConn) error
+	GetAzureVngConnStatus(connectionName string) (*AzureVngConnResp, error)
+	DisconnectAzureVng(vpcId string, connectionName string) error
+}
+
+// SpokeClient manages spoke gateways, their attachments and external connections.
+type SpokeClient interface {
+	BaseClient
+
+	LaunchSpokeVpc(ctx context.Context, spoke *SpokeVpc) error
+	SpokeJoinTransit(spoke *SpokeVpc) error
+	SpokeLeaveAllTransit(spoke *SpokeVpc) error
+	SpokeLeaveTransit(spoke *SpokeVpc) error
+	EnableHaSpokeVpc(ctx context.Context, spoke *SpokeVpc) error
+	EnableHaSpokeGateway(ctx context.Context, gateway *SpokeVpc) error
+	EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error
+	DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error
+	GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+	EnableSpokeConnectionLearnedCIDRApproval(gwName, connName string) error
+	DisableSpokeConnectionLearnedCIDRApproval(gwName, connName string) error
+	UpdateSpokeConnectionPendingApprovedCidrs(gwName, connName string, approvedCidrs []string) error
+	EditSpokeConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error
+	SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error
+	EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error
+	DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error
+	SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error
+	SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime string) error
+	SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error
+	EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error
+	DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error
+	UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error
+	SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error
+	EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error
+	EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
+	DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
+	EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error
+	DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error
+	EnableGlobalVpc(gateway *Gateway) error
+	DisableGlobalVpc(gateway *Gateway) error
+
+	CreateSpokeHaGw(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error)
+
+	CreateSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+	GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+	DeleteSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+	GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+
+	EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+
+	AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	GetSubnetsForInspection(gatewayName string) ([]string, error)
+
+	CreateAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) error
+	GetAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) (*AzureSpokeNativePeering, error)
+	DeleteAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) error
+}
+
+// EdgeClient manages Edge gateways, CloudN devices and their traffic engineering.
+type EdgeClient interface {
+	BaseClient
+
+	CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error
+	GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, error)
+	DeleteEdgeCSP(ctx context.Context, accountName, name string) error
+	UpdateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error)
+	GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaResp, error)
+	UpdateEdgeCSPHa(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error
+	GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error)
+	DeleteEdgeEquinix(ctx context.Context, accountName, name string) error
+	UpdateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error)
+	GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error)
+	UpdateEdgeEquinixHa(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error)
+	DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error
+
+	GetEdgeGatewayWanIp(ctx context.Context, gwName, wanInterfaceName string) (string, error)
+
+	CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error
+	GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, error)
+	DeleteEdgeNEO(ctx context.Context, accountName, name string) error
+	UpdateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	GetEdgeNEODevice(ctx context.Context, accountName, deviceName string) (*EdgeNEODeviceResp, error)
+	UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	DeleteEdgeNEODevice(ctx context.Context, accountName, serialNumber string) error
+	DownloadEdgeNEOConfigFile(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error)
+	GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaResp, error)
+	UpdateEdgeNEOHa(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error)
+	UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	DeleteEdgeSpoke(ctx context.Context, name string) error
+	EnableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error
+	DisableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error
+	UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error)
+	GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error)
+	UpdateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error
+
+	CreateCloudnRegistration(ctx context.Context, cloudnRegistration *CloudnRegistration) error
+	GetCloudnRegistration(ctx context.Context, cloudnRegistration *CloudnRegistration) (*CloudnRegistration, error)
+	DeleteCloudnRegistration(ctx context.Context, cloudnRegistration *CloudnRegistration) error
+
+	GetDevice(d *Device) (*Device, error)
+	GetDeviceName(connName string) (string, error)
+	GetDeviceInterfaces(deviceName string) (*[]DeviceWanInterface, error)
+	ConfigureDeviceInterfaces(config *DeviceInterfaceConfig) error
+
+	CreateDeviceTag(deviceTag *DeviceTag) error
+	GetDeviceTag(brt *DeviceTag) (*DeviceTag, error)
+	UpdateDeviceTagConfig(brt *DeviceTag) error
+	AttachDeviceTag(brt *DeviceTag) error
+	CommitDeviceTag(brt *DeviceTag) error
+	DeleteDeviceTag(brt *DeviceTag) error
+
+	CreateDeviceAwsTgwAttachment(ctx context.Context, attachment *DeviceAwsTgwAttachment) error
+	GetDeviceAwsTgwAttachment(tgwAttachment *DeviceAwsTgwAttachment) (*DeviceAwsTgwAttachment, error)
+
+	CreateLinkHierarchy(ctx context.Context, linkHierarchy map[string]interface{}) (string, error)
+	GetLinkHierarchy(ctx context.Context, uuid string) (*LinkHierarchy, error)
+	UpdateLinkHierarchy(ctx context.Context, linkHierarchy map[string]interface{}, uuid string) error
+	DeleteLinkHierarchy(ctx context.Context, uuid string) error
+
+	CreateQosClass(ctx context.Context, qosClass *QosClass) (string, error)
+	GetQosClass(ctx context.Context, uuid string) (*QosClass, error)
+	UpdateQosClass(ctx context.Context, qosClass *QosClass, uuid string) error
+	DeleteQosClass(ctx context.Context, uuid string) error
+
+	UpdateQosPolicyList(ctx context.Context, qosPolicyList *QosPolicyList) error
+	GetQosPolicyList(ctx context.Context) (*[]QosPolicyList, error)
+	DeleteQosPolicyList(ctx context.Context) error
+
+	CreateSLAClass(ctx context.Context, slaClass *SLAClass) (string, error)
+	GetSLAClass(ctx context.Context, uuid string) (*SLAClass, error)
+	UpdateSLAClass(ctx context.Context, slaClass *SLAClass, uuid string) error
+	DeleteSLAClass(ctx context.Context, uuid string) error
+
+	CreateTrafficClassifier(ctx context.Context, policyList *PolicyList) error
+	GetTrafficClassifier(ctx context.Context) (*[]PolicyList, error)
+	DeleteTrafficClassifier(ctx context.Context) error
+
+	CreateDNSProfile(ctx context.Context, data map[string]interface{}) error
+	GetDNSProfile(ctx context.Context, name string) (map[string]interface{}, error)
+	UpdateDNSProfile(ctx context.Context, data map[string]interface{}) error
+	DeleteDNSProfile(ctx context.Context, data map[string]interface{}) error
+}
+
+// SecurityClient manages FireNet, firewalls, FQDN filtering and distributed firewalling.
+type SecurityClient interface {
+	BaseClient
+
+	CreateFireNet(fireNet *FireNet) error
+	GetFireNet(fireNet *FireNet) (*FireNetDetail, error)
+	AssociateFirewallWithFireNet(ctx context.Context, firewallInstance *FirewallInstance) error
+	DisassociateFirewallFromFireNet(ctx context.Context, firewallInstance *FirewallInstance) error
+	AttachFirewallToFireNet(firewallInstance *FirewallInstance) error
+	DetachFirewallFromFireNet(firewallInstance *FirewallInstance) error
+	ConnectFireNetWithTgw(ctx context.Context, awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error
+	DisconnectFireNetFromTgw(ctx context.Context, awsTgw *AWSTgw, vpcID string) error
+	EditFireNetInspection(fireNet *FireNet) error
+	EditFireNetEgress(fireNet *FireNet) error
+	EditFireNetHashingAlgorithm(fireNet *FireNet) error
+	EnableFireNetLanKeepAlive(net *FireNet) error
+	DisableFireNetLanKeepAlive(net *FireNet) error
+	EnableTgwSegmentationForEgress(net *FireNet) error
+	DisableTgwSegmentationForEgress(net *FireNet) error
+	EditFirenetEgressStaticCidr(net *FireNet) error
+	EditFirenetExcludedCidr(net *FireNet) error
+
+	SetBasePolicy(firewall *Firewall) error
+	UpdatePolicy(firewall *Firewall) error
+	GetPolicy(firewall *Firewall) (*Firewall, error)
+	ValidatePolicy(policy *Policy) error
+	AddFirewallPolicy(fw *Firewall) error
+	DeleteFirewallPolicy(fw *Firewall) error
+	GetFirewallPolicy(fw *Firewall) (*Firewall, error)
+	InsertFirewallPolicy(fw *Firewall) error
+
+	CreateFirewallInstance(ctx context.Context, firewallInstance *FirewallInstance) (string, error)
+	GetFirewallInstance(firewallInstance *FirewallInstance) (*FirewallInstance, error)
+	DeleteFirewallInstance(ctx context.Context, firewallInstance *FirewallInstance) error
+	GetFirewallInstanceImages(vpcId string) (*[]FirewallInstanceImage, error)
+
+	CreateFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) error
+	GetFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) (*FirewallManagementAccess, error)
+	DestroyFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) error
+
+	CreateFirewallTag(firewall_tag *FirewallTag) error
+	UpdateFirewallTag(firewall_tag *FirewallTag) error
+	GetFirewallTag(firewall_tag *FirewallTag) (*FirewallTag, error)
+	DeleteFirewallTag(firewall_tag *FirewallTag) error
+
+	CreateFQDN(fqdn *FQDN) error
+	DeleteFQDN(fqdn *FQDN) error
+	UpdateFQDNStatus(fqdn *FQDN) error
+	UpdateFQDNMode(fqdn *FQDN) error
+	UpdateDomains(fqdn *FQDN) error
+	AttachGws(fqdn *FQDN) error
+	DetachGws(fqdn *FQDN, gwList []string) error
+	ListFQDNTags() ([]*FQDN, error)
+	GetFQDNTag(fqdn *FQDN) (*FQDN, error)
+	ListDomains(fqdn *FQDN) (*FQDN, error)
+	ListGws(fqdn *FQDN) ([]string, error)
+	AttachTagToGw(fqdn *FQDN, gateway *Gateway) error
+	UpdateSourceIPFilters(fqdn *FQDN, gateway *Gateway, sourceIPs []string) error
+	GetGwFilterTagList(fqdn *FQDN) (*FQDN, error)
+	GetFQDNPassThroughCIDRs(gw *Gateway) ([]string, error)
+	ConfigureFQDNPassThroughCIDRs(gw *Gateway, IPs []string) error
+	DisableFQDNPassThrough(gw *Gateway) error
+	AddFQDNTagRule(fqdn *FQDN) error
+	GetFQDNTagRule(fqdn *FQDN) (*FQDN, error)
+	DeleteFQDNTagRule(fqdn *FQDN) error
+	EnableFQDNExceptionRule(ctx context.Context) error
+	DisableFQDNExceptionRule(ctx context.Context) error
+	EnableFQDNPrivateNetworks(ctx context.Context) error
+	DisableFQDNPrivateNetwork(ctx context.Context) error
+	SetFQDNCustomNetwork(ctx context.Context, configIPs []string) error
+	EnableFQDNCache(ctx context.Context) error
+	DisableFQDNCache(ctx context.Context) error
+	EnableFQDNExactMatch(ctx context.Context) error
+	DisableFQDNExactMatch(ctx context.Context) error
+	GetFQDNCacheGlobalStatus(ctx context.Context) (*string, error)
+	GetFQDNExactMatchStatus(ctx context.Context) (*string, error)
+	GetFQDNExceptionRuleStatus(ctx context.Context) (*string, error)
+	GetFQDNPrivateNetworkFilteringStatus(ctx context.Context) (*FQDNPrivateNetworkingFilteringStatus, error)
+
+	EditFireNetFirewallVendorInfo(vendorInfo *VendorInfo) error
+	ShowFireNetFirewallVendorConfig(vendorInfo *VendorInfo) error
+	EditFireNetFirewallManagerVendorInfo(ctx context.Context, firewallManager *FirewallManager) error
+	SyncFireNetFirewallManagerVendorConfig(ctx context.Context, firewallManager *FirewallManager) error
+	EditFireNetFirewallVendorInfoWithPrivateKey(vendorInfo *VendorInfo) error
+
+	CreateTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error
+	GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error
+	DeleteTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error
+
+	GetPrimaryFireNet(ctx context.Context) ([]string, error)
+	GetSecondaryFireNet(ctx context.Context) ([]string, error)
+	CreateCentralizedTransitFireNet(ctx context.Context, firenetAttachment *CentralizedTransitFirenet) error
+	GetCentralizedTransitFireNet(ctx context.Context, centralizedTransitFirenet *CentralizedTransitFirenet) error
+	DeleteCentralizedTransitFireNet(ctx context.Context, firenetAttachment *CentralizedTransitFirenet) error
+
+	UpdateAwsGuardDutyPollInterval(scanningInterval int) error
+	EnableAwsGuardDuty(account *AwsGuardDutyAccount) error
+	DisableAwsGuardDuty(account *AwsGuardDutyAccount) error
+	UpdateAwsGuardDutyExcludedIPs(account *AwsGuardDutyAccount) error
+	GetAwsGuardDuty() (*AwsGuardDuty, error)
+	GetAwsGuardDutyAccount(accountName, region string) (*AwsGuardDutyAccount, error)
+
+	EnableDistributedFirewalling(ctx context.Context) error
+	DisableDistributedFirewalling(ctx context.Context) error
+	GetDistributedFirewallingStatus(ctx context.Context) (*DistributedFirewallingConfig, error)
+
+	CreateDistributedFirewallingIntraVpc(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error
+	GetDistributedFirewallingIntraVpc(ctx context.Context) (*DistributedFirewallingIntraVpcList, error)
+	DeleteDistributedFirewallingIntraVpc(ctx context.Context) error
+
+	SetEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error
+	GetEnforcementLevel(ctx context.Context) (*EnforcementLevel, error)
+	UpdateEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error
+	DeleteEnforcementLevel(ctx context.Context) error
+
+	CreateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+	GetDistributedFirewallingPolicyList(ctx context.Context) (*DistributedFirewallingPolicyList, error)
+	UpdateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+	DeleteDistributedFirewallingPolicyList(ctx context.Context) error
+
+	SetNewCertificate(ctx context.Context, proxyCaConfig *ProxyCaConfig) error
+	GetCaCertificate(ctx context.Context) (*ProxyCaConfig, error)
+	GetMetaCaCertificate(ctx context.Context) (*ProxyCaCertInstance, error)
+	DeleteCaCertificate(ctx context.Context) error
+
+	CreateSmartGroup(ctx context.Context, smartGroup *SmartGroup) (string, error)
+	GetSmartGroup(ctx context.Context, uuid string) (*SmartGroup, error)
+	UpdateSmartGroup(ctx context.Context, smartGroup *SmartGroup, uuid string) error
+	DeleteSmartGroup(ctx context.Context, uuid string) error
+	GetSmartGroups(ctx context.Context) ([]*SmartGroup, error)
+
+	CreateWebGroup(ctx context.Context, webGroup *WebGroup) (string, error)
+	GetWebGroup(ctx context.Context, uuid string) (*WebGroup, error)
+	UpdateWebGroup(ctx context.Context, webGroup *WebGroup, uuid string) error
+	DeleteWebGroup(ctx context.Context, uuid string) error
+}
+
+// SegmentationClient manages network domains and their connection policies.
+type SegmentationClient interface {
+	BaseClient
+
+	CreateSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error
+	DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error
+	GetSegmentationSecurityDomain(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error)
+	CreateSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error
+	DeleteSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error
+	GetSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error)
+	CreateSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error
+	DeleteSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error
+	GetSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error)
+}
+
+// Site2CloudClient manages site2cloud connections.
+type Site2CloudClient interface {
+	BaseClient
+
+	CreateSite2Cloud(site2cloud *Site2Cloud) error
+	GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error)
+	GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error)
+	UpdateSite2Cloud(site2cloud *EditSite2Cloud) error
+	DeleteSite2Cloud(site2cloud *Site2Cloud) error
+	EnableDeadPeerDetection(site2cloud *Site2Cloud) error
+	DisableDeadPeerDetection(site2cloud *Site2Cloud) error
+	EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error
+	DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error
+	EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error
+	DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error
+	EnableSite2CloudEventTriggeredHA(vpcID, connectionName string) error
+	DisableSite2CloudEventTriggeredHA(vpcID, connectionName string) error
+	EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error
+
+	CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) error
+	GetS2CCaCertTag(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error)
+	DeleteCertInstance(ctx context.Context, caCertInstance *CaCertInstance) error
+}
+
+// AWSTgwClient manages AWS TGWs, their attachments and security domains.
+type AWSTgwClient interface {
+	BaseClient
+
+	CreateAWSTgw(ctx context.Context, awsTgw *AWSTgw) error
+	GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error)
+	IsFirewallSecurityDomain(tgwName string, domainName string) (bool, error)
+	DeleteAWSTgw(ctx context.Context, awsTgw *AWSTgw) error
+	ValidateAWSTgwDomains(domainsAll []string, domainConnAll [][]string, attachedVPCAll [][]string) ([]string, [][]string, [][]string, error)
+	AttachAviatrixTransitGWToAWSTgw(ctx context.Context, awsTgw *AWSTgw, gateway *Gateway, SecurityDomainName string) error
+	DetachAviatrixTransitGWFromAWSTgw(ctx context.Context, awsTgw *AWSTgw, gateway *Gateway, SecurityDomainName string) error
+	AttachVpcToAWSTgw(ctx context.Context, awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error
+	DetachVpcFromAWSTgw(ctx context.Context, awsTgw *AWSTgw, vpcID string) error
+	GetTransitGwFromVpcID(awsTgw *AWSTgw, gateway *Gateway) (*Gateway, error)
+	ListTgwDetails(awsTgw *AWSTgw) (*AWSTgw, error)
+	IsVpcAttachedToTgw(awsTgw *AWSTgw, vpcSolo *VPCSolo) (bool, error)
+	GetAttachmentRouteTableDetails(tgwName string, attachmentName string) (*AttachmentRouteTableDetails, error)
+	UpdateTGWCidrs(tgwName string, cidrs []string) error
+	UpdateTGWInspectionMode(tgwName, inspectionMode string) error
+
+	AttachTGWConnectToTGW(ctx context.Context, connect *AwsTgwConnect) error
+	DetachTGWConnectFromTGW(ctx context.Context, connect *AwsTgwConnect) error
+	GetTGWConnect(ctx context.Context, connect *AwsTgwConnect) (*AwsTgwConnect, error)
+	CreateTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) error
+	DeleteTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) error
+	GetTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) (*AwsTgwConnectPeer, error)
+
+	CreateAwsTgwDirectConnect(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error
+	GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) (*AwsTgwDirectConnect, error)
+	UpdateDirectConnAllowedPrefix(awsTgwDirectConnect *AwsTgwDirectConnect) error
+	DeleteAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) error
+	EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *AwsTgwDirectConnect) error
+	DisableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *AwsTgwDirectConnect) error
+
+	CreateAwsTgwPeering(ctx context.Context, awsTgwPeering *AwsTgwPeering) error
+	GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error
+	DeleteAwsTgwPeering(ctx context.Context, awsTgwPeering *AwsTgwPeering) error
+
+	CreateDomainConn(ctx context.Context, domainConn *DomainConn) error
+	GetDomainConn(domainConn *DomainConn) error
+	DeleteDomainConn(ctx context.Context, domainConn *DomainConn) error
+
+	CreateAwsTgwTransitGwAttachment(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error
+	GetAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) (*AwsTgwTransitGwAttachment, error)
+	DeleteAwsTgwTransitGwAttachment(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error
+
+	CreateAwsTgwVpcAttachment(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	GetAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error)
+	DeleteAwsTgwVpcAttachment(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	DeleteAwsTgwVpcAttachmentForFireNet(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	GetAwsTgwDetail(awsTgw *AWSTgw) (*AWSTgw, error)
+	GetAwsTgwDomain(awsTgw *AWSTgw, sDM string) error
+	GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error)
+	EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	GetFirenetManagementDetails(awsTgwVpcAttachment *AwsTgwVpcAttachment) ([]string, error)
+
+	CreateAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (string, error)
+	GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn, error)
+	DeleteAwsTgwVpnConn(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error
+	EnableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVpnConn) error
+	DisableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVpnConn) error
+	GetAwsTgwVpnTunnelData(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConnEdit, error)
+
+	CreateSecurityDomain(ctx context.Context, securityDomain *SecurityDomain) error
+	GetSecurityDomain(securityDomain *SecurityDomain) (string, error)
+	UpdateSecurityDomain(securityDomain *SecurityDomain) error
+	DeleteSecurityDomain(securityDomain *SecurityDomain) error
+	CreateDomainConnection(awsTgw *AWSTgw, sourceDomain string, destinationDomain string) error
+	DeleteDomainConnection(awsTgw *AWSTgw, sourceDomain string, destinationDomain string) error
+	SecurityDomainRuleValidation(securityDomainRule *SecurityDomainRule) bool
+	GetSecurityDomainDetails(ctx context.Context, domain *SecurityDomain) (*SecurityDomainDetails, error)
+	EnableIntraDomainInspection(ctx context.Context, intraDomainInspection *IntraDomainInspection) error
+	DisableIntraDomainInspection(ctx context.Context, intraDomainInspection *IntraDomainInspection) error
+	GetAllNetworkDomains(ctx context.Context) ([]NetworkDomainDetails, error)
+	GetIntraDomainInspectionStatus(ctx context.Context, intraDomainInspection *IntraDomainInspection) error
+}
+
+// NetworkingClient manages VPCs and the peerings between them.
+type NetworkingClient interface {
+	BaseClient
+
+	CreateVpc(vpc *Vpc) error
+	GetVpcCloudTypeById(ID string) (int, error)
+	GetCloudTypeFromVpcID(vpcID string) (int, error)
+	GetVpc(vpc *Vpc) (*Vpc, error)
+	GetVpcRouteTableIDs(vpc *Vpc) ([]string, error)
+	UpdateVpc(vpc *Vpc) error
+	DeleteVpc(vpc *Vpc) error
+	EnableNativeAwsGwlbFirenet(vpc *Vpc) error
+	DisableNativeAwsGwlbFirenet(vpc *Vpc) error
+	ListOciVpcAvailabilityDomains(vpc *Vpc) ([]string, error)
+	ListOciVpcFaultDomains(vpc *Vpc) ([]string, error)
+
+	GetVpcTracker() ([]*VpcTracker, error)
+
+	CreateAWSPeer(awsPeer *AWSPeer) (string, error)
+	GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error)
+	UpdateAWSPeer(awsPeer *AWSPeer) error
+	DeleteAWSPeer(awsPeer *AWSPeer) error
+
+	CreateAzurePeer(azurePeer *AzurePeer) error
+	GetAzurePeer(azurePeer *AzurePeer) (*AzurePeer, error)
+	DeleteAzurePeer(azurePeer *AzurePeer) error
+
+	CreateTransPeer(transPeer *TransPeer) error
+	GetTransPeer(transPeer *TransPeer) (*TransPeer, error)
+	UpdateTransPeer(transPeer *TransPeer) error
+	DeleteTransPeer(transPeer *TransPeer) error
+
+	CreateTunnel(tunnel *Tunnel) error
+	GetTunnel(tunnel *Tunnel) (*Tunnel, error)
+	UpdateTunnel(tunnel *Tunnel) error
+	DeleteTunnel(tunnel *Tunnel) error
+}
+
+// VPNClient manages VPN users, profiles and SAML endpoints.
+type VPNClient interface {
+	BaseClient
+
+	CreateVPNUser(vpnUser *VPNUser) error
+	GetVPNUser(vpnUser *VPNUser) (*VPNUser, error)
+	DeleteVPNUser(vpnUser *VPNUser) error
+
+	GetVpnUserAccelerator() ([]string, error)
+	UpdateVpnUserAccelerator(xlr *VpnUserXlr) error
+
+	EnableVPNCertDownload(vpnCertDownload *VPNCertDownload) error
+	DisableVPNCertDownload() error
+	GetVPNCertDownloadStatus() (*GetVPNCertDownloadStatusResp, error)
+
+	EnableGeoVPN(ctx context.Context, geoVPN *GeoVPN) error
+	GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error)
+	AddElbToGeoVPN(geoVPN *GeoVPN) error
+	DeleteElbFromGeoVPN(geoVPN *GeoVPN) error
+	DisableGeoVPN(geoVPN *GeoVPN) error
+	GetGeoVPNName(gateway *Gateway) (*GeoVPN, error)
+
+	GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error)
+	ModifySplitTunnel(splitTunnel *SplitTunnel) error
+
+	CreateProfile(profile *Profile) error
+	GetProfile(profile *Profile) (*Profile, error)
+	UpdateProfilePolicy(profile *Profile) error
+	AttachUsers(profile *Profile) error
+	DetachUsers(profile *Profile) error
+	DeleteProfile(profile *Profile) error
+	GetProfileBasePolicy(profile *Profile) (*Profile, error)
+	ValidateProfileRule(profileRule *ProfileRule) error
+
+	CreateSamlEndpoint(samlEndpoint *SamlEndpoint) error
+	GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo, error)
+	EditSamlEndpoint(samlEndpoint *SamlEndpoint) error
+	DeleteSamlEndpoint(samlEndpoint *SamlEndpoint) error
+}
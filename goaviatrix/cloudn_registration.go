@@ -5,7 +5,6 @@ import (
 )
 
 type CloudnRegistration struct {
-	CID               string `form:"CID"`
 	Action            string `form:"action"`
 	ControllerAddress string `form:"controller_ip_or_fqdn"`
 	Username          string `form:"username"`
@@ -16,7 +15,6 @@ type CloudnRegistration struct {
 
 // CreateCloudnRegistration should only be called with a CloudN Client, not the default controller Client
 func (c *Client) CreateCloudnRegistration(ctx context.Context, cloudnRegistration *CloudnRegistration) error {
-	cloudnRegistration.CID = c.CID
 	cloudnRegistration.Action = "register_caag_with_controller"
 
 	return c.PostAPIContext1(ctx, cloudnRegistration.Action, cloudnRegistration, BasicCheck)
@@ -25,7 +23,6 @@ func (c *Client) CreateCloudnRegistration(ctx context.Context, cloudnRegistratio
 func (c *Client) GetCloudnRegistration(ctx context.Context, cloudnRegistration *CloudnRegistration) (*CloudnRegistration, error) {
 	data := map[string]string{
 		"action": "list_cloudwan_devices_summary",
-		"CID":    c.CID,
 	}
 
 	type CloudnRegistrationAPIResult struct {
@@ -58,7 +55,6 @@ func (c *Client) GetCloudnRegistration(ctx context.Context, cloudnRegistration *
 func (c *Client) DeleteCloudnRegistration(ctx context.Context, cloudnRegistration *CloudnRegistration) error {
 	data := map[string]string{
 		"action":      "deregister_cloudwan_device",
-		"CID":         c.CID,
 		"device_name": cloudnRegistration.Name,
 	}
 
@@ -42,7 +42,6 @@ type CloudnTransitGatewayAttachment struct {
 	DpdConfig                        string   `json:"dpd_config"`
 	RoutingProtocol                  string   `form:"routing_protocol"`
 	Action                           string   `form:"action"`
-	CID                              string   `form:"CID"`
 	EnableLearnedCidrsApproval       string   `form:"conn_learned_cidrs_approval" json:"conn_learned_cidrs_approval"`
 	ApprovedCidrs                    []string `json:"conn_approved_learned_cidrs"`
 	PrependAsPath                    string   `json:"conn_bgp_prepend_as_path"`
@@ -68,7 +67,6 @@ type CloudnTransitGatewayAttachmentResp struct {
 
 func (c *Client) CreateCloudnTransitGatewayAttachment(ctx context.Context, attachment *CloudnTransitGatewayAttachment) error {
 	attachment.Action = "attach_cloudwan_device_to_transit_gateway"
-	attachment.CID = c.CID
 	attachment.RoutingProtocol = "bgp"
 	attachment.Async = true
 
@@ -116,7 +114,6 @@ func (c *Client) GetCloudnTransitGatewayAttachment(ctx context.Context, connName
 
 	form := map[string]string{
 		"action":    "get_site2cloud_conn_detail",
-		"CID":       c.CID,
 		"conn_name": connName,
 		"vpc_id":    vpcID,
 	}
@@ -146,7 +143,6 @@ func (c *Client) GetCloudnTransitGatewayAttachment(ctx context.Context, connName
 func (c *Client) EnableJumboFrameOnConnectionToCloudn(ctx context.Context, connName, vpcID string) error {
 	form := map[string]string{
 		"action":          "enable_jumbo_frame_on_connection_to_cloudn",
-		"CID":             c.CID,
 		"connection_name": connName,
 		"vpc_id":          vpcID,
 	}
@@ -156,7 +152,6 @@ func (c *Client) EnableJumboFrameOnConnectionToCloudn(ctx context.Context, connN
 func (c *Client) DisableJumboFrameOnConnectionToCloudn(ctx context.Context, connName, vpcID string) error {
 	form := map[string]string{
 		"action":          "disable_jumbo_frame_on_connection_to_cloudn",
-		"CID":             c.CID,
 		"connection_name": connName,
 		"vpc_id":          vpcID,
 	}
@@ -166,13 +161,11 @@ func (c *Client) DisableJumboFrameOnConnectionToCloudn(ctx context.Context, conn
 func (c *Client) EditCloudnTransitGatewayAttachmentASPathPrepend(ctx context.Context, attachment *CloudnTransitGatewayAttachment, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
 	return c.PostAPIContext(ctx, action, struct {
-		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
 		ConnectionName string `form:"connection_name"`
 		PrependASPath  string `form:"connection_as_path_prepend"`
 	}{
-		CID:            c.CID,
 		Action:         action,
 		GatewayName:    attachment.TransitGatewayName,
 		ConnectionName: attachment.ConnectionName,
@@ -182,7 +175,6 @@ func (c *Client) EditCloudnTransitGatewayAttachmentASPathPrepend(ctx context.Con
 
 func (c *Client) GetDeviceAttachmentVpcID(connectionName string) (string, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_cloudwan_attachments",
 	}
 
@@ -213,21 +205,20 @@ func (c *Client) GetDeviceAttachmentVpcID(connectionName string) (string, error)
 	return "", ErrNotFound
 }
 
-func (c *Client) DeleteDeviceAttachment(connectionName string) error {
+func (c *Client) DeleteDeviceAttachment(ctx context.Context, connectionName string) error {
 	vpcID, err := c.GetDeviceAttachmentVpcID(connectionName)
 	if err != nil {
 		return fmt.Errorf("could not get device attachment VPC id: %v", err)
 	}
 
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "detach_cloudwan_device",
 		"vpc_id":          vpcID,
 		"connection_name": connectionName,
 		"async":           "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAPIContextCloudnTransitGatewayAttachment(ctx context.Context, v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error {
@@ -245,10 +236,9 @@ func (c *Client) GetAPIContextCloudnTransitGatewayAttachment(ctx context.Context
 			break
 		}
 
-		if try == maxTries {
+		if try == maxTries || SleepContext(ctx, backoff) != nil {
 			return fmt.Errorf("HTTP Get %s failed: %v", action, err)
 		}
-		time.Sleep(backoff)
 		// Double the backoff time after each failed try
 		backoff *= 2
 	}
@@ -1,7 +1,6 @@
 package goaviatrix
 
 type CloudwatchAgent struct {
-	CID                   string
 	RoleArn               string
 	Region                string
 	LogGroupName          string
@@ -19,7 +18,6 @@ type CloudwatchAgentResp struct {
 func (c *Client) EnableCloudwatchAgent(r *CloudwatchAgent) error {
 	params := map[string]string{
 		"action":               "enable_cloudwatch_agent",
-		"CID":                  c.CID,
 		"cloudwatch_role_arn":  r.RoleArn,
 		"region":               r.Region,
 		"log_group_name":       r.LogGroupName,
@@ -32,7 +30,6 @@ func (c *Client) EnableCloudwatchAgent(r *CloudwatchAgent) error {
 func (c *Client) GetCloudwatchAgentStatus() (*CloudwatchAgentResp, error) {
 	params := map[string]string{
 		"action": "get_cloudwatch_agent_status",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -58,7 +55,6 @@ func (c *Client) GetCloudwatchAgentStatus() (*CloudwatchAgentResp, error) {
 func (c *Client) DisableCloudwatchAgent() error {
 	params := map[string]string{
 		"action": "disable_cloudwatch_agent",
-		"CID":    c.CID,
 	}
 
 	return c.PostAPI(params["action"], params, BasicCheck)
@@ -1,9 +1,7 @@
 package goaviatrix
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -31,7 +29,6 @@ type SecurityGroupInfo struct {
 }
 
 type CloudnBackupConfiguration struct {
-	CID                 string `form:"CID,omitempty"`
 	Action              string `form:"action,omitempty"`
 	BackupConfiguration string `json:"enabled,omitempty"`
 	BackupAccountName   string `json:"acct_name,omitempty"`
@@ -61,7 +58,6 @@ type ResourceCounts struct {
 
 func (c *Client) EnableHttpAccess() error {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "config_http_access",
 		"operation": "enable",
 	}
@@ -70,7 +66,6 @@ func (c *Client) EnableHttpAccess() error {
 
 func (c *Client) DisableHttpAccess() error {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "config_http_access",
 		"operation": "disable",
 	}
@@ -80,7 +75,6 @@ func (c *Client) DisableHttpAccess() error {
 func (c *Client) GetHttpAccessEnabled() (string, error) {
 	var data ControllerHttpAccessResp
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "config_http_access",
 		"operation": "get",
 	}
@@ -93,7 +87,6 @@ func (c *Client) GetHttpAccessEnabled() (string, error) {
 
 func (c *Client) EnableExceptionRule() error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "enable_fqdn_exception_rule",
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -101,7 +94,6 @@ func (c *Client) EnableExceptionRule() error {
 
 func (c *Client) DisableExceptionRule() error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_fqdn_exception_rule",
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -110,7 +102,6 @@ func (c *Client) DisableExceptionRule() error {
 func (c *Client) GetExceptionRuleStatus() (bool, error) {
 	var data GetFqdnExceptionRuleResp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_fqdn_exception_rule_status",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -125,7 +116,6 @@ func (c *Client) GetExceptionRuleStatus() (bool, error) {
 
 func (c *Client) EnableSecurityGroupManagement(account string) error {
 	form := map[string]string{
-		"CID":                 c.CID,
 		"action":              "enable_controller_security_group_management",
 		"access_account_name": account,
 	}
@@ -134,7 +124,6 @@ func (c *Client) EnableSecurityGroupManagement(account string) error {
 
 func (c *Client) DisableSecurityGroupManagement() error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_controller_security_group_management",
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -143,7 +132,6 @@ func (c *Client) DisableSecurityGroupManagement() error {
 func (c *Client) GetSecurityGroupManagementStatus() (*SecurityGroupInfo, error) {
 	var data GetSecurityGroupManagementResp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_controller_security_group_management_status",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -155,7 +143,6 @@ func (c *Client) GetSecurityGroupManagementStatus() (*SecurityGroupInfo, error)
 
 func (c *Client) EnableCloudnBackupConfig(cloudnBackupConfiguration *CloudnBackupConfiguration) error {
 	form := map[string]string{
-		"CID":            c.CID,
 		"action":         "enable_cloudn_backup_config",
 		"cloud_type":     strconv.Itoa(cloudnBackupConfiguration.BackupCloudType),
 		"acct_name":      cloudnBackupConfiguration.BackupAccountName,
@@ -172,7 +159,6 @@ func (c *Client) EnableCloudnBackupConfig(cloudnBackupConfiguration *CloudnBacku
 
 func (c *Client) DisableCloudnBackupConfig() error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_cloudn_backup_config",
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -181,7 +167,6 @@ func (c *Client) DisableCloudnBackupConfig() error {
 func (c *Client) GetCloudnBackupConfig() (*CloudnBackupConfiguration, error) {
 	var data GetCloudnBackupConfigResp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_cloudn_backup_config",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -199,7 +184,6 @@ func (c *Client) GetControllerVpcDnsServerStatus() (bool, error) {
 	}
 	var data Resp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_controller_vpc_dns_server_status",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -215,7 +199,6 @@ func (c *Client) SetControllerVpcDnsServer(enabled bool) error {
 		action = "disable_controller_vpc_dns_server"
 	}
 	return c.PostAPI(action, &APIRequest{
-		CID:    c.CID,
 		Action: action,
 	}, BasicCheck)
 }
@@ -226,7 +209,6 @@ func (c *Client) SetEmailExceptionNotification(ctx context.Context, enabled bool
 		action = "disable_exception_email_notification"
 	}
 	return c.PostAPIContext(ctx, action, &APIRequest{
-		CID:    c.CID,
 		Action: action,
 	}, BasicCheck)
 }
@@ -234,7 +216,6 @@ func (c *Client) SetEmailExceptionNotification(ctx context.Context, enabled bool
 func (c *Client) GetEmailExceptionNotificationStatus(ctx context.Context) (bool, error) {
 	params := map[string]string{
 		"action": "get_exception_email_notification_status",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -260,7 +241,6 @@ func (c *Client) GetEmailExceptionNotificationStatus(ctx context.Context) (bool,
 func (c *Client) SetCertDomain(ctx context.Context, certDomain string) error {
 	params := map[string]string{
 		"action":      "set_cert_domain",
-		"CID":         c.CID,
 		"cert_domain": certDomain,
 		"async":       "true",
 	}
@@ -270,7 +250,6 @@ func (c *Client) SetCertDomain(ctx context.Context, certDomain string) error {
 func (c *Client) GetCertDomain(ctx context.Context) (*CertDomainConfig, error) {
 	params := map[string]string{
 		"action": "list_cert_domain",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -292,7 +271,6 @@ func (c *Client) GetCertDomain(ctx context.Context) (*CertDomainConfig, error) {
 func (c *Client) GetGatewayCount(ctx context.Context) (int, error) {
 	params := map[string]string{
 		"action": "list_resource_counts",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -325,68 +303,13 @@ func (c *Client) GetSleepTime(ctx context.Context) (time.Duration, error) {
 }
 
 func (c *Client) PostAsyncAPIContextSetCertDomain(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc) error {
-	resp, err := c.PostContext(ctx, c.baseURL, i)
+	requestID, err := c.startAsyncTask(ctx, action, i)
 	if err != nil {
-		return fmt.Errorf("HTTP POST %s failed: %v", action, err)
-	}
-	var data struct {
-		Return bool   `json:"return"`
-		Result string `json:"results"`
-		Reason string `json:"reason"`
-	}
-
-	buf := new(bytes.Buffer)
-	buf.ReadFrom(resp.Body)
-	resp.Body.Close()
-	bodyString := buf.String()
-	bodyIoCopy := strings.NewReader(bodyString)
-	if err = json.NewDecoder(bodyIoCopy).Decode(&data); err != nil {
-		return fmt.Errorf("Json Decode %s failed %v\n Body: %s", action, err, bodyString)
-	}
-	if !data.Return {
-		return fmt.Errorf("rest API %s POST failed to initiate async action: %s", action, data.Reason)
+		return err
 	}
 
-	requestID := data.Result
-	form := map[string]string{
-		"action":     "check_task_status",
-		"CID":        c.CID,
-		"request_id": requestID,
-	}
-
-	const maxPoll = 360
-	sleepDuration := time.Second * 10
-	var j int
-	for ; j < maxPoll; j++ {
-		resp, err = c.PostContext(ctx, c.baseURL, form)
-		if err != nil {
-			// Could be transient HTTP error, e.g. EOF error
-			time.Sleep(sleepDuration)
-			continue
-		}
-		buf = new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		var data struct {
-			Return bool   `json:"return"`
-			Reason string `json:"reason"`
-		}
-		err = json.Unmarshal(buf.Bytes(), &data)
-		if err != nil {
-			return fmt.Errorf("decode check_task_status failed: %v\n Body: %s", err, buf.String())
-		}
-		if !data.Return {
-			if data.Reason != "REQUEST_IN_PROGRESS" {
-				return fmt.Errorf("rest API %s POST failed: %s", action, data.Reason)
-			}
-
-			// Not done yet
-			time.Sleep(sleepDuration)
-			continue
-		}
-
-		// Async API is done, return result of checkFunc
-		return checkFunc(action, "Post", "", data.Return)
-	}
-	// Waited for too long and async API never finished
-	return fmt.Errorf("waited %s but upgrade never finished. Please manually verify the upgrade status", maxPoll*sleepDuration)
+	return c.WaitForAsyncTask(ctx, action, requestID, func(action, method, reason string, ret bool) error {
+		// The result of set_cert_domain is not a reason
+		return checkFunc(action, method, "", ret)
+	}, AsyncPollConfig{FailWithoutReason: true})
 }
@@ -10,7 +10,6 @@ import (
 func (c *Client) SetControllerBgpMaxAsLimit(ctx context.Context, maxAsLimit int) error {
 	data := map[string]string{
 		"action":       "set_bgp_max_as_limit",
-		"CID":          c.CID,
 		"max_as_limit": fmt.Sprint(maxAsLimit),
 	}
 
@@ -26,7 +25,6 @@ func (c *Client) SetControllerBgpMaxAsLimit(ctx context.Context, maxAsLimit int)
 func (c *Client) DisableControllerBgpMaxAsLimit(ctx context.Context) error {
 	data := map[string]string{
 		"action":       "set_bgp_max_as_limit",
-		"CID":          c.CID,
 		"max_as_limit": "",
 	}
 
@@ -42,7 +40,6 @@ func (c *Client) DisableControllerBgpMaxAsLimit(ctx context.Context) error {
 func (c *Client) GetControllerBgpMaxAsLimit(ctx context.Context) (int, error) {
 	data := map[string]string{
 		"action": "show_bgp_max_as_limit",
-		"CID":    c.CID,
 	}
 
 	type BgpMaxAsLimitResults struct {
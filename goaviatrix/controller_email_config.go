@@ -6,7 +6,6 @@ import (
 )
 
 type EmailConfiguration struct {
-	CID                              string `form:"CID,omitempty"`
 	Action                           string `form:"action,omitempty"`
 	AdminAlertEmail                  string `form:"admin_alert_email,omitempty"`
 	CriticalAlertEmail               string `form:"critical_alert_email,omitempty"`
@@ -61,7 +60,6 @@ func (c *Client) ConfigNotificationEmails(ctx context.Context, emailConfiguratio
 	}
 
 	form := map[string]interface{}{
-		"CID":              c.CID,
 		"action":           "add_notif_email_addr",
 		"notif_email_args": notificationEmailArgs,
 	}
@@ -71,7 +69,6 @@ func (c *Client) ConfigNotificationEmails(ctx context.Context, emailConfiguratio
 
 func (c *Client) GetNotificationEmails(ctx context.Context) (*EmailConfiguration, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_notif_email_addr",
 	}
 
@@ -88,7 +85,6 @@ func (c *Client) GetNotificationEmails(ctx context.Context) (*EmailConfiguration
 	}
 
 	form1 := map[string]string{
-		"CID":    c.CID,
 		"action": "get_rate_limit_emails",
 	}
 
@@ -117,7 +113,6 @@ func (c *Client) GetNotificationEmails(ctx context.Context) (*EmailConfiguration
 
 func (c *Client) SetStatusChangeNotificationInterval(emailConfiguration *EmailConfiguration) error {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "set_rate_limit_emails",
 		"send_rate": strconv.Itoa(emailConfiguration.StatusChangeNotificationInterval),
 	}
@@ -15,7 +15,6 @@ type ControllerPrivateModeConfig struct {
 func (c *Client) EnablePrivateMode(ctx context.Context) error {
 	action := "enable_private_mode"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 	}
 
@@ -35,7 +34,6 @@ func (c *Client) EnablePrivateMode(ctx context.Context) error {
 func (c *Client) DisablePrivateMode(ctx context.Context) error {
 	action := "disable_private_mode"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 	}
 
@@ -55,7 +53,6 @@ func (c *Client) DisablePrivateMode(ctx context.Context) error {
 func (c *Client) UpdatePrivateModeCopilot(ctx context.Context, copilotId string) error {
 	action := "update_private_mode_copilot"
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      action,
 		"instance_id": copilotId,
 	}
@@ -66,7 +63,6 @@ func (c *Client) UpdatePrivateModeCopilot(ctx context.Context, copilotId string)
 func (c *Client) UpdatePrivateModeControllerProxies(ctx context.Context, proxies []string) error {
 	action := "update_private_mode_controller_proxies"
 	form := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"instance_ids": proxies,
 	}
@@ -77,7 +73,6 @@ func (c *Client) UpdatePrivateModeControllerProxies(ctx context.Context, proxies
 func (c *Client) GetPrivateModeInfo(ctx context.Context) (*ControllerPrivateModeConfig, error) {
 	action := "get_private_mode_info"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 	}
 	controllerPrivateModeConfig := &ControllerPrivateModeConfig{}
@@ -120,7 +115,6 @@ func (c *Client) GetPrivateModeInfo(ctx context.Context) (*ControllerPrivateMode
 func (c *Client) GetPrivateModeProxies(ctx context.Context, lbVpcId string) ([]*PrivateModeMulticloudProxy, error) {
 	action := "get_private_mode_info"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 	}
 
@@ -14,7 +14,6 @@ type PrivateOobResp struct {
 func (c *Client) EnablePrivateOob() error {
 	data := map[string]string{
 		"action": "enable_private_oob",
-		"CID":    c.CID,
 	}
 	checkFunc := func(action, method, reason string, ret bool) error {
 		if !ret && !strings.HasPrefix(reason, "enable already") {
@@ -28,7 +27,6 @@ func (c *Client) EnablePrivateOob() error {
 func (c *Client) GetPrivateOobState() (bool, error) {
 	var data PrivateOobResp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_private_oob_state",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -46,7 +44,6 @@ func (c *Client) GetPrivateOobState() (bool, error) {
 func (c *Client) DisablePrivateOob() error {
 	data := map[string]string{
 		"action": "disable_private_oob",
-		"CID":    c.CID,
 	}
 	checkFunc := func(action, method, reason string, ret bool) error {
 		if !ret && !strings.HasPrefix(reason, "disable already") {
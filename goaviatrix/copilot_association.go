@@ -5,7 +5,6 @@ import "context"
 func (c *Client) EnableCopilotAssociation(ctx context.Context, addr string) error {
 	form := map[string]string{
 		"action":     "enable_copilot_association",
-		"CID":        c.CID,
 		"copilot_ip": addr,
 	}
 	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
@@ -14,7 +13,6 @@ func (c *Client) EnableCopilotAssociation(ctx context.Context, addr string) erro
 func (c *Client) DisableCopilotAssociation(ctx context.Context) error {
 	form := map[string]string{
 		"action": "disable_copilot_association",
-		"CID":    c.CID,
 	}
 	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
@@ -28,7 +26,6 @@ type CopilotAssociationStatus struct {
 func (c *Client) GetCopilotAssociationStatus(ctx context.Context) (*CopilotAssociationStatus, error) {
 	form := map[string]string{
 		"action": "get_copilot_association_status",
-		"CID":    c.CID,
 	}
 	var resp struct {
 		APIResp
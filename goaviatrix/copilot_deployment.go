@@ -4,7 +4,6 @@ import "context"
 
 type CopilotSimpleDeployment struct {
 	Action                           string `json:"action,omitempty"`
-	CID                              string `json:"CID,omitempty"`
 	CloudType                        int    `json:"cloud_type,omitempty"`
 	AccountName                      string `json:"account_name,omitempty"`
 	Region                           string `json:"vpc_region,omitempty"`
@@ -20,7 +19,6 @@ type CopilotSimpleDeployment struct {
 
 type CopilotFaultTolerantDeployment struct {
 	Action                           string             `json:"action,omitempty"`
-	CID                              string             `json:"CID,omitempty"`
 	CloudType                        int                `json:"cloud_type,omitempty"`
 	AccountName                      string             `json:"account_name,omitempty"`
 	Region                           string             `json:"region_name,omitempty"`
@@ -49,7 +47,6 @@ type ClusterDataNode struct {
 
 func (c *Client) CreateCopilotSimple(ctx context.Context, copilotDeployment *CopilotSimpleDeployment) error {
 	copilotDeployment.Action = "deploy_copilot"
-	copilotDeployment.CID = c.CID
 	copilotDeployment.IsCluster = false
 	copilotDeployment.Async = true
 
@@ -63,7 +60,6 @@ func (c *Client) CreateCopilotSimple(ctx context.Context, copilotDeployment *Cop
 
 func (c *Client) CreateCopilotFaultTolerant(ctx context.Context, copilotFaultTolerantDeployment *CopilotFaultTolerantDeployment) error {
 	copilotFaultTolerantDeployment.Action = "deploy_copilot"
-	copilotFaultTolerantDeployment.CID = c.CID
 	copilotFaultTolerantDeployment.IsCluster = true
 	copilotFaultTolerantDeployment.Async = true
 
@@ -78,7 +74,6 @@ func (c *Client) CreateCopilotFaultTolerant(ctx context.Context, copilotFaultTol
 func (c *Client) DeleteCopilotSimple(ctx context.Context) error {
 	form := map[string]string{
 		"action": "cleanup_copilot",
-		"CID":    c.CID,
 		"async":  "false",
 	}
 
@@ -88,7 +83,6 @@ func (c *Client) DeleteCopilotSimple(ctx context.Context) error {
 func (c *Client) DeleteCopilotFaultTolerant(ctx context.Context) error {
 	form := map[string]string{
 		"action":     "cleanup_copilot",
-		"CID":        c.CID,
 		"async":      "false",
 		"is_cluster": "true",
 	}
@@ -8,7 +8,6 @@ import (
 
 type CopilotSecurityGroupManagementConfig struct {
 	Action                               string `json:"action,omitempty"`
-	CID                                  string `json:"CID,omitempty"`
 	CloudType                            int    `json:"cloud_type,omitempty"`
 	AccountName                          string `json:"account_name,omitempty"`
 	Region                               string `json:"region,omitempty"`
@@ -23,7 +22,6 @@ type CopilotSecurityGroupManagementConfig struct {
 
 func (c *Client) EnableCopilotSecurityGroupManagement(ctx context.Context, copilotSecurityGroupManagementConfig *CopilotSecurityGroupManagementConfig) error {
 	copilotSecurityGroupManagementConfig.Action = "enable_copilot_sg"
-	copilotSecurityGroupManagementConfig.CID = c.CID
 	copilotSecurityGroupManagementConfig.LogEnable = true
 
 	return c.PostAPIContext2(ctx, nil, copilotSecurityGroupManagementConfig.Action, copilotSecurityGroupManagementConfig, BasicCheck)
@@ -32,7 +30,6 @@ func (c *Client) EnableCopilotSecurityGroupManagement(ctx context.Context, copil
 func (c *Client) GetCopilotSecurityGroupManagementConfig(ctx context.Context) (*CopilotSecurityGroupManagementConfig, error) {
 	form := map[string]string{
 		"action": "get_copilot_sg",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -54,7 +51,6 @@ func (c *Client) GetCopilotSecurityGroupManagementConfig(ctx context.Context) (*
 func (c *Client) DisableCopilotSecurityGroupManagement(ctx context.Context) error {
 	form := map[string]string{
 		"action":     "disable_copilot_sg",
-		"CID":        c.CID,
 		"log_enable": "true",
 	}
 
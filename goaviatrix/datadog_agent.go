@@ -3,7 +3,6 @@ package goaviatrix
 import "strconv"
 
 type DatadogAgent struct {
-	CID                   string
 	ApiKey                string
 	Site                  string
 	ExcludedGatewaysInput string
@@ -21,7 +20,6 @@ type DatadogAgentResp struct {
 func (c *Client) EnableDatadogAgent(r *DatadogAgent) error {
 	params := map[string]string{
 		"action":               "enable_datadog_agent_logging",
-		"CID":                  c.CID,
 		"api_key":              r.ApiKey,
 		"site":                 r.Site,
 		"exclude_gateway_list": r.ExcludedGatewaysInput,
@@ -34,7 +32,6 @@ func (c *Client) EnableDatadogAgent(r *DatadogAgent) error {
 func (c *Client) GetDatadogAgentStatus() (*DatadogAgentResp, error) {
 	params := map[string]string{
 		"action": "get_datadog_agent_logging_status",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -60,7 +57,6 @@ func (c *Client) GetDatadogAgentStatus() (*DatadogAgentResp, error) {
 func (c *Client) DisableDatadogAgent() error {
 	params := map[string]string{
 		"action": "disable_datadog_agent_logging",
-		"CID":    c.CID,
 	}
 
 	return c.PostAPI(params["action"], params, BasicCheck)
@@ -1,16 +1,14 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // Device represents a device used in CloudWAN
 type Device struct {
 	Action             string `form:"action,omitempty" json:"-"`
-	CID                string `form:"CID,omitempty" json:"-"`
 	Name               string `form:"device_name,omitempty" json:"rgw_name"`
 	PublicIP           string `form:"public_ip,omitempty" json:"hostname"`
 	Username           string `form:"username,omitempty" json:"username"`
@@ -49,7 +47,6 @@ func (c *Client) GetDevice(d *Device) (*Device, error) {
 	}
 	var data Resp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_cloudwan_devices_summary",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -64,7 +61,7 @@ func (c *Client) GetDevice(d *Device) (*Device, error) {
 		}
 	}
 	if foundDevice == nil {
-		log.Errorf("Could not find Aviatrix device %s", d.Name)
+		logError(c.logContext(context.Background()), "Could not find Aviatrix device", map[string]interface{}{"device_name": d.Name})
 		return nil, ErrNotFound
 	}
 
@@ -79,7 +76,6 @@ func (c *Client) GetDeviceName(connName string) (string, error) {
 	}
 	var data Resp
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_cloudwan_devices_summary",
 	}
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
@@ -102,7 +98,6 @@ func (c *Client) GetDeviceName(connName string) (string, error) {
 
 func (c *Client) GetDeviceInterfaces(deviceName string) (*[]DeviceWanInterface, error) {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "get_cloudwan_device_wan_interfaces",
 		"device_name": deviceName,
 	}
@@ -134,7 +129,6 @@ func (c *Client) ConfigureDeviceInterfaces(config *DeviceInterfaceConfig) error
 	}
 
 	form := map[string]string{
-		"CID":            c.CID,
 		"action":         "config_cloudwan_device_wan_interfaces",
 		"device_name":    config.DeviceName,
 		"wan_primary_if": config.PrimaryInterface,
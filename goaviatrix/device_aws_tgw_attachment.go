@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -14,7 +15,6 @@ type DeviceAwsTgwAttachment struct {
 	SecurityDomainName      string `form:"route_domain_name"`
 	EnableGlobalAccelerator string `form:"enable_global_accelerator"`
 	Action                  string `form:"action"`
-	CID                     string `form:"CID"`
 	Async                   bool   `form:"async,omitempty"`
 }
 
@@ -22,17 +22,15 @@ func (b *DeviceAwsTgwAttachment) ID() string {
 	return b.ConnectionName + "~" + b.DeviceName + "~" + b.AwsTgwName
 }
 
-func (c *Client) CreateDeviceAwsTgwAttachment(attachment *DeviceAwsTgwAttachment) error {
+func (c *Client) CreateDeviceAwsTgwAttachment(ctx context.Context, attachment *DeviceAwsTgwAttachment) error {
 	attachment.Action = "attach_cloudwan_device_to_aws_tgw"
-	attachment.CID = c.CID
 	attachment.Async = true
-	return c.PostAsyncAPI(attachment.Action, attachment, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, attachment.Action, attachment, BasicCheck)
 }
 
 func (c *Client) GetDeviceAwsTgwAttachment(tgwAttachment *DeviceAwsTgwAttachment) (*DeviceAwsTgwAttachment, error) {
 	form := map[string]string{
 		"action":                    "list_tgw_details",
-		"CID":                       c.CID,
 		"connection_name":           tgwAttachment.ConnectionName,
 		"device_name":               tgwAttachment.DeviceName,
 		"tgw_name":                  tgwAttachment.AwsTgwName,
@@ -11,13 +11,11 @@ type DeviceTag struct {
 	Config        string `form:"custom_cfg,omitempty"`
 	Devices       []string
 	DevicesString string `form:"include_device_list,omitempty"`
-	CID           string `form:"CID"`
 	Action        string `form:"action"`
 }
 
 func (c *Client) CreateDeviceTag(deviceTag *DeviceTag) error {
 	// Create the tag
-	deviceTag.CID = c.CID
 	deviceTag.Action = "add_cloudwan_configtag"
 	err := c.PostAPI(deviceTag.Action, deviceTag, BasicCheck)
 	if err != nil {
@@ -46,7 +44,6 @@ func (c *Client) GetDeviceTag(brt *DeviceTag) (*DeviceTag, error) {
 	// Check if a tag exists with the given name
 	form := map[string]string{
 		"action":              "list_cloudwan_configtag_names",
-		"CID":                 c.CID,
 		"tag_name":            brt.Name,
 		"custom_cfg":          brt.Config,
 		"include_device_list": brt.DevicesString,
@@ -90,13 +87,11 @@ func (c *Client) GetDeviceTag(brt *DeviceTag) (*DeviceTag, error) {
 }
 
 func (c *Client) UpdateDeviceTagConfig(brt *DeviceTag) error {
-	brt.CID = c.CID
 	brt.Action = "edit_cloudwan_configtag"
 	return c.PostAPI(brt.Action, brt, BasicCheck)
 }
 
 func (c *Client) AttachDeviceTag(brt *DeviceTag) error {
-	brt.CID = c.CID
 	brt.Action = "attach_devices_to_cloudwan_configtag"
 	brt.DevicesString = strings.Join(brt.Devices, ", ")
 	return c.PostAPI(brt.Action, brt, BasicCheck)
@@ -128,13 +123,11 @@ func (c *Client) CommitDeviceTag(brt *DeviceTag) error {
 }
 
 func (c *Client) commitDeviceTagOnce(brt *DeviceTag) error {
-	brt.CID = c.CID
 	brt.Action = "commit_cloudwan_configtag_to_devices"
 	return c.PostAPI(brt.Action, brt, BasicCheck)
 }
 
 func (c *Client) DeleteDeviceTag(brt *DeviceTag) error {
-	brt.CID = c.CID
 	brt.Action = "delete_cloudwan_configtag"
 	return c.PostAPI(brt.Action, brt, BasicCheck)
 }
@@ -12,7 +12,6 @@ type DistributedFirewallingConfig struct {
 func (c *Client) EnableDistributedFirewalling(ctx context.Context) error {
 	action := "enable_controller_feature"
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  action,
 		"feature": "microseg",
 	}
@@ -30,7 +29,6 @@ func (c *Client) EnableDistributedFirewalling(ctx context.Context) error {
 func (c *Client) DisableDistributedFirewalling(ctx context.Context) error {
 	action := "disable_controller_feature"
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  action,
 		"feature": "microseg",
 	}
@@ -48,7 +46,6 @@ func (c *Client) DisableDistributedFirewalling(ctx context.Context) error {
 func (c *Client) GetDistributedFirewallingStatus(ctx context.Context) (*DistributedFirewallingConfig, error) {
 	action := "get_controller_feature"
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  action,
 		"feature": "microseg",
 	}
@@ -22,7 +22,6 @@ type DNSProfileListResp struct {
 func (c *Client) CreateDNSProfile(ctx context.Context, data map[string]interface{}) error {
 	form := map[string]string{
 		"action": "create_dns_profile",
-		"CID":    c.CID,
 	}
 
 	profiles, err := json.Marshal(data)
@@ -38,7 +37,6 @@ func (c *Client) CreateDNSProfile(ctx context.Context, data map[string]interface
 func (c *Client) GetDNSProfile(ctx context.Context, name string) (map[string]interface{}, error) {
 	form := map[string]string{
 		"action": "list_dns_profile",
-		"CID":    c.CID,
 	}
 
 	var data DNSProfileListResp
@@ -62,7 +60,6 @@ func (c *Client) GetDNSProfile(ctx context.Context, name string) (map[string]int
 func (c *Client) UpdateDNSProfile(ctx context.Context, data map[string]interface{}) error {
 	form := map[string]string{
 		"action": "update_dns_profile",
-		"CID":    c.CID,
 	}
 
 	profiles, err := json.Marshal(data)
@@ -78,7 +75,6 @@ func (c *Client) UpdateDNSProfile(ctx context.Context, data map[string]interface
 func (c *Client) DeleteDNSProfile(ctx context.Context, data map[string]interface{}) error {
 	form := map[string]string{
 		"action": "delete_dns_profile",
-		"CID":    c.CID,
 	}
 
 	profiles, err := json.Marshal(data)
@@ -9,7 +9,6 @@ import (
 
 type EdgeCSP struct {
 	Action                             string `json:"action,omitempty"`
-	CID                                string `json:"CID,omitempty"`
 	AccountName                        string `json:"account_name,omitempty"`
 	GwName                             string `json:"name,omitempty"`
 	SiteId                             string `json:"site_id,omitempty"`
@@ -137,7 +136,6 @@ type EdgeCSPListResp struct {
 
 func (c *Client) CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
 	edgeCSP.Action = "create_edge_csp_instance"
-	edgeCSP.CID = c.CID
 	edgeCSP.NoProgressBar = true
 
 	interfaces, err := json.Marshal(edgeCSP.InterfaceList)
@@ -169,7 +167,6 @@ func (c *Client) CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
 func (c *Client) GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -199,7 +196,6 @@ func (c *Client) GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, e
 func (c *Client) DeleteEdgeCSP(ctx context.Context, accountName, name string) error {
 	form := map[string]string{
 		"action":       "delete_edge_csp_instance",
-		"CID":          c.CID,
 		"account_name": accountName,
 		"name":         name,
 	}
@@ -209,7 +205,6 @@ func (c *Client) DeleteEdgeCSP(ctx context.Context, accountName, name string) er
 
 func (c *Client) UpdateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
 	edgeCSP.Action = "update_edge_gateway"
-	edgeCSP.CID = c.CID
 
 	interfaces, err := json.Marshal(edgeCSP.InterfaceList)
 	if err != nil {
@@ -8,7 +8,6 @@ import (
 
 type EdgeCSPHa struct {
 	Action                    string `json:"action"`
-	CID                       string `json:"CID"`
 	PrimaryGwName             string `json:"primary_gw_name"`
 	ComputeNodeUuid           string `json:"compute_node_uuid"`
 	Dhcp                      bool   `json:"dhcp,omitempty"`
@@ -38,7 +37,6 @@ type EdgeCSPHaListResp struct {
 }
 
 func (c *Client) CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error) {
-	edgeCSPHa.CID = c.CID
 	edgeCSPHa.Action = "create_multicloud_ha_gateway"
 	edgeCSPHa.NoProgressBar = true
 
@@ -55,7 +53,6 @@ func (c *Client) CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (str
 func (c *Client) GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -79,7 +76,6 @@ func (c *Client) GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaRes
 func (c *Client) UpdateEdgeCSPHa(ctx context.Context, edgeCSP *EdgeCSP) error {
 	form := map[string]string{
 		"action":         "update_edge_gateway",
-		"CID":            c.CID,
 		"name":           edgeCSP.GwName,
 		"mgmt_egress_ip": edgeCSP.ManagementEgressIpPrefix,
 	}
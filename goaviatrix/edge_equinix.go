@@ -10,7 +10,6 @@ import (
 
 type EdgeEquinix struct {
 	Action                             string `json:"action,omitempty"`
-	CID                                string `json:"CID,omitempty"`
 	AccountName                        string `json:"account_name,omitempty"`
 	GwName                             string `json:"name,omitempty"`
 	SiteId                             string `json:"site_id,omitempty"`
@@ -133,7 +132,6 @@ type CreateEdgeEquinixResp struct {
 
 func (c *Client) CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error {
 	edgeEquinix.Action = "create_equinix_instance"
-	edgeEquinix.CID = c.CID
 	edgeEquinix.NoProgressBar = true
 
 	interfaces, err := json.Marshal(edgeEquinix.InterfaceList)
@@ -179,7 +177,6 @@ func (c *Client) CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix
 func (c *Client) GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -209,7 +206,6 @@ func (c *Client) GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquini
 func (c *Client) DeleteEdgeEquinix(ctx context.Context, accountName, name string) error {
 	form := map[string]string{
 		"action":       "delete_equinix_instance",
-		"CID":          c.CID,
 		"account_name": accountName,
 		"name":         name,
 	}
@@ -219,7 +215,6 @@ func (c *Client) DeleteEdgeEquinix(ctx context.Context, accountName, name string
 
 func (c *Client) UpdateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error {
 	edgeEquinix.Action = "update_edge_gateway"
-	edgeEquinix.CID = c.CID
 
 	interfaces, err := json.Marshal(edgeEquinix.InterfaceList)
 	if err != nil {
@@ -9,7 +9,6 @@ import (
 
 type EdgeEquinixHa struct {
 	Action                   string `json:"action"`
-	CID                      string `json:"CID"`
 	PrimaryGwName            string `json:"primary_gw_name"`
 	ZtpFileDownloadPath      string
 	InterfaceList            []*EdgeEquinixInterface
@@ -33,7 +32,6 @@ type EdgeEquinixHaListResp struct {
 }
 
 func (c *Client) CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error) {
-	edgeEquinixHa.CID = c.CID
 	edgeEquinixHa.Action = "create_multicloud_ha_gateway"
 	edgeEquinixHa.NoProgressBar = true
 
@@ -69,7 +67,6 @@ func (c *Client) CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEqu
 func (c *Client) GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -93,7 +90,6 @@ func (c *Client) GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEqui
 func (c *Client) UpdateEdgeEquinixHa(ctx context.Context, edgeEquinix *EdgeEquinix) error {
 	form := map[string]string{
 		"action":         "update_edge_gateway",
-		"CID":            c.CID,
 		"name":           edgeEquinix.GwName,
 		"mgmt_egress_ip": edgeEquinix.ManagementEgressIpPrefix,
 	}
@@ -4,7 +4,6 @@ import "context"
 
 type EdgeExternalDeviceConn struct {
 	Action                 string `json:"action,omitempty"`
-	CID                    string `json:"CID,omitempty"`
 	VpcID                  string `json:"vpc_id,omitempty"`
 	ConnectionName         string `json:"conn_name,omitempty"`
 	GwName                 string `json:"gw_name,omitempty"`
@@ -55,14 +54,12 @@ type EdgeExternalDeviceConn struct {
 }
 
 func (c *Client) CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
-	edgeExternalDeviceConn.CID = c.CID
 	edgeExternalDeviceConn.Action = "transit_connect_external_device"
 
 	return c.PostAPIContext2WithResult(context.Background(), nil, edgeExternalDeviceConn.Action, edgeExternalDeviceConn, BasicCheck)
 }
 
 func (c *Client) DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
-	edgeExternalDeviceConn.CID = c.CID
 	edgeExternalDeviceConn.Action = "transit_disconnect"
 
 	return c.PostAPIContext2(context.Background(), nil, edgeExternalDeviceConn.Action, edgeExternalDeviceConn, BasicCheck)
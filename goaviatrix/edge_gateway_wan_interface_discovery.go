@@ -18,7 +18,6 @@ type WanInterfaceResp struct {
 func (c *Client) GetEdgeGatewayWanIp(ctx context.Context, gwName, wanInterfaceName string) (string, error) {
 	form := map[string]string{
 		"action":        "discovery_edge_gateway_wan_ip",
-		"CID":           c.CID,
 		"name":          gwName,
 		"wan_interface": wanInterfaceName,
 	}
@@ -9,7 +9,6 @@ import (
 
 type EdgeNEO struct {
 	Action                             string `json:"action,omitempty"`
-	CID                                string `json:"CID,omitempty"`
 	AccountName                        string `json:"account_name,omitempty"`
 	GwName                             string `json:"name,omitempty"`
 	SiteId                             string `json:"site_id,omitempty"`
@@ -28,7 +27,7 @@ type EdgeNEO struct {
 	PrependAsPathReturn                string   `json:"prepend_as_path,omitempty"`
 	IncludeCidrList                    []string `json:"include_cidr_list,omitempty"`
 	EnableLearnedCidrsApproval         bool     `json:"enable_learned_cidrs_approval,omitempty"`
-	ApprovedLearnedCidrs               []string `json:"approved_learned_cidrs,omitempty,omitempty"`
+	ApprovedLearnedCidrs               []string `json:"approved_learned_cidrs,omitempty"`
 	SpokeBgpManualAdvertisedCidrs      []string `json:"bgp_manual_spoke_advertise_cidrs,omitempty"`
 	EnablePreserveAsPath               bool     `json:"preserve_as_path,omitempty"`
 	BgpPollingTime                     int      `json:"bgp_polling_time,omitempty"`
@@ -130,7 +129,6 @@ type EdgeNEOListResp struct {
 
 func (c *Client) CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
 	edgeNEO.Action = "create_edge_csp_gateway"
-	edgeNEO.CID = c.CID
 	edgeNEO.NoProgressBar = true
 	edgeNEO.DirectAttachLan = true
 
@@ -163,7 +161,6 @@ func (c *Client) CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
 func (c *Client) GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -193,7 +190,6 @@ func (c *Client) GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, e
 func (c *Client) DeleteEdgeNEO(ctx context.Context, accountName, name string) error {
 	form := map[string]string{
 		"action":       "delete_edge_csp_gateway",
-		"CID":          c.CID,
 		"account_name": accountName,
 		"name":         name,
 	}
@@ -203,7 +199,6 @@ func (c *Client) DeleteEdgeNEO(ctx context.Context, accountName, name string) er
 
 func (c *Client) UpdateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
 	edgeNEO.Action = "update_edge_gateway"
-	edgeNEO.CID = c.CID
 
 	interfaces, err := json.Marshal(edgeNEO.InterfaceList)
 	if err != nil {
@@ -8,7 +8,6 @@ import (
 
 type EdgeNEODevice struct {
 	Action                 string                  `json:"action,omitempty"`
-	CID                    string                  `json:"CID,omitempty"`
 	AccountName            string                  `json:"account_name,omitempty"`
 	DeviceName             string                  `json:"device_name,omitempty"`
 	SerialNumber           string                  `json:"serial,omitempty"`
@@ -45,7 +44,6 @@ type EdgeNEODeviceListResp struct {
 
 func (c *Client) OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
 	edgeNEODevice.Action = "onboard_edge_csp_device"
-	edgeNEODevice.CID = c.CID
 
 	err := c.PostAPIContext2(ctx, nil, edgeNEODevice.Action, edgeNEODevice, BasicCheck)
 	if err != nil {
@@ -58,7 +56,6 @@ func (c *Client) OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNE
 func (c *Client) GetEdgeNEODevice(ctx context.Context, accountName, deviceName string) (*EdgeNEODeviceResp, error) {
 	form := map[string]string{
 		"action":       "list_edge_csp_devices",
-		"CID":          c.CID,
 		"account_name": accountName,
 	}
 
@@ -81,7 +78,6 @@ func (c *Client) GetEdgeNEODevice(ctx context.Context, accountName, deviceName s
 
 func (c *Client) UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
 	edgeNEODevice.Action = "update_edge_csp_device"
-	edgeNEODevice.CID = c.CID
 
 	err := c.PostAPIContext2(ctx, nil, edgeNEODevice.Action, edgeNEODevice, BasicCheck)
 	if err != nil {
@@ -94,7 +90,6 @@ func (c *Client) UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEO
 func (c *Client) DeleteEdgeNEODevice(ctx context.Context, accountName, serialNumber string) error {
 	form := map[string]string{
 		"action":       "delete_edge_csp_device",
-		"CID":          c.CID,
 		"account_name": accountName,
 		"serial":       serialNumber,
 	}
@@ -104,7 +99,6 @@ func (c *Client) DeleteEdgeNEODevice(ctx context.Context, accountName, serialNum
 
 func (c *Client) DownloadEdgeNEOConfigFile(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
 	edgeNEODevice.Action = "get_edge_csp_bootstrap_usb"
-	edgeNEODevice.CID = c.CID
 
 	resp, err := c.PostAPIContext2Download(ctx, edgeNEODevice.Action, edgeNEODevice, BasicCheck)
 	if err != nil {
@@ -8,7 +8,6 @@ import (
 
 type EdgeNEOHa struct {
 	Action                   string `json:"action"`
-	CID                      string `json:"CID"`
 	PrimaryGwName            string `json:"primary_gw_name"`
 	DeviceId                 string `json:"device_id"`
 	InterfaceList            []*EdgeNEOInterface
@@ -34,7 +33,6 @@ type EdgeNEOHaListResp struct {
 }
 
 func (c *Client) CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error) {
-	edgeNEOHa.CID = c.CID
 	edgeNEOHa.Action = "create_multicloud_ha_gateway"
 	edgeNEOHa.NoProgressBar = true
 	edgeNEOHa.DirectAttachLan = true
@@ -52,7 +50,6 @@ func (c *Client) CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (str
 func (c *Client) GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -76,7 +73,6 @@ func (c *Client) GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaRes
 func (c *Client) UpdateEdgeNEOHa(ctx context.Context, edgeNEO *EdgeNEO) error {
 	form := map[string]string{
 		"action":         "update_edge_gateway",
-		"CID":            c.CID,
 		"name":           edgeNEO.GwName,
 		"mgmt_egress_ip": edgeNEO.ManagementEgressIpPrefix,
 	}
@@ -16,7 +16,6 @@ import (
 
 type EdgeSpoke struct {
 	Action                             string `json:"action,omitempty"`
-	CID                                string `json:"CID,omitempty"`
 	Type                               string `json:"type,omitempty"`
 	Caag                               bool   `json:"caag,omitempty"`
 	GwName                             string `json:"gateway_name,omitempty"`
@@ -37,7 +36,7 @@ type EdgeSpoke struct {
 	PrependAsPathReturn                string   `json:"prepend_as_path,omitempty"`
 	IncludeCidrList                    []string `json:"include_cidr_list,omitempty"`
 	EnableLearnedCidrsApproval         bool     `json:"enable_learned_cidrs_approval,omitempty"`
-	ApprovedLearnedCidrs               []string `form:"approved_learned_cidrs,omitempty,omitempty"`
+	ApprovedLearnedCidrs               []string `json:"approved_learned_cidrs,omitempty"`
 	SpokeBgpManualAdvertisedCidrs      []string `json:"bgp_manual_spoke_advertise_cidrs,omitempty"`
 	EnablePreserveAsPath               bool     `json:"preserve_as_path,omitempty"`
 	BgpPollingTime                     int      `json:"bgp_polling_time,omitempty"`
@@ -117,7 +116,6 @@ type EdgeSpokeListResp struct {
 
 func (c *Client) CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error {
 	edgeSpoke.Action = "create_edge_gateway"
-	edgeSpoke.CID = c.CID
 	edgeSpoke.Type = "spoke"
 	edgeSpoke.Caag = false
 
@@ -156,7 +154,6 @@ func (c *Client) CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) erro
 func (c *Client) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -185,7 +182,6 @@ func (c *Client) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeRes
 
 func (c *Client) UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error {
 	edgeSpoke.Action = "update_edge_gateway"
-	edgeSpoke.CID = c.CID
 
 	interfaces, err := json.Marshal(edgeSpoke.InterfaceList)
 	if err != nil {
@@ -200,7 +196,6 @@ func (c *Client) UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) erro
 func (c *Client) DeleteEdgeSpoke(ctx context.Context, name string) error {
 	form := map[string]string{
 		"action": "delete_edge_gateway",
-		"CID":    c.CID,
 		"name":   name,
 	}
 
@@ -210,7 +205,6 @@ func (c *Client) DeleteEdgeSpoke(ctx context.Context, name string) error {
 func (c *Client) EnableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error {
 	form := map[string]string{
 		"action":       "enable_edge_transitive_routing",
-		"CID":          c.CID,
 		"gateway_name": name,
 	}
 
@@ -220,7 +214,6 @@ func (c *Client) EnableEdgeSpokeTransitiveRouting(ctx context.Context, name stri
 func (c *Client) DisableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error {
 	form := map[string]string{
 		"action":       "disable_edge_transitive_routing",
-		"CID":          c.CID,
 		"gateway_name": name,
 	}
 
@@ -230,7 +223,6 @@ func (c *Client) DisableEdgeSpokeTransitiveRouting(ctx context.Context, name str
 func (c *Client) UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *EdgeSpoke) error {
 	form := map[string]string{
 		"action":        "update_edge_gateway",
-		"CID":           c.CID,
 		"gateway_name":  edgeSpoke.GwName,
 		"geo_latitude":  edgeSpoke.Latitude,
 		"geo_longitude": edgeSpoke.Longitude,
@@ -9,7 +9,6 @@ import (
 
 type EdgeVmSelfmanagedHa struct {
 	Action                   string `json:"action"`
-	CID                      string `json:"CID"`
 	PrimaryGwName            string `json:"primary_gw_name"`
 	SiteId                   string
 	ZtpFileType              string
@@ -43,7 +42,6 @@ type CreateEdgeVmSelfmanagedHaResp struct {
 }
 
 func (c *Client) CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error) {
-	edgeVmSelfmanagedHa.CID = c.CID
 	edgeVmSelfmanagedHa.Action = "create_multicloud_ha_gateway"
 	edgeVmSelfmanagedHa.NoProgressBar = true
 
@@ -90,7 +88,6 @@ func (c *Client) CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanage
 func (c *Client) GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error) {
 	form := map[string]string{
 		"action":       "list_vpcs_summary",
-		"CID":          c.CID,
 		"gateway_name": gwName,
 	}
 
@@ -114,7 +111,6 @@ func (c *Client) GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*Ed
 func (c *Client) UpdateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error {
 	form := map[string]string{
 		"action":         "update_edge_gateway",
-		"CID":            c.CID,
 		"name":           edgeVmSelfmanaged.GwName,
 		"mgmt_egress_ip": edgeVmSelfmanaged.ManagementEgressIpPrefix,
 	}
@@ -0,0 +1,256 @@
+package goaviatrix
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrorClass classifies why a controller API call failed.
+type ErrorClass int
+
+const (
+	ErrorClassUnknown ErrorClass = iota
+	ErrorClassNotFound
+	ErrorClassAlreadyExists
+	// ErrorClassConflict is returned when another operation on the same object is in progress.
+	ErrorClassConflict
+	ErrorClassUnauthorized
+	ErrorClassValidation
+	// ErrorClassTransient is returned when the controller is temporarily unavailable and the
+	// request can be retried as is.
+	ErrorClassTransient
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassNotFound:
+		return "NotFound"
+	case ErrorClassAlreadyExists:
+		return "AlreadyExists"
+	case ErrorClassConflict:
+		return "Conflict"
+	case ErrorClassUnauthorized:
+		return "Unauthorized"
+	case ErrorClassValidation:
+		return "Validation"
+	case ErrorClassTransient:
+		return "Transient"
+	}
+	return "Unknown"
+}
+
+// APIError is returned when the controller rejects an API call.
+type APIError struct {
+	// Action is the v1/v2 API action
+	Action string
+	// Path is the v2.5 API path
+	Path   string
+	Method string
+	// StatusCode is the HTTP status code of the response, 0 if unknown
+	StatusCode int
+	// Reason is the reason (v1/v2) or message (v2.5) returned by the controller
+	Reason string
+	// PayloadFingerprint identifies the request payload without exposing it, so that failures of
+	// identical requests can be correlated in logs
+	PayloadFingerprint string
+	Class              ErrorClass
+}
+
+func (e *APIError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("HTTP %s %q failed: %s", e.Method, e.Path, e.Reason)
+	}
+	return fmt.Sprintf("rest API %s %s failed: %s", e.Action, e.Method, e.Reason)
+}
+
+// Is allows errors.Is(err, ErrNotFound) to match APIErrors classified as NotFound.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.Class == ErrorClassNotFound
+}
+
+// newAPIError returns an APIError for a failed v1/v2 action.
+func newAPIError(action, method, reason string) *APIError {
+	return &APIError{
+		Action: action,
+		Method: method,
+		Reason: reason,
+		Class:  ClassifyError(0, reason),
+	}
+}
+
+// knownReasons are the texts of controller reasons with a known meaning. They are matched, in
+// order and ignoring case, against the reason. Reasons that only mention a word like "invalid" or
+// "not found" are not classified, since the controller also uses them for unrelated failures,
+// e.g. "Endpoint not found" when the request never reached the object.
+var knownReasons = []struct {
+	text  string
+	class ErrorClass
+}{
+	{"cid is invalid or expired", ErrorClassUnauthorized},
+	{"invalid session. please login again", ErrorClassUnauthorized},
+	{"failed to authenticate", ErrorClassUnauthorized},
+	{"permission denied", ErrorClassUnauthorized},
+	{"does not exist", ErrorClassNotFound},
+	{"doesn't exist", ErrorClassNotFound},
+	{"not found in db", ErrorClassNotFound},
+	{"not found in controller database", ErrorClassNotFound},
+	{"already exists", ErrorClassAlreadyExists},
+	{"already exist", ErrorClassAlreadyExists},
+	{"another operation is in progress", ErrorClassConflict},
+	{"request_in_progress", ErrorClassConflict},
+	{"502 proxy error", ErrorClassTransient},
+	{"503 service unavailable", ErrorClassTransient},
+}
+
+// ClassifyError classifies a failure from the controller reason when it is one of the known
+// reasons, and from the HTTP status code otherwise.
+func ClassifyError(statusCode int, reason string) ErrorClass {
+	r := strings.ToLower(reason)
+	for _, known := range knownReasons {
+		if strings.Contains(r, known.text) {
+			return known.class
+		}
+	}
+
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrorClassNotFound
+	case statusCode == http.StatusConflict:
+		return ErrorClassConflict
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrorClassUnauthorized
+	case statusCode == http.StatusTooManyRequests || statusCode >= 500:
+		return ErrorClassTransient
+	case statusCode >= 400:
+		return ErrorClassValidation
+	}
+	return ErrorClassUnknown
+}
+
+// IsNotFoundReason reports whether the controller reason means the object does not exist.
+func IsNotFoundReason(reason string) bool {
+	return ClassifyError(0, reason) == ErrorClassNotFound
+}
+
+// ErrorClassOf returns the class of err, or ErrorClassUnknown if err is not an APIError.
+func ErrorClassOf(err error) ErrorClass {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Class
+	}
+	if errors.Is(err, ErrNotFound) {
+		return ErrorClassNotFound
+	}
+	return ErrorClassUnknown
+}
+
+// IsNotFound reports whether err means the object does not exist on the controller.
+func IsNotFound(err error) bool {
+	return ErrorClassOf(err) == ErrorClassNotFound
+}
+
+// IsAlreadyExists reports whether err means the object already exists on the controller.
+func IsAlreadyExists(err error) bool {
+	var dupErr DuplicateError
+	return ErrorClassOf(err) == ErrorClassAlreadyExists || errors.As(err, &dupErr)
+}
+
+// IsConflict reports whether err means another operation on the object is in progress.
+func IsConflict(err error) bool {
+	return ErrorClassOf(err) == ErrorClassConflict
+}
+
+// maxErrorBody is the length of the response body kept in the reason of an unexpected response.
+const maxErrorBody = 512
+
+// unexpectedResponseError returns the APIError of a failed response whose body is not a controller
+// response, e.g. the HTML page of a proxy once the retries are exhausted. It is classified from the
+// status code only and its reason is the beginning of the body.
+func unexpectedResponseError(resp *http.Response, method, action, path, body string) error {
+	if len(body) > maxErrorBody {
+		body = body[:maxErrorBody] + "..."
+	}
+	return annotateAPIError(&APIError{
+		Action:     action,
+		Path:       path,
+		Method:     method,
+		StatusCode: resp.StatusCode,
+		Reason:     fmt.Sprintf("unexpected response %s: %s", resp.Status, strings.TrimSpace(body)),
+		Class:      ClassifyError(resp.StatusCode, ""),
+	}, resp)
+}
+
+// failed reports whether resp has a status code other than 2xx.
+func failed(resp *http.Response) bool {
+	return resp.StatusCode < 200 || resp.StatusCode >= 300
+}
+
+// annotateAPIError adds the details of the HTTP exchange to err if it is an APIError.
+func annotateAPIError(err error, resp *http.Response) error {
+	var apiErr *APIError
+	if err == nil || resp == nil || !errors.As(err, &apiErr) {
+		return err
+	}
+	if apiErr.StatusCode == 0 {
+		apiErr.StatusCode = resp.StatusCode
+	}
+	if apiErr.PayloadFingerprint == "" {
+		apiErr.PayloadFingerprint = payloadFingerprint(resp.Request)
+	}
+	return err
+}
+
+// payloadFingerprint returns a short hash of the request parameters, ignoring the CID so that
+// identical requests in different sessions share the same fingerprint.
+func payloadFingerprint(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+
+	var payload []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			payload, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+	if len(payload) == 0 && req.URL != nil {
+		payload = []byte(req.URL.RawQuery)
+	}
+	if len(payload) == 0 {
+		return ""
+	}
+
+	var canonical []byte
+	switch {
+	case strings.Contains(req.Header.Get("Content-Type"), "json"):
+		var m map[string]interface{}
+		if err := json.Unmarshal(payload, &m); err == nil {
+			delete(m, "CID")
+			// Map keys are sorted when marshalled
+			canonical, _ = json.Marshal(m)
+		}
+	case strings.Contains(req.Header.Get("Content-Type"), "multipart"):
+		// The multipart boundary is random, there is nothing stable to fingerprint
+		return ""
+	default:
+		if values, err := url.ParseQuery(string(payload)); err == nil {
+			values.Del("CID")
+			// Encode sorts the values by key
+			canonical = []byte(values.Encode())
+		}
+	}
+	if canonical == nil {
+		canonical = payload
+	}
+
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])[:12]
+}
@@ -0,0 +1,111 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		reason     string
+		want       ErrorClass
+	}{
+		{"not found", 0, "Gateway gw1 does not exist.", ErrorClassNotFound},
+		{"already exists", 0, "Account acc1 already exists", ErrorClassAlreadyExists},
+		{"in progress", 0, "Another operation is in progress for gw1", ErrorClassConflict},
+		{"invalid cid", 0, "CID is invalid or expired.", ErrorClassUnauthorized},
+		{"not found in DB", 0, "FireNet for vpc-1 not found in DB", ErrorClassNotFound},
+		{"unknown invalid", 0, "Invalid gw_size t2.foo", ErrorClassUnknown},
+		{"unknown not found", 0, "Endpoint not found", ErrorClassUnknown},
+		{"proxy error", 0, "502 Proxy Error", ErrorClassTransient},
+		{"v2.5 not found", http.StatusNotFound, "Resource missing", ErrorClassNotFound},
+		{"v2.5 bad request", http.StatusBadRequest, "bad value", ErrorClassValidation},
+		{"v2.5 unavailable", http.StatusServiceUnavailable, "", ErrorClassTransient},
+		{"unknown", 0, "something went wrong", ErrorClassUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.statusCode, tt.reason); got != tt.want {
+				t.Errorf("ClassifyError() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"return":false,"reason":"Gateway gw1 does not exist."}`)
+	})
+
+	form := map[string]string{"action": "get_gateway", "CID": "cid", "gw_name": "gw1"}
+	err := client.PostAPIContext(context.Background(), form["action"], form, DuplicateBasicCheck)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("PostAPIContext() error = %v, want an APIError", err)
+	}
+	if apiErr.Action != "get_gateway" || apiErr.StatusCode != http.StatusOK || apiErr.Class != ErrorClassNotFound {
+		t.Errorf("APIError = %+v, want action get_gateway, status 200 and class NotFound", apiErr)
+	}
+	if apiErr.PayloadFingerprint == "" {
+		t.Errorf("APIError.PayloadFingerprint is empty")
+	}
+	if !IsNotFound(err) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("IsNotFound() = false for %v", err)
+	}
+	if other := newAPIError("get_gateway", "Post", "License not found"); errors.Is(other, ErrNotFound) {
+		t.Errorf("errors.Is(%v, ErrNotFound) = true, want false", other)
+	}
+	if err.Error() != "rest API get_gateway Post failed: Gateway gw1 does not exist." {
+		t.Errorf("APIError.Error() = %q", err.Error())
+	}
+
+	// The fingerprint does not depend on the CID
+	form["CID"] = "other-cid"
+	err = client.PostAPIContext(context.Background(), form["action"], form, BasicCheck)
+	var otherErr *APIError
+	if !errors.As(err, &otherErr) || otherErr.PayloadFingerprint != apiErr.PayloadFingerprint {
+		t.Errorf("PayloadFingerprint = %v, want %q", err, apiErr.PayloadFingerprint)
+	}
+}
+
+func TestUnexpectedResponseError(t *testing.T) {
+	page := "<html><body><h1>502 Bad Gateway</h1>" + strings.Repeat("<p>proxy</p>", 100) + "</body></html>"
+	status := http.StatusBadGateway
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(status)
+		fmt.Fprint(w, page)
+	})
+
+	err := client.GetAPIContext25(context.Background(), nil, "app-domains", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetAPIContext25() error = %v, want an APIError", err)
+	}
+	if apiErr.StatusCode != status || apiErr.Class != ErrorClassTransient || apiErr.Path != "app-domains" {
+		t.Errorf("APIError = %+v, want path app-domains, status 502 and class Transient", apiErr)
+	}
+	if !strings.Contains(apiErr.Reason, "502 Bad Gateway") || len(apiErr.Reason) > maxErrorBody+100 {
+		t.Errorf("APIError.Reason = %q, want the beginning of the body", apiErr.Reason)
+	}
+
+	status = http.StatusNotFound
+	form := map[string]string{"action": "get_gateway", "gw_name": "gw1"}
+	err = client.PostAPIContext(context.Background(), form["action"], form, BasicCheck)
+	if !IsNotFound(err) || ErrorClassOf(err) != ErrorClassNotFound {
+		t.Errorf("PostAPIContext() error = %v, want a NotFound APIError", err)
+	}
+	err = client.PostAPIContext2(context.Background(), nil, form["action"], form, BasicCheck)
+	if !IsNotFound(err) {
+		t.Errorf("PostAPIContext2() error = %v, want a NotFound APIError", err)
+	}
+}
@@ -0,0 +1,90 @@
+package fakecontroller
+
+import (
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func (s *Server) registerAccountHandlers() {
+	s.actions["setup_account_profile"] = s.setupAccountProfile
+	s.actions["edit_account_profile"] = s.editAccountProfile
+	s.actions["delete_account_profile"] = s.deleteAccountProfile
+	s.actions["list_accounts"] = s.listAccounts
+	s.actions["get_account_audit_records"] = s.getAccountAuditRecords
+}
+
+func (s *Server) account(name string) (int, *goaviatrix.Account) {
+	for i, account := range s.accounts {
+		if account.AccountName == name {
+			return i, account
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) setupAccountProfile(r *Request) (interface{}, error) {
+	account := &goaviatrix.Account{}
+	if err := r.Decode(account); err != nil {
+		return nil, err
+	}
+	if account.AccountName == "" {
+		return nil, Errorf("account_name is required")
+	}
+	if _, existing := s.account(account.AccountName); existing != nil {
+		return nil, Errorf("Account %s already exists.", account.AccountName)
+	}
+	if account.CloudType == goaviatrix.AliCloud {
+		// The Alibaba Cloud account ID is returned as the account number
+		account.AwsAccountNumber = account.AlicloudAccountId
+	}
+	s.accounts = append(s.accounts, account)
+	return "An email with instructions has been sent to the account owner.", nil
+}
+
+func (s *Server) editAccountProfile(r *Request) (interface{}, error) {
+	_, account := s.account(r.Get("account_name"))
+	if account == nil {
+		return nil, NotFoundf("Account %s does not exist.", r.Get("account_name"))
+	}
+	if err := r.Decode(account); err != nil {
+		return nil, err
+	}
+	return "Account profile has been updated.", nil
+}
+
+func (s *Server) deleteAccountProfile(r *Request) (interface{}, error) {
+	i, account := s.account(r.Get("account_name"))
+	if account == nil {
+		return nil, NotFoundf("Account %s does not exist.", r.Get("account_name"))
+	}
+	for _, gw := range s.gateways {
+		if gw.AccountName == account.AccountName {
+			return nil, Errorf("Account %s is in use by gateway %s.", account.AccountName, gw.GwName)
+		}
+	}
+	s.accounts = append(s.accounts[:i], s.accounts[i+1:]...)
+	return "Account profile has been deleted.", nil
+}
+
+// listAccounts returns the accounts without their secrets, as the controller does.
+func (s *Server) listAccounts(r *Request) (interface{}, error) {
+	accounts := make([]goaviatrix.Account, 0, len(s.accounts))
+	for _, account := range s.accounts {
+		a := *account
+		a.Action = ""
+		a.AwsSecretKey, a.AwsgovSecretKey, a.AwsChinaSecretKey, a.AlicloudSecretKey = "", "", "", ""
+		a.ArmApplicationClientSecret, a.AzuregovApplicationClientSecret, a.AzureChinaApplicationClientSecret = "", "", ""
+		accounts = append(accounts, a)
+	}
+	return goaviatrix.AccountResult{AccountList: accounts}, nil
+}
+
+func (s *Server) getAccountAuditRecords(r *Request) (interface{}, error) {
+	records := make([]map[string]string, 0, len(s.accounts))
+	for _, account := range s.accounts {
+		records = append(records, map[string]string{
+			"account_name": account.AccountName,
+			"status":       "Pass",
+		})
+	}
+	return records, nil
+}
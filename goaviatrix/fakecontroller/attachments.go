@@ -0,0 +1,67 @@
+package fakecontroller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func (s *Server) registerAttachmentHandlers() {
+	s.actions["attach_spoke_to_transit_gw"] = s.attachSpokeToTransitGw
+	s.actions["detach_spoke_from_transit_gw"] = s.detachSpokeFromTransitGw
+	s.actions["get_inter_transit_gateway_peering_details"] = s.getInterTransitGatewayPeeringDetails
+}
+
+func (s *Server) attachSpokeToTransitGw(r *Request) (interface{}, error) {
+	spoke, err := s.gatewayByParam(r, "spoke_gw")
+	if err != nil {
+		return nil, err
+	}
+	transit, err := s.gatewayByParam(r, "transit_gw")
+	if err != nil {
+		return nil, err
+	}
+	if transit.TransitVpc != "yes" {
+		return nil, Errorf("Gateway %s is not a transit gateway.", transit.GwName)
+	}
+	if spoke.TransitGwName != "" {
+		return nil, Errorf("Spoke gateway %s is already attached to transit gateway %s.", spoke.GwName, spoke.TransitGwName)
+	}
+	spoke.TransitGwName = transit.GwName
+	if routeTables := r.Get("route_table_list"); routeTables != "" {
+		s.routeTables[spoke.GwName] = strings.Split(routeTables, ",")
+	}
+	return fmt.Sprintf("Spoke gateway %s has been attached to transit gateway %s.", spoke.GwName, transit.GwName), nil
+}
+
+func (s *Server) detachSpokeFromTransitGw(r *Request) (interface{}, error) {
+	spoke, err := s.gatewayByParam(r, "spoke_gw")
+	if err != nil {
+		return nil, err
+	}
+	if spoke.TransitGwName == "" || r.Get("transit_gw") != "" && spoke.TransitGwName != r.Get("transit_gw") {
+		return nil, NotFoundf("Attachment of spoke gateway %s to transit gateway %s does not exist.", spoke.GwName, r.Get("transit_gw"))
+	}
+	transit := spoke.TransitGwName
+	spoke.TransitGwName = ""
+	delete(s.routeTables, spoke.GwName)
+	return fmt.Sprintf("Spoke gateway %s has been detached from transit gateway %s.", spoke.GwName, transit), nil
+}
+
+// getInterTransitGatewayPeeringDetails returns the details of a spoke to transit attachment, in
+// either order of the gateways.
+func (s *Server) getInterTransitGatewayPeeringDetails(r *Request) (interface{}, error) {
+	gw1, gw2 := r.Get("gateway1"), r.Get("gateway2")
+	for _, gw := range s.gateways {
+		if gw.GwName == gw1 && gw.TransitGwName == gw2 || gw.GwName == gw2 && gw.TransitGwName == gw1 {
+			return goaviatrix.TransitGatewayPeeringDetailsResults{
+				Site1:       goaviatrix.TransitGatewayPeeringDetail{ExcludedCIDRs: []string{}, ExcludedTGWConnections: []string{}},
+				Site2:       goaviatrix.TransitGatewayPeeringDetail{ExcludedCIDRs: []string{}, ExcludedTGWConnections: []string{}},
+				Tunnels:     []goaviatrix.TunnelsDetail{{SubTunnelCount: 1}},
+				TunnelCount: 1,
+			}, nil
+		}
+	}
+	return nil, NotFoundf("Peering between %s and %s does not exist.", gw1, gw2)
+}
@@ -0,0 +1,22 @@
+package fakecontroller
+
+func (s *Server) registerControllerHandlers() {
+	s.actions["list_version_info"] = s.listVersionInfo
+	s.actions["get_private_mode_info"] = s.getPrivateModeInfo
+}
+
+func (s *Server) listVersionInfo(r *Request) (interface{}, error) {
+	return map[string]string{
+		"current_version":  s.version,
+		"previous_version": s.version,
+		"latest_version":   s.version,
+	}, nil
+}
+
+func (s *Server) getPrivateModeInfo(r *Request) (interface{}, error) {
+	return map[string]interface{}{
+		"contents": map[string]interface{}{
+			"private_mode_enabled": false,
+		},
+	}, nil
+}
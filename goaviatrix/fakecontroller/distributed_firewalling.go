@@ -0,0 +1,46 @@
+package fakecontroller
+
+import (
+	"net/http"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func (s *Server) registerDistributedFirewallingHandlers() {
+	s.HandleRoute(http.MethodGet, "microseg/policy-list", s.getPolicyList)
+	s.HandleRoute(http.MethodPut, "microseg/policy-list", s.putPolicyList)
+	s.HandleRoute(http.MethodDelete, "microseg/policy-list", s.deletePolicyList)
+}
+
+func (s *Server) getPolicyList(r *Request) (interface{}, error) {
+	if s.policyList == nil {
+		return goaviatrix.DistributedFirewallingPolicyList{Policies: []goaviatrix.DistributedFirewallingPolicy{}}, nil
+	}
+	return s.policyList, nil
+}
+
+// putPolicyList replaces the policy list, giving the new policies a UUID.
+func (s *Server) putPolicyList(r *Request) (interface{}, error) {
+	policyList := &goaviatrix.DistributedFirewallingPolicyList{}
+	if err := r.Decode(policyList); err != nil {
+		return nil, Errorf("invalid policy list: %v", err)
+	}
+	for i := range policyList.Policies {
+		policy := &policyList.Policies[i]
+		for _, uuid := range append(policy.SrcSmartGroups, policy.DstSmartGroups...) {
+			if _, smartGroup := s.smartGroup(uuid); smartGroup == nil {
+				return nil, Errorf("Smart group %s of policy %s does not exist.", uuid, policy.Name)
+			}
+		}
+		if policy.UUID == "" {
+			policy.UUID = s.newUUID()
+		}
+	}
+	s.policyList = policyList
+	return nil, nil
+}
+
+func (s *Server) deletePolicyList(r *Request) (interface{}, error) {
+	s.policyList = nil
+	return nil, nil
+}
@@ -0,0 +1,170 @@
+package fakecontroller
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func (s *Server) registerEdgeHandlers() {
+	s.actions["create_edge_gateway"] = s.createEdgeGateway
+	s.actions["update_edge_gateway"] = s.updateEdgeGateway
+	s.actions["delete_edge_gateway"] = s.deleteEdgeGateway
+	s.actions["enable_edge_transitive_routing"] = s.edgeSetting(func(edge *goaviatrix.EdgeSpoke) { edge.EnableEdgeTransitiveRouting = true })
+	s.actions["disable_edge_transitive_routing"] = s.edgeSetting(func(edge *goaviatrix.EdgeSpoke) { edge.EnableEdgeTransitiveRouting = false })
+}
+
+// createEdgeGateway creates an Edge gateway and returns its ZTP file. The Edge gateway is also
+// added to the gateways, which keep the settings it shares with the other gateways.
+func (s *Server) createEdgeGateway(r *Request) (interface{}, error) {
+	edge := &goaviatrix.EdgeSpoke{}
+	if err := r.Decode(edge); err != nil {
+		return nil, Errorf("invalid request: %v", err)
+	}
+	if edge.GwName == "" {
+		return nil, Errorf("gateway_name is required")
+	}
+	if _, gw := s.gateway(edge.GwName); gw != nil {
+		return nil, Errorf("Gateway %s already exists.", edge.GwName)
+	}
+	if err := decodeEdgeInterfaces(edge); err != nil {
+		return nil, err
+	}
+
+	s.edges[edge.GwName] = edge
+	s.gateways = append(s.gateways, &goaviatrix.Gateway{
+		GwName:    edge.GwName,
+		VpcID:     edge.SiteId,
+		InstState: "up",
+		SpokeVpc:  "yes",
+		// the settings of a new gateway, as set by the controller
+		JumboFrame:               edge.EnableJumboFrame,
+		BgpHoldTime:              180,
+		BgpPollingTime:           50,
+		LearnedCidrsApprovalMode: "gateway",
+	})
+	return Download(fmt.Sprintf("# %s ZTP file of %s\n", edge.ZtpFileType, edge.GwName)), nil
+}
+
+// updateEdgeGateway updates the geo coordinate of an Edge gateway when they are sent, and its
+// interfaces and active standby settings otherwise.
+func (s *Server) updateEdgeGateway(r *Request) (interface{}, error) {
+	name := r.Get("gateway_name")
+	edge, ok := s.edges[name]
+	if !ok {
+		return nil, NotFoundf("Gateway %s does not exist.", name)
+	}
+	if _, ok := r.Params["geo_latitude"]; ok {
+		edge.Latitude, edge.Longitude = r.Get("geo_latitude"), r.Get("geo_longitude")
+		return fmt.Sprintf("Gateway %s has been updated.", name), nil
+	}
+
+	update := &goaviatrix.EdgeSpoke{}
+	if err := r.Decode(update); err != nil {
+		return nil, Errorf("invalid request: %v", err)
+	}
+	if update.Interfaces != "" {
+		if err := decodeEdgeInterfaces(update); err != nil {
+			return nil, err
+		}
+		edge.InterfaceList = update.InterfaceList
+	}
+	switch {
+	case update.EnableEdgeActiveStandby:
+		edge.EnableEdgeActiveStandby = true
+	case update.DisableEdgeActiveStandby:
+		edge.EnableEdgeActiveStandby = false
+	}
+	switch {
+	case update.EnableEdgeActiveStandbyPreemptive:
+		edge.EnableEdgeActiveStandbyPreemptive = true
+	case update.DisableEdgeActiveStandbyPreemptive:
+		edge.EnableEdgeActiveStandbyPreemptive = false
+	}
+	return fmt.Sprintf("Gateway %s has been updated.", name), nil
+}
+
+func (s *Server) deleteEdgeGateway(r *Request) (interface{}, error) {
+	name := r.Get("name")
+	i, gw := s.gateway(name)
+	if _, ok := s.edges[name]; !ok || gw == nil {
+		return nil, NotFoundf("Gateway %s does not exist.", name)
+	}
+	s.gateways = append(s.gateways[:i], s.gateways[i+1:]...)
+	delete(s.edges, name)
+	delete(s.approvedLearnedCidrs, name)
+	return fmt.Sprintf("Gateway %s has been deleted.", name), nil
+}
+
+// edgeSetting returns a handler that applies set to the Edge gateway named by the request.
+func (s *Server) edgeSetting(set func(edge *goaviatrix.EdgeSpoke)) HandlerFunc {
+	return func(r *Request) (interface{}, error) {
+		edge, ok := s.edges[r.Get("gateway_name")]
+		if !ok {
+			return nil, NotFoundf("Gateway %s does not exist.", r.Get("gateway_name"))
+		}
+		set(edge)
+		return fmt.Sprintf("Gateway %s has been updated.", edge.GwName), nil
+	}
+}
+
+// edgeSummary returns the list_vpcs_summary entry of an Edge gateway, which has the fields of
+// both the gateway and the Edge gateway.
+func edgeSummary(gw *goaviatrix.Gateway, edge *goaviatrix.EdgeSpoke) (map[string]interface{}, error) {
+	latitude, _ := strconv.ParseFloat(edge.Latitude, 64)
+	longitude, _ := strconv.ParseFloat(edge.Longitude, 64)
+	resp := goaviatrix.EdgeSpokeResp{
+		GwName:                             gw.GwName,
+		SiteId:                             edge.SiteId,
+		ManagementEgressIpPrefix:           edge.ManagementEgressIpPrefix,
+		EnableManagementOverPrivateNetwork: edge.EnableManagementOverPrivateNetwork,
+		DnsServerIp:                        edge.DnsServerIp,
+		SecondaryDnsServerIp:               edge.SecondaryDnsServerIp,
+		ZtpFileType:                        edge.ZtpFileType,
+		EnableEdgeActiveStandby:            edge.EnableEdgeActiveStandby,
+		EnableEdgeActiveStandbyPreemptive:  edge.EnableEdgeActiveStandbyPreemptive,
+		LocalAsNumber:                      gw.LocalASNumber,
+		PrependAsPathReturn:                gw.PrependASPath,
+		IncludeCidrList:                    gw.IncludeCidrList,
+		EnableLearnedCidrsApproval:         gw.EnableLearnedCidrsApproval,
+		SpokeBgpManualAdvertisedCidrs:      gw.BgpManualSpokeAdvertiseCidrs,
+		EnablePreserveAsPath:               gw.EnablePreserveAsPath,
+		BgpPollingTime:                     gw.BgpPollingTime,
+		BgpHoldTime:                        gw.BgpHoldTime,
+		EnableEdgeTransitiveRouting:        edge.EnableEdgeTransitiveRouting,
+		EnableJumboFrame:                   gw.JumboFrame,
+		Latitude:                           latitude,
+		Longitude:                          longitude,
+		RxQueueSize:                        gw.RxQueueSize,
+		State:                              gw.InstState,
+		InterfaceList:                      edge.InterfaceList,
+	}
+
+	summary := make(map[string]interface{})
+	for _, v := range []interface{}{gw, resp} {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &summary); err != nil {
+			return nil, err
+		}
+	}
+	return summary, nil
+}
+
+// decodeEdgeInterfaces decodes the interfaces of an Edge gateway, which are sent base64 encoded.
+func decodeEdgeInterfaces(edge *goaviatrix.EdgeSpoke) error {
+	b, err := b64.StdEncoding.DecodeString(edge.Interfaces)
+	if err != nil {
+		return Errorf("invalid interfaces: %v", err)
+	}
+	edge.InterfaceList = nil
+	if err := json.Unmarshal(b, &edge.InterfaceList); err != nil {
+		return Errorf("invalid interfaces: %v", err)
+	}
+	return nil
+}
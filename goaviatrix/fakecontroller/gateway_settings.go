@@ -0,0 +1,272 @@
+package fakecontroller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// registerGatewaySettingHandlers registers the actions that change a setting of a transit or spoke
+// gateway after it is launched. The settings are kept in the gateway returned by list_vpcs_summary.
+func (s *Server) registerGatewaySettingHandlers() {
+	s.actions["enable_single_az_ha"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.SingleAZ = "yes"
+		return nil
+	})
+	s.actions["disable_single_az_ha"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.SingleAZ = "no"
+		return nil
+	})
+	s.actions["enable_jumbo_frame"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.JumboFrame = true
+		return nil
+	})
+	s.actions["disable_jumbo_frame"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.JumboFrame = false
+		return nil
+	})
+	s.actions["enable_gro_gso"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.GroGso = "enabled"
+		return nil
+	})
+	s.actions["disable_gro_gso"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.GroGso = "disabled"
+		return nil
+	})
+	s.actions["enable_vpc_dns_server"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableVpcDnsServer = "Enabled"
+		return nil
+	})
+	s.actions["disable_vpc_dns_server"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableVpcDnsServer = "Disabled"
+		return nil
+	})
+	s.actions["enable_connected_transit_on_gateway"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.ConnectedTransit = "yes"
+		return nil
+	})
+	s.actions["disable_connected_transit_on_gateway"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.ConnectedTransit = "no"
+		return nil
+	})
+	s.actions["enable_transit_gateway_interface_to_aws_tgw"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableHybridConnection = true
+		return nil
+	})
+	s.actions["disable_transit_gateway_interface_to_aws_tgw"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableHybridConnection = false
+		return nil
+	})
+	s.actions["enable_advertise_transit_cidr"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableAdvertiseTransitCidr = r.Get("advertise_transit_cidr") == "yes"
+		return nil
+	})
+	s.actions["enable_bgp_ecmp"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.BgpEcmp = true
+		return nil
+	})
+	s.actions["disable_bgp_ecmp"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.BgpEcmp = false
+		return nil
+	})
+	s.actions["enable_transit_preserve_as_path"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnablePreserveAsPath = true
+		return nil
+	})
+	s.actions["disable_transit_preserve_as_path"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnablePreserveAsPath = false
+		return nil
+	})
+	s.actions["enable_spoke_preserve_as_path"] = s.actions["enable_transit_preserve_as_path"]
+	s.actions["disable_spoke_preserve_as_path"] = s.actions["disable_transit_preserve_as_path"]
+	s.actions["enable_multitier_transit"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableMultitierTransit = true
+		return nil
+	})
+	s.actions["disable_multitier_transit"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableMultitierTransit = false
+		return nil
+	})
+	s.actions["enable_private_vpc_default_route"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.PrivateVpcDefaultEnabled = true
+		return nil
+	})
+	s.actions["disable_private_vpc_default_route"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.PrivateVpcDefaultEnabled = false
+		return nil
+	})
+	s.actions["enable_skip_public_route_table_update"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.SkipPublicVpcUpdateEnabled = true
+		return nil
+	})
+	s.actions["disable_skip_public_route_table_update"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.SkipPublicVpcUpdateEnabled = false
+		return nil
+	})
+	s.actions["enable_auto_advertise_s2c_cidrs"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.AutoAdvertiseCidrsEnabled = true
+		return nil
+	})
+	s.actions["disable_auto_advertise_s2c_cidrs"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.AutoAdvertiseCidrsEnabled = false
+		return nil
+	})
+	s.actions["enable_spoke_onprem_route_propagation"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.DisableRoutePropagation = false
+		return nil
+	})
+	s.actions["disable_spoke_onprem_route_propagation"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.DisableRoutePropagation = true
+		return nil
+	})
+	s.actions["enable_s2c_rx_balancing"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableS2CRxBalancing = r.Get("s2c_rx_balancing") == "yes"
+		return nil
+	})
+	s.actions["enable_transit_gateway_for_multi_cloud_security_domain"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableSegmentation = true
+		return nil
+	})
+	s.actions["disable_transit_gateway_for_multi_cloud_security_domain"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableSegmentation = false
+		return nil
+	})
+	s.actions["enable_active_standby"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableActiveStandby = true
+		gw.EnableActiveStandbyPreemptive = r.Get("preemptive") == "true"
+		return nil
+	})
+	s.actions["disable_active_standby"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableActiveStandby = false
+		gw.EnableActiveStandbyPreemptive = false
+		return nil
+	})
+	s.actions["enable_transit_learned_cidrs_approval"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableLearnedCidrsApproval = true
+		return nil
+	})
+	s.actions["disable_transit_learned_cidrs_approval"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.EnableLearnedCidrsApproval = false
+		return nil
+	})
+	s.actions["set_transit_learned_cidrs_approval_mode"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.LearnedCidrsApprovalMode = r.Get("mode")
+		return nil
+	})
+	s.actions["update_transit_pending_approved_cidrs"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		s.approvedLearnedCidrs[gw.GwName] = cidrList(r.Get("approved_learned_cidrs"))
+		return nil
+	})
+	s.actions["edit_gateway_custom_routes"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.CustomizedSpokeVpcRoutes = cidrList(r.Get("cidr"))
+		return nil
+	})
+	s.actions["edit_gateway_filter_routes"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.FilteredSpokeVpcRoutes = cidrList(r.Get("cidr"))
+		return nil
+	})
+	s.actions["edit_gateway_advertised_cidr"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		// The CIDRs are excluded from the routes advertised by a transit gateway, and the
+		// only routes advertised by a spoke gateway
+		if gw.TransitVpc == "yes" {
+			gw.ExcludeCidrList = cidrList(r.Get("cidr"))
+		} else {
+			gw.IncludeCidrList = cidrList(r.Get("cidr"))
+		}
+		return nil
+	})
+	s.actions["edit_transit_gateway_customized_vpc_route"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.CustomizedTransitVpcRoutes = cidrList(r.Get("customized_routes"))
+		return nil
+	})
+	s.actions["edit_aviatrix_transit_advanced_config"] = s.gatewaySetting(s.editAdvancedConfig)
+	s.actions["edit_aviatrix_spoke_advanced_config"] = s.gatewaySetting(s.editAdvancedConfig)
+	s.actions["edit_transit_local_as_number"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.LocalASNumber = r.Get("local_as_num")
+		return nil
+	})
+	s.actions["edit_spoke_local_as_number"] = s.actions["edit_transit_local_as_number"]
+	s.actions["change_bgp_polling_time"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		return atoi(r, "bgp_polling_time", &gw.BgpPollingTime)
+	})
+	s.actions["change_bgp_hold_time"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		return atoi(r, "bgp_hold_time", &gw.BgpHoldTime)
+	})
+	s.actions["modify_detection_time"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		return atoi(r, "detection_time", &gw.TunnelDetectionTime)
+	})
+	s.actions["set_rx_queue_size"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.RxQueueSize = r.Get("rx_queue_size")
+		return nil
+	})
+	s.actions["enable_monitor_gateway_subnets"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.MonitorSubnetsAction = "enable"
+		gw.MonitorExcludeGWList = cidrList(r.Get("monitor_exclude_gateway_list"))
+		return nil
+	})
+	s.actions["disable_monitor_gateway_subnets"] = s.gatewaySetting(func(gw *goaviatrix.Gateway, r *Request) error {
+		gw.MonitorSubnetsAction = "disable"
+		gw.MonitorExcludeGWList = nil
+		return nil
+	})
+}
+
+// gatewaySetting returns a handler that applies set to the gateway named by the request. The
+// settings of an HA gateway are kept with its primary gateway.
+func (s *Server) gatewaySetting(set func(gw *goaviatrix.Gateway, r *Request) error) HandlerFunc {
+	params := []string{"gateway_name", "gw_name", "transit_gateway_name", "entity"}
+	return func(r *Request) (interface{}, error) {
+		for _, param := range params {
+			if gw := s.haGateway(r.Get(param)); gw != nil {
+				if err := set(gw, r); err != nil {
+					return nil, err
+				}
+				return fmt.Sprintf("Gateway %s has been updated.", gw.HaGw.GwName), nil
+			}
+		}
+		gw, err := s.gatewayByParam(r, params...)
+		if err != nil {
+			return nil, err
+		}
+		if err := set(gw, r); err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("Gateway %s has been updated.", gw.GwName), nil
+	}
+}
+
+// editAdvancedConfig applies one subaction of the advanced config of a transit or spoke gateway.
+func (s *Server) editAdvancedConfig(gw *goaviatrix.Gateway, r *Request) error {
+	switch r.Get("subaction") {
+	case "bgp_manual_spoke":
+		gw.BgpManualSpokeAdvertiseCidrs = cidrList(r.Get("bgp_manual_spoke_advertise_cidrs"))
+	case "prepend_as_path":
+		gw.PrependASPath = r.Get("bgp_prepend_as_path")
+	default:
+		return Errorf("subaction %q is not implemented", r.Get("subaction"))
+	}
+	return nil
+}
+
+// cidrList splits a comma separated list as sent by the client, nil if the list is empty.
+func cidrList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// atoi parses the integer parameter param into v.
+func atoi(r *Request, param string, v *int) error {
+	i, err := strconv.Atoi(r.Get(param))
+	if err != nil {
+		return Errorf("invalid %s %q", param, r.Get(param))
+	}
+	*v = i
+	return nil
+}
@@ -0,0 +1,271 @@
+package fakecontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func (s *Server) registerGatewayHandlers() {
+	// create_multicloud_primary_gateway launches transit and spoke gateways, connect_container
+	// standalone gateways
+	s.actions["create_multicloud_primary_gateway"] = s.createGateway
+	s.actions["connect_container"] = s.createGateway
+	s.actions["create_multicloud_ha_gateway"] = s.createHaGateway
+	s.actions["edit_gw_config"] = s.editGwConfig
+	s.actions["delete_container"] = s.deleteGateway
+	s.actions["list_vpcs_summary"] = s.listVpcsSummary
+	s.actions["get_gateway_info"] = s.getGatewayInfo
+	s.actions["list_aviatrix_transit_advanced_config"] = s.listAdvancedConfig
+	s.actions["list_aviatrix_spoke_advanced_config"] = s.listAdvancedConfig
+	s.actions["get_firewall_lan_cidr"] = s.getFirewallLanCidr
+	s.actions["get_gro_gso_status"] = s.getGroGsoStatus
+}
+
+func (s *Server) gateway(name string) (int, *goaviatrix.Gateway) {
+	for i, gw := range s.gateways {
+		if gw.GwName == name {
+			return i, gw
+		}
+	}
+	return -1, nil
+}
+
+// gatewayByParam returns the gateway named by the first of the given parameters that is set.
+func (s *Server) gatewayByParam(r *Request, params ...string) (*goaviatrix.Gateway, error) {
+	var name string
+	for _, param := range params {
+		if name = r.Get(param); name != "" {
+			break
+		}
+	}
+	if _, gw := s.gateway(name); gw != nil {
+		return gw, nil
+	}
+	return nil, NotFoundf("Gateway %s does not exist.", name)
+}
+
+func (s *Server) createGateway(r *Request) (interface{}, error) {
+	name := r.Get("gw_name")
+	if name == "" {
+		return nil, Errorf("gw_name is required")
+	}
+	if _, gw := s.gateway(name); gw != nil {
+		return nil, Errorf("Gateway %s already exists.", name)
+	}
+	if _, account := s.account(r.Get("account_name")); account == nil {
+		return nil, NotFoundf("Account %s does not exist.", r.Get("account_name"))
+	}
+	cloudType, err := strconv.Atoi(r.Get("cloud_type"))
+	if err != nil {
+		return nil, Errorf("invalid cloud_type %q", r.Get("cloud_type"))
+	}
+
+	s.nextID++
+	allocateNewEip := r.Get("allocate_new_eip") != "off"
+	gw := &goaviatrix.Gateway{
+		GwName:                name,
+		AccountName:           r.Get("account_name"),
+		CloudType:             cloudType,
+		VpcID:                 r.Get("vpc_id"),
+		VpcRegion:             r.Get("vpc_region"),
+		GwSize:                r.Get("gw_size"),
+		VpcSize:               r.Get("gw_size"),
+		VpcNet:                r.Get("gw_subnet"),
+		GatewayZone:           r.Get("zone"),
+		InsaneMode:            r.Get("insane_mode"),
+		PublicIP:              fmt.Sprintf("203.0.113.%d", s.nextID%254+1),
+		PrivateIP:             fmt.Sprintf("10.0.0.%d", s.nextID%254+1),
+		InstState:             "up",
+		AllocateNewEipReadPtr: &allocateNewEip,
+		TransitVpc:            "no",
+		SpokeVpc:              "no",
+		// the settings of a new gateway, as set by the controller
+		SingleAZ:                 "yes",
+		JumboFrame:               true,
+		GroGso:                   "enabled",
+		EnableVpcDnsServer:       "Disabled",
+		ConnectedTransit:         "no",
+		MonitorSubnetsAction:     "disable",
+		BgpHoldTime:              180,
+		BgpPollingTime:           50,
+		TunnelDetectionTime:      60,
+		LearnedCidrsApprovalMode: "gateway",
+		// and the settings of the launch request
+		EnableLearnedCidrsApproval: r.Get("learned_cidrs_approval") == "yes",
+		EnableBgp:                  r.Get("enable_bgp") == "yes",
+	}
+	gw.Eip = gw.PublicIP
+	if r.Action == "create_multicloud_primary_gateway" {
+		if r.Get("transit") == "true" {
+			gw.TransitVpc = "yes"
+		} else {
+			gw.SpokeVpc = "yes"
+		}
+	}
+	if tags := r.Get("json_tags"); tags != "" {
+		if err := json.Unmarshal([]byte(tags), &gw.Tags); err != nil {
+			return nil, Errorf("invalid json_tags: %v", err)
+		}
+	}
+	s.gateways = append(s.gateways, gw)
+	return fmt.Sprintf("Gateway %s has been created.", name), nil
+}
+
+// haGateway returns the gateway whose HA gateway is named name.
+func (s *Server) haGateway(name string) *goaviatrix.Gateway {
+	for _, gw := range s.gateways {
+		if name != "" && gw.HaGw.GwName == name {
+			return gw
+		}
+	}
+	return nil
+}
+
+// createHaGateway launches the HA gateway of a transit or spoke gateway, named after the primary
+// gateway with the suffix "-hagw".
+func (s *Server) createHaGateway(r *Request) (interface{}, error) {
+	gw, err := s.gatewayByParam(r, "primary_gw_name")
+	if err != nil {
+		return nil, err
+	}
+	if gw.HaGw.GwName != "" {
+		return nil, Errorf("Gateway %s already has HA gateway %s.", gw.GwName, gw.HaGw.GwName)
+	}
+
+	// The HA gateway has the size of the primary gateway unless it is resized with edit_gw_config
+	s.nextID++
+	gw.HaGw = goaviatrix.HaGateway{
+		GwName:      gw.GwName + "-hagw",
+		CloudType:   gw.CloudType,
+		GwSize:      gw.GwSize,
+		VpcNet:      r.Get("gw_subnet"),
+		PublicIP:    fmt.Sprintf("203.0.113.%d", s.nextID%254+1),
+		PrivateIP:   fmt.Sprintf("10.0.0.%d", s.nextID%254+1),
+		GatewayZone: r.Get("zone"),
+		InsaneMode:  r.Get("insane_mode"),
+	}
+	return fmt.Sprintf("HA gateway %s has been created.", gw.HaGw.GwName), nil
+}
+
+// editGwConfig resizes a gateway or an HA gateway.
+func (s *Server) editGwConfig(r *Request) (interface{}, error) {
+	name := r.Get("gw_name")
+	if gw := s.haGateway(name); gw != nil {
+		gw.HaGw.GwSize = r.Get("gw_size")
+		return fmt.Sprintf("Gateway %s has been resized.", name), nil
+	}
+	gw, err := s.gatewayByParam(r, "gw_name")
+	if err != nil {
+		return nil, err
+	}
+	gw.GwSize = r.Get("gw_size")
+	gw.VpcSize = gw.GwSize
+	return fmt.Sprintf("Gateway %s has been resized.", name), nil
+}
+
+func (s *Server) deleteGateway(r *Request) (interface{}, error) {
+	if gw := s.haGateway(r.Get("gw_name")); gw != nil {
+		gw.HaGw = goaviatrix.HaGateway{}
+		return fmt.Sprintf("Gateway %s has been deleted.", r.Get("gw_name")), nil
+	}
+	i, gw := s.gateway(r.Get("gw_name"))
+	if gw == nil {
+		return nil, NotFoundf("Gateway %s does not exist.", r.Get("gw_name"))
+	}
+	for _, spoke := range s.gateways {
+		if spoke.TransitGwName == gw.GwName {
+			return nil, Errorf("Gateway %s is attached to spoke gateway %s.", gw.GwName, spoke.GwName)
+		}
+	}
+	s.gateways = append(s.gateways[:i], s.gateways[i+1:]...)
+	delete(s.approvedLearnedCidrs, gw.GwName)
+	return fmt.Sprintf("Gateway %s has been deleted.", gw.GwName), nil
+}
+
+func (s *Server) listVpcsSummary(r *Request) (interface{}, error) {
+	gateways := make([]interface{}, 0, len(s.gateways))
+	for _, gw := range s.gateways {
+		switch {
+		case r.Get("gateway_name") != "" && gw.GwName != r.Get("gateway_name"):
+		case r.Get("transit_only") == "true" && gw.TransitVpc != "yes":
+		case r.Get("spoke_only") == "true" && gw.SpokeVpc != "yes":
+		case s.edges[gw.GwName] != nil:
+			summary, err := edgeSummary(gw, s.edges[gw.GwName])
+			if err != nil {
+				return nil, err
+			}
+			gateways = append(gateways, summary)
+		default:
+			gateways = append(gateways, *gw)
+		}
+	}
+	return gateways, nil
+}
+
+func (s *Server) getGatewayInfo(r *Request) (interface{}, error) {
+	gw, err := s.gatewayByParam(r, "gateway_name", "gw_name")
+	if err != nil {
+		return nil, err
+	}
+	return goaviatrix.GatewayDetail{
+		AccountName:   gw.AccountName,
+		GwName:        gw.GwName,
+		GwZone:        gw.GatewayZone,
+		TransitGwName: gw.TransitGwName,
+		RouteTables:   s.routeTables[gw.GwName],
+		BgpEnabled:    gw.EnableBgp,
+	}, nil
+}
+
+// listAdvancedConfig returns the advanced config of a transit or spoke gateway, which includes the
+// BGP over LAN addresses.
+func (s *Server) listAdvancedConfig(r *Request) (interface{}, error) {
+	gw, err := s.gatewayByParam(r, "gateway_name")
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		goaviatrix.TransitGatewayAdvancedConfigRespResult
+		goaviatrix.TransitGatewayBgpLanIpInfoRespResult
+	}{
+		TransitGatewayAdvancedConfigRespResult: goaviatrix.TransitGatewayAdvancedConfigRespResult{
+			BgpPollingTime:           gw.BgpPollingTime,
+			PrependASPath:            gw.PrependASPath,
+			LocalASNumber:            gw.LocalASNumber,
+			BgpEcmpEnabled:           yesNo(gw.BgpEcmp),
+			ActiveStandby:            yesNo(gw.EnableActiveStandby),
+			LearnedCIDRsApprovalMode: gw.LearnedCidrsApprovalMode,
+			BgpHoldTime:              gw.BgpHoldTime,
+			EnableSummarizeCidrToTgw: yesNo(gw.EnableTransitSummarizeCidrToTgw),
+			ApprovedLearnedCidrs:     s.approvedLearnedCidrs[gw.GwName],
+		},
+	}, nil
+}
+
+func (s *Server) getFirewallLanCidr(r *Request) (interface{}, error) {
+	if gw := s.haGateway(r.Get("gateway_name")); gw != nil {
+		return map[string]string{"firewall_lan_cidr": ""}, nil
+	}
+	if _, err := s.gatewayByParam(r, "gateway_name"); err != nil {
+		return nil, err
+	}
+	return map[string]string{"firewall_lan_cidr": ""}, nil
+}
+
+func (s *Server) getGroGsoStatus(r *Request) (interface{}, error) {
+	gw, err := s.gatewayByParam(r, "gateway_name")
+	if err != nil {
+		return nil, err
+	}
+	return fmt.Sprintf("GRO/GSO is %s", gw.GroGso), nil
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,482 @@
+// Package fakecontroller implements an in-memory Aviatrix controller for tests.
+//
+// The Server serves the v1, v2 and v2.5 APIs used by goaviatrix over TLS, with the state of the
+// accounts, gateways, attachments, smart groups, distributed firewalling policies and site2cloud
+// connections kept in memory. It lets the provider acceptance tests run without a controller, a
+// network connection or cloud accounts:
+//
+//	server := fakecontroller.New()
+//	defer server.Close()
+//	client, err := server.NewClient()
+//
+// Actions that are not implemented fail with a reason naming the action, more can be added with
+// HandleAction and HandleRoute.
+package fakecontroller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/ajg/form"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+const (
+	// DefaultUsername and DefaultPassword are the credentials accepted by default
+	DefaultUsername = "admin"
+	DefaultPassword = "password"
+	// DefaultVersion is the controller version reported by default
+	DefaultVersion = "UserConnect-7.1.2131"
+)
+
+// HandlerFunc handles a request to the fake controller. For the v1 and v2 APIs the returned value
+// is sent as the "results" of a successful response, and the error message as the "reason" of a
+// failed one. For the v2.5 API the value is the response body, and the error is sent as the
+// "message" with the status of an *Error, or 400 Bad Request.
+//
+// Handlers are called with the server state locked, one request at a time.
+type HandlerFunc func(r *Request) (interface{}, error)
+
+// Download is returned by the handler of a v2 action that downloads a file, it is sent as the
+// response body.
+type Download []byte
+
+// Error is an error returned by a handler with a HTTP status, used by the v2.5 API.
+type Error struct {
+	Status  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Errorf returns an error that fails a request with the given message.
+func Errorf(format string, a ...interface{}) error {
+	return &Error{Status: http.StatusBadRequest, Message: fmt.Sprintf(format, a...)}
+}
+
+// NotFoundf returns an error that fails a request with the given message, and 404 Not Found for the
+// v2.5 API. The message should say that something "does not exist", which is how the client
+// recognizes missing objects of the v1 and v2 APIs.
+func NotFoundf(format string, a ...interface{}) error {
+	return &Error{Status: http.StatusNotFound, Message: fmt.Sprintf(format, a...)}
+}
+
+// Server is a fake Aviatrix controller. It embeds the underlying TLS test server, Close must be
+// called when the server is no longer used.
+type Server struct {
+	*httptest.Server
+
+	username string
+	password string
+	version  string
+
+	mu       sync.Mutex
+	actions  map[string]HandlerFunc
+	routes   []route
+	sessions map[string]bool
+	// tasks are the results of the async actions, by request ID
+	tasks  map[string]error
+	nextID int
+
+	apiToken string
+	accounts []*goaviatrix.Account
+	gateways []*goaviatrix.Gateway
+	// routeTables are the route tables of the spoke gateways attached to transit gateways
+	routeTables map[string][]string
+	// approvedLearnedCidrs are the learned CIDRs approved on transit and spoke gateways
+	approvedLearnedCidrs map[string][]string
+	smartGroups          []map[string]interface{}
+	policyList           *goaviatrix.DistributedFirewallingPolicyList
+	site2clouds          []*goaviatrix.Site2Cloud
+	// edges are the settings of the Edge gateways that are not kept in their gateway
+	edges map[string]*goaviatrix.EdgeSpoke
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials sets the username and password accepted by the server.
+func WithCredentials(username, password string) Option {
+	return func(s *Server) {
+		s.username = username
+		s.password = password
+	}
+}
+
+// WithVersion sets the controller version reported by the server, e.g. "UserConnect-7.1.2131".
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// New starts a fake controller.
+func New(opts ...Option) *Server {
+	s := &Server{
+		username: DefaultUsername,
+		password: DefaultPassword,
+		version:  DefaultVersion,
+		actions:  make(map[string]HandlerFunc),
+		sessions: make(map[string]bool),
+		tasks:    make(map[string]error),
+
+		routeTables:          make(map[string][]string),
+		approvedLearnedCidrs: make(map[string][]string),
+		edges:                make(map[string]*goaviatrix.EdgeSpoke),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.registerControllerHandlers()
+	s.registerAccountHandlers()
+	s.registerGatewayHandlers()
+	s.registerGatewaySettingHandlers()
+	s.registerAttachmentHandlers()
+	s.registerSmartGroupHandlers()
+	s.registerDistributedFirewallingHandlers()
+	s.registerSite2CloudHandlers()
+	s.registerEdgeHandlers()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/api", s.serveAction)
+	mux.HandleFunc("/v2/api", s.serveAction)
+	mux.HandleFunc("/v2.5/api/", s.serveRoute)
+	s.Server = httptest.NewTLSServer(mux)
+	return s
+}
+
+// Address returns the host and port of the server, the controller IP of the clients.
+func (s *Server) Address() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// Env returns the environment variables that point the provider at the server.
+func (s *Server) Env() map[string]string {
+	return map[string]string{
+		"AVIATRIX_CONTROLLER_IP": s.Address(),
+		"AVIATRIX_USERNAME":      s.username,
+		"AVIATRIX_PASSWORD":      s.password,
+	}
+}
+
+// NewClient returns a client logged in to the server.
+func (s *Server) NewClient(opts ...goaviatrix.ClientOption) (*goaviatrix.Client, error) {
+	return goaviatrix.NewClient(s.username, s.password, s.Address(), s.Client(), nil, opts...)
+}
+
+// HandleAction sets the handler of an action of the v1 and v2 APIs, replacing the built-in one.
+func (s *Server) HandleAction(action string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actions[action] = h
+}
+
+// HandleRoute sets the handler of a v2.5 API path, relative to "/v2.5/api/". Path segments in
+// braces, e.g. "app-domains/{uuid}", match any value, available with Request.PathValue.
+func (s *Server) HandleRoute(method, pattern string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.routes {
+		if s.routes[i].method == method && s.routes[i].pattern == pattern {
+			s.routes[i].handler = h
+			return
+		}
+	}
+	s.routes = append(s.routes, route{method: method, pattern: pattern, handler: h})
+}
+
+// ExpireSessions invalidates every session, the clients have to login again.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[string]bool)
+}
+
+// newID returns a new unique ID with the given prefix.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%d", prefix, s.nextID)
+}
+
+// newUUID returns a new unique UUID.
+func (s *Server) newUUID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+// Request is a request to the fake controller.
+type Request struct {
+	// Action is the action of a v1 or v2 request
+	Action string
+	// Params are the query parameters and the form fields. For a JSON request they also hold
+	// the top level JSON fields, formatted as strings.
+	Params url.Values
+	// Files are the contents of the multipart files, by parameter name
+	Files map[string][]byte
+	// CID is the session the request was sent with
+	CID string
+
+	method     string
+	body       []byte
+	json       bool
+	pathValues map[string]string
+}
+
+// Get returns the first value of the given parameter.
+func (r *Request) Get(key string) string {
+	return r.Params.Get(key)
+}
+
+// PathValue returns the value of a braced segment of the v2.5 route.
+func (r *Request) PathValue(name string) string {
+	return r.pathValues[name]
+}
+
+// Decode decodes the request into v, from the JSON body or else from the parameters with the form
+// tags of v. It is meant to decode the request into the goaviatrix struct it was encoded from.
+func (r *Request) Decode(v interface{}) error {
+	if r.json {
+		if len(r.body) == 0 {
+			return nil
+		}
+		return json.Unmarshal(r.body, v)
+	}
+	decoder := form.NewDecoder(nil)
+	decoder.IgnoreUnknownKeys(true)
+	return decoder.DecodeValues(v, r.Params)
+}
+
+func parseRequest(req *http.Request) (*Request, error) {
+	r := &Request{
+		method: req.Method,
+		Params: req.URL.Query(),
+		Files:  make(map[string][]byte),
+	}
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		// v1 GET and DELETE requests send the form in the body too, which ParseForm ignores
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		values, err := url.ParseQuery(string(data))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range values {
+			r.Params[k] = v
+		}
+	case "multipart/form-data":
+		if err := req.ParseMultipartForm(32 << 20); err != nil {
+			return nil, err
+		}
+		for k, v := range req.MultipartForm.Value {
+			r.Params[k] = v
+		}
+		for k, headers := range req.MultipartForm.File {
+			f, err := headers[0].Open()
+			if err != nil {
+				return nil, err
+			}
+			data, err := io.ReadAll(f)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			r.Files[k] = data
+		}
+	case "application/json":
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		r.body, r.json = body, true
+		var fields map[string]interface{}
+		if json.Unmarshal(body, &fields) == nil {
+			for k, v := range fields {
+				switch v := v.(type) {
+				case string:
+					r.Params.Set(k, v)
+				case bool, float64:
+					r.Params.Set(k, fmt.Sprint(v))
+				}
+			}
+		}
+	}
+	r.Action = r.Params.Get("action")
+	r.CID = r.Params.Get("CID")
+	if auth := req.Header.Get("Authorization"); strings.HasPrefix(auth, "cid ") {
+		r.CID = strings.TrimPrefix(auth, "cid ")
+	}
+	return r, nil
+}
+
+// serveAction serves the v1 and v2 APIs.
+func (s *Server) serveAction(w http.ResponseWriter, req *http.Request) {
+	r, err := parseRequest(req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"return": false, "reason": err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Action {
+	case "get_api_token":
+		s.apiToken = s.newID("fake-api-token-")
+		writeResults(w, goaviatrix.ApiTokenInfo{ApiToken: s.apiToken})
+		return
+	case "login":
+		if r.Get("username") != s.username || r.Get("password") != s.password {
+			writeReason(w, "Invalid username or password.")
+			return
+		}
+		cid := s.newID("fake-cid-")
+		s.sessions[cid] = true
+		writeJSON(w, http.StatusOK, goaviatrix.LoginResp{Return: true, Results: "User login:" + s.username + " in account:admin has been authorized successfully", CID: cid})
+		return
+	}
+
+	if !s.sessions[r.CID] {
+		if r.json {
+			writeReason(w, fmt.Sprintf("Session %s expired", r.CID))
+		} else {
+			writeReason(w, "CID is invalid or expired.")
+		}
+		return
+	}
+
+	if r.Action == "check_task_status" {
+		err, ok := s.tasks[r.Get("request_id")]
+		switch {
+		case !ok:
+			writeReason(w, fmt.Sprintf("Request ID %s does not exist", r.Get("request_id")))
+		case err != nil:
+			writeReason(w, err.Error())
+		default:
+			writeResults(w, "Task completed successfully.")
+		}
+		return
+	}
+
+	h, ok := s.actions[r.Action]
+	if !ok {
+		writeReason(w, fmt.Sprintf("fakecontroller: action %q is not implemented", r.Action))
+		return
+	}
+	results, err := h(r)
+	if r.Get("async") == "true" {
+		// Async actions finish immediately, their result is returned by check_task_status
+		requestID := s.newID("fake-task-")
+		s.tasks[requestID] = err
+		writeResults(w, requestID)
+		return
+	}
+	if err != nil {
+		writeReason(w, err.Error())
+		return
+	}
+	if file, ok := results.(Download); ok {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write(file)
+		return
+	}
+	writeResults(w, results)
+}
+
+type route struct {
+	method  string
+	pattern string
+	handler HandlerFunc
+}
+
+// match reports whether the route matches the method and path, and returns the values of the
+// braced segments.
+func (rt *route) match(method, path string) (map[string]string, bool) {
+	if rt.method != method {
+		return nil, false
+	}
+	patternSegments := strings.Split(rt.pattern, "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+	values := make(map[string]string)
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			values[strings.Trim(segment, "{}")] = pathSegments[i]
+		} else if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// serveRoute serves the v2.5 API.
+func (s *Server) serveRoute(w http.ResponseWriter, req *http.Request) {
+	r, err := parseRequest(req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.sessions[r.CID] {
+		writeJSON(w, http.StatusForbidden, map[string]string{"message": "Invalid CID"})
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2.5/api/")
+	for i := range s.routes {
+		values, ok := s.routes[i].match(req.Method, path)
+		if !ok {
+			continue
+		}
+		r.pathValues = values
+		body, err := s.routes[i].handler(r)
+		if err != nil {
+			status := http.StatusBadRequest
+			var e *Error
+			if errors.As(err, &e) {
+				status = e.Status
+			}
+			writeJSON(w, status, map[string]string{"message": err.Error()})
+			return
+		}
+		if body == nil {
+			body = struct{}{}
+		}
+		writeJSON(w, http.StatusOK, body)
+		return
+	}
+	writeJSON(w, http.StatusNotFound, map[string]string{"message": fmt.Sprintf("fakecontroller: %s %s is not implemented", req.Method, path)})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeResults(w http.ResponseWriter, results interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"return": true, "results": results})
+}
+
+func writeReason(w http.ResponseWriter, reason string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"return": false, "reason": reason})
+}
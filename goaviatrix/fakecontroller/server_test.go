@@ -0,0 +1,450 @@
+package fakecontroller
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func newTestClient(t *testing.T) (*Server, *goaviatrix.Client) {
+	t.Helper()
+	server := New()
+	t.Cleanup(server.Close)
+	client, err := server.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return server, client
+}
+
+func createTestAccount(t *testing.T, client *goaviatrix.Client, name string) {
+	t.Helper()
+	err := client.CreateAccount(&goaviatrix.Account{
+		AccountName:      name,
+		CloudType:        goaviatrix.AWS,
+		AwsAccountNumber: "123456789012",
+		AwsIam:           "false",
+		AwsAccessKey:     "access-key",
+		AwsSecretKey:     "secret-key",
+	})
+	if err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	server := New(WithCredentials("user", "secret"), WithVersion("UserConnect-7.1.1000"))
+	defer server.Close()
+
+	if _, err := goaviatrix.NewClient("user", "wrong", server.Address(), server.Client(), nil); err == nil {
+		t.Errorf("NewClient() with a wrong password succeeded")
+	}
+	client, err := goaviatrix.NewClient("user", "secret", server.Address(), server.Client(), nil)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	_, version, err := client.GetCurrentVersion(context.Background())
+	if err != nil {
+		t.Fatalf("GetCurrentVersion() error = %v", err)
+	}
+	if version.Major != 7 || version.Minor != 1 || version.Build != 1000 {
+		t.Errorf("GetCurrentVersion() = %+v, want 7.1.1000", version)
+	}
+	if env := server.Env(); env["AVIATRIX_CONTROLLER_IP"] != server.Address() || env["AVIATRIX_USERNAME"] != "user" {
+		t.Errorf("Env() = %v", env)
+	}
+}
+
+func TestAccounts(t *testing.T) {
+	_, client := newTestClient(t)
+
+	createTestAccount(t, client, "aws-account")
+	account, err := client.GetAccount(&goaviatrix.Account{AccountName: "aws-account"})
+	if err != nil {
+		t.Fatalf("GetAccount() error = %v", err)
+	}
+	if account.AwsAccountNumber != "123456789012" || account.AwsAccessKey != "access-key" {
+		t.Errorf("GetAccount() = %+v", account)
+	}
+	if account.AwsSecretKey != "" {
+		t.Errorf("GetAccount() returned the secret key")
+	}
+
+	account.AwsAccessKey = "new-access-key"
+	if err := client.UpdateAccount(account); err != nil {
+		t.Fatalf("UpdateAccount() error = %v", err)
+	}
+	if account, err = client.GetAccount(&goaviatrix.Account{AccountName: "aws-account"}); err != nil || account.AwsAccessKey != "new-access-key" {
+		t.Errorf("GetAccount() after update = %+v, %v", account, err)
+	}
+
+	if err := client.DeleteAccount(&goaviatrix.Account{AccountName: "aws-account"}); err != nil {
+		t.Fatalf("DeleteAccount() error = %v", err)
+	}
+	if _, err := client.GetAccount(&goaviatrix.Account{AccountName: "aws-account"}); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetAccount() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGatewaysAndAttachments(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	createTestAccount(t, client, "aws-account")
+
+	transit := &goaviatrix.TransitVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: "aws-account",
+		GwName:      "transit",
+		VpcID:       "vpc-1",
+		VpcRegion:   "us-east-1",
+		VpcSize:     "c5.xlarge",
+		Subnet:      "10.1.0.0/24",
+		Transit:     true,
+	}
+	if err := client.LaunchTransitVpc(ctx, transit); err != nil {
+		t.Fatalf("LaunchTransitVpc() error = %v", err)
+	}
+	spoke := &goaviatrix.SpokeVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: "aws-account",
+		GwName:      "spoke",
+		VpcID:       "vpc-2",
+		VpcRegion:   "us-east-1",
+		VpcSize:     "t3.small",
+		Subnet:      "10.2.0.0/24",
+		TagJson:     `{"env":"test"}`,
+	}
+	if err := client.LaunchSpokeVpc(ctx, spoke); err != nil {
+		t.Fatalf("LaunchSpokeVpc() error = %v", err)
+	}
+	if err := client.LaunchSpokeVpc(ctx, spoke); err == nil {
+		t.Errorf("LaunchSpokeVpc() of an existing gateway succeeded")
+	}
+
+	gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: "spoke"})
+	if err != nil {
+		t.Fatalf("GetGateway() error = %v", err)
+	}
+	if gw.SpokeVpc != "yes" || gw.VpcNet != "10.2.0.0/24" || gw.Tags["env"] != "test" || gw.PublicIP == "" {
+		t.Errorf("GetGateway() = %+v", gw)
+	}
+	transits, err := client.GetTransitGatewayList(ctx)
+	if err != nil || len(transits) != 1 || transits[0].GwName != "transit" {
+		t.Errorf("GetTransitGatewayList() = %v, %v", transits, err)
+	}
+
+	attachment := &goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke", TransitGwName: "transit", RouteTables: "rtb-1,rtb-2"}
+	if err := client.CreateSpokeTransitAttachment(ctx, attachment); err != nil {
+		t.Fatalf("CreateSpokeTransitAttachment() error = %v", err)
+	}
+	attachment, err = client.GetSpokeTransitAttachment(&goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke", TransitGwName: "transit"})
+	if err != nil {
+		t.Fatalf("GetSpokeTransitAttachment() error = %v", err)
+	}
+	if attachment.RouteTables != "rtb-1,rtb-2" {
+		t.Errorf("GetSpokeTransitAttachment() route tables = %q", attachment.RouteTables)
+	}
+	if _, err := client.GetTransitGatewayPeeringDetails(&goaviatrix.TransitGatewayPeering{TransitGatewayName1: "spoke", TransitGatewayName2: "transit"}); err != nil {
+		t.Errorf("GetTransitGatewayPeeringDetails() error = %v", err)
+	}
+	if err := client.DeleteGateway(ctx, &goaviatrix.Gateway{CloudType: goaviatrix.AWS, GwName: "transit"}); err == nil {
+		t.Errorf("DeleteGateway() of a transit gateway with an attached spoke succeeded")
+	}
+
+	if err := client.DeleteSpokeTransitAttachment(ctx, &goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke", TransitGwName: "transit"}); err != nil {
+		t.Fatalf("DeleteSpokeTransitAttachment() error = %v", err)
+	}
+	if _, err := client.GetSpokeTransitAttachment(&goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke", TransitGwName: "transit"}); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetSpokeTransitAttachment() after delete error = %v, want ErrNotFound", err)
+	}
+	for _, name := range []string{"spoke", "transit"} {
+		if err := client.DeleteGateway(ctx, &goaviatrix.Gateway{CloudType: goaviatrix.AWS, GwName: name}); err != nil {
+			t.Fatalf("DeleteGateway(%s) error = %v", name, err)
+		}
+	}
+	if _, err := client.GetGateway(&goaviatrix.Gateway{GwName: "spoke"}); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetGateway() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGatewaySettings(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	createTestAccount(t, client, "aws-account")
+
+	transit := &goaviatrix.TransitVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: "aws-account",
+		GwName:      "transit",
+		VpcID:       "vpc-1",
+		VpcRegion:   "us-east-1",
+		VpcSize:     "c5.xlarge",
+		Subnet:      "10.1.0.0/24",
+		Transit:     true,
+	}
+	if err := client.LaunchTransitVpc(ctx, transit); err != nil {
+		t.Fatalf("LaunchTransitVpc() error = %v", err)
+	}
+	_, err := client.CreateTransitHaGw(ctx, &goaviatrix.TransitHaGateway{
+		CloudType:     goaviatrix.AWS,
+		PrimaryGwName: "transit",
+		GwName:        "transit-hagw",
+		Subnet:        "10.1.1.0/24",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransitHaGw() error = %v", err)
+	}
+	if _, err := client.CreateTransitHaGw(ctx, &goaviatrix.TransitHaGateway{CloudType: goaviatrix.AWS, PrimaryGwName: "transit"}); err == nil {
+		t.Errorf("CreateTransitHaGw() of a gateway with an HA gateway succeeded")
+	}
+
+	if err := client.DisableSingleAZGateway(ctx, &goaviatrix.Gateway{GwName: "transit"}); err != nil {
+		t.Fatalf("DisableSingleAZGateway() error = %v", err)
+	}
+	if err := client.SetLocalASNumber(ctx, transit, "65001"); err != nil {
+		t.Fatalf("SetLocalASNumber() error = %v", err)
+	}
+	if err := client.SetBgpPollingTime(ctx, transit, "20"); err != nil {
+		t.Fatalf("SetBgpPollingTime() error = %v", err)
+	}
+	if err := client.EnableTransitLearnedCidrsApproval(transit); err != nil {
+		t.Fatalf("EnableTransitLearnedCidrsApproval() error = %v", err)
+	}
+	transit.ApprovedLearnedCidrs = []string{"10.10.0.0/16", "10.20.0.0/16"}
+	if err := client.UpdateTransitPendingApprovedCidrs(ctx, transit); err != nil {
+		t.Fatalf("UpdateTransitPendingApprovedCidrs() error = %v", err)
+	}
+	// the settings of the HA gateway are kept with the primary gateway
+	if err := client.SetRxQueueSize(ctx, &goaviatrix.Gateway{GwName: "transit-hagw", RxQueueSize: "2K"}); err != nil {
+		t.Fatalf("SetRxQueueSize() of the HA gateway error = %v", err)
+	}
+
+	gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: "transit"})
+	if err != nil {
+		t.Fatalf("GetGateway() error = %v", err)
+	}
+	if gw.SingleAZ != "no" || !gw.EnableLearnedCidrsApproval || gw.RxQueueSize != "2K" || gw.HaGw.GwName != "transit-hagw" || gw.HaGw.GwSize != "c5.xlarge" {
+		t.Errorf("GetGateway() = %+v", gw)
+	}
+	config, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: "transit"})
+	if err != nil {
+		t.Fatalf("GetTransitGatewayAdvancedConfig() error = %v", err)
+	}
+	if config.LocalASNumber != "65001" || config.BgpPollingTime != "20" || len(config.ApprovedLearnedCidrs) != 2 {
+		t.Errorf("GetTransitGatewayAdvancedConfig() = %+v", config)
+	}
+
+	if err := client.DeleteGateway(ctx, &goaviatrix.Gateway{CloudType: goaviatrix.AWS, GwName: "transit-hagw"}); err != nil {
+		t.Fatalf("DeleteGateway() of the HA gateway error = %v", err)
+	}
+	if gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: "transit"}); err != nil || gw.HaGw.GwName != "" {
+		t.Errorf("GetGateway() after the HA gateway was deleted = %+v, %v", gw, err)
+	}
+}
+
+func TestSmartGroupsAndPolicyList(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	smartGroup := &goaviatrix.SmartGroup{
+		Name: "sg",
+		Selector: goaviatrix.SmartGroupSelector{Expressions: []*goaviatrix.SmartGroupMatchExpression{
+			{CIDR: "10.0.0.0/16"},
+			{Type: "vm", Tags: map[string]string{"k": "v"}},
+		}},
+	}
+	uuid, err := client.CreateSmartGroup(ctx, smartGroup)
+	if err != nil {
+		t.Fatalf("CreateSmartGroup() error = %v", err)
+	}
+	got, err := client.GetSmartGroup(ctx, uuid)
+	if err != nil {
+		t.Fatalf("GetSmartGroup() error = %v", err)
+	}
+	if got.Name != "sg" || len(got.Selector.Expressions) != 2 || got.Selector.Expressions[1].Tags["k"] != "v" {
+		t.Errorf("GetSmartGroup() = %+v", got)
+	}
+	smartGroup.Name = "sg-renamed"
+	if err := client.UpdateSmartGroup(ctx, smartGroup, uuid); err != nil {
+		t.Fatalf("UpdateSmartGroup() error = %v", err)
+	}
+	if got, err := client.GetSmartGroup(ctx, uuid); err != nil || got.Name != "sg-renamed" {
+		t.Errorf("GetSmartGroup() after update = %+v, %v", got, err)
+	}
+
+	if _, err := client.GetDistributedFirewallingPolicyList(ctx); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetDistributedFirewallingPolicyList() error = %v, want ErrNotFound", err)
+	}
+	policyList := &goaviatrix.DistributedFirewallingPolicyList{Policies: []goaviatrix.DistributedFirewallingPolicy{
+		{Name: "allow", Action: "PERMIT", Priority: 1, Protocol: "TCP", SrcSmartGroups: []string{uuid}, DstSmartGroups: []string{uuid}},
+	}}
+	if err := client.CreateDistributedFirewallingPolicyList(ctx, policyList); err != nil {
+		t.Fatalf("CreateDistributedFirewallingPolicyList() error = %v", err)
+	}
+	gotList, err := client.GetDistributedFirewallingPolicyList(ctx)
+	if err != nil {
+		t.Fatalf("GetDistributedFirewallingPolicyList() error = %v", err)
+	}
+	if len(gotList.Policies) != 1 || gotList.Policies[0].Name != "allow" || gotList.Policies[0].UUID == "" {
+		t.Errorf("GetDistributedFirewallingPolicyList() = %+v", gotList)
+	}
+	if err := client.DeleteSmartGroup(ctx, uuid); err == nil {
+		t.Errorf("DeleteSmartGroup() of a smart group in use succeeded")
+	}
+
+	if err := client.DeleteDistributedFirewallingPolicyList(ctx); err != nil {
+		t.Fatalf("DeleteDistributedFirewallingPolicyList() error = %v", err)
+	}
+	if err := client.DeleteSmartGroup(ctx, uuid); err != nil {
+		t.Fatalf("DeleteSmartGroup() error = %v", err)
+	}
+	if _, err := client.GetSmartGroup(ctx, uuid); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetSmartGroup() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSite2Cloud(t *testing.T) {
+	_, client := newTestClient(t)
+	createTestAccount(t, client, "aws-account")
+	err := client.LaunchSpokeVpc(context.Background(), &goaviatrix.SpokeVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: "aws-account",
+		GwName:      "gw",
+		VpcID:       "vpc-1",
+		VpcRegion:   "us-east-1",
+		VpcSize:     "t3.small",
+		Subnet:      "10.1.0.0/24",
+	})
+	if err != nil {
+		t.Fatalf("LaunchSpokeVpc() error = %v", err)
+	}
+
+	site2cloud := &goaviatrix.Site2Cloud{
+		VpcID:        "vpc-1",
+		TunnelName:   "s2c",
+		ConnType:     "unmapped",
+		TunnelType:   "policy",
+		RemoteGwType: "generic",
+		GwName:       "gw",
+		RemoteGwIP:   "198.51.100.1",
+		RemoteSubnet: "192.168.0.0/16",
+		LocalSubnet:  "10.1.0.0/24",
+		HAEnabled:    "no",
+	}
+	if err := client.CreateSite2Cloud(site2cloud); err != nil {
+		t.Fatalf("CreateSite2Cloud() error = %v", err)
+	}
+	if _, err := client.GetSite2Cloud(&goaviatrix.Site2Cloud{VpcID: "vpc-1", TunnelName: "s2c"}); err != nil {
+		t.Errorf("GetSite2Cloud() error = %v", err)
+	}
+	detail, err := client.GetSite2CloudConnDetail(&goaviatrix.Site2Cloud{VpcID: "vpc-1", TunnelName: "s2c"})
+	if err != nil {
+		t.Fatalf("GetSite2CloudConnDetail() error = %v", err)
+	}
+	if detail.GwName != "gw" || detail.RemoteGwIP != "198.51.100.1" || detail.RemoteSubnet != "192.168.0.0/16" || detail.TunnelType != "policy" || detail.CustomAlgorithms {
+		t.Errorf("GetSite2CloudConnDetail() = %+v", detail)
+	}
+	if !detail.DeadPeerDetection || detail.Phase1LocalIdentifier != "public_ip" {
+		t.Errorf("GetSite2CloudConnDetail() of a new connection = %+v", detail)
+	}
+	if err := client.DisableDeadPeerDetection(site2cloud); err != nil {
+		t.Fatalf("DisableDeadPeerDetection() error = %v", err)
+	}
+	if err := client.EnableSite2CloudEventTriggeredHA("vpc-1", "s2c"); err != nil {
+		t.Fatalf("EnableSite2CloudEventTriggeredHA() error = %v", err)
+	}
+	detail, err = client.GetSite2CloudConnDetail(&goaviatrix.Site2Cloud{VpcID: "vpc-1", TunnelName: "s2c"})
+	if err != nil || detail.DeadPeerDetection || !detail.EventTriggeredHA {
+		t.Errorf("GetSite2CloudConnDetail() after update = %+v, %v", detail, err)
+	}
+
+	if err := client.DeleteSite2Cloud(&goaviatrix.Site2Cloud{VpcID: "vpc-1", TunnelName: "s2c"}); err != nil {
+		t.Fatalf("DeleteSite2Cloud() error = %v", err)
+	}
+	if _, err := client.GetSite2CloudConnDetail(&goaviatrix.Site2Cloud{VpcID: "vpc-1", TunnelName: "s2c"}); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetSite2CloudConnDetail() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEdgeSpoke(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	edgeSpoke := &goaviatrix.EdgeSpoke{
+		GwName:              "edge",
+		SiteId:              "site-1",
+		ZtpFileType:         "cloud-init",
+		ZtpFileDownloadPath: dir,
+		InterfaceList: []*goaviatrix.EdgeSpokeInterface{
+			{IfName: "eth0", Type: "WAN", IpAddr: "10.4.0.10/24", GatewayIp: "10.4.0.1"},
+		},
+	}
+	if err := client.CreateEdgeSpoke(ctx, edgeSpoke); err != nil {
+		t.Fatalf("CreateEdgeSpoke() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "edge-site-1-cloud-init.txt")); err != nil {
+		t.Errorf("CreateEdgeSpoke() did not download the ZTP file: %v", err)
+	}
+	if err := client.EnableEdgeSpokeTransitiveRouting(ctx, "edge"); err != nil {
+		t.Fatalf("EnableEdgeSpokeTransitiveRouting() error = %v", err)
+	}
+	if err := client.SetLocalASNumber(ctx, &goaviatrix.TransitVpc{GwName: "edge"}, "65002"); err != nil {
+		t.Fatalf("SetLocalASNumber() error = %v", err)
+	}
+	edgeSpoke.Latitude, edgeSpoke.Longitude = "37.4", "-122.1"
+	if err := client.UpdateEdgeSpokeGeoCoordinate(ctx, edgeSpoke); err != nil {
+		t.Fatalf("UpdateEdgeSpokeGeoCoordinate() error = %v", err)
+	}
+
+	got, err := client.GetEdgeSpoke(ctx, "edge")
+	if err != nil {
+		t.Fatalf("GetEdgeSpoke() error = %v", err)
+	}
+	if got.SiteId != "site-1" || got.ZtpFileType != "cloud-init" || !got.EnableEdgeTransitiveRouting || got.LocalAsNumber != "65002" ||
+		got.Latitude != 37.4 || len(got.InterfaceList) != 1 || got.InterfaceList[0].IpAddr != "10.4.0.10/24" {
+		t.Errorf("GetEdgeSpoke() = %+v", got)
+	}
+
+	if err := client.DeleteEdgeSpoke(ctx, "edge"); err != nil {
+		t.Fatalf("DeleteEdgeSpoke() error = %v", err)
+	}
+	if _, err := client.GetEdgeSpoke(ctx, "edge"); !errors.Is(err, goaviatrix.ErrNotFound) {
+		t.Errorf("GetEdgeSpoke() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestExpireSessions(t *testing.T) {
+	server, client := newTestClient(t)
+	createTestAccount(t, client, "aws-account")
+
+	server.ExpireSessions()
+	if _, err := client.GetAccount(&goaviatrix.Account{AccountName: "aws-account"}); err != nil {
+		t.Errorf("GetAccount() after the session expired error = %v", err)
+	}
+	server.ExpireSessions()
+	if _, err := client.GetSmartGroups(context.Background()); err != nil {
+		t.Errorf("GetSmartGroups() after the session expired error = %v", err)
+	}
+}
+
+func TestHandleAction(t *testing.T) {
+	server, client := newTestClient(t)
+
+	err := client.PostAPI("enable_fqdn_cache_global", map[string]string{"action": "enable_fqdn_cache_global"}, goaviatrix.BasicCheck)
+	if err == nil || !strings.Contains(err.Error(), `action "enable_fqdn_cache_global" is not implemented`) {
+		t.Errorf("PostAPI() of an unimplemented action error = %v", err)
+	}
+
+	server.HandleAction("enable_fqdn_cache_global", func(r *Request) (interface{}, error) {
+		return "FQDN cache has been enabled.", nil
+	})
+	if err := client.PostAPI("enable_fqdn_cache_global", map[string]string{"action": "enable_fqdn_cache_global"}, goaviatrix.BasicCheck); err != nil {
+		t.Errorf("PostAPI() of a handled action error = %v", err)
+	}
+}
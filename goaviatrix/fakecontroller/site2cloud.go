@@ -0,0 +1,204 @@
+package fakecontroller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func (s *Server) registerSite2CloudHandlers() {
+	s.actions["add_site2cloud"] = s.addSite2Cloud
+	s.actions["edit_site2cloud_conn"] = s.editSite2CloudConn
+	s.actions["delete_site2cloud_connection"] = s.deleteSite2CloudConnection
+	s.actions["list_site2cloud_conn"] = s.listSite2CloudConn
+	s.actions["get_site2cloud_conn_detail"] = s.getSite2CloudConnDetail
+	s.actions["enable_dpd_config"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.DeadPeerDetection = true })
+	s.actions["disable_dpd_config"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.DeadPeerDetection = false })
+	s.actions["enable_site2cloud_active_active_ha"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.EnableActiveActive = true })
+	s.actions["disable_site2cloud_active_active_ha"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.EnableActiveActive = false })
+	s.actions["enable_spoke_mapped_site2cloud_forwarding"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.ForwardToTransit = true })
+	s.actions["disable_spoke_mapped_site2cloud_forwarding"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.ForwardToTransit = false })
+	s.actions["enable_site2cloud_event_triggered_ha"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.EventTriggeredHA = true })
+	s.actions["disable_site2cloud_event_triggered_ha"] = s.site2cloudSetting(func(conn *goaviatrix.Site2Cloud) { conn.EventTriggeredHA = false })
+}
+
+func (s *Server) site2cloud(vpcID, name string) (int, *goaviatrix.Site2Cloud) {
+	for i, conn := range s.site2clouds {
+		if conn.VpcID == vpcID && conn.TunnelName == name {
+			return i, conn
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) addSite2Cloud(r *Request) (interface{}, error) {
+	vpcID, name := r.Get("vpc_id"), r.Get("connection_name")
+	if _, conn := s.site2cloud(vpcID, name); conn != nil {
+		return nil, Errorf("Connection %s already exists.", name)
+	}
+	// The HA gateway and remote gateway IP are sent after the primary ones, separated by a comma
+	gwNames := strings.SplitN(r.Get("primary_cloud_gateway_name"), ",", 2)
+	for _, gwName := range gwNames {
+		if _, gw := s.gateway(gwName); gw == nil {
+			return nil, NotFoundf("Gateway %s does not exist.", gwName)
+		}
+	}
+	remoteGwIPs := strings.SplitN(r.Get("remote_gateway_ip"), ",", 2)
+	conn := &goaviatrix.Site2Cloud{
+		VpcID:               vpcID,
+		TunnelName:          name,
+		ConnType:            r.Get("connection_type"),
+		TunnelType:          r.Get("tunnel_type"),
+		RemoteGwType:        r.Get("remote_gateway_type"),
+		GwName:              gwNames[0],
+		RemoteGwIP:          remoteGwIPs[0],
+		RemoteSubnet:        r.Get("remote_subnet_cidr"),
+		LocalSubnet:         r.Get("local_subnet_cidr"),
+		RemoteSubnetVirtual: r.Get("virtual_remote_subnet_cidr"),
+		LocalSubnetVirtual:  r.Get("virtual_local_subnet_cidr"),
+		HAEnabled:           "disabled",
+		Phase1Auth:          r.Get("phase1_auth"),
+		Phase1DhGroups:      r.Get("phase1_dh_group"),
+		Phase1Encryption:    r.Get("phase1_encryption"),
+		Phase2Auth:          r.Get("phase2_auth"),
+		Phase2DhGroups:      r.Get("phase2_dh_group"),
+		Phase2Encryption:    r.Get("phase2_encryption"),
+		EnableIKEv2:         r.Get("enable_ikev2"),
+		SslServerPool:       r.Get("ssl_server_pool"),
+		AuthType:            r.Get("auth_type"),
+		LocalTunnelIp:       r.Get("local_tunnel_ip"),
+		RemoteTunnelIp:      r.Get("remote_tunnel_ip"),
+		// the settings of a new connection, as set by the controller
+		Phase1LocalIdentifier: "public_ip",
+		DeadPeerDetection:     true,
+	}
+	if r.Get("ha_enabled") == "yes" || r.Get("ha_enabled") == "true" {
+		conn.HAEnabled = "enabled"
+		if len(gwNames) == 2 {
+			conn.BackupGwName = gwNames[1]
+		}
+		if len(remoteGwIPs) == 2 {
+			conn.RemoteGwIP2 = remoteGwIPs[1]
+		}
+		conn.BackupLocalTunnelIp = r.Get("backup_local_tunnel_ip")
+		conn.BackupRemoteTunnelIp = r.Get("backup_remote_tunnel_ip")
+		conn.EnableSingleIpHA = r.Get("enable_single_ip_ha") == "true"
+	}
+	s.site2clouds = append(s.site2clouds, conn)
+	return fmt.Sprintf("Site2Cloud connection %s has been created.", name), nil
+}
+
+func (s *Server) editSite2CloudConn(r *Request) (interface{}, error) {
+	_, conn := s.site2cloud(r.Get("vpc_id"), r.Get("conn_name"))
+	if conn == nil {
+		return nil, NotFoundf("Connection %s does not exist.", r.Get("conn_name"))
+	}
+	if v, ok := r.Params["phase1_identifier"]; ok {
+		conn.Phase1LocalIdentifier = v[0]
+	}
+	if v, ok := r.Params["phase1_remote_identifier"]; ok {
+		conn.Phase1RemoteIdentifier = v[0]
+	}
+	return fmt.Sprintf("Site2Cloud connection %s has been updated.", conn.TunnelName), nil
+}
+
+// site2cloudSetting returns a handler that applies set to the connection named by the request.
+func (s *Server) site2cloudSetting(set func(conn *goaviatrix.Site2Cloud)) HandlerFunc {
+	return func(r *Request) (interface{}, error) {
+		_, conn := s.site2cloud(r.Get("vpc_id"), r.Get("connection_name"))
+		if conn == nil {
+			return nil, NotFoundf("Connection %s does not exist.", r.Get("connection_name"))
+		}
+		set(conn)
+		return fmt.Sprintf("Site2Cloud connection %s has been updated.", conn.TunnelName), nil
+	}
+}
+
+func (s *Server) deleteSite2CloudConnection(r *Request) (interface{}, error) {
+	i, conn := s.site2cloud(r.Get("vpc_id"), r.Get("connection_name"))
+	if conn == nil {
+		return nil, NotFoundf("Connection %s does not exist.", r.Get("connection_name"))
+	}
+	s.site2clouds = append(s.site2clouds[:i], s.site2clouds[i+1:]...)
+	return fmt.Sprintf("Site2Cloud connection %s has been deleted.", conn.TunnelName), nil
+}
+
+func (s *Server) listSite2CloudConn(r *Request) (interface{}, error) {
+	connections := make([]goaviatrix.Site2Cloud, 0, len(s.site2clouds))
+	for _, conn := range s.site2clouds {
+		connections = append(connections, *conn)
+	}
+	return goaviatrix.Site2CloudConnList{Connections: connections}, nil
+}
+
+// getSite2CloudConnDetail returns the connection detail, with the default algorithms unless custom
+// ones were set when the connection was created.
+func (s *Server) getSite2CloudConnDetail(r *Request) (interface{}, error) {
+	_, conn := s.site2cloud(r.Get("vpc_id"), r.Get("conn_name"))
+	if conn == nil {
+		return nil, NotFoundf("Connection %s does not exist.", r.Get("conn_name"))
+	}
+	orDefault := func(value, defaultValue string) []string {
+		if value == "" {
+			return []string{defaultValue}
+		}
+		return []string{value}
+	}
+	detail := goaviatrix.EditSite2CloudConnDetail{
+		VpcID:      []string{conn.VpcID},
+		TunnelName: []string{conn.TunnelName},
+		ConnType:   conn.ConnType,
+		TunnelType: conn.TunnelType,
+		GwName:     conn.GwName,
+		Tunnels: []goaviatrix.TunnelInfo{
+			{Name: conn.TunnelName, GwName: conn.GwName, PeerIP: conn.RemoteGwIP, Status: "up", TunnelStatus: "up"},
+		},
+		RemoteCidr:          conn.RemoteSubnet,
+		LocalCidr:           conn.LocalSubnet,
+		RemoteSubnet:        conn.RemoteSubnet,
+		LocalSubnet:         conn.LocalSubnet,
+		RemoteSubnetVirtual: conn.RemoteSubnetVirtual,
+		LocalSubnetVirtual:  conn.LocalSubnetVirtual,
+		HAEnabled:           conn.HAEnabled,
+		PeerType:            conn.RemoteGwType,
+		Algorithm: goaviatrix.AlgorithmInfo{
+			Phase1Auth:      orDefault(conn.Phase1Auth, goaviatrix.Phase1AuthDefault),
+			Phase1DhGroups:  orDefault(conn.Phase1DhGroups, goaviatrix.Phase1DhGroupDefault),
+			Phase1Encrption: orDefault(conn.Phase1Encryption, goaviatrix.Phase1EncryptionDefault),
+			Phase2Auth:      orDefault(conn.Phase2Auth, goaviatrix.Phase2AuthDefault),
+			Phase2DhGroups:  orDefault(conn.Phase2DhGroups, goaviatrix.Phase2DhGroupDefault),
+			Phase2Encrption: orDefault(conn.Phase2Encryption, goaviatrix.Phase2EncryptionDefault),
+		},
+		SslServerPool:           orDefault(conn.SslServerPool, goaviatrix.SslServerPoolDefault),
+		DeadPeerDetectionConfig: enableDisable(conn.DeadPeerDetection, "enable", "disable"),
+		EnableActiveActive:      enableDisable(conn.EnableActiveActive, "enable", "disable"),
+		ForwardToTransit:        enableDisable(conn.ForwardToTransit, "enable", "disable"),
+		EventTriggeredHA:        enableDisable(conn.EventTriggeredHA, "enabled", "disabled"),
+		EnableSingleIpHA:        enableDisable(conn.EnableSingleIpHA, "enabled", "disabled"),
+		AuthType:                conn.AuthType,
+		BgpLocalIP:              conn.LocalTunnelIp,
+		BgpRemoteIP:             conn.RemoteTunnelIp,
+		BgpBackupLocalIP:        conn.BackupLocalTunnelIp,
+		BgpBackupRemoteIP:       conn.BackupRemoteTunnelIp,
+		Phase1LocalIdentifier:   conn.Phase1LocalIdentifier,
+		Phase1RemoteIdentifier:  conn.Phase1RemoteIdentifier,
+	}
+	if conn.EnableIKEv2 == "true" {
+		detail.EnableIKEv2 = "2"
+	}
+	if conn.BackupGwName != "" {
+		detail.Tunnels = append(detail.Tunnels, goaviatrix.TunnelInfo{
+			Name: conn.TunnelName, GwName: conn.BackupGwName, PeerIP: conn.RemoteGwIP2, Status: "up", TunnelStatus: "up",
+		})
+	}
+	return goaviatrix.Site2CloudConnDetailList{Connections: detail}, nil
+}
+
+// enableDisable returns enabled or disabled, as the controller spells them for the setting.
+func enableDisable(b bool, enabled, disabled string) string {
+	if b {
+		return enabled
+	}
+	return disabled
+}
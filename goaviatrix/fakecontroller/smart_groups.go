@@ -0,0 +1,74 @@
+package fakecontroller
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerSmartGroupHandlers() {
+	s.HandleRoute(http.MethodPost, "app-domains", s.createSmartGroup)
+	s.HandleRoute(http.MethodGet, "app-domains", s.listSmartGroups)
+	s.HandleRoute(http.MethodPut, "app-domains/{uuid}", s.updateSmartGroup)
+	s.HandleRoute(http.MethodDelete, "app-domains/{uuid}", s.deleteSmartGroup)
+}
+
+func (s *Server) smartGroup(uuid string) (int, map[string]interface{}) {
+	for i, smartGroup := range s.smartGroups {
+		if smartGroup["uuid"] == uuid {
+			return i, smartGroup
+		}
+	}
+	return -1, nil
+}
+
+// createSmartGroup stores the smart group as it was sent, which is also the format it is listed in.
+func (s *Server) createSmartGroup(r *Request) (interface{}, error) {
+	var smartGroup map[string]interface{}
+	if err := r.Decode(&smartGroup); err != nil {
+		return nil, Errorf("invalid smart group: %v", err)
+	}
+	if name, _ := smartGroup["name"].(string); name == "" {
+		return nil, Errorf("name is required")
+	}
+	uuid := s.newUUID()
+	smartGroup["uuid"] = uuid
+	s.smartGroups = append(s.smartGroups, smartGroup)
+	return map[string]string{"uuid": uuid}, nil
+}
+
+func (s *Server) listSmartGroups(r *Request) (interface{}, error) {
+	smartGroups := make([]map[string]interface{}, 0, len(s.smartGroups))
+	smartGroups = append(smartGroups, s.smartGroups...)
+	return map[string]interface{}{"app_domains": smartGroups}, nil
+}
+
+func (s *Server) updateSmartGroup(r *Request) (interface{}, error) {
+	i, smartGroup := s.smartGroup(r.PathValue("uuid"))
+	if smartGroup == nil {
+		return nil, NotFoundf("Smart group %s does not exist.", r.PathValue("uuid"))
+	}
+	var update map[string]interface{}
+	if err := r.Decode(&update); err != nil {
+		return nil, Errorf("invalid smart group: %v", err)
+	}
+	update["uuid"] = smartGroup["uuid"]
+	s.smartGroups[i] = update
+	return nil, nil
+}
+
+func (s *Server) deleteSmartGroup(r *Request) (interface{}, error) {
+	i, smartGroup := s.smartGroup(r.PathValue("uuid"))
+	if smartGroup == nil {
+		return nil, NotFoundf("Smart group %s does not exist.", r.PathValue("uuid"))
+	}
+	if s.policyList != nil {
+		for _, policy := range s.policyList.Policies {
+			for _, uuid := range append(policy.SrcSmartGroups, policy.DstSmartGroups...) {
+				if uuid == smartGroup["uuid"] {
+					return nil, Errorf("Smart group %s is used by policy %s.", uuid, policy.Name)
+				}
+			}
+		}
+	}
+	s.smartGroups = append(s.smartGroups[:i], s.smartGroups[i+1:]...)
+	return nil, nil
+}
@@ -10,7 +10,6 @@ type FilebeatForwarderResp struct {
 func (c *Client) GetFilebeatForwarderStatus() (*FilebeatForwarderResp, error) {
 	params := map[string]string{
 		"action": "get_logstash_logging_status",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -36,7 +35,6 @@ func (c *Client) GetFilebeatForwarderStatus() (*FilebeatForwarderResp, error) {
 func (c *Client) DisableFilebeatForwarder() error {
 	params := map[string]string{
 		"action": "disable_logstash_logging",
-		"CID":    c.CID,
 	}
 
 	return c.PostAPI(params["action"], params, BasicCheck)
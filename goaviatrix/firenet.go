@@ -1,13 +1,13 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 )
 
 type FireNet struct {
-	CID               string `form:"CID,omitempty"`
 	Action            string `form:"action,omitempty"`
 	VpcID             string `form:"vpc_id,omitempty" json:"vpc_id,omitempty"`
 	GwName            string `form:"gw_name,omitempty" json:"gw_name,omitempty"`
@@ -75,7 +75,6 @@ func (c *Client) CreateFireNet(fireNet *FireNet) error {
 
 func (c *Client) GetFireNet(fireNet *FireNet) (*FireNetDetail, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "show_firenet_detail",
 		"vpc_id": fireNet.VpcID,
 	}
@@ -104,9 +103,8 @@ func (c *Client) GetFireNet(fireNet *FireNet) (*FireNetDetail, error) {
 	return nil, ErrNotFound
 }
 
-func (c *Client) AssociateFirewallWithFireNet(firewallInstance *FirewallInstance) error {
+func (c *Client) AssociateFirewallWithFireNet(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "associate_firewall_with_firenet",
 		"vpc_id":       firewallInstance.VpcID,
 		"gateway_name": firewallInstance.GwName,
@@ -132,12 +130,11 @@ func (c *Client) AssociateFirewallWithFireNet(firewallInstance *FirewallInstance
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
-func (c *Client) DisassociateFirewallFromFireNet(firewallInstance *FirewallInstance) error {
+func (c *Client) DisassociateFirewallFromFireNet(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "disassociate_firewall_with_firenet",
 		"vpc_id":      firewallInstance.VpcID,
 		"firewall_id": firewallInstance.InstanceID,
@@ -153,12 +150,11 @@ func (c *Client) DisassociateFirewallFromFireNet(firewallInstance *FirewallInsta
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) AttachFirewallToFireNet(firewallInstance *FirewallInstance) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "attach_firewall_to_firenet",
 		"vpc_id":      firewallInstance.VpcID,
 		"firewall_id": firewallInstance.InstanceID,
@@ -169,7 +165,6 @@ func (c *Client) AttachFirewallToFireNet(firewallInstance *FirewallInstance) err
 
 func (c *Client) DetachFirewallFromFireNet(firewallInstance *FirewallInstance) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "detach_firewall_from_firenet",
 		"vpc_id":      firewallInstance.VpcID,
 		"firewall_id": firewallInstance.InstanceID,
@@ -178,9 +173,8 @@ func (c *Client) DetachFirewallFromFireNet(firewallInstance *FirewallInstance) e
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) ConnectFireNetWithTgw(awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error {
+func (c *Client) ConnectFireNetWithTgw(ctx context.Context, awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "connect_firenet_with_tgw",
 		"vpc_id":      vpcSolo.VpcID,
 		"tgw_name":    awsTgw.Name,
@@ -188,23 +182,21 @@ func (c *Client) ConnectFireNetWithTgw(awsTgw *AWSTgw, vpcSolo VPCSolo, Security
 		"async":       "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) DisconnectFireNetFromTgw(awsTgw *AWSTgw, vpcID string) error {
+func (c *Client) DisconnectFireNetFromTgw(ctx context.Context, awsTgw *AWSTgw, vpcID string) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disconnect_firenet_with_tgw",
 		"vpc_id": vpcID,
 		"async":  "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EditFireNetInspection(fireNet *FireNet) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "edit_firenet",
 		"vpc_id":     fireNet.VpcID,
 		"inspection": strconv.FormatBool(fireNet.Inspection),
@@ -225,7 +217,6 @@ func (c *Client) EditFireNetInspection(fireNet *FireNet) error {
 
 func (c *Client) EditFireNetEgress(fireNet *FireNet) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "edit_firenet",
 		"vpc_id":          fireNet.VpcID,
 		"firewall_egress": strconv.FormatBool(fireNet.FirewallEgress),
@@ -247,7 +238,6 @@ func (c *Client) EditFireNetEgress(fireNet *FireNet) error {
 func (c *Client) EditFireNetHashingAlgorithm(fireNet *FireNet) error {
 	data := map[string]string{
 		"action":           "edit_firenet",
-		"CID":              c.CID,
 		"vpc_id":           fireNet.VpcID,
 		"firewall_hashing": fireNet.HashingAlgorithm,
 	}
@@ -268,7 +258,6 @@ func (c *Client) EditFireNetHashingAlgorithm(fireNet *FireNet) error {
 func (c *Client) EnableFireNetLanKeepAlive(net *FireNet) error {
 	data := map[string]string{
 		"action":   "edit_firenet",
-		"CID":      c.CID,
 		"vpc_id":   net.VpcID,
 		"lan_ping": "true",
 	}
@@ -290,7 +279,6 @@ func (c *Client) EnableFireNetLanKeepAlive(net *FireNet) error {
 func (c *Client) DisableFireNetLanKeepAlive(net *FireNet) error {
 	data := map[string]string{
 		"action":   "edit_firenet",
-		"CID":      c.CID,
 		"vpc_id":   net.VpcID,
 		"lan_ping": "false",
 	}
@@ -312,7 +300,6 @@ func (c *Client) DisableFireNetLanKeepAlive(net *FireNet) error {
 func (c *Client) EnableTgwSegmentationForEgress(net *FireNet) error {
 	data := map[string]string{
 		"action": "enable_firenet_tgw_segmentation_for_egress",
-		"CID":    c.CID,
 		"vpc_id": net.VpcID,
 	}
 
@@ -322,7 +309,6 @@ func (c *Client) EnableTgwSegmentationForEgress(net *FireNet) error {
 func (c *Client) DisableTgwSegmentationForEgress(net *FireNet) error {
 	data := map[string]string{
 		"action": "disable_firenet_tgw_segmentation_for_egress",
-		"CID":    c.CID,
 		"vpc_id": net.VpcID,
 	}
 
@@ -332,7 +318,6 @@ func (c *Client) DisableTgwSegmentationForEgress(net *FireNet) error {
 func (c *Client) EditFirenetEgressStaticCidr(net *FireNet) error {
 	data := map[string]string{
 		"action":             "edit_firenet_egress_static_cidr",
-		"CID":                c.CID,
 		"vpc_id":             net.VpcID,
 		"egress_static_cidr": net.EgressStaticCidrs,
 	}
@@ -343,7 +328,6 @@ func (c *Client) EditFirenetEgressStaticCidr(net *FireNet) error {
 func (c *Client) EditFirenetExcludedCidr(net *FireNet) error {
 	form := map[string]string{
 		"action":       "edit_firenet_excluded_cidr",
-		"CID":          c.CID,
 		"vpc_id":       net.VpcID,
 		"exclude_cidr": net.ExcludedCidrs,
 	}
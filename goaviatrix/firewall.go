@@ -7,8 +7,6 @@ import (
 	"strings"
 
 	"golang.org/x/net/context"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type Policy struct {
@@ -24,7 +22,6 @@ type Policy struct {
 
 // Gateway simple struct to hold firewall details
 type Firewall struct {
-	CID            string    `form:"CID,omitempty"`
 	Action         string    `form:"action,omitempty"`
 	GwName         string    `form:"vpc_name,omitempty" json:"vpc_name,omitempty"`
 	BasePolicy     string    `form:"base_policy,omitempty" json:"base_policy,omitempty"`
@@ -41,7 +38,6 @@ type FirewallResp struct {
 
 func (c *Client) SetBasePolicy(firewall *Firewall) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "set_vpc_base_policy",
 		"vpc_name":               firewall.GwName,
 		"base_policy":            firewall.BasePolicy,
@@ -52,7 +48,6 @@ func (c *Client) SetBasePolicy(firewall *Firewall) error {
 }
 
 func (c *Client) UpdatePolicy(firewall *Firewall) error {
-	firewall.CID = c.CID
 	firewall.Action = "update_access_policy"
 	// If the PolicyList is nil it will be encoded as 'null'.
 	// Instead, we want to set PolicyList to an empty slice so that it is encoded as '[]'.
@@ -70,15 +65,17 @@ func (c *Client) UpdatePolicy(firewall *Firewall) error {
 
 func (c *Client) GetPolicy(firewall *Firewall) (*Firewall, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "vpc_access_policy",
 		"vpc_name": firewall.GwName,
 	}
 
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
-				log.Errorf("Couldn't find Aviatrix Firewall policies for gateway %s: %s", firewall.GwName, reason)
+			if IsNotFoundReason(reason) {
+				logError(c.logContext(context.Background()), "Couldn't find Aviatrix firewall policies", map[string]interface{}{
+					"gw_name": firewall.GwName,
+					"reason":  reason,
+				})
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -141,7 +138,6 @@ func (c *Client) AddFirewallPolicy(fw *Firewall) error {
 	}
 
 	form := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       "append_stateful_firewall_rules",
 		"gateway_name": fw.GwName,
 		"rules":        string(rules),
@@ -157,7 +153,6 @@ func (c *Client) DeleteFirewallPolicy(fw *Firewall) error {
 	}
 
 	form := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       "delete_stateful_firewall_rules",
 		"gateway_name": fw.GwName,
 		"rules":        string(rules),
@@ -214,7 +209,6 @@ func (c *Client) InsertFirewallPolicy(fw *Firewall) error {
 	}
 
 	form := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       "insert_stateful_firewall_rules",
 		"gateway_name": fw.GwName,
 		"rules":        string(rules),
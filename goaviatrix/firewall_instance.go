@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -8,7 +9,6 @@ import (
 )
 
 type FirewallInstance struct {
-	CID                  string `form:"CID,omitempty"`
 	Action               string `form:"action,omitempty"`
 	CloudType            int    `form:"cloud_type,omitempty"`
 	VpcID                string `form:"vpc_id,omitempty" json:"vpc_id,omitempty"`
@@ -90,10 +90,9 @@ type FirewallInstanceImage struct {
 	Size    []string `json:"size"`
 }
 
-func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (string, error) {
+func (c *Client) CreateFirewallInstance(ctx context.Context, firewallInstance *FirewallInstance) (string, error) {
 	action := "add_firewall_instance"
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 action,
 		"cloud_type":             strconv.Itoa(firewallInstance.CloudType),
 		"firewall_name":          firewallInstance.FirewallName,
@@ -165,7 +164,7 @@ func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (str
 	}
 
 	var data FirewallInstanceCreateResp
-	err := c.PostAPIWithResponse(&data, action, form, BasicCheck)
+	err := c.PostAPIContextWithResponse(ctx, &data, action, form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -177,7 +176,6 @@ func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (str
 
 func (c *Client) GetFirewallInstance(firewallInstance *FirewallInstance) (*FirewallInstance, error) {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "get_instance_by_id",
 		"instance_id": firewallInstance.InstanceID,
 	}
@@ -213,21 +211,19 @@ func (c *Client) GetFirewallInstance(firewallInstance *FirewallInstance) (*Firew
 	return nil, ErrNotFound
 }
 
-func (c *Client) DeleteFirewallInstance(firewallInstance *FirewallInstance) error {
+func (c *Client) DeleteFirewallInstance(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "delete_firenet_firewall_instance",
 		"vpc_id":      firewallInstance.VpcID,
 		"firewall_id": firewallInstance.InstanceID,
 		"async":       "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetFirewallInstanceImages(vpcId string) (*[]FirewallInstanceImage, error) {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "list_firenet",
 		"subaction": "firewall_image",
 		"vpc_id":    vpcId,
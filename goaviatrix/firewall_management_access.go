@@ -1,7 +1,6 @@
 package goaviatrix
 
 type FirewallManagementAccess struct {
-	CID                          string `form:"CID,omitempty"`
 	Action                       string `form:"action,omitempty"`
 	TransitFireNetGatewayName    string `form:"transit_firenet_gateway_name,omitempty" json:"gw_name,omitempty"`
 	ManagementAccessResourceName string `form:"management_access,omitempty" json:"management_access,omitempty"`
@@ -15,7 +14,6 @@ type FirewallManagementAccessAPIResp struct {
 
 func (c *Client) CreateFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) error {
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "edit_transit_firenet_management_access",
 		"gateway_name":      firewallManagementAccess.TransitFireNetGatewayName,
 		"management_access": firewallManagementAccess.ManagementAccessResourceName,
@@ -26,7 +24,6 @@ func (c *Client) CreateFirewallManagementAccess(firewallManagementAccess *Firewa
 
 func (c *Client) GetFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) (*FirewallManagementAccess, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_transit_firenet_spoke_policies",
 	}
 
@@ -56,7 +53,6 @@ func (c *Client) GetFirewallManagementAccess(firewallManagementAccess *FirewallM
 
 func (c *Client) DestroyFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) error {
 	form := map[string]string{
-		"CID":               c.CID,
 		"action":            "edit_transit_firenet_management_access",
 		"gateway_name":      firewallManagementAccess.TransitFireNetGatewayName,
 		"management_access": firewallManagementAccess.ManagementAccessResourceName,
@@ -11,7 +11,6 @@ type CIDRMember struct {
 
 // Gateway simple struct to hold firewall_tag details
 type FirewallTag struct {
-	CID      string       `form:"CID,omitempty"`
 	Action   string       `form:"action,omitempty"`
 	Name     string       `form:"tag_name,omitempty" json:"tag_name,omitempty"`
 	CIDRList []CIDRMember `form:"new_policies,omitempty" json:"members,omitempty"`
@@ -24,7 +23,6 @@ type FirewallTagResp struct {
 }
 
 func (c *Client) CreateFirewallTag(firewall_tag *FirewallTag) error {
-	firewall_tag.CID = c.CID
 	firewall_tag.Action = "add_policy_tag"
 
 	return c.PostAPI(firewall_tag.Action, firewall_tag, BasicCheck)
@@ -33,7 +31,6 @@ func (c *Client) CreateFirewallTag(firewall_tag *FirewallTag) error {
 func (c *Client) UpdateFirewallTag(firewall_tag *FirewallTag) error {
 	action := "update_policy_members"
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   action,
 		"tag_name": firewall_tag.Name,
 	}
@@ -52,7 +49,6 @@ func (c *Client) UpdateFirewallTag(firewall_tag *FirewallTag) error {
 
 func (c *Client) GetFirewallTag(firewall_tag *FirewallTag) (*FirewallTag, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_policy_members",
 		"tag_name": firewall_tag.Name,
 	}
@@ -75,7 +71,6 @@ func (c *Client) GetFirewallTag(firewall_tag *FirewallTag) (*FirewallTag, error)
 }
 
 func (c *Client) DeleteFirewallTag(firewall_tag *FirewallTag) error {
-	firewall_tag.CID = c.CID
 	firewall_tag.Action = "del_policy_tag"
 
 	return c.PostAPI(firewall_tag.Action, firewall_tag, BasicCheck)
@@ -6,8 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type Filters struct {
@@ -21,7 +19,6 @@ type Filters struct {
 type FQDN struct {
 	FQDNTag         string        `form:"tag_name,omitempty" json:"tag_name,omitempty"`
 	Action          string        `form:"action,omitempty"`
-	CID             string        `form:"CID,omitempty"`
 	FQDNStatus      string        `form:"status,omitempty" json:"status,omitempty"`
 	FQDNMode        string        `form:"color,omitempty" json:"color,omitempty"`
 	GwFilterTagList []GwFilterTag `form:"gw_name,omitempty" json:"gw_name,omitempty"`
@@ -79,7 +76,6 @@ type FQDNPrivateNetworkingFilteringStatus struct {
 
 func (c *Client) CreateFQDN(fqdn *FQDN) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "add_fqdn_filter_tag",
 		"tag_name": fqdn.FQDNTag,
 	}
@@ -89,7 +85,6 @@ func (c *Client) CreateFQDN(fqdn *FQDN) error {
 
 func (c *Client) DeleteFQDN(fqdn *FQDN) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "del_fqdn_filter_tag",
 		"tag_name": fqdn.FQDNTag,
 	}
@@ -100,7 +95,6 @@ func (c *Client) DeleteFQDN(fqdn *FQDN) error {
 // UpdateFQDNStatus change state to 'enabled' or 'disabled'
 func (c *Client) UpdateFQDNStatus(fqdn *FQDN) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "set_fqdn_filter_tag_state",
 		"tag_name": fqdn.FQDNTag,
 		"status":   fqdn.FQDNStatus,
@@ -112,7 +106,6 @@ func (c *Client) UpdateFQDNStatus(fqdn *FQDN) error {
 // UpdateFQDNMode Change default mode to 'white' or 'black'
 func (c *Client) UpdateFQDNMode(fqdn *FQDN) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "set_fqdn_filter_tag_color",
 		"tag_name": fqdn.FQDNTag,
 		"color":    fqdn.FQDNMode,
@@ -124,7 +117,6 @@ func (c *Client) UpdateFQDNMode(fqdn *FQDN) error {
 func (c *Client) UpdateDomains(fqdn *FQDN) error {
 	action := "set_fqdn_filter_tag_domain_names"
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   action,
 		"tag_name": fqdn.FQDNTag,
 	}
@@ -145,7 +137,6 @@ func (c *Client) AttachGws(fqdn *FQDN) error {
 
 func (c *Client) DetachGws(fqdn *FQDN, gwList []string) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "detach_fqdn_filter_tag_from_gw",
 		"tag_name": fqdn.FQDNTag,
 	}
@@ -163,7 +154,6 @@ func (c *Client) DetachGws(fqdn *FQDN, gwList []string) error {
 
 func (c *Client) ListFQDNTags() ([]*FQDN, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_fqdn_filter_tags",
 	}
 
@@ -202,13 +192,12 @@ func (c *Client) GetFQDNTag(fqdn *FQDN) (*FQDN, error) {
 			return fqdn, nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix FQDN tag %s", fqdn.FQDNTag)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix FQDN tag", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 	return nil, ErrNotFound
 }
 
 func (c *Client) ListDomains(fqdn *FQDN) (*FQDN, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_fqdn_filter_tag_domain_names",
 		"tag_name": fqdn.FQDNTag,
 	}
@@ -237,7 +226,6 @@ func (c *Client) ListDomains(fqdn *FQDN) (*FQDN, error) {
 
 func (c *Client) ListGws(fqdn *FQDN) ([]string, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_fqdn_filter_tag_attached_gws",
 		"tag_name": fqdn.FQDNTag,
 	}
@@ -254,7 +242,6 @@ func (c *Client) ListGws(fqdn *FQDN) ([]string, error) {
 
 func (c *Client) AttachTagToGw(fqdn *FQDN, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "attach_fqdn_filter_tag_to_gw",
 		"tag_name": fqdn.FQDNTag,
 		"gw_name":  gateway.GwName,
@@ -265,7 +252,6 @@ func (c *Client) AttachTagToGw(fqdn *FQDN, gateway *Gateway) error {
 
 func (c *Client) UpdateSourceIPFilters(fqdn *FQDN, gateway *Gateway, sourceIPs []string) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "update_fqdn_filter_tag_source_ip_filters",
 		"tag_name":     fqdn.FQDNTag,
 		"gateway_name": gateway.GwName,
@@ -291,7 +277,6 @@ func (c *Client) GetGwFilterTagList(fqdn *FQDN) (*FQDN, error) {
 	var gwFilterTagList []GwFilterTag
 	for i := range listGws {
 		form := map[string]string{
-			"CID":          c.CID,
 			"action":       "list_fqdn_filter_tag_source_ip_filters",
 			"tag_name":     fqdn.FQDNTag,
 			"gateway_name": listGws[i],
@@ -320,7 +305,6 @@ func (c *Client) GetGwFilterTagList(fqdn *FQDN) (*FQDN, error) {
 
 func (c *Client) GetFQDNPassThroughCIDRs(gw *Gateway) ([]string, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_fqdn_pass_through_cidrs",
 		"gateway_name": gw.GwName,
 	}
@@ -341,7 +325,6 @@ func (c *Client) GetFQDNPassThroughCIDRs(gw *Gateway) ([]string, error) {
 
 func (c *Client) ConfigureFQDNPassThroughCIDRs(gw *Gateway, IPs []string) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "update_fqdn_pass_through_cidrs",
 		"gateway_name": gw.GwName,
 	}
@@ -359,7 +342,6 @@ func (c *Client) ConfigureFQDNPassThroughCIDRs(gw *Gateway, IPs []string) error
 
 func (c *Client) DisableFQDNPassThrough(gw *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "update_fqdn_pass_through_cidrs",
 		"gateway_name": gw.GwName,
 	}
@@ -374,7 +356,6 @@ func (c *Client) AddFQDNTagRule(fqdn *FQDN) error {
 	}
 
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "add_fqdn_policies_to_tag",
 		"tag_name": fqdn.FQDNTag,
 		"policies": string(policies),
@@ -409,7 +390,6 @@ func (c *Client) DeleteFQDNTagRule(fqdn *FQDN) error {
 	}
 
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "delete_fqdn_policies_to_tag",
 		"tag_name": fqdn.FQDNTag,
 		"policies": string(policies),
@@ -430,7 +410,6 @@ func (c *Client) DeleteFQDNTagRule(fqdn *FQDN) error {
 
 func (c *Client) EnableFQDNExceptionRule(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "enable_fqdn_exception_rule",
 	}
 
@@ -449,7 +428,6 @@ func (c *Client) EnableFQDNExceptionRule(ctx context.Context) error {
 
 func (c *Client) DisableFQDNExceptionRule(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_fqdn_exception_rule",
 	}
 
@@ -468,7 +446,6 @@ func (c *Client) DisableFQDNExceptionRule(ctx context.Context) error {
 
 func (c *Client) EnableFQDNPrivateNetworks(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "enable_fqdn_on_private_networks",
 	}
 
@@ -487,7 +464,6 @@ func (c *Client) EnableFQDNPrivateNetworks(ctx context.Context) error {
 
 func (c *Client) DisableFQDNPrivateNetwork(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_fqdn_on_private_networks",
 	}
 
@@ -507,7 +483,6 @@ func (c *Client) DisableFQDNPrivateNetwork(ctx context.Context) error {
 func (c *Client) SetFQDNCustomNetwork(ctx context.Context, configIPs []string) error {
 	action := "disable_fqdn_on_custom_networks"
 	form := map[string]interface{}{
-		"CID":    c.CID,
 		"action": action,
 	}
 
@@ -524,7 +499,6 @@ func (c *Client) SetFQDNCustomNetwork(ctx context.Context, configIPs []string) e
 
 func (c *Client) EnableFQDNCache(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "enable_fqdn_cache_global",
 	}
 
@@ -543,7 +517,6 @@ func (c *Client) EnableFQDNCache(ctx context.Context) error {
 
 func (c *Client) DisableFQDNCache(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_fqdn_cache_global",
 	}
 
@@ -562,7 +535,6 @@ func (c *Client) DisableFQDNCache(ctx context.Context) error {
 
 func (c *Client) EnableFQDNExactMatch(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "enable_fqdn_exact_match",
 	}
 
@@ -581,7 +553,6 @@ func (c *Client) EnableFQDNExactMatch(ctx context.Context) error {
 
 func (c *Client) DisableFQDNExactMatch(ctx context.Context) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_fqdn_exact_match",
 	}
 
@@ -600,7 +571,6 @@ func (c *Client) DisableFQDNExactMatch(ctx context.Context) error {
 
 func (c *Client) GetFQDNCacheGlobalStatus(ctx context.Context) (*string, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_fqdn_cache_global_status",
 	}
 
@@ -617,7 +587,6 @@ func (c *Client) GetFQDNCacheGlobalStatus(ctx context.Context) (*string, error)
 
 func (c *Client) GetFQDNExactMatchStatus(ctx context.Context) (*string, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_fqdn_exact_match_status",
 	}
 
@@ -634,7 +603,6 @@ func (c *Client) GetFQDNExactMatchStatus(ctx context.Context) (*string, error) {
 
 func (c *Client) GetFQDNExceptionRuleStatus(ctx context.Context) (*string, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_fqdn_exception_rule_status",
 	}
 
@@ -650,7 +618,6 @@ func (c *Client) GetFQDNExceptionRuleStatus(ctx context.Context) (*string, error
 
 func (c *Client) GetFQDNPrivateNetworkFilteringStatus(ctx context.Context) (*FQDNPrivateNetworkingFilteringStatus, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "get_fqdn_private_network_filtering_status",
 	}
 
@@ -12,7 +12,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	log "github.com/sirupsen/logrus"
 )
 
 // Gateway simple struct to hold gateway details
@@ -26,7 +25,6 @@ type Gateway struct {
 	AllocateNewEipRead           bool
 	BkupGatewayZone              string `form:"bkup_gateway_zone,omitempty" json:"bkup_gateway_zone,omitempty"`
 	BkupPrivateIP                string `form:"bkup_private_ip,omitempty" json:"bkup_private_ip,omitempty"`
-	CID                          string `form:"CID,omitempty"`
 	CIDR                         string `form:"cidr,omitempty"`
 	ClientCertAuth               string `form:"client_cert_auth,omitempty" json:"client_cert_auth,omitempty"`
 	ClientCertSharing            string `form:"client_cert_sharing,omitempty" json:"client_cert_sharing,omitempty"`
@@ -304,7 +302,6 @@ type ListTransitFireNetPolicyResp struct {
 type VpnGatewayAuth struct { // Used for set_vpn_gateway_authentication rest api call
 	Action             string `form:"action,omitempty"`
 	AuthType           string `form:"auth_type,omitempty" json:"auth_type,omitempty"`
-	CID                string `form:"CID,omitempty"`
 	DuoAPIHostname     string `form:"duo_api_hostname,omitempty" json:"duo_api_hostname,omitempty"`
 	DuoIntegrationKey  string `form:"duo_integration_key,omitempty" json:"duo_integration_key,omitempty"`
 	DuoPushMode        string `form:"duo_push_mode,omitempty" json:"duo_push_mode,omitempty"`
@@ -364,18 +361,16 @@ type FQDNGatwayInfo struct {
 	ArmFqdnLanCidr map[string]string   `json:"arm_fqdn_lan_cidr"`
 }
 
-func (c *Client) CreateGateway(gateway *Gateway) error {
-	gateway.CID = c.CID
+func (c *Client) CreateGateway(ctx context.Context, gateway *Gateway) error {
 	gateway.Action = "connect_container"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) CreatePublicSubnetFilteringGateway(gateway *Gateway) error {
+func (c *Client) CreatePublicSubnetFilteringGateway(ctx context.Context, gateway *Gateway) error {
 	data := map[string]string{
 		"action":         "add_public_subnet_filtering_gateway",
-		"CID":            c.CID,
 		"cloud_type":     strconv.Itoa(gateway.CloudType),
 		"account_name":   gateway.AccountName,
 		"region":         gateway.VpcRegion,
@@ -387,28 +382,26 @@ func (c *Client) CreatePublicSubnetFilteringGateway(gateway *Gateway) error {
 		"tag":            "",
 		"async":          "true",
 	}
-	return c.PostAsyncAPI(data["action"], data, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DeletePublicSubnetFilteringGateway(gateway *Gateway) error {
 	data := map[string]string{
 		"action":       "delete_public_subnet_filtering_gateway",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
 
-func (c *Client) EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error {
+func (c *Client) EnablePublicSubnetFilteringHAGateway(ctx context.Context, gateway *Gateway) error {
 	data := map[string]string{
 		"action":         "enable_ha_for_public_subnet_filtering_gateway",
-		"CID":            c.CID,
 		"gateway_name":   gateway.GwName,
 		"gateway_subnet": gateway.PeeringHASubnet,
 		"route_table":    gateway.RouteTable,
 		"async":          "true",
 	}
-	return c.PostAsyncAPI(data["action"], data, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 type PublicSubnetFilteringGatewayDetails struct {
@@ -430,7 +423,6 @@ type PublicSubnetFilteringGatewayDetailsResp struct {
 func (c *Client) GetPublicSubnetFilteringGatewayDetails(gateway *Gateway) (*PublicSubnetFilteringGatewayDetails, error) {
 	data := map[string]string{
 		"action":       "get_public_subnet_filtering_gateway_details",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	var resp PublicSubnetFilteringGatewayDetailsResp
@@ -444,7 +436,6 @@ func (c *Client) GetPublicSubnetFilteringGatewayDetails(gateway *Gateway) (*Publ
 func (c *Client) EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error {
 	data := map[string]string{
 		"action":       "edit_public_subnet_filtering_enforced_route_table_list",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 		"route_table":  strings.Join(routeTables, ", "),
 	}
@@ -454,7 +445,6 @@ func (c *Client) EditPublicSubnetFilteringRouteTableList(gateway *Gateway, route
 func (c *Client) EnableGuardDutyEnforcement(gateway *Gateway) error {
 	data := map[string]string{
 		"action":       "enable_public_subnet_filtering_guard_duty_enforced_mode",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
@@ -463,38 +453,33 @@ func (c *Client) EnableGuardDutyEnforcement(gateway *Gateway) error {
 func (c *Client) DisableGuardDutyEnforcement(gateway *Gateway) error {
 	data := map[string]string{
 		"action":       "disable_public_subnet_filtering_guard_duty_enforced_mode",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
 
 func (c *Client) EnableSingleAZGateway(gateway *Gateway) error {
-	gateway.CID = c.CID
 	gateway.Action = "enable_single_az_ha"
 
 	return c.PostAPI(gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) EnablePeeringHaGateway(gateway *Gateway) error {
-	gateway.CID = c.CID
+func (c *Client) EnablePeeringHaGateway(ctx context.Context, gateway *Gateway) error {
 	gateway.Action = "create_peering_ha_gateway"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) DisableSingleAZGateway(gateway *Gateway) error {
-	gateway.CID = c.CID
+func (c *Client) DisableSingleAZGateway(ctx context.Context, gateway *Gateway) error {
 	gateway.Action = "disable_single_az_ha"
 
-	return c.PostAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 	action := "list_vpcs_summary"
 	params := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
@@ -514,14 +499,13 @@ func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 			return &gwList[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix gateway %s", gateway.GwName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) GetTransitGatewayList(ctx context.Context) ([]Gateway, error) {
 	action := "list_vpcs_summary"
 	params := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"transit_only": "true",
 	}
@@ -542,7 +526,6 @@ func (c *Client) GetTransitGatewayList(ctx context.Context) ([]Gateway, error) {
 func (c *Client) GetSpokeGatewayList(ctx context.Context) ([]Gateway, error) {
 	action := "list_vpcs_summary"
 	params := map[string]string{
-		"CID":        c.CID,
 		"action":     action,
 		"spoke_only": "true",
 	}
@@ -562,7 +545,6 @@ func (c *Client) GetSpokeGatewayList(ctx context.Context) ([]Gateway, error) {
 
 func (c *Client) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "get_gateway_info",
 		"gateway_name": gateway.GwName,
 	}
@@ -578,32 +560,29 @@ func (c *Client) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
 		return &data.Results, nil
 	}
 
-	log.Errorf("Couldn't find Aviatrix gateway %s", gateway.GwName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 	return nil, ErrNotFound
 }
 
-func (c *Client) UpdateGateway(gateway *Gateway) error {
-	gateway.CID = c.CID
+func (c *Client) UpdateGateway(ctx context.Context, gateway *Gateway) error {
 	gateway.Action = "edit_gw_config"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) DeleteGateway(gateway *Gateway) error {
+func (c *Client) DeleteGateway(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "delete_container",
 		"cloud_type": strconv.Itoa(gateway.CloudType),
 		"gw_name":    gateway.GwName,
 		"async":      "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableSNat(gateway *Gateway) error {
-	gateway.CID = c.CID
 	gateway.Action = "enable_snat"
 	args, err := json.Marshal(gateway.SnatPolicy)
 	if err != nil {
@@ -616,7 +595,6 @@ func (c *Client) EnableSNat(gateway *Gateway) error {
 }
 
 func (c *Client) EnableCustomizedSNat(gateway *Gateway) error {
-	gateway.CID = c.CID
 	gateway.Action = "edit_gw_customized_snat_config"
 	args, err := json.Marshal(gateway.SnatPolicy)
 	if err != nil {
@@ -635,14 +613,12 @@ func (c *Client) EnableCustomizedSNat(gateway *Gateway) error {
 }
 
 func (c *Client) DisableSNat(gateway *Gateway) error {
-	gateway.CID = c.CID
 	gateway.Action = "disable_snat"
 
 	return c.PostAPI(gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) DisableCustomSNat(gateway *Gateway) error {
-	gateway.CID = c.CID
 	gateway.Action = "edit_gw_customized_snat_config"
 	args, err := json.Marshal(gateway.SnatPolicy)
 	if err != nil {
@@ -661,7 +637,6 @@ func (c *Client) DisableCustomSNat(gateway *Gateway) error {
 }
 
 func (c *Client) UpdateDNat(gateway *Gateway) error {
-	gateway.CID = c.CID
 	gateway.Action = "update_dnat_config"
 	args, err := json.Marshal(gateway.DnatPolicy)
 	if err != nil {
@@ -675,7 +650,6 @@ func (c *Client) UpdateDNat(gateway *Gateway) error {
 
 func (c *Client) UpdateVpnCidr(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "edit_vpn_gateway_virtual_address_range",
 		"vpn_cidr":     gateway.VpnCidr,
 		"gateway_name": gateway.GwName,
@@ -686,7 +660,6 @@ func (c *Client) UpdateVpnCidr(gateway *Gateway) error {
 
 func (c *Client) UpdateMaxVpnConn(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":                c.CID,
 		"action":             "set_vpn_max_connection",
 		"max_connections":    gateway.MaxConn,
 		"vpc_id":             gateway.VpcID,
@@ -701,25 +674,22 @@ func (c *Client) UpdateMaxVpnConn(gateway *Gateway) error {
 }
 
 func (c *Client) SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error {
-	gateway.CID = c.CID
 	gateway.Action = "set_vpn_gateway_authentication"
 
 	return c.PostAPI(gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) EnableVpcDnsServer(gateway *Gateway) error {
+func (c *Client) EnableVpcDnsServer(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_vpc_dns_server",
 		"gateway_name": gateway.GwName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableVpcDnsServer(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_vpc_dns_server",
 		"gateway_name": gateway.GwName,
 	}
@@ -729,7 +699,6 @@ func (c *Client) DisableVpcDnsServer(gateway *Gateway) error {
 
 func (c *Client) EnableVpnNat(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "enable_nat_on_vpn_gateway",
 		"vpc_id": gateway.VpcID,
 	}
@@ -748,7 +717,6 @@ func (c *Client) EnableVpnNat(gateway *Gateway) error {
 
 func (c *Client) DisableVpnNat(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "disable_nat_on_vpn_gateway",
 		"vpc_id": gateway.VpcID,
 	}
@@ -767,7 +735,6 @@ func (c *Client) DisableVpnNat(gateway *Gateway) error {
 
 func (c *Client) EditDesignatedGateway(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               "set_designated_gateway_additional_cidr_list",
 		"gateway_name":         gateway.GwName,
 		"additional_cidr_list": gateway.AdditionalCidrsDesignatedGw,
@@ -778,7 +745,6 @@ func (c *Client) EditDesignatedGateway(gateway *Gateway) error {
 
 func (c *Client) EnableEncryptVolume(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "encrypt_gateway_volume",
 		"gateway_name": gateway.GwName,
 	}
@@ -800,57 +766,52 @@ func (c *Client) EnableEncryptVolume(gateway *Gateway) error {
 	return c.PostAPI(form["action"], form, checkFunc)
 }
 
-func (c *Client) EditGatewayCustomRoutes(gateway *Gateway) error {
+func (c *Client) EditGatewayCustomRoutes(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "edit_gateway_custom_routes",
 		"gateway_name": gateway.GwName,
 		"cidr":         strings.Join(gateway.CustomizedSpokeVpcRoutes, ","),
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) EditGatewayFilterRoutes(gateway *Gateway) error {
+func (c *Client) EditGatewayFilterRoutes(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "edit_gateway_filter_routes",
 		"gateway_name": gateway.GwName,
 		"cidr":         strings.Join(gateway.FilteredSpokeVpcRoutes, ","),
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) EditGatewayAdvertisedCidr(gateway *Gateway) error {
+func (c *Client) EditGatewayAdvertisedCidr(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "edit_gateway_advertised_cidr",
 		"gateway_name": gateway.GwName,
 		"cidr":         strings.Join(gateway.AdvertisedSpokeRoutes, ","),
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) EnableTransitFireNet(gateway *Gateway) error {
+func (c *Client) EnableTransitFireNet(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_gateway_for_transit_firenet",
 		"gateway_name": gateway.GwName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) EnableTransitFireNetWithGWLB(gateway *Gateway) error {
+func (c *Client) EnableTransitFireNetWithGWLB(ctx context.Context, gateway *Gateway) error {
 	data := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_gateway_for_transit_firenet",
 		"gateway_name": gateway.GwName,
 		"mode":         "gwlb",
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableTransitFireNet(gateway *Gateway) error {
@@ -860,7 +821,6 @@ func (c *Client) DisableTransitFireNet(gateway *Gateway) error {
 	}
 
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_gateway_for_transit_firenet",
 		"gateway_name": gateway.GwName,
 	}
@@ -870,7 +830,6 @@ func (c *Client) DisableTransitFireNet(gateway *Gateway) error {
 
 func (c *Client) IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_transit_firenet_spoke_policies",
 	}
 
@@ -900,20 +859,18 @@ func (c *Client) IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error {
 	return nil
 }
 
-func (c *Client) EnableSegmentation(transitGateway *TransitVpc) error {
+func (c *Client) EnableSegmentation(ctx context.Context, transitGateway *TransitVpc) error {
 	action := "enable_transit_gateway_for_multi_cloud_security_domain"
 	form := map[string]interface{}{
-		"CID":                  c.CID,
 		"action":               action,
 		"transit_gateway_name": transitGateway.GwName,
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) DisableSegmentation(transitGateway *TransitVpc) error {
 	action := "disable_transit_gateway_for_multi_cloud_security_domain"
 	form := map[string]interface{}{
-		"CID":                  c.CID,
 		"action":               action,
 		"transit_gateway_name": transitGateway.GwName,
 	}
@@ -922,7 +879,6 @@ func (c *Client) DisableSegmentation(transitGateway *TransitVpc) error {
 
 func (c *Client) IsSegmentationEnabled(transitGateway *TransitVpc) (bool, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_transit_gateways_for_multi_cloud_domains",
 	}
 
@@ -947,43 +903,39 @@ func (c *Client) IsSegmentationEnabled(transitGateway *TransitVpc) (bool, error)
 	return Contains(data.Results.EnabledDomains, transitGateway.GwName), nil
 }
 
-func (c *Client) EnableEgressTransitFirenet(transitGateway *TransitVpc) error {
+func (c *Client) EnableEgressTransitFirenet(ctx context.Context, transitGateway *TransitVpc) error {
 	action := "enable_transit_firenet_on_egress_transit_gateway"
 	data := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) DisableEgressTransitFirenet(transitGateway *TransitVpc) error {
 	action := "disable_transit_firenet_on_egress_transit_gateway"
 	data := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 	}
 	return c.PostAPI(action, data, BasicCheck)
 }
 
-func (c *Client) EnableMonitorGatewaySubnets(gwName string, excludedInstances []string) error {
+func (c *Client) EnableMonitorGatewaySubnets(ctx context.Context, gwName string, excludedInstances []string) error {
 	action := "enable_monitor_gateway_subnets"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gwName,
 	}
 	if len(excludedInstances) != 0 {
 		form["monitor_exclude_gateway_list"] = strings.Join(excludedInstances, ",")
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) DisableMonitorGatewaySubnets(gwName string) error {
 	action := "disable_monitor_gateway_subnets"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gwName,
 	}
@@ -1002,7 +954,6 @@ func (c *Client) DisableMonitorGatewaySubnets(gwName string) error {
 func (c *Client) EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
 	action := "edit_vpn_config"
 	form := map[string]interface{}{
-		"CID":     c.CID,
 		"action":  action,
 		"command": "enable",
 		"vpc_id":  gateway.VpcID,
@@ -1016,7 +967,6 @@ func (c *Client) EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
 func (c *Client) DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
 	action := "edit_vpn_config"
 	form := map[string]interface{}{
-		"CID":     c.CID,
 		"action":  action,
 		"command": "disable",
 		"vpc_id":  gateway.VpcID,
@@ -1029,7 +979,6 @@ func (c *Client) DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
 
 func (c *Client) GetVPNConfigList(gateway *Gateway) ([]VPNConfig, error) {
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  "edit_vpn_config",
 		"command": "show",
 		"vpc_id":  gateway.VpcID,
@@ -1046,20 +995,18 @@ func (c *Client) GetVPNConfigList(gateway *Gateway) ([]VPNConfig, error) {
 	return data.Results, ErrNotFound
 }
 
-func (c *Client) EnableActiveStandby(transitGateway *TransitVpc) error {
+func (c *Client) EnableActiveStandby(ctx context.Context, transitGateway *TransitVpc) error {
 	action := "enable_active_standby"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) DisableActiveStandby(transitGateway *TransitVpc) error {
 	action := "disable_active_standby"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 	}
@@ -1069,7 +1016,6 @@ func (c *Client) DisableActiveStandby(transitGateway *TransitVpc) error {
 func (c *Client) SwitchActiveTransitGateway(gwName, connName string) error {
 	action := "active_standby_connection_switchover"
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          action,
 		"gateway_name":    gwName,
 		"connection_name": connName,
@@ -1079,7 +1025,6 @@ func (c *Client) SwitchActiveTransitGateway(gwName, connName string) error {
 
 func (c *Client) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "get_firewall_lan_cidr",
 		"gateway_name": gatewayName,
 	}
@@ -1109,7 +1054,6 @@ func (c *Client) GetFqdnGatewayInfo(gateway *Gateway) (*FQDNGatwayInfo, error) {
 		"action":    "list_firenet",
 		"subaction": "instance",
 		"vpc_id":    gateway.VpcID,
-		"CID":       c.CID,
 	}
 	var data FQDNGatewayInfoResp
 	err := c.GetAPI(&data, params["action"], params, BasicCheck)
@@ -1119,41 +1063,37 @@ func (c *Client) GetFqdnGatewayInfo(gateway *Gateway) (*FQDNGatwayInfo, error) {
 	return &data.Results, nil
 }
 
-func (c *Client) UpdateTransitGatewayCustomizedVpcRoute(gateway string, customizedTransitVpcRoutes []string) error {
+func (c *Client) UpdateTransitGatewayCustomizedVpcRoute(ctx context.Context, gateway string, customizedTransitVpcRoutes []string) error {
 	params := map[string]string{
 		"action":            "edit_transit_gateway_customized_vpc_route",
-		"CID":               c.CID,
 		"gateway_name":      gateway,
 		"customized_routes": strings.Join(customizedTransitVpcRoutes, ","),
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 func (c *Client) EnableJumboFrame(gateway *Gateway) error {
 	action := "enable_jumbo_frame"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(action, form, BasicCheck)
 }
 
-func (c *Client) DisableJumboFrame(gateway *Gateway) error {
+func (c *Client) DisableJumboFrame(ctx context.Context, gateway *Gateway) error {
 	action := "disable_jumbo_frame"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) GetJumboFrameStatus(gateway *Gateway) (bool, error) {
 	action := "get_jumbo_frame_status"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
@@ -1174,7 +1114,6 @@ func (c *Client) GetJumboFrameStatus(gateway *Gateway) (bool, error) {
 
 func (c *Client) EnablePrivateVpcDefaultRoute(gw *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_private_vpc_default_route",
 		"gateway_name": gw.GwName,
 	}
@@ -1183,7 +1122,6 @@ func (c *Client) EnablePrivateVpcDefaultRoute(gw *Gateway) error {
 
 func (c *Client) DisablePrivateVpcDefaultRoute(gw *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_private_vpc_default_route",
 		"gateway_name": gw.GwName,
 	}
@@ -1192,7 +1130,6 @@ func (c *Client) DisablePrivateVpcDefaultRoute(gw *Gateway) error {
 
 func (c *Client) EnableSkipPublicRouteUpdate(gw *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_skip_public_route_table_update",
 		"gateway_name": gw.GwName,
 	}
@@ -1201,7 +1138,6 @@ func (c *Client) EnableSkipPublicRouteUpdate(gw *Gateway) error {
 
 func (c *Client) DisableSkipPublicRouteUpdate(gw *Gateway) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_skip_public_route_table_update",
 		"gateway_name": gw.GwName,
 	}
@@ -1211,7 +1147,6 @@ func (c *Client) DisableSkipPublicRouteUpdate(gw *Gateway) error {
 // Entity should be gateway name or "Controller"
 func (c *Client) GetTunnelDetectionTime(entity string) (int, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "show_tunnel_status_change_detection_time",
 		"entity": entity,
 	}
@@ -1234,37 +1169,34 @@ func (c *Client) GetTunnelDetectionTime(entity string) (int, error) {
 	return resp.Results.DetectionTime, err
 }
 
-func (c *Client) ModifyTunnelDetectionTime(entity string, detectionTime int) error {
+func (c *Client) ModifyTunnelDetectionTime(ctx context.Context, entity string, detectionTime int) error {
 	form := map[string]string{
-		"CID":            c.CID,
 		"action":         "modify_detection_time",
 		"detection_time": strconv.Itoa(detectionTime),
 		"entity":         entity,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) EnableActiveStandbyPreemptive(transitGateway *TransitVpc) error {
+func (c *Client) EnableActiveStandbyPreemptive(ctx context.Context, transitGateway *TransitVpc) error {
 	action := "enable_active_standby"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 		"preemptive":   "true",
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
-func (c *Client) SetRxQueueSize(gateway *Gateway) error {
+func (c *Client) SetRxQueueSize(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
-		"CID":           c.CID,
 		"action":        "set_rx_queue_size",
 		"gateway_name":  gateway.GwName,
 		"rx_queue_size": gateway.RxQueueSize,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func DiffSuppressFuncGatewaySNat(k, old, new string, d *schema.ResourceData) bool {
@@ -1373,7 +1305,6 @@ func DiffSuppressFuncGatewayDNat(k, old, new string, d *schema.ResourceData) boo
 
 func (c *Client) ChangeBgpOverLanIntfCnt(gateway *Gateway) error {
 	form := map[string]interface{}{
-		"CID":                   c.CID,
 		"action":                "change_bgp_over_lan_intf_cnt",
 		"gw_name":               gateway.GwName,
 		"bgp_over_lan":          "enable",
@@ -1386,27 +1317,24 @@ func (c *Client) ChangeBgpOverLanIntfCnt(gateway *Gateway) error {
 func (c *Client) EnableGroGso(gateway *Gateway) error {
 	action := "enable_gro_gso"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(action, form, BasicCheck)
 }
 
-func (c *Client) DisableGroGso(gateway *Gateway) error {
+func (c *Client) DisableGroGso(ctx context.Context, gateway *Gateway) error {
 	action := "disable_gro_gso"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) GetGroGsoStatus(gateway *Gateway) (bool, error) {
 	action := "get_gro_gso_status"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": gateway.GwName,
 	}
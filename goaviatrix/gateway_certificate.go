@@ -19,7 +19,6 @@ type GatewayCertificateStatus struct {
 func (c *Client) ConfigureGatewayCertificate(ctx context.Context, gwCert *GatewayCertificate) error {
 	data := map[string]string{
 		"action": "import_gateway_ca_certificate",
-		"CID":    c.CID,
 	}
 	files := []File{
 		{
@@ -41,7 +40,6 @@ func (c *Client) ConfigureGatewayCertificate(ctx context.Context, gwCert *Gatewa
 func (c *Client) DisableGatewayCertificate(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_certificate_checking",
-		"CID":    c.CID,
 	}
 	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -49,7 +47,6 @@ func (c *Client) DisableGatewayCertificate(ctx context.Context) error {
 func (c *Client) GetGatewayCertificateStatus(ctx context.Context) (string, error) {
 	formData := map[string]string{
 		"action": "get_gateway_ca_certificate_status",
-		"CID":    c.CID,
 	}
 	var data GatewayCertificateStatusResp
 	err := c.GetAPIContext(ctx, &data, formData["action"], formData, BasicCheck)
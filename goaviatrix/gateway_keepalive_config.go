@@ -9,7 +9,6 @@ import (
 func (c *Client) SetGatewayKeepaliveConfig(ctx context.Context, speed string) error {
 	data := map[string]string{
 		"action": "set_keep_alive_speed",
-		"CID":    c.CID,
 		"speed":  speed,
 	}
 
@@ -26,7 +25,6 @@ func (c *Client) SetGatewayKeepaliveConfig(ctx context.Context, speed string) er
 func (c *Client) GetGatewayKeepaliveConfig(ctx context.Context) (string, error) {
 	data := map[string]string{
 		"action": "get_keep_alive_speed",
-		"CID":    c.CID,
 	}
 
 	type GatewayKeepaliveResults struct {
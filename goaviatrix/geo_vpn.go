@@ -6,13 +6,10 @@ import (
 	"strings"
 
 	"golang.org/x/net/context"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type GeoVPN struct {
 	Action      string `json:"action,omitempty"`
-	CID         string `json:"CID,omitempty"`
 	AccountName string `json:"account_name,omitempty"`
 	CloudType   int    `json:"cloud_type,omitempty"`
 	DomainName  string `json:"domain_name,omitempty"`
@@ -41,7 +38,6 @@ type GetGeoVPNInfoResp struct {
 }
 
 func (c *Client) EnableGeoVPN(ctx context.Context, geoVPN *GeoVPN) error {
-	geoVPN.CID = c.CID
 	geoVPN.Action = "enable_geo_vpn"
 
 	return c.PostAPIContext2(ctx, nil, geoVPN.Action, geoVPN, BasicCheck)
@@ -49,7 +45,6 @@ func (c *Client) EnableGeoVPN(ctx context.Context, geoVPN *GeoVPN) error {
 
 func (c *Client) GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error) {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "get_geo_vpn_info",
 		"cloud_type": strconv.Itoa(geoVPN.CloudType),
 	}
@@ -84,26 +79,23 @@ func (c *Client) GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error) {
 		return geoVPN, nil
 	}
 
-	log.Errorf("Couldn't find Aviatrix Geo VPN: %v", geoVPN)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix Geo VPN", map[string]interface{}{"geo_vpn": fmt.Sprintf("%v", geoVPN)})
 	return nil, ErrNotFound
 }
 
 func (c *Client) AddElbToGeoVPN(geoVPN *GeoVPN) error {
-	geoVPN.CID = c.CID
 	geoVPN.Action = "add_elb_to_geo_vpn"
 
 	return c.PostAPI(geoVPN.Action, geoVPN, BasicCheck)
 }
 
 func (c *Client) DeleteElbFromGeoVPN(geoVPN *GeoVPN) error {
-	geoVPN.CID = c.CID
 	geoVPN.Action = "delete_elb_from_geo_vpn"
 
 	return c.PostAPI(geoVPN.Action, geoVPN, BasicCheck)
 }
 
 func (c *Client) DisableGeoVPN(geoVPN *GeoVPN) error {
-	geoVPN.CID = c.CID
 	geoVPN.Action = "disable_geo_vpn"
 
 	return c.PostAPI(geoVPN.Action, geoVPN, BasicCheck)
@@ -111,7 +103,6 @@ func (c *Client) DisableGeoVPN(geoVPN *GeoVPN) error {
 
 func (c *Client) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "get_geo_vpn_info",
 		"cloud_type": strconv.Itoa(gateway.CloudType),
 	}
@@ -146,6 +137,6 @@ func (c *Client) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix Geo VPN")
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix Geo VPN", map[string]interface{}{"gw_name": gateway.GwName})
 	return nil, ErrNotFound
 }
@@ -3,7 +3,6 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
-	"strings"
 )
 
 type GlobalVpcExcludedInstance struct {
@@ -37,7 +36,7 @@ func (c *Client) GetGlobalVpcExcludedInstance(ctx context.Context, uuid string)
 	var data GlobalVpcExcludedInstanceResp
 	err := c.GetAPIContext25(ctx, &data, endpoint, nil)
 	if err != nil {
-		if strings.Contains(data.Reason, "does not exist") {
+		if IsNotFoundReason(data.Reason) {
 			return nil, ErrNotFound
 		}
 		return nil, err
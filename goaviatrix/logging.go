@@ -0,0 +1,83 @@
+package goaviatrix
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the terraform-plugin-log subsystem the client logs to. Its level can be set
+// independently of the provider with TF_LOG_PROVIDER_AVIATRIX_CLIENT.
+const LogSubsystem = "aviatrix_client"
+
+// logSubsystemKey marks a context that already carries the client log subsystem.
+type logSubsystemKey struct{}
+
+// NewLogContext returns ctx with the client log subsystem. The subsystem includes the fields set
+// on the provider root logger, e.g. the resource type and gateway name. ctx is returned unchanged
+// if it has no provider logger, in which case nothing is logged.
+func NewLogContext(ctx context.Context) context.Context {
+	if ctx.Value(logSubsystemKey{}) != nil {
+		return ctx
+	}
+	logCtx := tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithRootFields())
+	if logCtx == ctx {
+		return ctx
+	}
+	logCtx = tflog.SubsystemMaskFieldValuesWithFieldKeys(logCtx, LogSubsystem, "CID", "password", "api_token")
+	return context.WithValue(logCtx, logSubsystemKey{}, true)
+}
+
+// WithLogContext sets the context whose logger is used when a request is sent with a context
+// that carries no provider logger, e.g. by the methods that take no context. Typically this is
+// the context passed to the provider configure function. Only its values are used.
+func WithLogContext(ctx context.Context) ClientOption {
+	return func(c *Client) {
+		c.logCtx = NewLogContext(ctx)
+	}
+}
+
+// logContext returns ctx if it carries a provider logger. Otherwise it returns ctx with the
+// logger of the context set with WithLogContext, keeping the deadline and cancellation of ctx.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	if logCtx := NewLogContext(ctx); logCtx.Value(logSubsystemKey{}) != nil {
+		return logCtx
+	}
+	if c.logCtx == nil {
+		return ctx
+	}
+	return logValuesContext{Context: ctx, logCtx: c.logCtx}
+}
+
+// logValuesContext looks up values in Context first, then in logCtx.
+type logValuesContext struct {
+	context.Context
+	logCtx context.Context
+}
+
+func (c logValuesContext) Value(key interface{}) interface{} {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+	return c.logCtx.Value(key)
+}
+
+func logTrace(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemTrace(ctx, LogSubsystem, msg, fields...)
+}
+
+func logDebug(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, msg, fields...)
+}
+
+func logInfo(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemInfo(ctx, LogSubsystem, msg, fields...)
+}
+
+func logWarn(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemWarn(ctx, LogSubsystem, msg, fields...)
+}
+
+func logError(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemError(ctx, LogSubsystem, msg, fields...)
+}
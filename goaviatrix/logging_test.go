@@ -0,0 +1,68 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogContext(t *testing.T) {
+	tests := []struct {
+		name       string
+		logCtx     bool
+		requestCtx bool
+	}{
+		{"request context", false, true},
+		{"client log context", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			rootCtx := tflogtest.RootLogger(context.Background(), &output)
+			rootCtx = tflog.SetField(rootCtx, "gw_name", "gw1")
+
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"return":true}`)
+			})
+			client.CID = "secret-cid"
+			if tt.logCtx {
+				WithLogContext(rootCtx)(client)
+			}
+			ctx := context.Background()
+			if tt.requestCtx {
+				ctx = rootCtx
+			}
+
+			form := map[string]string{"action": "test_action", "CID": client.CID}
+			if err := client.PostAPIContext(ctx, form["action"], form, BasicCheck); err != nil {
+				t.Fatalf("PostAPIContext() error = %v", err)
+			}
+
+			if bytes.Contains(output.Bytes(), []byte(client.CID)) {
+				t.Errorf("the CID was logged: %s", output.String())
+			}
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			if err != nil {
+				t.Fatalf("could not decode log output: %v", err)
+			}
+			if len(entries) == 0 {
+				t.Fatal("nothing was logged")
+			}
+			for _, entry := range entries {
+				if entry["@module"] != "provider."+LogSubsystem {
+					t.Errorf("@module = %v, want provider.%s", entry["@module"], LogSubsystem)
+				}
+				if entry["action"] != "test_action" || entry["gw_name"] != "gw1" {
+					t.Errorf("entry %q is missing action or gw_name: %v", entry["@message"], entry)
+				}
+			}
+		})
+	}
+}
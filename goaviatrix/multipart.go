@@ -0,0 +1,201 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// uploadProgressStep is the fraction of an upload after which progress is logged again.
+const uploadProgressStep = 0.1
+
+// multipartFile is a file of a multipart upload whose size and content type are known before
+// it is sent.
+type multipartFile struct {
+	File
+	fileName    string
+	contentType string
+	size        int64
+}
+
+// open returns the content of the file.
+func (f *multipartFile) open() (io.ReadCloser, error) {
+	if f.UseFileContent {
+		return io.NopCloser(strings.NewReader(f.FileContent)), nil
+	}
+	return os.Open(f.Path)
+}
+
+// prepareMultipartFiles returns the size and content type of the files to upload, so that missing
+// files are reported before the request is sent. Files without content are skipped.
+func prepareMultipartFiles(files []File) ([]*multipartFile, error) {
+	var prepared []*multipartFile
+	for _, f := range files {
+		mf := &multipartFile{File: f}
+		if f.UseFileContent {
+			mf.fileName = f.FileName
+			mf.size = int64(len(f.FileContent))
+		} else {
+			if f.Path == "" {
+				continue
+			}
+			fi, err := os.Stat(f.Path)
+			if err != nil {
+				return nil, err
+			}
+			mf.fileName = filepath.Base(f.Path)
+			mf.size = fi.Size()
+		}
+
+		// Only the first 512 bytes are used to detect the content type
+		r, err := mf.open()
+		if err != nil {
+			return nil, err
+		}
+		head := make([]byte, 512)
+		n, err := io.ReadFull(r, head)
+		r.Close()
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		mf.contentType = http.DetectContentType(head[:n])
+		prepared = append(prepared, mf)
+	}
+	return prepared, nil
+}
+
+// newMultipartBody returns a body that streams the files and parameters with multipart form
+// encoding, with its content type and length. Files are read from disk while the body is sent,
+// so memory use does not depend on the size of the files. Progress is logged to ctx.
+func newMultipartBody(ctx context.Context, params map[string]string, files []File) (io.ReadCloser, string, int64, error) {
+	prepared, err := prepareMultipartFiles(files)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	contentLength, err := multipartContentLength(writer.Boundary(), params, prepared)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	go func() {
+		pw.CloseWithError(writeMultipart(ctx, writer, params, prepared))
+	}()
+	return pr, writer.FormDataContentType(), contentLength, nil
+}
+
+// multipartContentLength returns the length of the multipart body written by writeMultipart.
+func multipartContentLength(boundary string, params map[string]string, files []*multipartFile) (int64, error) {
+	counter := &countingWriter{}
+	writer := multipart.NewWriter(counter)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return 0, err
+	}
+	for _, f := range files {
+		if _, err := createFormFile(f.ParamName, f.fileName, f.contentType, writer); err != nil {
+			return 0, err
+		}
+		counter.n += f.size
+	}
+	for _, key := range sortedKeys(params) {
+		if err := writer.WriteField(key, params[key]); err != nil {
+			return 0, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return 0, err
+	}
+	return counter.n, nil
+}
+
+// writeMultipart writes the files, then the parameters, to writer.
+func writeMultipart(ctx context.Context, writer *multipart.Writer, params map[string]string, files []*multipartFile) error {
+	for _, f := range files {
+		part, err := createFormFile(f.ParamName, f.fileName, f.contentType, writer)
+		if err != nil {
+			return err
+		}
+		r, err := f.open()
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(part, &progressReader{ctx: ctx, r: r, name: f.fileName, total: f.size})
+		r.Close()
+		if err != nil {
+			return fmt.Errorf("could not upload %s: %v", f.fileName, err)
+		}
+	}
+	for _, key := range sortedKeys(params) {
+		if err := writer.WriteField(key, params[key]); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func createFormFile(fieldname, filename, fileContentType string, w *multipart.Writer) (io.Writer, error) {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition",
+		fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			escapeQuotes(fieldname), escapeQuotes(filename)))
+	h.Set("Content-Type", fileContentType)
+	return w.CreatePart(h)
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// progressReader logs the upload progress of a file every uploadProgressStep.
+type progressReader struct {
+	ctx    context.Context
+	r      io.Reader
+	name   string
+	total  int64
+	sent   int64
+	logged int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.sent += int64(n)
+	if p.total > 0 && (p.sent-p.logged >= int64(float64(p.total)*uploadProgressStep) || (err == io.EOF && p.logged < p.sent)) {
+		p.logged = p.sent
+		logDebug(p.ctx, "Uploading file", map[string]interface{}{
+			"file":        p.name,
+			"bytes_sent":  p.sent,
+			"total_bytes": p.total,
+			"percent":     p.sent * 100 / p.total,
+		})
+	}
+	return n, err
+}
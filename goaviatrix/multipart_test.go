@@ -0,0 +1,82 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPostFileStreaming(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 100000)
+	path := filepath.Join(t.TempDir(), "image.bin")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var contentLength int64
+	var received, inline []byte
+	var params map[string]string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.ContentLength
+		body, _ := io.ReadAll(r.Body)
+		if int64(len(body)) != r.ContentLength {
+			t.Errorf("body length = %d, Content-Length = %d", len(body), r.ContentLength)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("could not parse multipart form: %v", err)
+		}
+		for name, dst := range map[string]*[]byte{"file": &received, "inline": &inline} {
+			f, _, err := r.FormFile(name)
+			if err != nil {
+				t.Fatalf("missing file %s: %v", name, err)
+			}
+			*dst, _ = io.ReadAll(f)
+			f.Close()
+		}
+		params = map[string]string{"action": r.FormValue("action"), "CID": r.FormValue("CID")}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"return":true}`)
+	})
+
+	files := []File{
+		{Path: path, ParamName: "file"},
+		{ParamName: "inline", UseFileContent: true, FileName: "inline.txt", FileContent: "inline content"},
+	}
+	err := client.PostFileAPIContext(context.Background(), map[string]string{"action": "upload", "CID": "cid"}, files, BasicCheck)
+	if err != nil {
+		t.Fatalf("PostFileAPIContext() error = %v", err)
+	}
+	if contentLength <= int64(len(content)) {
+		t.Errorf("Content-Length = %d, want more than %d", contentLength, len(content))
+	}
+	if !bytes.Equal(received, content) {
+		t.Errorf("received %d bytes, want %d", len(received), len(content))
+	}
+	if string(inline) != "inline content" {
+		t.Errorf("inline file = %q, want %q", inline, "inline content")
+	}
+	if params["action"] != "upload" || params["CID"] != "cid" {
+		t.Errorf("params = %v", params)
+	}
+}
+
+func TestPostFileMissing(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	})
+
+	files := []File{{Path: filepath.Join(t.TempDir(), "missing"), ParamName: "file"}}
+	if _, err := client.PostFile(client.baseURL, nil, files); err == nil {
+		t.Error("PostFile() with a missing file succeeded")
+	}
+	if calls != 0 {
+		t.Errorf("PostFile() with a missing file sent %d requests", calls)
+	}
+}
@@ -3,7 +3,6 @@ package goaviatrix
 import "strconv"
 
 type NetflowAgent struct {
-	CID                   string
 	ServerIp              string
 	Port                  int
 	Version               int
@@ -23,7 +22,6 @@ type NetflowAgentResp struct {
 func (c *Client) EnableNetflowAgent(r *NetflowAgent) error {
 	params := map[string]string{
 		"action":               "enable_netflow_agent",
-		"CID":                  c.CID,
 		"server_ip":            r.ServerIp,
 		"port":                 strconv.Itoa(r.Port),
 		"version":              strconv.Itoa(r.Version),
@@ -37,7 +35,6 @@ func (c *Client) EnableNetflowAgent(r *NetflowAgent) error {
 func (c *Client) GetNetflowAgentStatus() (*NetflowAgentResp, error) {
 	params := map[string]string{
 		"action": "get_netflow_agent",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -63,7 +60,6 @@ func (c *Client) GetNetflowAgentStatus() (*NetflowAgentResp, error) {
 func (c *Client) DisableNetflowAgent() error {
 	params := map[string]string{
 		"action": "disable_netflow_agent",
-		"CID":    c.CID,
 	}
 
 	return c.PostAPI(params["action"], params, BasicCheck)
@@ -2,7 +2,6 @@ package goaviatrix
 
 type PeriodicPing struct {
 	Action        string `form:"action"`
-	CID           string `form:"CID"`
 	GwName        string `form:"gateway_name"`
 	Interval      string `form:"interval"`
 	IntervalAsInt int
@@ -23,14 +22,12 @@ type PeriodicPingStatusResult struct {
 
 func (c *Client) CreatePeriodicPing(pp *PeriodicPing) error {
 	pp.Action = "enable_gateway_periodic_ping"
-	pp.CID = c.CID
 
 	return c.PostAPI(pp.Action, pp, BasicCheck)
 }
 
 func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "get_gateway_periodic_ping_status",
 		"gateway_name": pp.GwName,
 	}
@@ -55,7 +52,6 @@ func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
 
 func (c *Client) DeletePeriodicPing(pp *PeriodicPing) error {
 	pp.Action = "disable_gateway_periodic_ping"
-	pp.CID = c.CID
 
 	return c.PostAPI(pp.Action, pp, BasicCheck)
 }
@@ -7,7 +7,6 @@ import (
 )
 
 type PrivateModeLb struct {
-	CID                   string `json:"CID"`
 	Action                string `json:"action"`
 	AccountName           string `json:"account_name"`
 	VpcId                 string `json:"vpc_id"`
@@ -44,13 +43,11 @@ func privateModeLoadBalancerCheckFunc(action, method, reason string, ret bool) e
 }
 
 func (c *Client) CreatePrivateModeControllerLoadBalancer(ctx context.Context, privateModeLb *PrivateModeLb) error {
-	privateModeLb.CID = c.CID
 	privateModeLb.Action = "create_private_mode_controller_load_balancer"
 	return c.PostAPIContext2(ctx, nil, privateModeLb.Action, privateModeLb, BasicCheck)
 }
 
 func (c *Client) CreatePrivateModeMulticloudLoadBalancer(ctx context.Context, privateModeLb *PrivateModeLb) error {
-	privateModeLb.CID = c.CID
 	privateModeLb.Action = "create_private_mode_multicloud_load_balancer"
 	return c.PostAPIContext2(ctx, nil, privateModeLb.Action, privateModeLb, BasicCheck)
 }
@@ -58,7 +55,6 @@ func (c *Client) CreatePrivateModeMulticloudLoadBalancer(ctx context.Context, pr
 func (c *Client) UpdatePrivateModeMulticloudProxies(ctx context.Context, privateModeLb *PrivateModeLb) error {
 	action := "update_private_mode_multicloud_proxies"
 	form := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"lb_vpc_id":    privateModeLb.VpcId,
 		"account_name": privateModeLb.AccountName,
@@ -79,7 +75,6 @@ func (c *Client) UpdatePrivateModeMulticloudProxies(ctx context.Context, private
 func (c *Client) GetPrivateModeLoadBalancer(ctx context.Context, loadBalancerVpcId string) (*PrivateModeLbRead, error) {
 	action := "get_private_mode_load_balancer_detail"
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               action,
 		"load_balancer_vpc_id": loadBalancerVpcId,
 	}
@@ -100,7 +95,6 @@ func (c *Client) GetPrivateModeLoadBalancer(ctx context.Context, loadBalancerVpc
 func (c *Client) DeletePrivateModeLoadBalancer(ctx context.Context, loadBalancerVpcId string) error {
 	action := "delete_private_mode_load_balancer"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 		"vpc_id": loadBalancerVpcId,
 	}
@@ -5,7 +5,6 @@ import (
 )
 
 type PrivateModeMulticloudEndpoint struct {
-	CID               string `json:"CID"`
 	Action            string `json:"action"`
 	AccountName       string `json:"account_name"`
 	VpcId             string `json:"endpoint_vpc_id"`
@@ -22,7 +21,6 @@ type PrivateModeMultiCloudEndpointRead struct {
 }
 
 func (c *Client) CreatePrivateModeMulticloudEndpoint(ctx context.Context, privateModeMulticloudEndpoint *PrivateModeMulticloudEndpoint) error {
-	privateModeMulticloudEndpoint.CID = c.CID
 	privateModeMulticloudEndpoint.Action = "create_private_mode_multicloud_endpoint"
 	return c.PostAPIContext2(ctx, nil, privateModeMulticloudEndpoint.Action, privateModeMulticloudEndpoint, BasicCheck)
 }
@@ -30,7 +28,6 @@ func (c *Client) CreatePrivateModeMulticloudEndpoint(ctx context.Context, privat
 func (c *Client) GetPrivateModeMulticloudEndpoint(ctx context.Context, vpcId string) (*PrivateModeMultiCloudEndpointRead, error) {
 	action := "list_private_mode_multicloud_endpoints"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 	}
 
@@ -53,7 +50,6 @@ func (c *Client) GetPrivateModeMulticloudEndpoint(ctx context.Context, vpcId str
 func (c *Client) DeletePrivateModeMulticloudEndpoint(ctx context.Context, vpcId string) error {
 	action := "delete_private_mode_multicloud_endpoint"
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": action,
 		"vpc_id": vpcId,
 	}
@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type ProfileRule struct {
@@ -18,7 +17,6 @@ type ProfileRule struct {
 // Gateway simple struct to hold profile details
 type Profile struct {
 	Action   string        `form:"action,omitempty"`
-	CID      string        `form:"CID,omitempty"`
 	Name     string        `form:"tag_name,omitempty" json:"tag_name,omitempty"`
 	BaseRule string        `form:"base_rule,omitempty" json:"status,omitempty"`
 	Policy   []ProfileRule `form:"domain_names[],omitempty" json:"domain_names,omitempty"`
@@ -51,7 +49,6 @@ type ProfileBasePolicyResp struct {
 
 func (c *Client) CreateProfile(profile *Profile) error {
 	form1 := map[string]string{
-		"CID":          c.CID,
 		"action":       "add_user_profile",
 		"profile_name": profile.Name,
 		"base_policy":  profile.BaseRule,
@@ -64,7 +61,6 @@ func (c *Client) CreateProfile(profile *Profile) error {
 
 	policyStr, _ := json.Marshal(profile.Policy)
 	form2 := map[string]string{
-		"CID":          c.CID,
 		"action":       "update_profile_policy",
 		"profile_name": profile.Name,
 		"policy":       string(policyStr),
@@ -77,7 +73,6 @@ func (c *Client) CreateProfile(profile *Profile) error {
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
-			"CID":          c.CID,
 			"action":       "add_profile_member",
 			"profile_name": profile.Name,
 			"username":     user,
@@ -93,7 +88,6 @@ func (c *Client) CreateProfile(profile *Profile) error {
 
 func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 	form1 := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_profile_policies",
 		"profile_name": profile.Name,
 	}
@@ -102,7 +96,7 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -116,10 +110,9 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 	}
 
 	profile.Policy = data1.Results
-	log.Tracef("Profile policy %s", profile.Policy)
+	logTrace(c.logContext(context.Background()), "Profile policy", map[string]interface{}{"policy": profile.Policy})
 
 	form2 := map[string]string{
-		"CID":    c.CID,
 		"action": "list_user_profile_names",
 	}
 
@@ -131,16 +124,15 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 	}
 
 	profile.UserList = data2.Results[profile.Name]
-	log.Tracef("Profile list of users %s", profile.UserList)
+	logTrace(c.logContext(context.Background()), "Profile list of users", map[string]interface{}{"users": profile.UserList})
 	return profile, nil
 }
 
 func (c *Client) UpdateProfilePolicy(profile *Profile) error {
-	log.Tracef("Updating Profile Policy %#v", profile)
+	logTrace(c.logContext(context.Background()), "Updating profile policy", map[string]interface{}{"profile": fmt.Sprintf("%#v", profile)})
 
 	policyStr, _ := json.Marshal(profile.Policy)
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "update_profile_policy",
 		"profile_name": profile.Name,
 		"policy":       string(policyStr),
@@ -150,11 +142,10 @@ func (c *Client) UpdateProfilePolicy(profile *Profile) error {
 }
 
 func (c *Client) AttachUsers(profile *Profile) error {
-	log.Tracef("Attaching users %s", profile.UserList)
+	logTrace(c.logContext(context.Background()), "Attaching users", map[string]interface{}{"users": profile.UserList})
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
-			"CID":          c.CID,
 			"action":       "add_profile_member",
 			"profile_name": profile.Name,
 			"username":     user,
@@ -169,11 +160,10 @@ func (c *Client) AttachUsers(profile *Profile) error {
 }
 
 func (c *Client) DetachUsers(profile *Profile) error {
-	log.Tracef("Detaching users %s", profile.UserList)
+	logTrace(c.logContext(context.Background()), "Detaching users", map[string]interface{}{"users": profile.UserList})
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
-			"CID":          c.CID,
 			"action":       "del_profile_member",
 			"profile_name": profile.Name,
 			"username":     user,
@@ -189,7 +179,6 @@ func (c *Client) DetachUsers(profile *Profile) error {
 
 func (c *Client) DeleteProfile(profile *Profile) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "del_user_profile",
 		"profile_name": profile.Name,
 	}
@@ -199,7 +188,6 @@ func (c *Client) DeleteProfile(profile *Profile) error {
 
 func (c *Client) GetProfileBasePolicy(profile *Profile) (*Profile, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "get_profile_base_policy",
 		"profile_name": profile.Name,
 	}
@@ -19,7 +19,6 @@ type ProxyConfigResp struct {
 func (c *Client) CreateProxyConfig(proxyConfig *ProxyConfig) error {
 	action := "apply_proxy_config"
 	params := map[string]string{
-		"CID":         c.CID,
 		"action":      action,
 		"http_proxy":  "http://" + proxyConfig.HttpProxy,
 		"https_proxy": "https://" + proxyConfig.HttpsProxy,
@@ -43,7 +42,6 @@ func (c *Client) CreateProxyConfig(proxyConfig *ProxyConfig) error {
 func (c *Client) GetProxyConfig() (*ProxyConfig, error) {
 	formData := map[string]string{
 		"action": "show_proxy_config",
-		"CID":    c.CID,
 	}
 	var data ProxyConfigResp
 	err := c.GetAPI(&data, formData["action"], formData, BasicCheck)
@@ -65,7 +63,6 @@ func (c *Client) DeleteProxyConfig() error {
 	action := "delete_proxy_config"
 	data := map[string]interface{}{
 		"action": action,
-		"CID":    c.CID,
 	}
 	return c.PostAPI(action, data, BasicCheck)
 }
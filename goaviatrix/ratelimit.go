@@ -0,0 +1,111 @@
+package goaviatrix
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// requestLimiter caps the number of in-flight requests and the rate of requests sent to the
+// controller. A nil requestLimiter does not limit anything.
+type requestLimiter struct {
+	// sem holds one token per in-flight request, nil if concurrency is not limited
+	sem chan struct{}
+
+	mu     sync.Mutex
+	rate   float64 // tokens per second, 0 if the rate is not limited
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRequestLimiter returns a limiter allowing maxConcurrent in-flight requests and
+// requestsPerSecond requests per second. A value of 0 disables the corresponding limit.
+func newRequestLimiter(maxConcurrent int, requestsPerSecond float64) *requestLimiter {
+	if maxConcurrent <= 0 && requestsPerSecond <= 0 {
+		return nil
+	}
+
+	l := &requestLimiter{}
+	if maxConcurrent > 0 {
+		l.sem = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		l.rate = requestsPerSecond
+		l.burst = math.Max(1, math.Ceil(requestsPerSecond))
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent or ctx is done. The returned release func must be
+// called once the response has been read.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	// Take the in-flight slot first: a rate token taken while waiting for a slot would be
+	// spent by the time the request is sent, letting the requests after it burst.
+	release := func() {}
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() {
+			once.Do(func() { <-l.sem })
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// wait takes one token from the bucket, sleeping until it is available.
+func (l *requestLimiter) wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// Reserve the token now, even if the bucket goes negative, so waiters are served in order
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		// Give the reserved token back
+		l.mu.Lock()
+		l.tokens = math.Min(l.burst, l.tokens+1)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// WithRateLimit limits the client to maxConcurrent in-flight requests and requestsPerSecond
+// requests per second. A value of 0 disables the corresponding limit.
+func WithRateLimit(maxConcurrent int, requestsPerSecond float64) ClientOption {
+	return func(c *Client) {
+		c.limiter = newRequestLimiter(maxConcurrent, requestsPerSecond)
+	}
+}
@@ -0,0 +1,98 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiterConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"return":true}`)
+	})
+	WithRateLimit(2, 0)(client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := client.PostAPIContext(context.Background(), "test_action", map[string]string{"action": "test_action"}, BasicCheck); err != nil {
+				t.Errorf("PostAPIContext() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("max in-flight requests = %d, want at most 2", maxInFlight)
+	}
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	l := newRequestLimiter(0, 20)
+	start := time.Now()
+	// The first 20 requests use the burst, the next 10 need half a second at 20 requests per second
+	for i := 0; i < 30; i++ {
+		release, err := l.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("acquire() error = %v", err)
+		}
+		release()
+	}
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Errorf("30 requests took %s, want at least 450ms", elapsed)
+	}
+}
+
+func TestRequestLimiterCancel(t *testing.T) {
+	l := newRequestLimiter(1, 0)
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); err == nil {
+		t.Errorf("acquire() expected an error when the context is done")
+	}
+}
+
+func TestRequestLimiterSlotBeforeToken(t *testing.T) {
+	l := newRequestLimiter(1, 5)
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	// A request waiting for the in-flight slot must not take a rate token meanwhile
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); err == nil {
+		t.Fatalf("acquire() expected an error when the context is done")
+	}
+	l.mu.Lock()
+	tokens := l.tokens
+	l.mu.Unlock()
+	if tokens < 4 {
+		t.Errorf("tokens = %v, want 4 left after the first request", tokens)
+	}
+	release()
+}
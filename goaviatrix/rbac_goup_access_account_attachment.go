@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 )
 
 type RbacGroupAccessAccountAttachment struct {
-	CID               string `form:"CID,omitempty"`
 	Action            string `form:"action,omitempty"`
 	GroupName         string `form:"group_name,omitempty" json:"group_name,omitempty"`
 	AccessAccountName string `form:"accounts,omitempty" json:"accounts,omitempty"`
@@ -18,7 +17,6 @@ type RbacGroupAccessAccountAttachmentListResp struct {
 }
 
 func (c *Client) CreateRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error {
-	rbacGroupAccessAccountAttachment.CID = c.CID
 	rbacGroupAccessAccountAttachment.Action = "add_access_accounts_to_rbac_group"
 
 	return c.PostAPI(rbacGroupAccessAccountAttachment.Action, rbacGroupAccessAccountAttachment, BasicCheck)
@@ -26,7 +24,6 @@ func (c *Client) CreateRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAt
 
 func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error) {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "list_access_accounts_in_rbac_group",
 		"group_name": rbacGroupAccessAccountAttachment.GroupName,
 	}
@@ -41,20 +38,23 @@ func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttac
 	attachments := data.RbacGroupAccessAccountAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupAccessAccountAttachment.AccessAccountName {
-			log.Infof("Found Aviatrix RBAC group access account attachment: %s",
-				rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix RBAC group access account attachment", map[string]interface{}{
+				"group_name":          rbacGroupAccessAccountAttachment.GroupName,
+				"access_account_name": rbacGroupAccessAccountAttachment.AccessAccountName,
+			})
 			return rbacGroupAccessAccountAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group access account attachment: %s",
-		rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix RBAC group access account attachment", map[string]interface{}{
+		"group_name":          rbacGroupAccessAccountAttachment.GroupName,
+		"access_account_name": rbacGroupAccessAccountAttachment.AccessAccountName,
+	})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "delete_access_accounts_from_rbac_group",
 		"group_name": rbacGroupAccessAccountAttachment.GroupName,
 		"accounts":   rbacGroupAccessAccountAttachment.AccessAccountName,
@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 )
 
 type RbacGroup struct {
-	CID       string `form:"CID,omitempty"`
 	Action    string `form:"action,omitempty"`
 	GroupName string `form:"group_name,omitempty" json:"group_name,omitempty"`
 }
@@ -28,7 +27,6 @@ type RbacGroupListDetailsResp struct {
 }
 
 func (c *Client) CreatePermissionGroup(rbacGroup *RbacGroup) error {
-	rbacGroup.CID = c.CID
 	rbacGroup.Action = "add_permission_group"
 
 	return c.PostAPI(rbacGroup.Action, rbacGroup, BasicCheck)
@@ -36,7 +34,6 @@ func (c *Client) CreatePermissionGroup(rbacGroup *RbacGroup) error {
 
 func (c *Client) GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_permission_groups",
 	}
 
@@ -50,18 +47,17 @@ func (c *Client) GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error) {
 	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i] == rbacGroup.GroupName {
-			log.Infof("Found Aviatrix RBAC group: %s", rbacGroup.GroupName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix RBAC group", map[string]interface{}{"group_name": rbacGroup.GroupName})
 			return rbacGroup, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group: %s", rbacGroup.GroupName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix RBAC group", map[string]interface{}{"group_name": rbacGroup.GroupName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeletePermissionGroup(rbacGroup *RbacGroup) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "delete_permission_group",
 		"group_name": rbacGroup.GroupName,
 	}
@@ -72,7 +68,6 @@ func (c *Client) DeletePermissionGroup(rbacGroup *RbacGroup) error {
 func (c *Client) EnableLocalLoginForRBACGroup(GroupName string) error {
 	data := map[string]string{
 		"action":     "enable_local_login",
-		"CID":        c.CID,
 		"group_name": GroupName,
 	}
 	return c.PostAPI("disable_local_login", data, BasicCheck)
@@ -81,7 +76,6 @@ func (c *Client) EnableLocalLoginForRBACGroup(GroupName string) error {
 func (c *Client) DisableLocalLoginForRBACGroup(GroupName string) error {
 	data := map[string]string{
 		"action":     "disable_local_login",
-		"CID":        c.CID,
 		"group_name": GroupName,
 	}
 	return c.PostAPI("disable_local_login", data, BasicCheck)
@@ -89,7 +83,6 @@ func (c *Client) DisableLocalLoginForRBACGroup(GroupName string) error {
 
 func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_permission_group_details",
 	}
 
@@ -103,10 +96,10 @@ func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse
 	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i].GroupName == GroupName {
-			log.Infof("Found Aviatrix RBAC group: %s", GroupName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix RBAC group", map[string]interface{}{"group_name": GroupName})
 			return &groups[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix RBAC group: %s", GroupName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix RBAC group", map[string]interface{}{"group_name": GroupName})
 	return nil, ErrNotFound
 }
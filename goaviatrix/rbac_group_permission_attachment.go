@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 )
 
 type RbacGroupPermissionAttachment struct {
-	CID            string `form:"CID,omitempty"`
 	Action         string `form:"action,omitempty"`
 	GroupName      string `form:"group_name,omitempty" json:"group_name,omitempty"`
 	PermissionName string `form:"permissions,omitempty" json:"permissions,omitempty"`
@@ -25,7 +24,6 @@ type PermissionAttachmentInfo struct {
 }
 
 func (c *Client) CreateRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error {
-	rbacGroupPermissionAttachment.CID = c.CID
 	rbacGroupPermissionAttachment.Action = "add_permissions_to_rbac_group"
 
 	return c.PostAPI(rbacGroupPermissionAttachment.Action, rbacGroupPermissionAttachment, BasicCheck)
@@ -33,7 +31,6 @@ func (c *Client) CreateRbacGroupPermissionAttachment(rbacGroupPermissionAttachme
 
 func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) (*RbacGroupPermissionAttachment, error) {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "list_rbac_group_permissions",
 		"group_name": rbacGroupPermissionAttachment.GroupName,
 	}
@@ -48,20 +45,23 @@ func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment
 	attachments := data.RbacGroupPermissionAttachmentList
 	for i := range attachments {
 		if attachments[i].Name == rbacGroupPermissionAttachment.PermissionName {
-			log.Infof("Found Aviatrix RBAC group permission attachment: %s",
-				rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix RBAC group permission attachment", map[string]interface{}{
+				"group_name":      rbacGroupPermissionAttachment.GroupName,
+				"permission_name": rbacGroupPermissionAttachment.PermissionName,
+			})
 			return rbacGroupPermissionAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group permission attachment: %s",
-		rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix RBAC group permission attachment", map[string]interface{}{
+		"group_name":      rbacGroupPermissionAttachment.GroupName,
+		"permission_name": rbacGroupPermissionAttachment.PermissionName,
+	})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "delete_permissions_from_rbac_group",
 		"group_name":  rbacGroupPermissionAttachment.GroupName,
 		"permissions": rbacGroupPermissionAttachment.PermissionName,
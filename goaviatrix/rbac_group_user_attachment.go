@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 )
 
 type RbacGroupUserAttachment struct {
-	CID       string `form:"CID,omitempty"`
 	Action    string `form:"action,omitempty"`
 	GroupName string `form:"group_name,omitempty" json:"group_name,omitempty"`
 	UserName  string `form:"users,omitempty" json:"users,omitempty"`
@@ -18,7 +17,6 @@ type RbacGroupUserAttachmentListResp struct {
 }
 
 func (c *Client) CreateRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) error {
-	rbacGroupUserAttachment.CID = c.CID
 	rbacGroupUserAttachment.Action = "add_users_to_rbac_group"
 
 	return c.PostAPI(rbacGroupUserAttachment.Action, rbacGroupUserAttachment, BasicCheck)
@@ -26,7 +24,6 @@ func (c *Client) CreateRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGrou
 
 func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error) {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "list_users_in_rbac_group",
 		"group_name": rbacGroupUserAttachment.GroupName,
 	}
@@ -41,20 +38,23 @@ func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUs
 	attachments := data.RbacGroupUserAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupUserAttachment.UserName {
-			log.Infof("Found Aviatrix RBAC group user attachment: %s",
-				rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName)
+			logInfo(c.logContext(context.Background()), "Found Aviatrix RBAC group user attachment", map[string]interface{}{
+				"group_name": rbacGroupUserAttachment.GroupName,
+				"username":   rbacGroupUserAttachment.UserName,
+			})
 			return rbacGroupUserAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group user attachment: %s",
-		rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix RBAC group user attachment", map[string]interface{}{
+		"group_name": rbacGroupUserAttachment.GroupName,
+		"username":   rbacGroupUserAttachment.UserName,
+	})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "delete_users_from_rbac_group",
 		"group_name": rbacGroupUserAttachment.GroupName,
 		"users":      rbacGroupUserAttachment.UserName,
@@ -0,0 +1,176 @@
+package goaviatrix
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// readActions are the v1/v2 actions allowed in read-only mode, i.e. the actions the client sends
+// that do not change the controller. Login and get_api_token only create a session. An action
+// must be added here to be sent in read-only mode.
+var readActions = map[string]bool{
+	"check_task_status":                                  true,
+	"cloud_network_info":                                 true,
+	"discovery_edge_gateway_wan_ip":                      true,
+	"get_account_audit_records":                          true,
+	"get_allow_downloading_vpn_client_status":            true,
+	"get_api_token":                                      true,
+	"get_cloudn_backup_config":                           true,
+	"get_cloudwan_configtag_details":                     true,
+	"get_cloudwan_device_wan_interfaces":                 true,
+	"get_cloudwatch_agent_status":                        true,
+	"get_compatible_image_version":                       true,
+	"get_controller_feature":                             true,
+	"get_controller_security_group_management_status":    true,
+	"get_controller_vpc_dns_server_status":               true,
+	"get_copilot_association_status":                     true,
+	"get_copilot_sg":                                     true,
+	"get_custom_vpc_by_name":                             true,
+	"get_datadog_agent_logging_status":                   true,
+	"get_edge_csp_bootstrap_usb":                         true,
+	"get_exception_email_notification_status":            true,
+	"get_firewall_lan_cidr":                              true,
+	"get_fqdn_cache_global_status":                       true,
+	"get_fqdn_exact_match_status":                        true,
+	"get_fqdn_exception_rule_status":                     true,
+	"get_fqdn_private_network_filtering_status":          true,
+	"get_gateway_ca_certificate_status":                  true,
+	"get_gateway_info":                                   true,
+	"get_gateway_periodic_ping_status":                   true,
+	"get_geo_vpn_info":                                   true,
+	"get_gro_gso_status":                                 true,
+	"get_https_certs_status":                             true,
+	"get_instance_by_id":                                 true,
+	"get_inter_transit_gateway_peering_details":          true,
+	"get_jumbo_frame_status":                             true,
+	"get_keep_alive_speed":                               true,
+	"get_logstash_logging_status":                        true,
+	"get_netflow_agent":                                  true,
+	"get_private_mode_info":                              true,
+	"get_private_mode_load_balancer_detail":              true,
+	"get_private_oob_state":                              true,
+	"get_profile_base_policy":                            true,
+	"get_public_subnet_filtering_gateway_details":        true,
+	"get_rate_limit_emails":                              true,
+	"get_remote_syslog_logging_status":                   true,
+	"get_s2c_ca_cert_list_by_name":                       true,
+	"get_saml_endpoint_information":                      true,
+	"get_site2cloud_conn_detail":                         true,
+	"get_splunk_logging_status":                          true,
+	"get_spoke_gateway_subnet_group":                     true,
+	"get_sumologic_logging_status":                       true,
+	"get_tgw_attachment_details":                         true,
+	"get_tgw_connect_by_connection_name":                 true,
+	"get_tgw_connect_peer_by_connect_peer_name":          true,
+	"get_vpn_user_by_name":                               true,
+	"list_access_accounts_in_rbac_group":                 true,
+	"list_account_users":                                 true,
+	"list_accounts":                                      true,
+	"list_all_tgw_attachments":                           true,
+	"list_all_tgw_security_domains":                      true,
+	"list_arm_native_spokes":                             true,
+	"list_arm_peer_vnet_pairs":                           true,
+	"list_attached_vpc_names_to_route_domain":            true,
+	"list_attachment_route_table_details":                true,
+	"list_aviatrix_spoke_advanced_config":                true,
+	"list_aviatrix_transit_advanced_config":              true,
+	"list_aws_guard_duty":                                true,
+	"list_aws_peerings":                                  true,
+	"list_cert_domain":                                   true,
+	"list_cloudwan_attachments":                          true,
+	"list_cloudwan_configtag_names":                      true,
+	"list_cloudwan_devices_summary":                      true,
+	"list_connected_route_domains":                       true,
+	"list_custom_vpcs":                                   true,
+	"list_dns_profile":                                   true,
+	"list_edge_csp_devices":                              true,
+	"list_extended_vpc_peer":                             true,
+	"list_firenet":                                       true,
+	"list_fqdn_filter_tag_attached_gws":                  true,
+	"list_fqdn_filter_tag_domain_names":                  true,
+	"list_fqdn_filter_tag_source_ip_filters":             true,
+	"list_fqdn_filter_tags":                              true,
+	"list_fqdn_pass_through_cidrs":                       true,
+	"list_inter_transit_gateway_peering":                 true,
+	"list_multi_cloud_domain_attachments":                true,
+	"list_multi_cloud_security_domain_connection_policy": true,
+	"list_multi_cloud_security_domain_names":             true,
+	"list_notif_email_addr":                              true,
+	"list_oci_vpc_availability_domains":                  true,
+	"list_oci_vpc_fault_domains":                         true,
+	"list_peer_vpc_pairs":                                true,
+	"list_peered_tgw_names":                              true,
+	"list_permission_group_details":                      true,
+	"list_permission_groups":                             true,
+	"list_policy_members":                                true,
+	"list_primary_firenet":                               true,
+	"list_private_mode_multicloud_endpoints":             true,
+	"list_profile_policies":                              true,
+	"list_rbac_group_permissions":                        true,
+	"list_resource_counts":                               true,
+	"list_resource_tags":                                 true,
+	"list_route_domain_names":                            true,
+	"list_secondary_firenet":                             true,
+	"list_site2cloud_conn":                               true,
+	"list_spoke_gateway_subnets":                         true,
+	"list_tgw_details":                                   true,
+	"list_tgw_security_domain_details":                   true,
+	"list_transit_firenet":                               true,
+	"list_transit_firenet_spoke_policies":                true,
+	"list_transit_gateways_for_multi_cloud_domains":      true,
+	"list_user_profile_names":                            true,
+	"list_users_in_rbac_group":                           true,
+	"list_version_info":                                  true,
+	"list_vgw_connections":                               true,
+	"list_vnets_with_vng":                                true,
+	"list_vpc_route_tables":                              true,
+	"list_vpcs_summary":                                  true,
+	"list_vpn_user_xlr":                                  true,
+	"login":                                              true,
+	"platform_upgrade_status":                            true,
+	"show_bgp_max_as_limit":                              true,
+	"show_firenet_detail":                                true,
+	"show_firenet_firewall_vendor_config":                true,
+	"show_multi_cloud_transit_peering_details":           true,
+	"show_proxy_config":                                  true,
+	"show_tunnel_status_change_detection_time":           true,
+	"view_route_domain_details":                          true,
+	"vpc_access_policy":                                  true,
+}
+
+// ReadOnlyError is returned for requests that would change the controller when the client is
+// in read-only mode. The request is not sent.
+type ReadOnlyError struct {
+	Method string
+	// Action is the v1/v2 action or the v2.5 path
+	Action string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("the provider is in read-only mode: %s %q may change the controller and was not sent", e.Method, e.Action)
+}
+
+// WithReadOnly rejects every request that may change the controller with a ReadOnlyError. Only
+// the allow-listed v1/v2 read actions and v2.5 GET requests are sent.
+func WithReadOnly() ClientOption {
+	return func(c *Client) {
+		c.readOnly = true
+	}
+}
+
+// checkReadOnly returns a ReadOnlyError if the client is in read-only mode and the request may
+// change the controller. Requests without a known action are rejected.
+func (c *Client) checkReadOnly(req *http.Request, action string) error {
+	if !c.readOnly {
+		return nil
+	}
+	if strings.Contains(req.URL.Path, "/v2.5/") {
+		if req.Method == http.MethodGet || req.Method == http.MethodHead {
+			return nil
+		}
+	} else if readActions[action] {
+		return nil
+	}
+	return &ReadOnlyError{Method: req.Method, Action: action}
+}
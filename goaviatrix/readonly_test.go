@@ -0,0 +1,117 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestReadOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		send     func(c *Client) error
+		wantSent bool
+	}{
+		{
+			"list action",
+			func(c *Client) error {
+				return c.GetAPI(nil, "list_vpcs_summary", map[string]string{"action": "list_vpcs_summary"}, BasicCheck)
+			},
+			true,
+		},
+		{
+			"allow-listed action",
+			func(c *Client) error {
+				return c.PostAPI("login", map[string]string{"action": "login"}, BasicCheck)
+			},
+			true,
+		},
+		{
+			"unknown list action",
+			func(c *Client) error {
+				return c.GetAPI(nil, "list_and_reset_counters", map[string]string{"action": "list_and_reset_counters"}, BasicCheck)
+			},
+			false,
+		},
+		{
+			"v2.5 head",
+			func(c *Client) error {
+				_, err := c.RequestContext25(context.Background(), "HEAD", "https://"+c.ControllerIP+"/v2.5/api/app-domains", nil)
+				return err
+			},
+			true,
+		},
+		{
+			"v2.5 delete",
+			func(c *Client) error {
+				return c.DeleteAPIContext25(context.Background(), "app-domains/uuid", nil)
+			},
+			false,
+		},
+		{
+			"create action",
+			func(c *Client) error {
+				return c.PostAPI("create_gateway", map[string]string{"action": "create_gateway"}, BasicCheck)
+			},
+			false,
+		},
+		{
+			"read verb with write action",
+			func(c *Client) error {
+				return c.GetAPI(nil, "delete_gateway", map[string]string{"action": "delete_gateway"}, BasicCheck)
+			},
+			false,
+		},
+		{
+			"v2 json",
+			func(c *Client) error {
+				return c.PostAPIContext2(context.Background(), nil, "enable_gro_gso", map[string]string{"action": "enable_gro_gso"}, BasicCheck)
+			},
+			false,
+		},
+		{
+			"v2.5 get",
+			func(c *Client) error {
+				return c.GetAPIContext25(context.Background(), nil, "app-domains", nil)
+			},
+			true,
+		},
+		{
+			"v2.5 put",
+			func(c *Client) error {
+				return c.PutAPIContext25(context.Background(), "app-domains/uuid", map[string]string{"name": "test"})
+			},
+			false,
+		},
+		{
+			"multipart",
+			func(c *Client) error {
+				return c.PostFileAPI(map[string]string{"action": "list_files"}, []File{{ParamName: "file", UseFileContent: true, FileName: "a", FileContent: "a"}}, BasicCheck)
+			},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := false
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				sent = true
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"return":true}`)
+			})
+			WithReadOnly()(client)
+
+			err := tt.send(client)
+			if sent != tt.wantSent {
+				t.Errorf("request sent = %v, want %v", sent, tt.wantSent)
+			}
+			var readOnlyErr *ReadOnlyError
+			if errors.As(err, &readOnlyErr) == tt.wantSent {
+				t.Errorf("error = %v, want ReadOnlyError %v", err, !tt.wantSent)
+			}
+		})
+	}
+}
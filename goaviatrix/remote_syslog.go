@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,7 +12,6 @@ import (
 )
 
 type RemoteSyslog struct {
-	CID                 string `form:"CID,omitempty"`
 	Server              string `form:"server,omitempty" json:"server"`
 	Port                int    `form:"port,omitempty" json:"port"`
 	Protocol            string `form:"protocol,omitempty" json:"protocol"`
@@ -64,7 +62,6 @@ type RemoteSyslogResp struct {
 func (c *Client) EnableRemoteSyslog(r *RemoteSyslog) error {
 	params := map[string]string{
 		"action":               "enable_remote_syslog_logging",
-		"CID":                  c.CID,
 		"index":                strconv.Itoa(r.Index),
 		"name":                 r.Name,
 		"server":               r.Server,
@@ -112,7 +109,6 @@ func (c *Client) EnableRemoteSyslog(r *RemoteSyslog) error {
 func (c *Client) GetRemoteSyslogStatus(idx int) (*RemoteSyslogResp, error) {
 	params := map[string]string{
 		"action": "get_remote_syslog_logging_status",
-		"CID":    c.CID,
 		"index":  strconv.Itoa(idx),
 	}
 
@@ -139,11 +135,10 @@ func (c *Client) GetRemoteSyslogStatus(idx int) (*RemoteSyslogResp, error) {
 func (c *Client) DisableRemoteSyslog(idx int) error {
 	params := map[string]string{
 		"action": "disable_remote_syslog_logging",
-		"CID":    c.CID,
 		"index":  strconv.Itoa(idx),
 	}
 
-	log.Printf("[INFO] Deleting remote syslog index %d", idx)
+	logInfo(c.logContext(context.Background()), "Deleting remote syslog", map[string]interface{}{"index": idx})
 
 	return c.PostAPI(params["action"], params, BasicCheck)
 }
@@ -0,0 +1,214 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ajg/form"
+)
+
+// bodyEncoding is how the payload of a request is encoded.
+type bodyEncoding int
+
+const (
+	bodyNone bodyEncoding = iota
+	// bodyForm encodes the payload as x-www-form-urlencoded. The payload is sent in the body for
+	// every verb, as v1 actions like get_api_token expect. Without a payload the CID is sent as a
+	// query parameter of GET, HEAD and DELETE requests.
+	bodyForm
+	bodyJSON
+	bodyMultipart
+)
+
+// cidPlacement is where the CID of the session is sent.
+type cidPlacement int
+
+const (
+	cidNone cidPlacement = iota
+	// cidPayload sends the CID as the "CID" field of the form, JSON or multipart payload
+	cidPayload
+	// cidAuthorization sends the CID in the Authorization header, as expected by the v2.5 API
+	cidAuthorization
+)
+
+// requestBuilder builds the requests sent by the v1, v2 and v2.5 clients. The verb is always
+// sent as is, the builder only decides where and how the payload is encoded for that verb.
+//
+// The CID is added by the builder when the request is sent, so callers do not set it in the
+// payload. Any CID already in the payload or the URL is replaced.
+type requestBuilder struct {
+	encoding bodyEncoding
+	payload  interface{}
+	// params and files are the multipart form fields
+	params map[string]string
+	files  []File
+
+	query  url.Values
+	header http.Header
+	cid    cidPlacement
+}
+
+// formRequest returns a builder for a form encoded payload. i may be nil.
+func formRequest(i interface{}) *requestBuilder {
+	return &requestBuilder{encoding: bodyForm, payload: i}
+}
+
+// jsonRequest returns a builder for a JSON encoded payload. i may be nil.
+func jsonRequest(i interface{}) *requestBuilder {
+	return &requestBuilder{encoding: bodyJSON, payload: i}
+}
+
+// multipartRequest returns a builder for a multipart form with the given fields and files.
+func multipartRequest(params map[string]string, files []File) *requestBuilder {
+	return &requestBuilder{encoding: bodyMultipart, params: params, files: files}
+}
+
+// withQuery adds query parameters to the request URL.
+func (b *requestBuilder) withQuery(key, value string) *requestBuilder {
+	if b.query == nil {
+		b.query = url.Values{}
+	}
+	b.query.Add(key, value)
+	return b
+}
+
+// withHeader sets a request header.
+func (b *requestBuilder) withHeader(key, value string) *requestBuilder {
+	if b.header == nil {
+		b.header = http.Header{}
+	}
+	b.header.Set(key, value)
+	return b
+}
+
+// withCID sets where the CID is sent.
+func (b *requestBuilder) withCID(placement cidPlacement) *requestBuilder {
+	b.cid = placement
+	return b
+}
+
+// build returns a new request for one attempt, sent with the given CID. It has the signature
+// of apiCall.newRequest.
+func (b *requestBuilder) build(ctx context.Context, verb, path, cid string) (*http.Request, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	for k, v := range b.query {
+		query[k] = append(query[k], v...)
+	}
+	// There is no CID before login
+	injectCID := b.cid == cidPayload && cid != ""
+
+	var body io.Reader
+	var contentType string
+	contentLength := int64(-1)
+	switch b.encoding {
+	case bodyForm:
+		values := url.Values{}
+		if b.payload != nil {
+			values, err = form.EncodeToValues(b.payload, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if injectCID {
+			values.Set("CID", cid)
+		}
+		if len(values) == 0 {
+			break
+		}
+		if b.payload == nil && !verbHasBody(verb) {
+			for k, v := range values {
+				query[k] = v
+			}
+			break
+		}
+		body = strings.NewReader(values.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	case bodyJSON:
+		if b.payload == nil {
+			break
+		}
+		data, err := json.Marshal(b.payload)
+		if err != nil {
+			return nil, err
+		}
+		if injectCID {
+			if data, err = setJSONCID(data, cid); err != nil {
+				return nil, err
+			}
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	case bodyMultipart:
+		params := b.params
+		if injectCID {
+			params = make(map[string]string, len(b.params)+1)
+			for k, v := range b.params {
+				params[k] = v
+			}
+			params["CID"] = cid
+		}
+		multipartBody, multipartContentType, length, err := newMultipartBody(ctx, params, b.files)
+		if err != nil {
+			return nil, err
+		}
+		body = multipartBody
+		contentType = multipartContentType
+		contentLength = length
+	}
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, verb, u.String(), body)
+	if err != nil {
+		if closer, ok := body.(io.Closer); ok {
+			closer.Close()
+		}
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if contentLength >= 0 {
+		req.ContentLength = contentLength
+	}
+	for k, v := range b.header {
+		req.Header[k] = v
+	}
+	if b.cid == cidAuthorization {
+		req.Header.Set("Authorization", "cid "+cid)
+	}
+	return req, nil
+}
+
+// setJSONCID sets the "CID" field of a JSON object. Other JSON values are returned as is.
+func setJSONCID(data []byte, cid string) ([]byte, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return data, nil
+	}
+	value, err := json.Marshal(cid)
+	if err != nil {
+		return nil, err
+	}
+	object["CID"] = value
+	return json.Marshal(object)
+}
+
+// verbHasBody reports whether requests with the given verb usually carry a body.
+func verbHasBody(verb string) bool {
+	switch verb {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,387 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata/encoding")
+
+// encodingCases are the request structs sent to the controller, with the encoding used by the
+// methods that send them. A struct sent both as a form and as JSON is listed twice.
+var encodingCases = []struct {
+	name     string
+	encoding bodyEncoding
+	payload  interface{}
+}{
+	{"APIRequest", bodyForm, &APIRequest{}},
+	{"AWSPeer", bodyForm, &AWSPeer{}},
+	{"AWSTgw", bodyForm, &AWSTgw{}},
+	{"Account", bodyForm, &Account{}},
+	{"AccountUser", bodyForm, &AccountUser{}},
+	{"AccountUserEdit", bodyForm, &AccountUserEdit{}},
+	{"AllowList", bodyJSON, &AllowList{}},
+	{"AwsTgwConnect", bodyForm, &AwsTgwConnect{}},
+	{"AwsTgwConnectPeer", bodyForm, &AwsTgwConnectPeer{}},
+	{"AwsTgwDirectConnect", bodyForm, &AwsTgwDirectConnect{}},
+	{"AwsTgwPeering", bodyForm, &AwsTgwPeering{}},
+	{"AwsTgwVpnConn", bodyForm, &AwsTgwVpnConn{}},
+	{"AzurePeer", bodyForm, &AzurePeer{}},
+	{"AzureSpokeNativePeering", bodyForm, &AzureSpokeNativePeering{}},
+	{"CentralizedTransitFirenet", bodyForm, &CentralizedTransitFirenet{}},
+	{"CloudnRegistration", bodyForm, &CloudnRegistration{}},
+	{"CloudnTransitGatewayAttachment", bodyForm, &CloudnTransitGatewayAttachment{}},
+	{"CopilotFaultTolerantDeployment", bodyJSON, &CopilotFaultTolerantDeployment{}},
+	{"CopilotSecurityGroupManagementConfig", bodyJSON, &CopilotSecurityGroupManagementConfig{}},
+	{"CopilotSimpleDeployment", bodyJSON, &CopilotSimpleDeployment{}},
+	{"DeviceAwsTgwAttachment", bodyForm, &DeviceAwsTgwAttachment{}},
+	{"DeviceTag", bodyForm, &DeviceTag{}},
+	{"DistributedFirewallingIntraVpcList", bodyJSON, &DistributedFirewallingIntraVpcList{}},
+	{"DistributedFirewallingPolicyList", bodyJSON, &DistributedFirewallingPolicyList{}},
+	{"EdgeAccount", bodyJSON, &EdgeAccount{}},
+	{"EdgeCSP", bodyJSON, &EdgeCSP{}},
+	{"EdgeCSPHa", bodyJSON, &EdgeCSPHa{}},
+	{"EdgeEquinix", bodyJSON, &EdgeEquinix{}},
+	{"EdgeEquinixHa", bodyJSON, &EdgeEquinixHa{}},
+	{"EdgeExternalDeviceConn", bodyJSON, &EdgeExternalDeviceConn{}},
+	{"EdgeNEO", bodyJSON, &EdgeNEO{}},
+	{"EdgeNEODevice", bodyJSON, &EdgeNEODevice{}},
+	{"EdgeNEOHa", bodyJSON, &EdgeNEOHa{}},
+	{"EdgeSpoke", bodyJSON, &EdgeSpoke{}},
+	{"EdgeVmSelfmanagedHa", bodyJSON, &EdgeVmSelfmanagedHa{}},
+	{"EditBgpMd5Key", bodyForm, &EditBgpMd5Key{}},
+	{"EditSite2Cloud", bodyForm, &EditSite2Cloud{}},
+	{"ExternalDeviceConn", bodyForm, &ExternalDeviceConn{}},
+	{"Firewall", bodyForm, &Firewall{}},
+	{"FirewallTag", bodyForm, &FirewallTag{}},
+	{"Gateway", bodyForm, &Gateway{}},
+	{"GeoVPN", bodyForm, &GeoVPN{}},
+	{"GeoVPN_json", bodyJSON, &GeoVPN{}},
+	{"GlobalVpcExcludedInstance", bodyJSON, &GlobalVpcExcludedInstance{}},
+	{"GlobalVpcTaggingSettings", bodyJSON, &GlobalVpcTaggingSettings{}},
+	{"PeriodicPing", bodyForm, &PeriodicPing{}},
+	{"PolicyList", bodyJSON, &PolicyList{}},
+	{"PrivateModeLb", bodyJSON, &PrivateModeLb{}},
+	{"PrivateModeMulticloudEndpoint", bodyJSON, &PrivateModeMulticloudEndpoint{}},
+	{"QosClass", bodyJSON, &QosClass{}},
+	{"QosPolicyList", bodyJSON, &QosPolicyList{}},
+	{"RbacGroup", bodyForm, &RbacGroup{}},
+	{"RbacGroupAccessAccountAttachment", bodyForm, &RbacGroupAccessAccountAttachment{}},
+	{"RbacGroupPermissionAttachment", bodyForm, &RbacGroupPermissionAttachment{}},
+	{"RbacGroupUserAttachment", bodyForm, &RbacGroupUserAttachment{}},
+	{"SLAClass", bodyJSON, &SLAClass{}},
+	{"SamlEndpoint", bodyForm, &SamlEndpoint{}},
+	{"SecurityDomain", bodyForm, &SecurityDomain{}},
+	{"Site2Cloud", bodyForm, &Site2Cloud{}},
+	{"SpokeHaGateway", bodyJSON, &SpokeHaGateway{}},
+	{"SpokeTransitAttachment", bodyForm, &SpokeTransitAttachment{}},
+	{"SpokeVpc", bodyForm, &SpokeVpc{}},
+	{"Tags", bodyForm, &Tags{}},
+	{"TransPeer", bodyForm, &TransPeer{}},
+	{"TransitGatewayPeering", bodyForm, &TransitGatewayPeering{}},
+	{"TransitGatewayPeeringEdit", bodyForm, &TransitGatewayPeeringEdit{}},
+	{"TransitHaGateway", bodyJSON, &TransitHaGateway{}},
+	{"TransitVpc", bodyForm, &TransitVpc{}},
+	{"VPNCertDownload", bodyForm, &VPNCertDownload{}},
+	{"VpnGatewayAuth", bodyForm, &VpnGatewayAuth{}},
+	{"VpnUserXlr", bodyForm, &VpnUserXlr{}},
+}
+
+// TestRequestEncoding checks the wire encoding of every request struct against
+// testdata/encoding/<name>.golden, once with every field set and once with the zero value. The
+// golden files are rewritten by running the test with -update.
+func TestRequestEncoding(t *testing.T) {
+	for _, tt := range encodingCases {
+		t.Run(tt.name, func(t *testing.T) {
+			zero := reflect.New(reflect.TypeOf(tt.payload).Elem()).Interface()
+			full := reflect.New(reflect.TypeOf(tt.payload).Elem())
+			fillRepresentative(full.Elem(), reflect.TypeOf(tt.payload).Elem().Name(), 0)
+
+			var b strings.Builder
+			fmt.Fprintf(&b, "# every field set\n%s\n", encodeRequest(t, tt.encoding, full.Interface()))
+			fmt.Fprintf(&b, "# zero value\n%s", encodeRequest(t, tt.encoding, zero))
+			checkGolden(t, tt.name, b.String())
+		})
+	}
+}
+
+// TestFormBuilderEncoding checks the payloads built by hand, by the form builders and by the
+// methods that build their form themselves, against testdata/encoding/<name>.golden.
+func TestFormBuilderEncoding(t *testing.T) {
+	smartGroup := &SmartGroup{Name: "smart-group"}
+	for _, expression := range []*SmartGroupMatchExpression{
+		{Type: "vm", AccountName: "aws-account", Region: "us-east-1", Tags: map[string]string{"env": "prod", "k8s.io/role": "web"}},
+		{CIDR: "10.0.0.0/16"},
+		{FQDN: "www.example.com"},
+		{Site: "site-1"},
+		{Type: "vpc", ResId: "vpc-0123456789", AccountId: "123456789012", Name: "vpc-name", Zone: "us-east-1a"},
+	} {
+		smartGroup.Selector.Expressions = append(smartGroup.Selector.Expressions, expression)
+	}
+	webGroup := &WebGroup{
+		Name: "web-group",
+		Selector: WebGroupSelector{
+			Expressions: []*WebGroupMatchExpression{
+				{SniFilter: "*.example.com"},
+				{UrlFilter: "https://www.example.com/path"},
+			},
+		},
+	}
+	site2cloud := &Site2Cloud{}
+	fillRepresentative(reflect.ValueOf(site2cloud).Elem(), "Site2Cloud", 0)
+	pubkeySite2Cloud := *site2cloud
+	pubkeySite2Cloud.AuthType = "pubkey"
+	pubkeySite2Cloud.HAEnabled = "yes"
+
+	tests := []struct {
+		name string
+		// encode returns the golden encoding of the payload
+		encode func(t *testing.T) string
+	}{
+		{"makeSmartGroupForm", func(t *testing.T) string {
+			return encodeRequest(t, bodyJSON, makeSmartGroupForm(smartGroup))
+		}},
+		{"makeWebGroupForm", func(t *testing.T) string {
+			return encodeRequest(t, bodyJSON, makeWebGroupForm(webGroup))
+		}},
+		// PolicyToMap is not sent to the controller, its keys are the attributes of a policy
+		{"PolicyToMap", func(t *testing.T) string {
+			return encodeRequest(t, bodyJSON, PolicyToMap(&Policy{
+				SrcIP:       "10.0.0.0/16",
+				DstIP:       "10.1.0.0/16",
+				Protocol:    "all",
+				Action:      "allow",
+				LogEnabled:  "on",
+				Description: "description",
+			}))
+		}},
+		{"CreateSite2Cloud", func(t *testing.T) string {
+			return captureRequests(t, func(client *Client) error { return client.CreateSite2Cloud(site2cloud) })
+		}},
+		{"CreateSite2Cloud_pubkey", func(t *testing.T) string {
+			return captureRequests(t, func(client *Client) error { return client.CreateSite2Cloud(&pubkeySite2Cloud) })
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.name, tt.encode(t))
+		})
+	}
+}
+
+// TestRequestStructTags checks the tags of the request structs and of the structs they contain.
+// ajg/form only reads the first option of a form tag, and a field of a struct sent as JSON with
+// only a form tag is sent under its Go name.
+func TestRequestStructTags(t *testing.T) {
+	for _, tt := range encodingCases {
+		checked := map[reflect.Type]bool{}
+		var check func(typ reflect.Type)
+		check = func(typ reflect.Type) {
+			for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+				typ = typ.Elem()
+			}
+			if typ.Kind() != reflect.Struct || checked[typ] {
+				return
+			}
+			checked[typ] = true
+			for i := 0; i < typ.NumField(); i++ {
+				field := typ.Field(i)
+				if field.PkgPath != "" {
+					continue
+				}
+				formTag, hasForm := field.Tag.Lookup("form")
+				jsonTag, hasJSON := field.Tag.Lookup("json")
+				if hasForm {
+					if _, options, _ := strings.Cut(formTag, ","); options != "" && options != "omitempty" {
+						t.Errorf("%s.%s: form tag %q: only omitempty is supported", typ.Name(), field.Name, formTag)
+					}
+				}
+				if hasJSON {
+					_, options, _ := strings.Cut(jsonTag, ",")
+					seen := map[string]bool{}
+					for _, option := range strings.Split(options, ",") {
+						if option == "" {
+							continue
+						}
+						if option != "omitempty" && option != "string" {
+							t.Errorf("%s.%s: json tag %q: unknown option %q", typ.Name(), field.Name, jsonTag, option)
+						}
+						if seen[option] {
+							t.Errorf("%s.%s: json tag %q: duplicate option %q", typ.Name(), field.Name, jsonTag, option)
+						}
+						seen[option] = true
+					}
+				}
+				if tt.encoding == bodyJSON && hasForm && !hasJSON {
+					t.Errorf("%s.%s is sent as JSON but only has a form tag", typ.Name(), field.Name)
+				}
+				check(field.Type)
+			}
+		}
+		check(reflect.TypeOf(tt.payload))
+	}
+}
+
+// fillRepresentative sets every exported field of v, recursively, to a deterministic value:
+// strings are set to the path of the field so that the golden files show which field is sent
+// under which name, booleans to true and numbers to 1. Slices get two elements and maps one
+// entry. Interfaces are left nil.
+func fillRepresentative(v reflect.Value, path string, depth int) {
+	// Guard against recursive types
+	if depth > 5 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(path)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillRepresentative(v.Elem(), path, depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fillRepresentative(v.Field(i), path+"."+field.Name, depth+1)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fillRepresentative(v.Index(i), fmt.Sprintf("%s.%d", path, i), depth+1)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillRepresentative(v.Index(i), fmt.Sprintf("%s.%d", path, i), depth+1)
+		}
+	case reflect.Map:
+		key := reflect.New(v.Type().Key()).Elem()
+		fillRepresentative(key, path+".key", depth+1)
+		value := reflect.New(v.Type().Elem()).Elem()
+		fillRepresentative(value, path+".value", depth+1)
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(key, value)
+	}
+}
+
+// encodeRequest returns the body of a POST request with the payload, built the same way as the
+// requests of the client, in the format of the golden files: one form parameter per line, or
+// indented JSON.
+func encodeRequest(t *testing.T, encoding bodyEncoding, payload interface{}) string {
+	t.Helper()
+	b := &requestBuilder{encoding: encoding, payload: payload}
+	req, err := b.build(context.Background(), http.MethodPost, "https://controller.example.com/v2/api", "")
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+	return goldenBody(t, req)
+}
+
+// captureRequests returns the requests sent by call, in the format of the golden files. The
+// controller accepts every request.
+func captureRequests(t *testing.T, call func(client *Client) error) string {
+	t.Helper()
+	var b strings.Builder
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(&b, "# %s %s\n%s\n", r.Method, r.URL.Path, goldenBody(t, r))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"return":true,"results":"ok"}`)
+	})
+	if err := call(client); err != nil {
+		t.Fatalf("request error = %v", err)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func goldenBody(t *testing.T, req *http.Request) string {
+	t.Helper()
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			t.Fatalf("could not read the request body: %v", err)
+		}
+	}
+	if len(body) == 0 {
+		body = []byte(req.URL.RawQuery)
+	}
+	if len(body) == 0 {
+		return "(empty)\n"
+	}
+	if strings.Contains(req.Header.Get("Content-Type"), "json") {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, body, "", "  "); err != nil {
+			t.Fatalf("invalid JSON body %s: %v", body, err)
+		}
+		return indented.String() + "\n"
+	}
+	params := strings.Split(string(body), "&")
+	sort.Strings(params)
+	return strings.Join(params, "\n") + "\n"
+}
+
+// checkGolden compares got with testdata/encoding/<name>.golden, or writes it with -update.
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "encoding", name+".golden")
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read golden file, run the test with -update to create it: %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("the encoding does not match %s, run the test with -update and review the difference:\n%s", path, diffLines(string(want), got))
+	}
+}
+
+// diffLines returns the lines only in want, prefixed with -, and only in got, prefixed with +.
+func diffLines(want, got string) string {
+	count := map[string]int{}
+	for _, line := range strings.Split(want, "\n") {
+		count[line]++
+	}
+	for _, line := range strings.Split(got, "\n") {
+		count[line]--
+	}
+	var diff []string
+	for _, line := range strings.Split(want, "\n") {
+		if count[line] > 0 {
+			diff = append(diff, "-"+line)
+			count[line]--
+		}
+	}
+	for _, line := range strings.Split(got, "\n") {
+		if count[line] < 0 {
+			diff = append(diff, "+"+line)
+			count[line]++
+		}
+	}
+	return strings.Join(diff, "\n")
+}
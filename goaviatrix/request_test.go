@@ -0,0 +1,192 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequestVerbs(t *testing.T) {
+	payload := map[string]string{"action": "test_action", "CID": "cid"}
+	tests := []struct {
+		name            string
+		send            func(c *Client) (*http.Response, error)
+		wantMethod      string
+		wantContentType string
+		wantQuery       string
+		wantBody        string
+	}{
+		{
+			"get",
+			func(c *Client) (*http.Response, error) { return c.Get(c.baseURL, payload) },
+			"GET",
+			"application/x-www-form-urlencoded",
+			"",
+			"CID=cid&action=test_action",
+		},
+		{
+			"post",
+			func(c *Client) (*http.Response, error) { return c.Post(c.baseURL, payload) },
+			"POST",
+			"application/x-www-form-urlencoded",
+			"",
+			"CID=cid&action=test_action",
+		},
+		{
+			"put",
+			func(c *Client) (*http.Response, error) { return c.Put(c.baseURL, payload) },
+			"PUT",
+			"application/x-www-form-urlencoded",
+			"",
+			"CID=cid&action=test_action",
+		},
+		{
+			"delete",
+			func(c *Client) (*http.Response, error) { return c.Delete(c.baseURL, payload) },
+			"DELETE",
+			"application/x-www-form-urlencoded",
+			"",
+			"CID=cid&action=test_action",
+		},
+		{
+			"v2 json",
+			func(c *Client) (*http.Response, error) {
+				return c.RequestContext2(context.Background(), "PUT", c.baseURL, payload)
+			},
+			"PUT",
+			"application/json",
+			"",
+			`{"CID":"cid","action":"test_action"}`,
+		},
+		{
+			"v2.5 delete",
+			func(c *Client) (*http.Response, error) {
+				return c.RequestContext25(context.Background(), "DELETE", c.baseURL, payload)
+			},
+			"DELETE",
+			"application/json",
+			"",
+			`{"CID":"cid","action":"test_action"}`,
+		},
+		{
+			"v2.5 without body",
+			func(c *Client) (*http.Response, error) {
+				return c.RequestContext25(context.Background(), "DELETE", c.baseURL+"?name=test", nil)
+			},
+			"DELETE",
+			"",
+			"name=test",
+			"",
+		},
+		{
+			"multipart",
+			func(c *Client) (*http.Response, error) {
+				return c.PostFile(c.baseURL, payload, []File{{ParamName: "file", UseFileContent: true, FileName: "test.txt", FileContent: "content"}})
+			},
+			"POST",
+			"multipart/form-data",
+			"",
+			"content",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var method, contentType, query, body string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				method = r.Method
+				contentType = r.Header.Get("Content-Type")
+				query = r.URL.RawQuery
+				b, _ := io.ReadAll(r.Body)
+				body = string(b)
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"return":true}`)
+			})
+
+			if _, err := tt.send(client); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			if method != tt.wantMethod {
+				t.Errorf("method = %s, want %s", method, tt.wantMethod)
+			}
+			if !strings.HasPrefix(contentType, tt.wantContentType) || (tt.wantContentType == "" && contentType != "") {
+				t.Errorf("Content-Type = %q, want %q", contentType, tt.wantContentType)
+			}
+			if query != tt.wantQuery {
+				t.Errorf("query = %q, want %q", query, tt.wantQuery)
+			}
+			if !strings.Contains(body, tt.wantBody) || (tt.wantBody == "" && body != "") {
+				t.Errorf("body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestRequestBuilder(t *testing.T) {
+	req, err := formRequest(map[string]string{"action": "test_action"}).
+		withQuery("extra", "1").
+		withHeader("X-Test", "header").
+		withCID(cidAuthorization).
+		build(context.Background(), "GET", "https://controller/v2/api?debug=true", "test")
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	if got, want := req.URL.RawQuery, "debug=true&extra=1"; got != want {
+		t.Errorf("query = %q, want %q", got, want)
+	}
+	if got := req.Header.Get("X-Test"); got != "header" {
+		t.Errorf("X-Test header = %q, want %q", got, "header")
+	}
+	if got := req.Header.Get("Authorization"); got != "cid test" {
+		t.Errorf("Authorization header = %q, want %q", got, "cid test")
+	}
+	if req.Body == nil {
+		t.Fatalf("GET request has no body")
+	}
+	if b, _ := io.ReadAll(req.Body); string(b) != "action=test_action" {
+		t.Errorf("body = %q, want %q", b, "action=test_action")
+	}
+}
+
+func TestRequestCID(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *requestBuilder
+		verb    string
+		cid     string
+		want    string
+	}{
+		{"form body", formRequest(map[string]string{"action": "test_action", "CID": "stale"}).withCID(cidPayload), "POST", "cid", "CID=cid&action=test_action"},
+		{"form query", formRequest(nil).withCID(cidPayload), "GET", "cid", "?CID=cid&action=test_action"},
+		{"form body of a GET", formRequest(map[string]string{"action": "get_api_token"}).withCID(cidPayload), "GET", "cid", "CID=cid&action=get_api_token"},
+		{"form without session", formRequest(map[string]string{"action": "login"}).withCID(cidPayload), "POST", "", "action=login"},
+		{"json object", jsonRequest(struct {
+			Action string `json:"action"`
+			CID    string `json:"CID,omitempty"`
+		}{Action: "test_action"}).withCID(cidPayload), "POST", "cid", `{"CID":"cid","action":"test_action"}`},
+		{"json array", jsonRequest([]string{"a"}).withCID(cidPayload), "POST", "cid", `["a"]`},
+		{"multipart", multipartRequest(map[string]string{"action": "test_action"}, nil).withCID(cidPayload), "POST", "cid", `name="CID"`},
+		{"not sent", formRequest(map[string]string{"action": "test_action"}), "POST", "cid", "action=test_action"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.builder.build(context.Background(), tt.verb, "https://controller/v1/api?action=test_action&CID=stale", tt.cid)
+			if err != nil {
+				t.Fatalf("build() error = %v", err)
+			}
+			got := "?" + req.URL.RawQuery
+			if req.Body != nil {
+				b, _ := io.ReadAll(req.Body)
+				got = string(b)
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("request = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package goaviatrix
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests to the controller.
+//
+// A request is retried when the HTTP status code is one of RetryableStatusCodes, or when the
+// controller reason contains one of RetryableReasons or is classified as one of RetryableClasses.
+// Transport errors (connection refused, EOF, ...) and retryable status codes are only retried for
+// idempotent verbs, since a POST may already have been processed by the controller. The exception
+// is 429 Too Many Requests, which the controller returns before processing the request and which
+// is retried for every verb. A Retry-After header in the response overrides the backoff, up to
+// MaxBackoff.
+// An expired session (CID) is renewed once per request, outside of MaxAttempts.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts for a single request, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. It doubles after every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are HTTP status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryableReasons are substrings of the controller reason that are retried.
+	RetryableReasons []string
+	// RetryableClasses are the error classes of the controller reason that are retried.
+	RetryableClasses []ErrorClass
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableClasses: []ErrorClass{ErrorClassTransient},
+	}
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// retryableStatus reports whether a response with the given status code for the given verb may be
+// retried.
+func (p *RetryPolicy) retryableStatus(verb string, code int) bool {
+	if code != http.StatusTooManyRequests && !idempotent(verb) {
+		return false
+	}
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) retryableReason(reason string) bool {
+	if reason == "" {
+		return false
+	}
+	for _, r := range p.RetryableReasons {
+		if r != "" && strings.Contains(reason, r) {
+			return true
+		}
+	}
+	// The status code is handled by RetryableStatusCodes, only classify the reason
+	class := ClassifyError(0, reason)
+	for _, c := range p.RetryableClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// retryableTransportError reports whether a transport error for the given verb may be retried.
+func (p *RetryPolicy) retryableTransportError(verb string) bool {
+	return idempotent(verb)
+}
+
+// idempotent reports whether sending a request with the given verb twice has the same effect as
+// sending it once.
+func idempotent(verb string) bool {
+	switch verb {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the jittered delay to wait after the given (1-based) attempt failed.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// Equal jitter: wait at least half of the backoff so retries still spread out.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// wait sleeps for the backoff of the given attempt or until ctx is done.
+func (p *RetryPolicy) wait(ctx context.Context, attempt int) error {
+	return SleepContext(ctx, p.backoff(attempt))
+}
+
+// waitResponse sleeps for the delay requested by the Retry-After header of resp, or for the
+// backoff of the given attempt when there is none.
+func (p *RetryPolicy) waitResponse(ctx context.Context, attempt int, resp *http.Response) error {
+	if d, ok := p.retryAfter(resp, time.Now()); ok {
+		return SleepContext(ctx, d)
+	}
+	return p.wait(ctx, attempt)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, capped by MaxBackoff so
+// that a bad header cannot stall a request until the timeout of the resource.
+func (p *RetryPolicy) retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	d, ok := retryAfter(resp, now)
+	if ok && p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d, ok
+}
+
+// retryAfter parses the Retry-After header of resp, which is either a number of seconds or an
+// HTTP date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	v := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// SleepContext waits for d. It returns the context error if ctx is done first, e.g. when the
+// timeout of a resource expires.
+func SleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the retry policy used for every request sent by the client.
+func WithRetryPolicy(p *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.RetryPolicy = p
+	}
+}
+
+func (c *Client) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy == nil {
+		return DefaultRetryPolicy()
+	}
+	return c.RetryPolicy
+}
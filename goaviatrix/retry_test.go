@@ -0,0 +1,280 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parseFormBody(r)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	controllerIP := strings.TrimPrefix(server.URL, "https://")
+	return &Client{
+		HTTPClient:   server.Client(),
+		ControllerIP: controllerIP,
+		baseURL:      "https://" + controllerIP + "/v2/api",
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:          3,
+			InitialBackoff:       time.Millisecond,
+			MaxBackoff:           time.Millisecond,
+			RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+			RetryableReasons:     []string{"controller is busy"},
+		},
+	}
+}
+
+// parseFormBody parses the form body of GET and DELETE requests too, which ParseForm ignores.
+// The body can still be read by the handler.
+func parseFormBody(r *http.Request) {
+	if r.Header.Get("Content-Type") != "application/x-www-form-urlencoded" || r.Body == nil {
+		return
+	}
+	b, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	r.PostForm, _ = url.ParseQuery(string(b))
+	r.Form = r.URL.Query()
+	for k, v := range r.PostForm {
+		r.Form[k] = append(v, r.Form[k]...)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		verb      string
+		responses []func(w http.ResponseWriter)
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			"retryable status",
+			http.MethodGet,
+			[]func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"return":true}`) },
+			},
+			2,
+			false,
+		},
+		{
+			"retryable status of a POST",
+			http.MethodPost,
+			[]func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+			},
+			1,
+			true,
+		},
+		{
+			"too many requests",
+			http.MethodPost,
+			[]func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusTooManyRequests) },
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"return":true}`) },
+			},
+			2,
+			false,
+		},
+		{
+			"retryable reason",
+			http.MethodPost,
+			[]func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"return":false,"reason":"controller is busy"}`) },
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"return":true}`) },
+			},
+			2,
+			false,
+		},
+		{
+			"attempts exhausted",
+			http.MethodGet,
+			[]func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+			},
+			3,
+			true,
+		},
+		{
+			"not retryable",
+			http.MethodPost,
+			[]func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { fmt.Fprint(w, `{"return":false,"reason":"bad input"}`) },
+			},
+			1,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				tt.responses[calls](w)
+				calls++
+			})
+			client.RetryPolicy.RetryableStatusCodes = append(client.RetryPolicy.RetryableStatusCodes, http.StatusTooManyRequests)
+
+			var err error
+			if tt.verb == http.MethodGet {
+				err = client.GetAPIContext(context.Background(), nil, "test_action", map[string]string{"action": "test_action"}, BasicCheck)
+			} else {
+				err = client.PostAPIContext(context.Background(), "test_action", map[string]string{"action": "test_action"}, BasicCheck)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.verb, err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("%s calls = %d, want %d", tt.verb, calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"-1", 0, false},
+		{now.Add(time.Minute).Format(http.TimeFormat), time.Minute, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		if tt.header != "" {
+			resp.Header.Set("Retry-After", tt.header)
+		}
+		got, ok := retryAfter(resp, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("retryAfter(%q) = %s, %t, want %s, %t", tt.header, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestRetryAfterMaxBackoff(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		header     string
+		maxBackoff time.Duration
+		want       time.Duration
+	}{
+		{"3", 30 * time.Second, 3 * time.Second},
+		{"86400", 30 * time.Second, 30 * time.Second},
+		{now.Add(365 * 24 * time.Hour).Format(http.TimeFormat), 30 * time.Second, 30 * time.Second},
+		{"86400", 0, 86400 * time.Second},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Retry-After", tt.header)
+		p := &RetryPolicy{MaxBackoff: tt.maxBackoff}
+		got, ok := p.retryAfter(resp, now)
+		if got != tt.want || !ok {
+			t.Errorf("retryAfter(%q) with MaxBackoff %s = %s, %t, want %s, true", tt.header, tt.maxBackoff, got, ok, tt.want)
+		}
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var calls int
+	var first time.Time
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		calls++
+		if calls == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if elapsed := time.Since(first); elapsed < time.Second {
+			t.Errorf("retried after %s, want at least 1s", elapsed)
+		}
+		fmt.Fprint(w, `{"return":true}`)
+	})
+	// Retry-After is capped by MaxBackoff
+	client.RetryPolicy.MaxBackoff = 10 * time.Second
+
+	if err := client.GetAPIContext(context.Background(), nil, "test_action", map[string]string{"action": "test_action"}, BasicCheck); err != nil {
+		t.Fatalf("GetAPIContext() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("GetAPIContext() calls = %d, want 2", calls)
+	}
+}
+
+func TestReloginOutsideRetryBudget(t *testing.T) {
+	var calls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "get_api_token":
+			fmt.Fprint(w, `{"return":true,"results":{"api_token":"token"}}`)
+		case "login":
+			fmt.Fprint(w, `{"return":true,"CID":"renewed"}`)
+		default:
+			calls++
+			if r.FormValue("CID") != "renewed" {
+				fmt.Fprint(w, `{"return":false,"reason":"CID is invalid or expired."}`)
+				return
+			}
+			fmt.Fprint(w, `{"return":true}`)
+		}
+	})
+	client.RetryPolicy.MaxAttempts = 1
+	client.CID = "expired"
+
+	if err := client.PostAPI("test_action", map[string]interface{}{"action": "test_action"}, BasicCheck); err != nil {
+		t.Fatalf("PostAPI() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("PostAPI() calls = %d, want 2", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		got := p.backoff(attempt + 1)
+		if got < max/2 || got > max {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", attempt+1, got, max/2, max)
+		}
+	}
+}
+
+func TestSleepContext(t *testing.T) {
+	if err := SleepContext(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("SleepContext() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := SleepContext(ctx, time.Minute); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("SleepContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("SleepContext() returned after %s, want it to stop at the deadline", elapsed)
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 type SamlEndpoint struct {
 	Action            string   `form:"action,omitempty"`
-	CID               string   `form:"CID,omitempty"`
 	EndPointName      string   `form:"endpoint_name,omitempty" json:"name,omitempty"`
 	IdpMetadataType   string   `form:"idp_metadata_type,omitempty" json:"metadata_type,omitempty"`
 	IdpMetadata       string   `form:"idp_metadata,omitempty" json:"idp_metadata,omitempty"`
@@ -44,7 +43,6 @@ type SamlResp struct {
 }
 
 func (c *Client) CreateSamlEndpoint(samlEndpoint *SamlEndpoint) error {
-	samlEndpoint.CID = c.CID
 	samlEndpoint.Action = "create_saml_endpoint"
 
 	return c.PostAPI(samlEndpoint.Action, samlEndpoint, BasicCheck)
@@ -52,7 +50,6 @@ func (c *Client) CreateSamlEndpoint(samlEndpoint *SamlEndpoint) error {
 
 func (c *Client) GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo, error) {
 	form := map[string]string{
-		"CID":           c.CID,
 		"action":        "get_saml_endpoint_information",
 		"endpoint_name": samlEndpoint.EndPointName,
 	}
@@ -79,7 +76,6 @@ func (c *Client) GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo,
 }
 
 func (c *Client) EditSamlEndpoint(samlEndpoint *SamlEndpoint) error {
-	samlEndpoint.CID = c.CID
 	samlEndpoint.Action = "edit_saml_endpoint"
 
 	return c.PostAPI("edit_saml_endpoint", samlEndpoint, BasicCheck)
@@ -87,7 +83,6 @@ func (c *Client) EditSamlEndpoint(samlEndpoint *SamlEndpoint) error {
 
 func (c *Client) DeleteSamlEndpoint(samlEndpoint *SamlEndpoint) error {
 	form := map[string]string{
-		"CID":           c.CID,
 		"action":        "delete_saml_endpoint",
 		"endpoint_name": samlEndpoint.EndPointName,
 	}
@@ -6,15 +6,14 @@ import (
 
 // AwsTGW simple struct to hold aws_tgw details
 type SecurityDomain struct {
-	Action                 string `form:"action, omitempty"`
-	CID                    string `form:"CID, omitempty"`
-	Name                   string `form:"route_domain_name, omitempty"`
-	AccountName            string `form:"account_name, omitempty"`
-	Region                 string `form:"region, omitempty"`
-	AwsTgwName             string `form:"tgw_name, omitempty"`
-	AviatrixFirewallDomain bool   `form:"firewall_domain, omitempty"`
-	NativeEgressDomain     bool   `form:"native_egress_domain, omitempty"`
-	NativeFirewallDomain   bool   `form:"native_firewall_domain, omitempty"`
+	Action                 string `form:"action"`
+	Name                   string `form:"route_domain_name"`
+	AccountName            string `form:"account_name"`
+	Region                 string `form:"region"`
+	AwsTgwName             string `form:"tgw_name"`
+	AviatrixFirewallDomain bool   `form:"firewall_domain"`
+	NativeEgressDomain     bool   `form:"native_egress_domain"`
+	NativeFirewallDomain   bool   `form:"native_firewall_domain"`
 	ForceDelete            bool   `form:"force,omitempty"`
 	Async                  bool   `form:"async,omitempty"`
 }
@@ -82,17 +81,15 @@ type NetworkDomainDetails struct {
 	Type                         string `json:"type"`
 }
 
-func (c *Client) CreateSecurityDomain(securityDomain *SecurityDomain) error {
-	securityDomain.CID = c.CID
+func (c *Client) CreateSecurityDomain(ctx context.Context, securityDomain *SecurityDomain) error {
 	securityDomain.Action = "add_route_domain"
 	securityDomain.Async = true
 
-	return c.PostAsyncAPI(securityDomain.Action, securityDomain, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, securityDomain.Action, securityDomain, BasicCheck)
 }
 
 func (c *Client) GetSecurityDomain(securityDomain *SecurityDomain) (string, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "list_route_domain_names",
 		"tgw_name": securityDomain.AwsTgwName,
 	}
@@ -122,7 +119,6 @@ func (c *Client) UpdateSecurityDomain(securityDomain *SecurityDomain) error {
 }
 
 func (c *Client) DeleteSecurityDomain(securityDomain *SecurityDomain) error {
-	securityDomain.CID = c.CID
 	securityDomain.Action = "delete_route_domain"
 
 	return c.PostAPI(securityDomain.Action, securityDomain, BasicCheck)
@@ -130,7 +126,6 @@ func (c *Client) DeleteSecurityDomain(securityDomain *SecurityDomain) error {
 
 func (c *Client) CreateDomainConnection(awsTgw *AWSTgw, sourceDomain string, destinationDomain string) error {
 	form := map[string]string{
-		"CID":                           c.CID,
 		"action":                        "add_connection_between_route_domains",
 		"account_name":                  awsTgw.AccountName,
 		"region":                        awsTgw.Region,
@@ -144,7 +139,6 @@ func (c *Client) CreateDomainConnection(awsTgw *AWSTgw, sourceDomain string, des
 
 func (c *Client) DeleteDomainConnection(awsTgw *AWSTgw, sourceDomain string, destinationDomain string) error {
 	form := map[string]string{
-		"CID":                           c.CID,
 		"action":                        "delete_connection_between_route_domains",
 		"tgw_name":                      awsTgw.Name,
 		"source_route_domain_name":      sourceDomain,
@@ -174,7 +168,6 @@ func (c *Client) SecurityDomainRuleValidation(securityDomainRule *SecurityDomain
 func (c *Client) GetSecurityDomainDetails(ctx context.Context, domain *SecurityDomain) (*SecurityDomainDetails, error) {
 	params := map[string]string{
 		"action":            "list_tgw_security_domain_details",
-		"CID":               c.CID,
 		"tgw_name":          domain.AwsTgwName,
 		"route_domain_name": domain.Name,
 	}
@@ -202,7 +195,6 @@ func (c *Client) GetSecurityDomainDetails(ctx context.Context, domain *SecurityD
 func (c *Client) EnableIntraDomainInspection(ctx context.Context, intraDomainInspection *IntraDomainInspection) error {
 	params := map[string]string{
 		"action":               "enable_tgw_intra_domain_inspection",
-		"CID":                  c.CID,
 		"tgw_name":             intraDomainInspection.TgwName,
 		"route_domain_name":    intraDomainInspection.RouteDomainName,
 		"firewall_domain_name": intraDomainInspection.FirewallDomainName,
@@ -214,7 +206,6 @@ func (c *Client) EnableIntraDomainInspection(ctx context.Context, intraDomainIns
 func (c *Client) DisableIntraDomainInspection(ctx context.Context, intraDomainInspection *IntraDomainInspection) error {
 	params := map[string]string{
 		"action":            "disable_tgw_intra_domain_inspection",
-		"CID":               c.CID,
 		"tgw_name":          intraDomainInspection.TgwName,
 		"route_domain_name": intraDomainInspection.RouteDomainName,
 	}
@@ -225,7 +216,6 @@ func (c *Client) DisableIntraDomainInspection(ctx context.Context, intraDomainIn
 func (c *Client) GetAllNetworkDomains(ctx context.Context) ([]NetworkDomainDetails, error) {
 	params := map[string]string{
 		"action": "list_all_tgw_security_domains",
-		"CID":    c.CID,
 	}
 
 	type DomainDetail struct {
@@ -249,7 +239,6 @@ func (c *Client) GetAllNetworkDomains(ctx context.Context) ([]NetworkDomainDetai
 func (c *Client) GetIntraDomainInspectionStatus(ctx context.Context, intraDomainInspection *IntraDomainInspection) error {
 	params := map[string]string{
 		"action": "list_all_tgw_security_domains",
-		"CID":    c.CID,
 	}
 
 	type DomainDetails struct {
@@ -21,7 +21,6 @@ func (c *Client) CreateSegmentationSecurityDomain(domain *SegmentationSecurityDo
 	action := "add_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":      action,
-		"CID":         c.CID,
 		"domain_name": domain.DomainName,
 	}
 	return c.PostAPI(action, data, BasicCheck)
@@ -31,7 +30,6 @@ func (c *Client) DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDo
 	action := "delete_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":      action,
-		"CID":         c.CID,
 		"domain_name": domain.DomainName,
 	}
 	return c.PostAPI(action, data, BasicCheck)
@@ -39,7 +37,6 @@ func (c *Client) DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDo
 
 func (c *Client) GetSegmentationSecurityDomain(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_multi_cloud_security_domain_names",
 	}
 
@@ -67,7 +64,6 @@ func (c *Client) CreateSegmentationSecurityDomainConnectionPolicy(policy *Segmen
 	action := "connect_multi_cloud_security_domains"
 	data := map[string]interface{}{
 		"action":            action,
-		"CID":               c.CID,
 		"domain_name":       policy.Domain1.DomainName,
 		"other_domain_name": policy.Domain2.DomainName,
 	}
@@ -78,7 +74,6 @@ func (c *Client) DeleteSegmentationSecurityDomainConnectionPolicy(policy *Segmen
 	action := "disconnect_multi_cloud_security_domains"
 	data := map[string]interface{}{
 		"action":            action,
-		"CID":               c.CID,
 		"domain_name":       policy.Domain1.DomainName,
 		"other_domain_name": policy.Domain2.DomainName,
 	}
@@ -87,7 +82,6 @@ func (c *Client) DeleteSegmentationSecurityDomainConnectionPolicy(policy *Segmen
 
 func (c *Client) GetSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "list_multi_cloud_security_domain_connection_policy",
 		"domain_name": policy.Domain1.DomainName,
 	}
@@ -122,7 +116,6 @@ func (c *Client) CreateSegmentationSecurityDomainAssociation(association *Segmen
 	action := "associate_attachment_to_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":          action,
-		"CID":             c.CID,
 		"attachment_name": association.AttachmentName,
 		"domain_name":     association.SecurityDomainName,
 	}
@@ -133,7 +126,6 @@ func (c *Client) DeleteSegmentationSecurityDomainAssociation(association *Segmen
 	action := "disassociate_attachment_from_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":          action,
-		"CID":             c.CID,
 		"attachment_name": association.AttachmentName,
 		"domain_name":     association.SecurityDomainName,
 	}
@@ -142,7 +134,6 @@ func (c *Client) DeleteSegmentationSecurityDomainAssociation(association *Segmen
 
 func (c *Client) GetSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_multi_cloud_domain_attachments",
 	}
 
@@ -0,0 +1,256 @@
+package goaviatrix
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// DefaultSessionCacheTTL is how long a cached session is reused when the cache has no TTL.
+const DefaultSessionCacheTTL = 30 * time.Minute
+
+// SessionCache shares controller sessions (CIDs) between the clients of the same controller and
+// user, e.g. the aliases of a provider, so that they do not all log in. Sessions are always shared
+// within the process. If Path is set they are also saved to that file, readable by the owner only,
+// and reused by later runs until they expire.
+//
+// A cached session may have been invalidated on the controller, e.g. by a restart. It is then
+// renewed by the re-login that follows the first request failing with an invalid CID.
+type SessionCache struct {
+	// Path is the file sessions are saved to, empty to only share sessions within the process
+	Path string
+	// TTL is how long a session is reused after login. Default: DefaultSessionCacheTTL.
+	TTL time.Duration
+}
+
+type cachedSession struct {
+	CID     string    `json:"cid"`
+	Expires time.Time `json:"expires"`
+}
+
+var (
+	// processSessionsMu also serializes the updates of session cache files
+	processSessionsMu sync.Mutex
+	processSessions   = make(map[string]cachedSession)
+)
+
+// WithSessionCache reuses cached sessions instead of logging in when the client is created.
+func WithSessionCache(cache *SessionCache) ClientOption {
+	return func(c *Client) {
+		c.sessionCache = cache
+	}
+}
+
+// sessionKey identifies the sessions of a user on a controller. It is hashed so that the cache
+// file does not list the controllers and users.
+func sessionKey(controllerIP, username string) string {
+	sum := sha256.Sum256([]byte(controllerIP + "\n" + username))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *SessionCache) ttl() time.Duration {
+	if s.TTL <= 0 {
+		return DefaultSessionCacheTTL
+	}
+	return s.TTL
+}
+
+// get returns the cached CID for key, or "" if there is no valid session.
+func (s *SessionCache) get(key string) (string, error) {
+	processSessionsMu.Lock()
+	defer processSessionsMu.Unlock()
+
+	now := time.Now()
+	if session, ok := processSessions[key]; ok && now.Before(session.Expires) {
+		return session.CID, nil
+	}
+	if s.Path == "" {
+		return "", nil
+	}
+
+	sessions, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	session, ok := sessions[key]
+	if !ok || !now.Before(session.Expires) {
+		return "", nil
+	}
+	processSessions[key] = session
+	return session.CID, nil
+}
+
+// put caches cid for key.
+func (s *SessionCache) put(key, cid string) error {
+	processSessionsMu.Lock()
+	defer processSessionsMu.Unlock()
+
+	session := cachedSession{CID: cid, Expires: time.Now().Add(s.ttl())}
+	processSessions[key] = session
+	if s.Path == "" {
+		return nil
+	}
+	return s.update(func(sessions map[string]cachedSession) {
+		sessions[key] = session
+	})
+}
+
+// invalidate removes the session for key if its CID is cid.
+func (s *SessionCache) invalidate(key, cid string) error {
+	processSessionsMu.Lock()
+	defer processSessionsMu.Unlock()
+
+	if session, ok := processSessions[key]; ok && session.CID == cid {
+		delete(processSessions, key)
+	}
+	if s.Path == "" {
+		return nil
+	}
+	return s.update(func(sessions map[string]cachedSession) {
+		if session, ok := sessions[key]; ok && session.CID == cid {
+			delete(sessions, key)
+		}
+	})
+}
+
+// read returns the sessions saved in the cache file.
+func (s *SessionCache) read() (map[string]cachedSession, error) {
+	sessions := make(map[string]cachedSession)
+	fi, err := os.Stat(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return sessions, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if runtime.GOOS != "windows" && fi.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("session cache %s must only be accessible by its owner, got mode %s", s.Path, fi.Mode().Perm())
+	}
+
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return sessions, nil
+	}
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		return nil, fmt.Errorf("could not decode session cache %s: %v", s.Path, err)
+	}
+	return sessions, nil
+}
+
+// update applies f to the sessions saved in the cache file, dropping the expired ones. The file
+// is replaced atomically so that concurrent runs never read a partial file.
+func (s *SessionCache) update(f func(map[string]cachedSession)) error {
+	sessions, err := s.read()
+	if err != nil {
+		return err
+	}
+	f(sessions)
+	now := time.Now()
+	for key, session := range sessions {
+		if !now.Before(session.Expires) {
+			delete(sessions, key)
+		}
+	}
+
+	data, err := json.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(s.Path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	// CreateTemp already creates the file readable by the owner only
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// loadCachedSession sets the CID of the client from the session cache. It returns false if there
+// is no cached session and the client must log in.
+func (c *Client) loadCachedSession() bool {
+	if c.sessionCache == nil {
+		return false
+	}
+	ctx := c.logContext(context.Background())
+	cid, err := c.sessionCache.get(sessionKey(c.ControllerIP, c.Username))
+	if err != nil {
+		logWarn(ctx, "Could not read the session cache", map[string]interface{}{"error": err.Error()})
+		return false
+	}
+	if cid == "" {
+		return false
+	}
+	logDebug(ctx, "Reusing cached controller session", map[string]interface{}{"username": c.Username})
+	c.setCID(cid)
+	return true
+}
+
+// cacheSession saves the current CID of the client in the session cache.
+func (c *Client) cacheSession() {
+	if c.sessionCache == nil {
+		return
+	}
+	if err := c.sessionCache.put(sessionKey(c.ControllerIP, c.Username), c.CurrentCID()); err != nil {
+		logWarn(c.logContext(context.Background()), "Could not update the session cache", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+// relogin logs in again after a request failed because the session staleCID expired. Concurrent
+// requests failing with the same session share a single login: only the first one logs in, the
+// others return once it is done and retry with the new CID.
+func (c *Client) relogin(staleCID string) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	if c.CurrentCID() != staleCID {
+		// Another request already logged in
+		return nil
+	}
+	if c.sessionCache != nil {
+		// Another client of the same controller and user may already have logged in
+		key := sessionKey(c.ControllerIP, c.Username)
+		if cid, err := c.sessionCache.get(key); err == nil && cid != "" && cid != staleCID {
+			c.setCID(cid)
+			return nil
+		}
+		if err := c.sessionCache.invalidate(key, staleCID); err != nil {
+			logWarn(c.logContext(context.Background()), "Could not update the session cache", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	return c.Login()
+}
+
+// CurrentCID returns the CID of the current session.
+func (c *Client) CurrentCID() string {
+	c.cidMu.RLock()
+	defer c.cidMu.RUnlock()
+	return c.CID
+}
+
+func (c *Client) setCID(cid string) {
+	c.cidMu.Lock()
+	defer c.cidMu.Unlock()
+	c.CID = cid
+}
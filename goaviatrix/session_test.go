@@ -0,0 +1,167 @@
+package goaviatrix
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSessionCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions", "cache.json")
+	cache := &SessionCache{Path: path, TTL: time.Hour}
+	key := sessionKey("controller-"+t.Name(), "admin")
+
+	if err := cache.put(key, "cid-1"); err != nil {
+		t.Fatalf("put() error = %v", err)
+	}
+	if runtime.GOOS != "windows" {
+		fi, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := fi.Mode().Perm(); perm != 0o600 {
+			t.Errorf("cache file mode = %s, want -rw-------", perm)
+		}
+	}
+
+	// Another run only has the file
+	processSessionsMu.Lock()
+	delete(processSessions, key)
+	processSessionsMu.Unlock()
+	if cid, err := cache.get(key); err != nil || cid != "cid-1" {
+		t.Errorf("get() = %q, %v, want %q", cid, err, "cid-1")
+	}
+
+	if err := cache.invalidate(key, "other-cid"); err != nil {
+		t.Fatalf("invalidate() error = %v", err)
+	}
+	if cid, _ := cache.get(key); cid != "cid-1" {
+		t.Errorf("get() after invalidating another CID = %q, want %q", cid, "cid-1")
+	}
+	if err := cache.invalidate(key, "cid-1"); err != nil {
+		t.Fatalf("invalidate() error = %v", err)
+	}
+	if cid, _ := cache.get(key); cid != "" {
+		t.Errorf("get() after invalidate() = %q, want no session", cid)
+	}
+
+	expired := &SessionCache{Path: path, TTL: time.Nanosecond}
+	if err := expired.put(key, "cid-2"); err != nil {
+		t.Fatalf("put() error = %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	if cid, _ := expired.get(key); cid != "" {
+		t.Errorf("get() of an expired session = %q, want no session", cid)
+	}
+}
+
+func TestSessionCachePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	path := filepath.Join(t.TempDir(), "cache.json")
+	key := sessionKey("controller-"+t.Name(), "admin")
+	data, _ := json.Marshal(map[string]cachedSession{key: {CID: "cid", Expires: time.Now().Add(time.Hour)}})
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := &SessionCache{Path: path}
+	if _, err := cache.get(key); err == nil {
+		t.Error("get() from a file readable by other users succeeded")
+	}
+}
+
+func TestReloginSingleFlight(t *testing.T) {
+	var logins int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "get_api_token":
+			fmt.Fprint(w, `{"return":true,"results":{"api_token":"token"}}`)
+		case "login":
+			n := atomic.AddInt32(&logins, 1)
+			// Let the other requests fail with the expired session meanwhile
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprintf(w, `{"return":true,"CID":"cid-%d"}`, n)
+		default:
+			if r.FormValue("CID") == "expired" {
+				fmt.Fprint(w, `{"return":false,"reason":"CID is invalid or expired."}`)
+				return
+			}
+			fmt.Fprint(w, `{"return":true}`)
+		}
+	})
+	client.CID = "expired"
+	client.Username = "admin"
+	client.sessionCache = &SessionCache{}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- client.PostAPI("test_action", map[string]interface{}{"action": "test_action"}, BasicCheck)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("PostAPI() error = %v", err)
+		}
+	}
+	if logins != 1 {
+		t.Errorf("logged in %d times, want 1", logins)
+	}
+
+	// A new client of the same controller and user reuses the session
+	other := &Client{ControllerIP: client.ControllerIP, Username: "admin", sessionCache: &SessionCache{}}
+	if !other.loadCachedSession() || other.CID != "cid-1" {
+		t.Errorf("cached CID = %q, want %q", other.CID, "cid-1")
+	}
+}
+
+func TestReloginWithoutSession(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Every request sent with the expired CID fails, including the login requests
+		if r.FormValue("CID") == "expired" {
+			fmt.Fprint(w, `{"return":false,"reason":"CID is invalid or expired."}`)
+			return
+		}
+		switch r.FormValue("action") {
+		case "get_api_token":
+			fmt.Fprint(w, `{"return":true,"results":{"api_token":"token"}}`)
+		case "login":
+			fmt.Fprint(w, `{"return":true,"CID":"renewed"}`)
+		default:
+			fmt.Fprint(w, `{"return":true}`)
+		}
+	})
+	client.CID = "expired"
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.PostAPI("test_action", map[string]interface{}{"action": "test_action"}, BasicCheck)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("PostAPI() error = %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("PostAPI() did not return, the re-login is deadlocked")
+	}
+	if got := client.CurrentCID(); got != "renewed" {
+		t.Errorf("CID = %q, want %q", got, "renewed")
+	}
+}
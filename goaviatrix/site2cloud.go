@@ -21,7 +21,6 @@ const SslServerPoolDefault = "192.168.44.0/24"
 // Site2Cloud simple struct to hold site2cloud details
 type Site2Cloud struct {
 	Action                        string   `form:"action,omitempty"`
-	CID                           string   `form:"CID,omitempty"`
 	VpcID                         string   `form:"vpc_id,omitempty" json:"vpc_id,omitempty"`
 	TunnelName                    string   `form:"connection_name,omitempty" json:"name,omitempty"`
 	RemoteGwType                  string   `form:"remote_gateway_type,omitempty"`
@@ -85,7 +84,6 @@ type Site2Cloud struct {
 
 type EditSite2Cloud struct {
 	Action                        string `form:"action,omitempty"`
-	CID                           string `form:"CID,omitempty"`
 	VpcID                         string `form:"vpc_id,omitempty"`
 	ConnName                      string `form:"conn_name"`
 	GwName                        string `form:"primary_cloud_gateway_name,omitempty"`
@@ -214,8 +212,6 @@ type AlgorithmInfo struct {
 
 func (c *Client) CreateSite2Cloud(site2cloud *Site2Cloud) error {
 	form := map[string]string{}
-	form["CID"] = c.CID
-	form["CID"] = c.CID
 	form["action"] = "add_site2cloud"
 	form["vpc_id"] = site2cloud.VpcID
 	form["connection_name"] = site2cloud.TunnelName
@@ -312,7 +308,6 @@ func (c *Client) CreateSite2Cloud(site2cloud *Site2Cloud) error {
 
 func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "list_site2cloud_conn",
 		"connection_name": site2cloud.TunnelName,
 	}
@@ -335,14 +330,13 @@ func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 
 func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
 		"conn_name": site2cloud.TunnelName,
 		"vpc_id":    site2cloud.VpcID,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -467,14 +461,12 @@ func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, e
 }
 
 func (c *Client) UpdateSite2Cloud(site2cloud *EditSite2Cloud) error {
-	site2cloud.CID = c.CID
 	site2cloud.Action = "edit_site2cloud_conn"
 
 	return c.PostAPI(site2cloud.Action, site2cloud, BasicCheck)
 }
 
 func (c *Client) DeleteSite2Cloud(site2cloud *Site2Cloud) error {
-	site2cloud.CID = c.CID
 	site2cloud.Action = "delete_site2cloud_connection"
 
 	return c.PostAPI(site2cloud.Action, site2cloud, BasicCheck)
@@ -482,7 +474,6 @@ func (c *Client) DeleteSite2Cloud(site2cloud *Site2Cloud) error {
 
 func (c *Client) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "enable_dpd_config",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
@@ -493,7 +484,6 @@ func (c *Client) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
 
 func (c *Client) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "disable_dpd_config",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
@@ -504,7 +494,6 @@ func (c *Client) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
 
 func (c *Client) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "enable_site2cloud_active_active_ha",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
@@ -525,7 +514,6 @@ func (c *Client) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 
 func (c *Client) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "disable_site2cloud_active_active_ha",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
@@ -546,7 +534,6 @@ func (c *Client) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 
 func (c *Client) EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
 	data := map[string]string{
-		"CID":             c.CID,
 		"action":          "enable_spoke_mapped_site2cloud_forwarding",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
@@ -556,7 +543,6 @@ func (c *Client) EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) e
 
 func (c *Client) DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
 	data := map[string]string{
-		"CID":             c.CID,
 		"action":          "disable_spoke_mapped_site2cloud_forwarding",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
@@ -566,7 +552,6 @@ func (c *Client) DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud)
 
 func (c *Client) EnableSite2CloudEventTriggeredHA(vpcID, connectionName string) error {
 	data := map[string]string{
-		"CID":             c.CID,
 		"action":          "enable_site2cloud_event_triggered_ha",
 		"vpc_id":          vpcID,
 		"connection_name": connectionName,
@@ -576,7 +561,6 @@ func (c *Client) EnableSite2CloudEventTriggeredHA(vpcID, connectionName string)
 
 func (c *Client) DisableSite2CloudEventTriggeredHA(vpcID, connectionName string) error {
 	data := map[string]string{
-		"CID":             c.CID,
 		"action":          "disable_site2cloud_event_triggered_ha",
 		"vpc_id":          vpcID,
 		"connection_name": connectionName,
@@ -586,7 +570,6 @@ func (c *Client) DisableSite2CloudEventTriggeredHA(vpcID, connectionName string)
 
 func (c *Client) EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error {
 	data := map[string]string{
-		"CID":               c.CID,
 		"action":            "edit_site2cloud_conn",
 		"vpc_id":            s2c.VpcID,
 		"conn_name":         s2c.ConnName,
@@ -6,14 +6,12 @@ import (
 
 type S2CCaCertTag struct {
 	Action         string `form:"action,omitempty"`
-	CID            string `form:"CID,omitempty"`
 	TagName        string `form:"name,omitempty"`
 	CaCertificates []CaCertInstance
 }
 
 type S2CCaCert struct {
 	Action          string `form:"action,omitempty"`
-	CID             string `form:"CID,omitempty"`
 	TagName         string `form:"name,omitempty"`
 	CaCertificate   string `form:"ca_cert"`
 	CaCertInstances []CaCertInstance
@@ -38,7 +36,6 @@ type CaCertInstance struct {
 func (c *Client) CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) error {
 	action := "add_s2c_ca_cert"
 	params := map[string]string{
-		"CID":                 c.CID,
 		"action":              action,
 		"s2c_cacert_tag_name": s2cCaCert.TagName,
 		"only_one_content":    "true",
@@ -62,7 +59,6 @@ func (c *Client) CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) erro
 func (c *Client) GetS2CCaCertTag(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error) {
 	formData := map[string]string{
 		"action":              "get_s2c_ca_cert_list_by_name",
-		"CID":                 c.CID,
 		"s2c_cacert_tag_name": s2cCaCertTag.TagName,
 	}
 	var data S2CCaCertListResp
@@ -86,7 +82,6 @@ func (c *Client) DeleteCertInstance(ctx context.Context, caCertInstance *CaCertI
 	action := "delete_s2c_ca_cert"
 	data := map[string]interface{}{
 		"action": action,
-		"CID":    c.CID,
 		"id":     caCertInstance.ID,
 	}
 	return c.PostAPIContext(ctx, action, data, BasicCheck)
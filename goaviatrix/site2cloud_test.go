@@ -0,0 +1,87 @@
+package goaviatrix
+
+import "testing"
+
+func TestSite2CloudCreateAndEdit(t *testing.T) {
+	client, rec := newCassetteClient(t, "site2cloud_create_edit")
+
+	vpcID := cassetteValue(rec, "AWS_VPC_ID", "vpc-0a1b2c3d4e5f67890")
+	site2cloud := &Site2Cloud{
+		VpcID:                vpcID,
+		TunnelName:           "cassette-s2c",
+		ConnType:             "unmapped",
+		RemoteGwType:         "generic",
+		TunnelType:           "route",
+		GwName:               "cassette-spoke",
+		BackupGwName:         "cassette-spoke-hagw",
+		HAEnabled:            "yes",
+		RemoteGwIP:           "198.51.100.10",
+		RemoteGwIP2:          "198.51.100.11",
+		PreSharedKey:         "cassette-pre-shared-key",
+		BackupPreSharedKey:   "cassette-backup-pre-shared-key",
+		RemoteSubnet:         "172.16.0.0/16",
+		LocalSubnet:          "10.20.0.0/16",
+		Phase1Auth:           "SHA-512",
+		Phase1DhGroups:       "19",
+		Phase1Encryption:     "AES-256-GCM-128",
+		Phase2Auth:           "NO-AUTH",
+		Phase2DhGroups:       "19",
+		Phase2Encryption:     "AES-256-GCM-128",
+		EnableIKEv2:          "true",
+		LocalTunnelIp:        "169.254.10.1/30",
+		RemoteTunnelIp:       "169.254.10.2/30",
+		BackupLocalTunnelIp:  "169.254.10.5/30",
+		BackupRemoteTunnelIp: "169.254.10.6/30",
+	}
+	if err := client.CreateSite2Cloud(site2cloud); err != nil {
+		t.Fatalf("CreateSite2Cloud() error = %v", err)
+	}
+
+	got, err := client.GetSite2CloudConnDetail(&Site2Cloud{VpcID: vpcID, TunnelName: site2cloud.TunnelName})
+	if err != nil {
+		t.Fatalf("GetSite2CloudConnDetail() error = %v", err)
+	}
+	want := map[string][2]string{
+		"GwName":              {got.GwName, site2cloud.GwName},
+		"BackupGwName":        {got.BackupGwName, site2cloud.BackupGwName},
+		"RemoteGwIP":          {got.RemoteGwIP, site2cloud.RemoteGwIP},
+		"RemoteGwIP2":         {got.RemoteGwIP2, site2cloud.RemoteGwIP2},
+		"TunnelType":          {got.TunnelType, "route"},
+		"RemoteSubnet":        {got.RemoteSubnet, site2cloud.RemoteSubnet},
+		"Phase1Auth":          {got.Phase1Auth, site2cloud.Phase1Auth},
+		"Phase2DhGroups":      {got.Phase2DhGroups, site2cloud.Phase2DhGroups},
+		"EnableIKEv2":         {got.EnableIKEv2, "true"},
+		"RemoteTunnelIp":      {got.RemoteTunnelIp, site2cloud.RemoteTunnelIp},
+		"BackupLocalTunnelIp": {got.BackupLocalTunnelIp, site2cloud.BackupLocalTunnelIp},
+	}
+	for field, values := range want {
+		if values[0] != values[1] {
+			t.Errorf("GetSite2CloudConnDetail() %s = %q, want %q", field, values[0], values[1])
+		}
+	}
+	if !got.CustomAlgorithms || !got.DeadPeerDetection || got.EnableActiveActive {
+		t.Errorf("GetSite2CloudConnDetail() = %+v, want custom algorithms and dead peer detection", got)
+	}
+
+	edit := &EditSite2Cloud{
+		VpcID:                  vpcID,
+		ConnName:               site2cloud.TunnelName,
+		Phase1LocalIdentifier:  "public_ip",
+		Phase1RemoteIdentifier: "198.51.100.10,198.51.100.11",
+	}
+	if err := client.UpdateSite2Cloud(edit); err != nil {
+		t.Fatalf("UpdateSite2Cloud() error = %v", err)
+	}
+
+	got, err = client.GetSite2CloudConnDetail(&Site2Cloud{VpcID: vpcID, TunnelName: site2cloud.TunnelName})
+	if err != nil {
+		t.Fatalf("GetSite2CloudConnDetail() after edit error = %v", err)
+	}
+	if got.Phase1RemoteIdentifier != edit.Phase1RemoteIdentifier {
+		t.Errorf("GetSite2CloudConnDetail() Phase1RemoteIdentifier = %q, want %q", got.Phase1RemoteIdentifier, edit.Phase1RemoteIdentifier)
+	}
+
+	if err := client.DeleteSite2Cloud(&Site2Cloud{VpcID: vpcID, TunnelName: site2cloud.TunnelName}); err != nil {
+		t.Fatalf("DeleteSite2Cloud() error = %v", err)
+	}
+}
@@ -3,7 +3,6 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 )
 
@@ -71,7 +70,6 @@ func smartGroupFilterToMap(filter *SmartGroupMatchExpression) map[string]string
 		}
 	}
 
-	log.Printf("[DEBUG] POST filter map: %v\n", filterMap)
 	return filterMap
 }
 
@@ -7,7 +7,6 @@ import (
 
 type SplitTunnel struct {
 	Action          string `form:"action,omitempty"`
-	CID             string `form:"CID,omitempty"`
 	Command         string `form:"command,omitempty"`
 	VpcID           string `form:"vpc_id,omitempty"`
 	ElbName         string `form:"lb_name,omitempty"`
@@ -34,7 +33,6 @@ type SplitTunnelUnit struct {
 
 func (c *Client) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  "modify_split_tunnel",
 		"command": "get",
 		"vpc_id":  splitTunnel.VpcID,
@@ -53,7 +51,6 @@ func (c *Client) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, err
 
 func (c *Client) ModifySplitTunnel(splitTunnel *SplitTunnel) error {
 	form := map[string]string{
-		"CID":              c.CID,
 		"action":           "modify_split_tunnel",
 		"command":          "modify",
 		"vpc_id":           splitTunnel.VpcID,
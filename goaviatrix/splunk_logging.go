@@ -11,7 +11,6 @@ type SplunkLoggingResp struct {
 func (c *Client) GetSplunkLoggingStatus() (*SplunkLoggingResp, error) {
 	params := map[string]string{
 		"action": "get_splunk_logging_status",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -37,7 +36,6 @@ func (c *Client) GetSplunkLoggingStatus() (*SplunkLoggingResp, error) {
 func (c *Client) DisableSplunkLogging() error {
 	params := map[string]string{
 		"action": "disable_splunk_logging",
-		"CID":    c.CID,
 	}
 
 	return c.PostAPI(params["action"], params, BasicCheck)
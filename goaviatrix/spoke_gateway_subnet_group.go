@@ -8,7 +8,6 @@ import (
 )
 
 type SpokeGatewaySubnetGroup struct {
-	CID             string
 	GatewayName     string
 	SubnetGroupName string
 	SubnetList      []string
@@ -26,7 +25,6 @@ type ListSpokeGatewaySubnetsResp struct {
 func (c *Client) AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
 	form := map[string]string{
 		"action":            "add_spoke_gateway_subnet_group",
-		"CID":               c.CID,
 		"gateway_name":      spokeGatewaySubnetGroup.GatewayName,
 		"subnet_group_name": spokeGatewaySubnetGroup.SubnetGroupName,
 		"subnet_list":       "[]",
@@ -38,7 +36,6 @@ func (c *Client) AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySub
 func (c *Client) GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
 	form := map[string]string{
 		"action":            "get_spoke_gateway_subnet_group",
-		"CID":               c.CID,
 		"gateway_name":      spokeGatewaySubnetGroup.GatewayName,
 		"subnet_group_name": spokeGatewaySubnetGroup.SubnetGroupName,
 	}
@@ -74,7 +71,6 @@ func (c *Client) GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySub
 func (c *Client) UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
 	form := map[string]string{
 		"action":            "update_spoke_gateway_subnet_group",
-		"CID":               c.CID,
 		"gateway_name":      spokeGatewaySubnetGroup.GatewayName,
 		"subnet_group_name": spokeGatewaySubnetGroup.SubnetGroupName,
 	}
@@ -111,7 +107,6 @@ func (c *Client) UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGateway
 func (c *Client) DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
 	form := map[string]string{
 		"action":            "delete_spoke_gateway_subnet_group",
-		"CID":               c.CID,
 		"gateway_name":      spokeGatewaySubnetGroup.GatewayName,
 		"subnet_group_name": spokeGatewaySubnetGroup.SubnetGroupName,
 	}
@@ -122,7 +117,6 @@ func (c *Client) DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGateway
 func (c *Client) GetSubnetsForInspection(gatewayName string) ([]string, error) {
 	form := map[string]string{
 		"action":       "list_spoke_gateway_subnets",
-		"CID":          c.CID,
 		"gateway_name": gatewayName,
 	}
 
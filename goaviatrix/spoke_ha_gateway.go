@@ -1,10 +1,9 @@
 package goaviatrix
 
-import "golang.org/x/net/context"
+import "context"
 
 type SpokeHaGateway struct {
 	Action                string `json:"action"`
-	CID                   string `json:"CID"`
 	AccountName           string `json:"account_name"`
 	CloudType             int    `json:"cloud_type"`
 	VpcID                 string `json:"vpc_id,omitempty"`
@@ -33,9 +32,8 @@ type APIRespHaGw struct {
 	HaGwName string `json:"ha_gw_name"`
 }
 
-func (c *Client) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error) {
-	spokeHaGateway.CID = c.CID
+func (c *Client) CreateSpokeHaGw(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
 	spokeHaGateway.Action = "create_multicloud_ha_gateway"
 
-	return c.PostAPIContext2HaGw(context.Background(), nil, spokeHaGateway.Action, spokeHaGateway, BasicCheck)
+	return c.PostAPIContext2HaGw(ctx, nil, spokeHaGateway.Action, spokeHaGateway, BasicCheck)
 }
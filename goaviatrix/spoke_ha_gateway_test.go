@@ -0,0 +1,54 @@
+package goaviatrix
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSpokeGatewayCreateWithHA(t *testing.T) {
+	client, rec := newCassetteClient(t, "spoke_gateway_create_ha")
+
+	spoke := &SpokeVpc{
+		CloudType:   AWS,
+		AccountName: cassetteValue(rec, "AWS_ACCOUNT_NAME", "aws-account"),
+		GwName:      "cassette-spoke",
+		VpcID:       cassetteValue(rec, "AWS_VPC_ID", "vpc-0a1b2c3d4e5f67890"),
+		VpcRegion:   cassetteValue(rec, "AWS_REGION", "us-west-1"),
+		VpcSize:     "t3.small",
+		Subnet:      cassetteValue(rec, "AWS_SUBNET", "10.20.0.0/28"),
+		EnableBgp:   "off",
+	}
+	if err := client.LaunchSpokeVpc(context.Background(), spoke); err != nil {
+		t.Fatalf("LaunchSpokeVpc() error = %v", err)
+	}
+
+	haSubnet := cassetteValue(rec, "AWS_HA_SUBNET", "10.20.0.16/28")
+	haGwName, err := client.CreateSpokeHaGw(context.Background(), &SpokeHaGateway{
+		PrimaryGwName: spoke.GwName,
+		GwName:        spoke.GwName + "-hagw",
+		Subnet:        haSubnet,
+		InsaneMode:    "no",
+	})
+	if err != nil {
+		t.Fatalf("CreateSpokeHaGw() error = %v", err)
+	}
+	if haGwName != "cassette-spoke-hagw" {
+		t.Errorf("CreateSpokeHaGw() = %q, want %q", haGwName, "cassette-spoke-hagw")
+	}
+
+	gw, err := client.GetGateway(&Gateway{GwName: spoke.GwName})
+	if err != nil {
+		t.Fatalf("GetGateway() error = %v", err)
+	}
+	if gw.SpokeVpc != "yes" || gw.VpcID != spoke.VpcID || gw.GwSize != "t3.small" || gw.CloudType != AWS || !gw.AllocateNewEipRead {
+		t.Errorf("GetGateway() = %+v, want the launched spoke gateway", gw)
+	}
+
+	haGw, err := client.GetGateway(&Gateway{GwName: haGwName})
+	if err != nil {
+		t.Fatalf("GetGateway() of the HA gateway error = %v", err)
+	}
+	if haGw.IsHagw != "yes" || haGw.VpcNet != haSubnet || haGw.PublicIP == "" {
+		t.Errorf("GetGateway() of the HA gateway = %+v, want the HA gateway", haGw)
+	}
+}
@@ -4,13 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type SpokeTransitAttachment struct {
 	Action                   string `form:"action,omitempty"`
-	CID                      string `form:"CID,omitempty"`
 	SpokeGwName              string `form:"spoke_gw,omitempty"`
 	TransitGwName            string `form:"transit_gw,omitempty"`
 	RouteTables              string `form:"route_table_list,omitempty"`
@@ -46,24 +43,22 @@ type SiteDetail struct {
 	ConnBgpPrependAsPath string `json:"conn_bgp_prepend_as_path"`
 }
 
-func (c *Client) CreateSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error {
+func (c *Client) CreateSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
 	action := "attach_spoke_to_transit_gw"
-	spokeTransitAttachment.CID = c.CID
 	spokeTransitAttachment.Action = action
-	return c.PostAPI(action, spokeTransitAttachment, BasicCheck)
+	return c.PostAPIContext(ctx, action, spokeTransitAttachment, BasicCheck)
 }
 
 func (c *Client) GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "get_gateway_info",
 		"gateway_name": spokeTransitAttachment.SpokeGwName,
 	}
 
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
-				log.Errorf("Couldn't find Spoke Transit Attachment: %s", reason)
+			if IsNotFoundReason(reason) {
+				logError(c.logContext(context.Background()), "Couldn't find spoke transit attachment", map[string]interface{}{"reason": reason})
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -86,20 +81,18 @@ func (c *Client) GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitA
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix gateway %s", spokeTransitAttachment.SpokeGwName)
+	logError(c.logContext(context.Background()), "Couldn't find Aviatrix gateway", map[string]interface{}{"gw_name": spokeTransitAttachment.SpokeGwName})
 	return nil, ErrNotFound
 }
 
-func (c *Client) DeleteSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error {
+func (c *Client) DeleteSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
 	action := "detach_spoke_from_transit_gw"
-	spokeTransitAttachment.CID = c.CID
 	spokeTransitAttachment.Action = action
-	return c.PostAPI(action, spokeTransitAttachment, BasicCheck)
+	return c.PostAPIContext(ctx, action, spokeTransitAttachment, BasicCheck)
 }
 
 func (c *Client) GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "show_multi_cloud_transit_peering_details",
 		"gateway1": spokeTransitAttachment.SpokeGwName,
 		"gateway2": spokeTransitAttachment.TransitGwName,
@@ -107,7 +100,7 @@ func (c *Client) GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransit
 
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -0,0 +1,55 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSpokeTransitAttachment(t *testing.T) {
+	client, _ := newCassetteClient(t, "spoke_transit_attachment")
+	ctx := context.Background()
+
+	attachment := &SpokeTransitAttachment{
+		SpokeGwName:   "cassette-spoke",
+		TransitGwName: "cassette-transit",
+		RouteTables:   "rtb-0a1b2c3d4e5f67890,rtb-0f9e8d7c6b5a43210",
+	}
+	if err := client.CreateSpokeTransitAttachment(ctx, attachment); err != nil {
+		t.Fatalf("CreateSpokeTransitAttachment() error = %v", err)
+	}
+
+	got, err := client.GetSpokeTransitAttachment(&SpokeTransitAttachment{
+		SpokeGwName:   attachment.SpokeGwName,
+		TransitGwName: attachment.TransitGwName,
+	})
+	if err != nil {
+		t.Fatalf("GetSpokeTransitAttachment() error = %v", err)
+	}
+	if got.RouteTables != attachment.RouteTables || got.SpokeBgpEnabled {
+		t.Errorf("GetSpokeTransitAttachment() = %+v, want route tables %q without BGP", got, attachment.RouteTables)
+	}
+
+	peering, err := client.GetTransitGatewayPeeringDetails(&TransitGatewayPeering{
+		TransitGatewayName1: attachment.SpokeGwName,
+		TransitGatewayName2: attachment.TransitGwName,
+	})
+	if err != nil {
+		t.Fatalf("GetTransitGatewayPeeringDetails() error = %v", err)
+	}
+	if peering.PrivateIPPeering != "no" || peering.TunnelCount != 2 || peering.PrependAsPath2 != "65001 65001" {
+		t.Errorf("GetTransitGatewayPeeringDetails() = %+v, want the spoke attachment details", peering)
+	}
+
+	if err := client.DeleteSpokeTransitAttachment(ctx, attachment); err != nil {
+		t.Fatalf("DeleteSpokeTransitAttachment() error = %v", err)
+	}
+
+	_, err = client.GetSpokeTransitAttachment(&SpokeTransitAttachment{
+		SpokeGwName:   attachment.SpokeGwName,
+		TransitGwName: attachment.TransitGwName,
+	})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSpokeTransitAttachment() after delete error = %v, want ErrNotFound", err)
+	}
+}
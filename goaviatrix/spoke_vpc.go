@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,7 +11,6 @@ import (
 type SpokeVpc struct {
 	AccountName                  string `form:"account_name,omitempty" json:"account_name,omitempty"`
 	Action                       string `form:"action,omitempty"`
-	CID                          string `form:"CID,omitempty"`
 	CloudType                    int    `form:"cloud_type,omitempty" json:"cloud_type,omitempty"`
 	DnsServer                    string `form:"dns_server,omitempty" json:"dns_server,omitempty"`
 	GwName                       string `form:"gw_name,omitempty" json:"vpc_name,omitempty"`
@@ -94,17 +94,15 @@ type SpokeGatewayAdvancedConfigRespResult struct {
 	ApprovedLearnedCidrs              []string                  `json:"approved_learned_cidrs"`
 }
 
-func (c *Client) LaunchSpokeVpc(spoke *SpokeVpc) error {
-	spoke.CID = c.CID
+func (c *Client) LaunchSpokeVpc(ctx context.Context, spoke *SpokeVpc) error {
 	spoke.Action = "create_multicloud_primary_gateway"
 	spoke.Async = true
 
-	return c.PostAsyncAPI(spoke.Action, spoke, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, spoke.Action, spoke, BasicCheck)
 }
 
 func (c *Client) SpokeJoinTransit(spoke *SpokeVpc) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "attach_spoke_to_transit_gw",
 		"spoke_gw":   spoke.GwName,
 		"transit_gw": spoke.TransitGateway,
@@ -115,7 +113,6 @@ func (c *Client) SpokeJoinTransit(spoke *SpokeVpc) error {
 
 func (c *Client) SpokeLeaveAllTransit(spoke *SpokeVpc) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "detach_spoke_from_transit_gw",
 		"spoke_gw": spoke.GwName,
 	}
@@ -136,7 +133,6 @@ func (c *Client) SpokeLeaveAllTransit(spoke *SpokeVpc) error {
 func (c *Client) SpokeLeaveTransit(spoke *SpokeVpc) error {
 	action := "detach_spoke_from_transit_gw"
 	data := map[string]interface{}{
-		"CID":        c.CID,
 		"action":     action,
 		"spoke_gw":   spoke.GwName,
 		"transit_gw": spoke.TransitGateway,
@@ -144,9 +140,8 @@ func (c *Client) SpokeLeaveTransit(spoke *SpokeVpc) error {
 	return c.PostAPI(action, data, BasicCheck)
 }
 
-func (c *Client) EnableHaSpokeVpc(spoke *SpokeVpc) error {
+func (c *Client) EnableHaSpokeVpc(ctx context.Context, spoke *SpokeVpc) error {
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  "create_multicloud_ha_gateway",
 		"gw_name": spoke.GwName,
 		"eip":     spoke.Eip,
@@ -170,20 +165,18 @@ func (c *Client) EnableHaSpokeVpc(spoke *SpokeVpc) error {
 		return nil
 	}
 
-	return c.PostAsyncAPI(form["action"], form, checkFunc)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, checkFunc)
 }
 
-func (c *Client) EnableHaSpokeGateway(gateway *SpokeVpc) error {
-	gateway.CID = c.CID
+func (c *Client) EnableHaSpokeGateway(ctx context.Context, gateway *SpokeVpc) error {
 	gateway.Action = "create_peering_ha_gateway"
 
-	return c.PostAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
 	form := map[string]string{
 		"action":       "enable_auto_advertise_s2c_cidrs",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -192,7 +185,6 @@ func (c *Client) EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
 func (c *Client) DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
 	form := map[string]string{
 		"action":       "disable_auto_advertise_s2c_cidrs",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -200,7 +192,6 @@ func (c *Client) DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
 
 func (c *Client) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_aviatrix_spoke_advanced_config",
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -254,7 +245,6 @@ func (c *Client) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGa
 func (c *Client) EnableSpokeConnectionLearnedCIDRApproval(gwName, connName string) error {
 	data := map[string]string{
 		"action":          "enable_transit_connection_learned_cidrs_approval",
-		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
@@ -264,7 +254,6 @@ func (c *Client) EnableSpokeConnectionLearnedCIDRApproval(gwName, connName strin
 func (c *Client) DisableSpokeConnectionLearnedCIDRApproval(gwName, connName string) error {
 	data := map[string]string{
 		"action":          "disable_transit_connection_learned_cidrs_approval",
-		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
@@ -274,7 +263,6 @@ func (c *Client) DisableSpokeConnectionLearnedCIDRApproval(gwName, connName stri
 func (c *Client) UpdateSpokeConnectionPendingApprovedCidrs(gwName, connName string, approvedCidrs []string) error {
 	data := map[string]string{
 		"action":                            "update_transit_connection_pending_approved_cidrs",
-		"CID":                               c.CID,
 		"gateway_name":                      gwName,
 		"connection_name":                   connName,
 		"connection_approved_learned_cidrs": strings.Join(approvedCidrs, ","),
@@ -285,7 +273,6 @@ func (c *Client) UpdateSpokeConnectionPendingApprovedCidrs(gwName, connName stri
 func (c *Client) EditSpokeConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error {
 	data := map[string]string{
 		"action":                                "edit_spoke_connection_bgp_manual_advertise_cidrs",
-		"CID":                                   c.CID,
 		"gateway_name":                          gwName,
 		"connection_name":                       connName,
 		"connection_bgp_manual_advertise_cidrs": strings.Join(cidrs, ","),
@@ -299,11 +286,9 @@ func (c *Client) SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error {
 		action = "disable_bgp_ecmp"
 	}
 	return c.PostAPI(action, struct {
-		CID         string `form:"CID"`
 		Action      string `form:"action"`
 		GatewayName string `form:"gateway_name"`
 	}{
-		CID:         c.CID,
 		Action:      action,
 		GatewayName: spokeGateway.GwName,
 	}, BasicCheck)
@@ -312,7 +297,6 @@ func (c *Client) SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error {
 func (c *Client) EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
 	action := "enable_active_standby"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -322,7 +306,6 @@ func (c *Client) EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
 func (c *Client) DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
 	action := "disable_active_standby"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -332,13 +315,11 @@ func (c *Client) DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
 func (c *Client) SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error {
 	action, subaction := "edit_aviatrix_spoke_advanced_config", "prepend_as_path"
 	return c.PostAPI(action+"/"+subaction, struct {
-		CID           string `form:"CID"`
 		Action        string `form:"action"`
 		Subaction     string `form:"subaction"`
 		GatewayName   string `form:"gateway_name"`
 		PrependASPath string `form:"bgp_prepend_as_path"`
 	}{
-		CID:           c.CID,
 		Action:        action,
 		Subaction:     subaction,
 		GatewayName:   spokeGateway.GwName,
@@ -349,12 +330,10 @@ func (c *Client) SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []s
 func (c *Client) SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime string) error {
 	action := "change_bgp_polling_time"
 	return c.PostAPI(action, struct {
-		CID         string `form:"CID"`
 		Action      string `form:"action"`
 		GatewayName string `form:"gateway_name"`
 		PollingTime string `form:"bgp_polling_time"`
 	}{
-		CID:         c.CID,
 		Action:      action,
 		GatewayName: spokeGateway.GwName,
 		PollingTime: newPollingTime,
@@ -363,7 +342,6 @@ func (c *Client) SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime s
 
 func (c *Client) SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error {
 	form := map[string]string{
-		"CID":                              c.CID,
 		"action":                           "edit_aviatrix_spoke_advanced_config",
 		"subaction":                        "bgp_manual_spoke",
 		"gateway_name":                     spokeGateway.GwName,
@@ -375,7 +353,6 @@ func (c *Client) SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) err
 
 func (c *Client) EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_transit_learned_cidrs_approval",
 		"gateway_name": gateway.GwName,
 	}
@@ -385,7 +362,6 @@ func (c *Client) EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
 
 func (c *Client) DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_transit_learned_cidrs_approval",
 		"gateway_name": gateway.GwName,
 	}
@@ -395,7 +371,6 @@ func (c *Client) DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
 
 func (c *Client) UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "update_transit_pending_approved_cidrs",
 		"gateway_name":           gateway.GwName,
 		"approved_learned_cidrs": strings.Join(gateway.ApprovedLearnedCidrs, ","),
@@ -407,12 +382,10 @@ func (c *Client) UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error {
 func (c *Client) SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error {
 	action := "edit_spoke_local_as_number"
 	return c.PostAPI(action, struct {
-		CID           string `form:"CID"`
 		Action        string `form:"action"`
 		GatewayName   string `form:"gateway_name"`
 		LocalASNumber string `form:"local_as_num"`
 	}{
-		CID:           c.CID,
 		Action:        action,
 		GatewayName:   spokeGateway.GwName,
 		LocalASNumber: localASNumber,
@@ -431,7 +404,6 @@ func (c *Client) SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber str
 func (c *Client) EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error {
 	action := "enable_active_standby"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 		"preemptive":   "true",
@@ -442,7 +414,6 @@ func (c *Client) EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) erro
 func (c *Client) EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
 	action := "enable_spoke_onprem_route_propagation"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -452,7 +423,6 @@ func (c *Client) EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
 func (c *Client) DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
 	action := "disable_spoke_onprem_route_propagation"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -462,7 +432,6 @@ func (c *Client) DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) erro
 func (c *Client) EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
 	action := "enable_spoke_preserve_as_path"
 	data := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -472,7 +441,6 @@ func (c *Client) EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
 func (c *Client) DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
 	action := "disable_spoke_preserve_as_path"
 	data := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": spokeGateway.GwName,
 	}
@@ -482,7 +450,6 @@ func (c *Client) DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
 func (c *Client) EnableGlobalVpc(gateway *Gateway) error {
 	form := map[string]string{
 		"action":       "enable_global_vpc",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -491,7 +458,6 @@ func (c *Client) EnableGlobalVpc(gateway *Gateway) error {
 func (c *Client) DisableGlobalVpc(gateway *Gateway) error {
 	form := map[string]string{
 		"action":       "disable_global_vpc",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
@@ -12,7 +12,6 @@ type SumologicForwarderResp struct {
 func (c *Client) GetSumologicForwarderStatus() (*SumologicForwarderResp, error) {
 	params := map[string]string{
 		"action": "get_sumologic_logging_status",
-		"CID":    c.CID,
 	}
 
 	type Resp struct {
@@ -38,7 +37,6 @@ func (c *Client) GetSumologicForwarderStatus() (*SumologicForwarderResp, error)
 func (c *Client) DisableSumologicForwarder() error {
 	params := map[string]string{
 		"action": "disable_sumologic_logging",
-		"CID":    c.CID,
 	}
 
 	return c.PostAPI(params["action"], params, BasicCheck)
@@ -8,7 +8,6 @@ import (
 // Tags simple struct to hold tag details
 type Tags struct {
 	Action       string `form:"action,omitempty"`
-	CID          string `form:"CID,omitempty"`
 	CloudType    int    `form:"cloud_type,omitempty"`
 	ResourceType string `form:"resource_type,omitempty"`
 	ResourceName string `form:"resource_name,omitempty"`
@@ -92,8 +91,58 @@ func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// DefaultTagsConfig are the tags added to every taggable resource. The resource tags win on
+// conflict.
+type DefaultTagsConfig struct {
+	Tags KeyValueTags
+}
+
+// WithDefaultTags sets the tags added to every taggable resource.
+func WithDefaultTags(config *DefaultTagsConfig) ClientOption {
+	return func(c *Client) {
+		c.DefaultTagsConfig = config
+	}
+}
+
+// MergeTags returns the default tags merged with tags. The tags win on conflict.
+func (config *DefaultTagsConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	if config != nil {
+		for k, v := range config.Tags {
+			result[k] = v
+		}
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// RemoveDefaultConfig returns the tags without the default tags the controller echoes back, i.e.
+// the tags with the same key and value as a default tag that are not in configured.
+func (tags KeyValueTags) RemoveDefaultConfig(config *DefaultTagsConfig, configured KeyValueTags) KeyValueTags {
+	if config == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultValue, ok := config.Tags[k]; ok && defaultValue == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 func (c *Client) AddTags(tags *Tags) error {
-	tags.CID = c.CID
 	tags.Action = "add_resource_tags"
 
 	return c.PostAPI(tags.Action, tags, BasicCheck)
@@ -102,7 +151,6 @@ func (c *Client) AddTags(tags *Tags) error {
 func (c *Client) GetTags(tags *Tags) ([]string, error) {
 	data := map[string]string{
 		"action":        "list_resource_tags",
-		"CID":           c.CID,
 		"cloud_type":    strconv.Itoa(tags.CloudType),
 		"resource_type": tags.ResourceType,
 		"resource_name": tags.ResourceName,
@@ -128,7 +176,6 @@ func (c *Client) GetTags(tags *Tags) ([]string, error) {
 func (c *Client) DeleteTags(tags *Tags) error {
 	params := map[string]string{
 		"action":        "delete_resource_tag",
-		"CID":           c.CID,
 		"cloud_type":    strconv.Itoa(tags.CloudType),
 		"del_tag_list":  tags.TagList,
 		"resource_name": tags.ResourceName,
@@ -139,7 +186,6 @@ func (c *Client) DeleteTags(tags *Tags) error {
 }
 
 func (c *Client) UpdateTags(tags *Tags) error {
-	tags.CID = c.CID
 	tags.Action = "update_resource_tags"
 
 	return c.PostAPI(tags.Action, tags, BasicCheck)
@@ -0,0 +1,59 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultTags(t *testing.T) {
+	config := &DefaultTagsConfig{Tags: KeyValueTags{"owner": "network", "cost-center": "1234"}}
+
+	merged := config.MergeTags(KeyValueTags{"owner": "security", "name": "spoke"})
+	want := KeyValueTags{"owner": "security", "cost-center": "1234", "name": "spoke"}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("MergeTags() = %v, want %v", merged, want)
+	}
+
+	var none *DefaultTagsConfig
+	if got := none.MergeTags(KeyValueTags{"name": "spoke"}); !reflect.DeepEqual(got, KeyValueTags{"name": "spoke"}) {
+		t.Errorf("MergeTags() without default tags = %v", got)
+	}
+
+	tests := []struct {
+		name       string
+		remote     KeyValueTags
+		configured KeyValueTags
+		want       KeyValueTags
+	}{
+		{
+			"echoed defaults",
+			KeyValueTags{"owner": "network", "cost-center": "1234", "name": "spoke"},
+			KeyValueTags{"name": "spoke"},
+			KeyValueTags{"name": "spoke"},
+		},
+		{
+			"resource overrides default",
+			KeyValueTags{"owner": "security", "cost-center": "1234"},
+			KeyValueTags{"owner": "security"},
+			KeyValueTags{"owner": "security"},
+		},
+		{
+			"resource repeats default",
+			KeyValueTags{"owner": "network", "cost-center": "1234"},
+			KeyValueTags{"owner": "network"},
+			KeyValueTags{"owner": "network"},
+		},
+		{
+			"changed outside terraform",
+			KeyValueTags{"owner": "someone", "cost-center": "1234"},
+			nil,
+			KeyValueTags{"owner": "someone"},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.remote.RemoveDefaultConfig(config, tt.configured); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: RemoveDefaultConfig() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -12,7 +12,6 @@ import (
 
 type ExternalDeviceConn struct {
 	Action                 string `form:"action,omitempty"`
-	CID                    string `form:"CID,omitempty"`
 	VpcID                  string `form:"vpc_id,omitempty"`
 	ConnectionName         string `form:"connection_name,omitempty"`
 	GwName                 string `form:"transit_gw,omitempty"`
@@ -105,7 +104,6 @@ type EditExternalDeviceConnDetail struct {
 
 type EditBgpMd5Key struct {
 	Action         string `form:"action,omitempty"`
-	CID            string `form:"CID,omitempty"`
 	ConnectionName string `form:"conn_name,omitempty"`
 	GwName         string `form:"gateway_name,omitempty"`
 	BgpMd5Key      string `form:"bgp_md5_key,omitempty"`
@@ -123,7 +121,6 @@ type ExternalDeviceConnDetailList struct {
 }
 
 func (c *Client) CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
-	externalDeviceConn.CID = c.CID
 	externalDeviceConn.Action = "connect_transit_gw_to_external_device"
 
 	return c.PostAPI(externalDeviceConn.Action, externalDeviceConn, BasicCheck)
@@ -131,14 +128,13 @@ func (c *Client) CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn
 
 func (c *Client) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn) (*ExternalDeviceConn, error) {
 	params := map[string]string{
-		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
 		"conn_name": externalDeviceConn.ConnectionName,
 		"vpc_id":    externalDeviceConn.VpcID,
 	}
 	checkFunc := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -311,7 +307,6 @@ func (c *Client) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceC
 }
 
 func (c *Client) DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
-	externalDeviceConn.CID = c.CID
 	externalDeviceConn.Action = "disconnect_transit_gw"
 
 	return c.PostAPI(externalDeviceConn.Action, externalDeviceConn, BasicCheck)
@@ -361,13 +356,11 @@ func TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc(k, old, new string, d
 func (c *Client) EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
 	return c.PostAPI(action, struct {
-		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
 		ConnectionName string `form:"connection_name"`
 		PrependASPath  string `form:"connection_as_path_prepend"`
 	}{
-		CID:            c.CID,
 		Action:         action,
 		GatewayName:    externalDeviceConn.GwName,
 		ConnectionName: externalDeviceConn.ConnectionName,
@@ -376,7 +369,6 @@ func (c *Client) EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn *
 }
 
 func (c *Client) EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error {
-	editBgpMd5Key.CID = c.CID
 	editBgpMd5Key.Action = "update_bgp_connection_md5_signature"
 
 	return c.PostAPI(editBgpMd5Key.Action, editBgpMd5Key, BasicCheck)
@@ -384,7 +376,6 @@ func (c *Client) EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error {
 
 func (c *Client) EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
 	params := map[string]string{
-		"CID":             c.CID,
 		"action":          "enable_jumbo_frame_on_connection_to_cloudn",
 		"connection_name": externalDeviceConn.ConnectionName,
 		"vpc_id":          externalDeviceConn.VpcID,
@@ -405,7 +396,6 @@ func (c *Client) EnableJumboFrameExternalDeviceConn(externalDeviceConn *External
 
 func (c *Client) DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
 	params := map[string]string{
-		"CID":             c.CID,
 		"action":          "disable_jumbo_frame_on_connection_to_cloudn",
 		"connection_name": externalDeviceConn.ConnectionName,
 		"vpc_id":          externalDeviceConn.VpcID,
@@ -426,14 +416,13 @@ func (c *Client) DisableJumboFrameExternalDeviceConn(externalDeviceConn *Externa
 
 func (c *Client) GetEdgeExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn) (*ExternalDeviceConn, error) {
 	params := map[string]string{
-		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
 		"conn_name": externalDeviceConn.ConnectionName,
 		"vpc_id":    externalDeviceConn.VpcID,
 	}
 	checkFunc := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -1,7 +1,7 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 )
 
 type TransitFireNetPolicy struct {
@@ -23,7 +23,6 @@ type TransitFireNetPolicyEdit struct {
 
 func (c *Client) CreateTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error {
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               "add_spoke_to_transit_firenet_inspection",
 		"firenet_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName,
 		"spoke_gateway_name":   transitFireNetPolicy.InspectedResourceName,
@@ -34,7 +33,6 @@ func (c *Client) CreateTransitFireNetPolicy(transitFireNetPolicy *TransitFireNet
 
 func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_transit_firenet_spoke_policies",
 		"gateway_name": transitFireNetPolicy.TransitFireNetGatewayName,
 	}
@@ -47,8 +45,10 @@ func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPol
 	}
 
 	if len(data.Results) == 0 {
-		log.Errorf("transit firenet policy between transit firenet gateway: %s and inspected resource name: %s not found",
-			transitFireNetPolicy.TransitFireNetGatewayName, transitFireNetPolicy.InspectedResourceName)
+		logError(c.logContext(context.Background()), "Transit FireNet policy not found", map[string]interface{}{
+			"gw_name":                 transitFireNetPolicy.TransitFireNetGatewayName,
+			"inspected_resource_name": transitFireNetPolicy.InspectedResourceName,
+		})
 		return ErrNotFound
 	}
 	policyList := data.Results
@@ -67,7 +67,6 @@ func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPol
 
 func (c *Client) DeleteTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error {
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               "delete_spoke_from_transit_firenet_inspection",
 		"firenet_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName,
 		"spoke_gateway_name":   transitFireNetPolicy.InspectedResourceName,
@@ -1,10 +1,9 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type TransitGatewayPeering struct {
@@ -24,7 +23,6 @@ type TransitGatewayPeering struct {
 	Gateway2ExcludedTGWConnectionsSlice []string
 	PrependAsPath1                      string
 	PrependAsPath2                      string
-	CID                                 string `form:"CID,omitempty"`
 	Action                              string `form:"action,omitempty"`
 	SingleTunnel                        string `form:"single_tunnel,omitempty"`
 	NoMaxPerformance                    bool   `form:"no_max_performance,omitempty"`
@@ -41,7 +39,6 @@ type TransitGatewayPeeringEdit struct {
 	InsaneModeOverInternet         bool   `form:"insane_mode_over_internet,omitempty"`
 	InsaneModeTunnelCount          int    `json:"insane_mode_tunnel_count"`
 	TunnelCount                    int    `form:"tunnel_count"`
-	CID                            string `form:"CID,omitempty"`
 	Action                         string `form:"action,omitempty"`
 	SingleTunnel                   string `form:"single_tunnel,omitempty"`
 	NoMaxPerformance               bool   `form:"no_max_performance,omitempty"`
@@ -82,14 +79,12 @@ type TunnelsDetail struct {
 }
 
 func (c *Client) CreateTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
-	transitGatewayPeering.CID = c.CID
 	transitGatewayPeering.Action = "create_inter_transit_gateway_peering"
 	return c.PostAPI(transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
 }
 
 func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_inter_transit_gateway_peering",
 	}
 
@@ -101,8 +96,10 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 	}
 
 	if len(data.Results) == 0 {
-		log.Errorf("Transit gateway peering with gateways %s and %s not found",
-			transitGatewayPeering.TransitGatewayName1, transitGatewayPeering.TransitGatewayName2)
+		logError(c.logContext(context.Background()), "Transit gateway peering not found", map[string]interface{}{
+			"gw_name1": transitGatewayPeering.TransitGatewayName1,
+			"gw_name2": transitGatewayPeering.TransitGatewayName2,
+		})
 		return ErrNotFound
 	}
 	peeringList := data.Results
@@ -112,9 +109,10 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 				peeringList[i][j].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName2 ||
 				peeringList[i][j].TransitGatewayName1 == transitGatewayPeering.TransitGatewayName2 &&
 					peeringList[i][j].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName1 {
-				log.Debugf("Found %s<->%s transit gateway peering: %#v",
-					transitGatewayPeering.TransitGatewayName1,
-					transitGatewayPeering.TransitGatewayName2, peeringList[i][j])
+				logDebug(c.logContext(context.Background()), "Found transit gateway peering", map[string]interface{}{
+					"gw_name1": transitGatewayPeering.TransitGatewayName1,
+					"gw_name2": transitGatewayPeering.TransitGatewayName2,
+				})
 				return nil
 			}
 		}
@@ -125,13 +123,12 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 func (c *Client) GetTransitGatewayPeeringDetails(transitGatewayPeering *TransitGatewayPeering) (*TransitGatewayPeering, error) {
 	form := map[string]string{
 		"action":   "get_inter_transit_gateway_peering_details",
-		"CID":      c.CID,
 		"gateway1": transitGatewayPeering.TransitGatewayName1,
 		"gateway2": transitGatewayPeering.TransitGatewayName2,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") || strings.Contains(reason, "not found") {
+			if IsNotFoundReason(reason) || strings.Contains(reason, "not found") {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -169,14 +166,12 @@ func (c *Client) GetTransitGatewayPeeringDetails(transitGatewayPeering *TransitG
 }
 
 func (c *Client) UpdateTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
-	transitGatewayPeering.CID = c.CID
 	transitGatewayPeering.Action = "edit_inter_transit_gateway_peering"
 
 	return c.PostAPI(transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
 }
 
 func (c *Client) UpdateTransitGatewayPeeringTunnelCount(transitGatewayPeering *TransitGatewayPeeringEdit) error {
-	transitGatewayPeering.CID = c.CID
 	transitGatewayPeering.Action = "edit_inter_transit_gateway_peering"
 
 	return c.PostAPI(transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
@@ -184,7 +179,6 @@ func (c *Client) UpdateTransitGatewayPeeringTunnelCount(transitGatewayPeering *T
 
 func (c *Client) DeleteTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "delete_inter_transit_gateway_peering",
 		"gateway1": transitGatewayPeering.TransitGatewayName1,
 		"gateway2": transitGatewayPeering.TransitGatewayName2,
@@ -196,13 +190,11 @@ func (c *Client) DeleteTransitGatewayPeering(transitGatewayPeering *TransitGatew
 func (c *Client) EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
 	return c.PostAPI(action, struct {
-		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
 		ConnectionName string `form:"connection_name"`
 		PrependASPath  string `form:"connection_as_path_prepend"`
 	}{
-		CID:            c.CID,
 		Action:         action,
 		GatewayName:    transitGatewayPeering.TransitGatewayName1,
 		ConnectionName: transitGatewayPeering.TransitGatewayName2 + "-peering",
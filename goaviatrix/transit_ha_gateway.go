@@ -1,10 +1,9 @@
 package goaviatrix
 
-import "golang.org/x/net/context"
+import "context"
 
 type TransitHaGateway struct {
 	Action                string `json:"action"`
-	CID                   string `json:"CID"`
 	AccountName           string `json:"account_name"`
 	CloudType             int    `json:"cloud_type"`
 	VpcID                 string `json:"vpc_id,omitempty"`
@@ -26,9 +25,8 @@ type TransitHaGateway struct {
 	AutoGenHaGwName       string `json:"autogen_hagw_name"`
 }
 
-func (c *Client) CreateTransitHaGw(transitHaGateway *TransitHaGateway) (string, error) {
-	transitHaGateway.CID = c.CID
+func (c *Client) CreateTransitHaGw(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
 	transitHaGateway.Action = "create_multicloud_ha_gateway"
 
-	return c.PostAPIContext2HaGw(context.Background(), nil, transitHaGateway.Action, transitHaGateway, BasicCheck)
+	return c.PostAPIContext2HaGw(ctx, nil, transitHaGateway.Action, transitHaGateway, BasicCheck)
 }
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,7 +11,6 @@ import (
 type TransitVpc struct {
 	AccountName                  string `form:"account_name,omitempty" json:"account_name,omitempty"`
 	Action                       string `form:"action,omitempty"`
-	CID                          string `form:"CID,omitempty"`
 	CloudType                    int    `form:"cloud_type,omitempty" json:"cloud_type,omitempty"`
 	DnsServer                    string `form:"dns_server,omitempty" json:"dns_server,omitempty"`
 	GwName                       string `form:"gw_name,omitempty" json:"vpc_name,omitempty"`
@@ -147,24 +147,21 @@ type TransitGatewayBgpLanIpInfo struct {
 	AzureHaBgpLanIpList []string
 }
 
-func (c *Client) LaunchTransitVpc(gateway *TransitVpc) error {
-	gateway.CID = c.CID
+func (c *Client) LaunchTransitVpc(ctx context.Context, gateway *TransitVpc) error {
 	gateway.Action = "create_multicloud_primary_gateway"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) EnableHaTransitGateway(gateway *TransitVpc) error {
-	gateway.CID = c.CID
+func (c *Client) EnableHaTransitGateway(ctx context.Context, gateway *TransitVpc) error {
 	gateway.Action = "create_peering_ha_gateway"
 
-	return c.PostAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
-func (c *Client) EnableHaTransitVpc(gateway *TransitVpc) error {
+func (c *Client) EnableHaTransitVpc(ctx context.Context, gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  "create_multicloud_ha_gateway",
 		"gw_name": gateway.GwName,
 		"eip":     gateway.Eip,
@@ -188,12 +185,11 @@ func (c *Client) EnableHaTransitVpc(gateway *TransitVpc) error {
 		return nil
 	}
 
-	return c.PostAsyncAPI(form["action"], form, checkFunc)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, checkFunc)
 }
 
-func (c *Client) AttachTransitGWForHybrid(gateway *TransitVpc) error {
+func (c *Client) AttachTransitGWForHybrid(ctx context.Context, gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_transit_gateway_interface_to_aws_tgw",
 		"gateway_name": gateway.GwName,
 	}
@@ -208,12 +204,11 @@ func (c *Client) AttachTransitGWForHybrid(gateway *TransitVpc) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) DetachTransitGWForHybrid(gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_transit_gateway_interface_to_aws_tgw",
 		"gateway_name": gateway.GwName,
 	}
@@ -221,19 +216,17 @@ func (c *Client) DetachTransitGWForHybrid(gateway *TransitVpc) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) EnableConnectedTransit(gateway *TransitVpc) error {
+func (c *Client) EnableConnectedTransit(ctx context.Context, gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_connected_transit_on_gateway",
 		"gateway_name": gateway.GwName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableConnectedTransit(gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_connected_transit_on_gateway",
 		"gateway_name": gateway.GwName,
 	}
@@ -241,19 +234,17 @@ func (c *Client) DisableConnectedTransit(gateway *TransitVpc) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) EnableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+func (c *Client) EnableGatewayFireNetInterfaces(ctx context.Context, gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_gateway_firenet_interfaces",
 		"gateway_name": gateway.GwName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableGatewayFireNetInterfaces(gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":     c.CID,
 		"action":  "disable_gateway_firenet_interfaces",
 		"gateway": gateway.GwName,
 	}
@@ -261,19 +252,17 @@ func (c *Client) DisableGatewayFireNetInterfaces(gateway *TransitVpc) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) EnableGatewayFireNetInterfacesWithGWLB(gateway *TransitVpc) error {
+func (c *Client) EnableGatewayFireNetInterfacesWithGWLB(ctx context.Context, gateway *TransitVpc) error {
 	data := map[string]string{
 		"action":       "enable_gateway_firenet_interfaces",
-		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 		"mode":         "gwlb",
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
-func (c *Client) EnableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+func (c *Client) EnableAdvertiseTransitCidr(ctx context.Context, transitGw *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_advertise_transit_cidr",
 		"gateway_name": transitGw.GwName,
 	}
@@ -284,12 +273,11 @@ func (c *Client) EnableAdvertiseTransitCidr(transitGw *TransitVpc) error {
 		form["advertise_transit_cidr"] = "no"
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableAdvertiseTransitCidr(transitGw *TransitVpc) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "disable_advertise_transit_cidr",
 		"gateway_name":           transitGw.GwName,
 		"advertise_transit_cidr": "no",
@@ -298,21 +286,19 @@ func (c *Client) DisableAdvertiseTransitCidr(transitGw *TransitVpc) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) SetBgpManualSpokeAdvertisedNetworks(transitGw *TransitVpc) error {
+func (c *Client) SetBgpManualSpokeAdvertisedNetworks(ctx context.Context, transitGw *TransitVpc) error {
 	form := map[string]string{
-		"CID":                              c.CID,
 		"action":                           "edit_aviatrix_transit_advanced_config",
 		"subaction":                        "bgp_manual_spoke",
 		"gateway_name":                     transitGw.GwName,
 		"bgp_manual_spoke_advertise_cidrs": transitGw.BgpManualSpokeAdvertiseCidrs,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "enable_transit_learned_cidrs_approval",
 		"gateway_name": gateway.GwName,
 	}
@@ -322,7 +308,6 @@ func (c *Client) EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
 
 func (c *Client) DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "disable_transit_learned_cidrs_approval",
 		"gateway_name": gateway.GwName,
 	}
@@ -330,42 +315,37 @@ func (c *Client) DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) UpdateTransitPendingApprovedCidrs(gateway *TransitVpc) error {
+func (c *Client) UpdateTransitPendingApprovedCidrs(ctx context.Context, gateway *TransitVpc) error {
 	form := map[string]string{
-		"CID":                    c.CID,
 		"action":                 "update_transit_pending_approved_cidrs",
 		"gateway_name":           gateway.GwName,
 		"approved_learned_cidrs": strings.Join(gateway.ApprovedLearnedCidrs, ","),
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
-func (c *Client) SetBgpPollingTime(transitGateway *TransitVpc, newPollingTime string) error {
+func (c *Client) SetBgpPollingTime(ctx context.Context, transitGateway *TransitVpc, newPollingTime string) error {
 	action := "change_bgp_polling_time"
-	return c.PostAPI(action, struct {
-		CID         string `form:"CID"`
+	return c.PostAPIContext(ctx, action, struct {
 		Action      string `form:"action"`
 		GatewayName string `form:"gateway_name"`
 		PollingTime string `form:"bgp_polling_time"`
 	}{
-		CID:         c.CID,
 		Action:      action,
 		GatewayName: transitGateway.GwName,
 		PollingTime: newPollingTime,
 	}, BasicCheck)
 }
 
-func (c *Client) SetPrependASPath(transitGateway *TransitVpc, prependASPath []string) error {
+func (c *Client) SetPrependASPath(ctx context.Context, transitGateway *TransitVpc, prependASPath []string) error {
 	action, subaction := "edit_aviatrix_transit_advanced_config", "prepend_as_path"
-	return c.PostAPI(action+"/"+subaction, struct {
-		CID           string `form:"CID"`
+	return c.PostAPIContext(ctx, action+"/"+subaction, struct {
 		Action        string `form:"action"`
 		Subaction     string `form:"subaction"`
 		GatewayName   string `form:"gateway_name"`
 		PrependASPath string `form:"bgp_prepend_as_path"`
 	}{
-		CID:           c.CID,
 		Action:        action,
 		Subaction:     subaction,
 		GatewayName:   transitGateway.GwName,
@@ -373,15 +353,13 @@ func (c *Client) SetPrependASPath(transitGateway *TransitVpc, prependASPath []st
 	}, BasicCheck)
 }
 
-func (c *Client) SetLocalASNumber(transitGateway *TransitVpc, localASNumber string) error {
+func (c *Client) SetLocalASNumber(ctx context.Context, transitGateway *TransitVpc, localASNumber string) error {
 	action := "edit_transit_local_as_number"
-	return c.PostAPI(action, struct {
-		CID           string `form:"CID"`
+	return c.PostAPIContext(ctx, action, struct {
 		Action        string `form:"action"`
 		GatewayName   string `form:"gateway_name"`
 		LocalASNumber string `form:"local_as_num"`
 	}{
-		CID:           c.CID,
 		Action:        action,
 		GatewayName:   transitGateway.GwName,
 		LocalASNumber: localASNumber,
@@ -397,17 +375,15 @@ func (c *Client) SetLocalASNumber(transitGateway *TransitVpc, localASNumber stri
 	})
 }
 
-func (c *Client) SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error {
+func (c *Client) SetBgpEcmp(ctx context.Context, transitGateway *TransitVpc, enabled bool) error {
 	action := "enable_bgp_ecmp"
 	if !enabled {
 		action = "disable_bgp_ecmp"
 	}
-	return c.PostAPI(action, struct {
-		CID         string `form:"CID"`
+	return c.PostAPIContext(ctx, action, struct {
 		Action      string `form:"action"`
 		GatewayName string `form:"gateway_name"`
 	}{
-		CID:         c.CID,
 		Action:      action,
 		GatewayName: transitGateway.GwName,
 	}, BasicCheck)
@@ -415,7 +391,6 @@ func (c *Client) SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error {
 
 func (c *Client) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_aviatrix_transit_advanced_config",
 		"gateway_name": transitGateway.GwName,
 	}
@@ -466,20 +441,18 @@ func (c *Client) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*T
 	}, nil
 }
 
-func (c *Client) SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string) error {
+func (c *Client) SetTransitLearnedCIDRsApprovalMode(ctx context.Context, gw *TransitVpc, mode string) error {
 	data := map[string]string{
 		"action":       "set_transit_learned_cidrs_approval_mode",
-		"CID":          c.CID,
 		"gateway_name": gw.GwName,
 		"mode":         mode,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EnableTransitConnectionLearnedCIDRApproval(gwName, connName string) error {
 	data := map[string]string{
 		"action":          "enable_transit_connection_learned_cidrs_approval",
-		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
@@ -489,7 +462,6 @@ func (c *Client) EnableTransitConnectionLearnedCIDRApproval(gwName, connName str
 func (c *Client) DisableTransitConnectionLearnedCIDRApproval(gwName, connName string) error {
 	data := map[string]string{
 		"action":          "disable_transit_connection_learned_cidrs_approval",
-		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
@@ -499,7 +471,6 @@ func (c *Client) DisableTransitConnectionLearnedCIDRApproval(gwName, connName st
 func (c *Client) UpdateTransitConnectionPendingApprovedCidrs(gwName, connName string, approvedCidrs []string) error {
 	data := map[string]string{
 		"action":                            "update_transit_connection_pending_approved_cidrs",
-		"CID":                               c.CID,
 		"gateway_name":                      gwName,
 		"connection_name":                   connName,
 		"connection_approved_learned_cidrs": strings.Join(approvedCidrs, ","),
@@ -510,7 +481,6 @@ func (c *Client) UpdateTransitConnectionPendingApprovedCidrs(gwName, connName st
 func (c *Client) EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error {
 	data := map[string]string{
 		"action":                                "edit_transit_connection_bgp_manual_advertise_cidrs",
-		"CID":                                   c.CID,
 		"gateway_name":                          gwName,
 		"connection_name":                       connName,
 		"connection_bgp_manual_advertise_cidrs": strings.Join(cidrs, ","),
@@ -518,48 +488,43 @@ func (c *Client) EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName s
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
 
-func (c *Client) ChangeBgpHoldTime(gwName string, holdTime int) error {
+func (c *Client) ChangeBgpHoldTime(ctx context.Context, gwName string, holdTime int) error {
 	data := map[string]string{
 		"action":        "change_bgp_hold_time",
 		"gateway_name":  gwName,
 		"bgp_hold_time": strconv.Itoa(holdTime),
-		"CID":           c.CID,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
-func (c *Client) EnableSummarizeCidrToTgw(gwName string) error {
+func (c *Client) EnableSummarizeCidrToTgw(ctx context.Context, gwName string) error {
 	data := map[string]string{
 		"action":       "enable_transit_summarize_cidr_to_tgw",
 		"gateway_name": gwName,
-		"CID":          c.CID,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableSummarizeCidrToTgw(gwName string) error {
 	data := map[string]string{
 		"action":       "disable_transit_summarize_cidr_to_tgw",
 		"gateway_name": gwName,
-		"CID":          c.CID,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
 
-func (c *Client) EnableMultitierTransit(gwName string) error {
+func (c *Client) EnableMultitierTransit(ctx context.Context, gwName string) error {
 	data := map[string]string{
 		"action":       "enable_multitier_transit",
 		"gateway_name": gwName,
-		"CID":          c.CID,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableMultitierTransit(gwName string) error {
 	data := map[string]string{
 		"action":       "disable_multitier_transit",
 		"gateway_name": gwName,
-		"CID":          c.CID,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
@@ -567,7 +532,6 @@ func (c *Client) DisableMultitierTransit(gwName string) error {
 func (c *Client) EditTransitConnectionRemoteSubnet(vpcId, connName, remoteSubnet string) error {
 	data := map[string]string{
 		"action":            "edit_site2cloud_conn",
-		"CID":               c.CID,
 		"vpc_id":            vpcId,
 		"conn_name":         connName,
 		"network_type":      "2",
@@ -578,7 +542,6 @@ func (c *Client) EditTransitConnectionRemoteSubnet(vpcId, connName, remoteSubnet
 
 func (c *Client) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_aviatrix_transit_advanced_config",
 		"gateway_name": transitGateway.GwName,
 	}
@@ -611,40 +574,36 @@ func (c *Client) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgp
 	}, nil
 }
 
-func (c *Client) EnableS2CRxBalancing(gwName string) error {
+func (c *Client) EnableS2CRxBalancing(ctx context.Context, gwName string) error {
 	data := map[string]string{
 		"action":           "enable_s2c_rx_balancing",
 		"gateway_name":     gwName,
-		"CID":              c.CID,
 		"s2c_rx_balancing": "yes",
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableS2CRxBalancing(gwName string) error {
 	data := map[string]string{
 		"action":           "disable_s2c_rx_balancing",
 		"gateway_name":     gwName,
-		"CID":              c.CID,
 		"s2c_rx_balancing": "no",
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
 }
 
-func (c *Client) EnableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+func (c *Client) EnableTransitPreserveAsPath(ctx context.Context, transitGateway *TransitVpc) error {
 	action := "enable_transit_preserve_as_path"
 	data := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) DisableTransitPreserveAsPath(transitGateway *TransitVpc) error {
 	action := "disable_transit_preserve_as_path"
 	data := map[string]interface{}{
-		"CID":          c.CID,
 		"action":       action,
 		"gateway_name": transitGateway.GwName,
 	}
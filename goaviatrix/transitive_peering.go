@@ -2,19 +2,16 @@ package goaviatrix
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
-
 	//"github.com/davecgh/go-spew/spew"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // TransPeer simple struct to hold transitive peering details
 
 type TransPeer struct {
-	CID           string `form:"CID,omitempty"`
 	Action        string `form:"action,omitempty"`
 	Source        string `form:"source" json:"source"`
 	Nexthop       string `form:"nexthop" json:"nexthop"`
@@ -28,7 +25,6 @@ type TransPeerListResp struct {
 }
 
 func (c *Client) CreateTransPeer(transPeer *TransPeer) error {
-	transPeer.CID = c.CID
 	transPeer.Action = "add_extended_vpc_peer"
 
 	return c.PostAPI(transPeer.Action, transPeer, BasicCheck)
@@ -36,7 +32,6 @@ func (c *Client) CreateTransPeer(transPeer *TransPeer) error {
 
 func (c *Client) GetTransPeer(transPeer *TransPeer) (*TransPeer, error) {
 	// TODO: use GetAPI - need API details
-	transPeer.CID = c.CID
 	transPeer.Action = "list_extended_vpc_peer"
 	resp, err := c.Post(c.baseURL, transPeer)
 	if err != nil {
@@ -59,8 +54,11 @@ func (c *Client) GetTransPeer(transPeer *TransPeer) (*TransPeer, error) {
 			return &transPeerList[i], nil
 		}
 	}
-	log.Errorf("Transitive peering with gateways %s and %s with subnet %s not found",
-		transPeer.Source, transPeer.Nexthop, transPeer.ReachableCidr)
+	logError(c.logContext(context.Background()), "Transitive peering not found", map[string]interface{}{
+		"source":         transPeer.Source,
+		"nexthop":        transPeer.Nexthop,
+		"reachable_cidr": transPeer.ReachableCidr,
+	})
 	return nil, ErrNotFound
 }
 
@@ -69,7 +67,6 @@ func (c *Client) UpdateTransPeer(transPeer *TransPeer) error {
 }
 
 func (c *Client) DeleteTransPeer(transPeer *TransPeer) error {
-	transPeer.CID = c.CID
 	transPeer.Action = "delete_extended_vpc_peer"
 
 	return c.PostAPI(transPeer.Action, transPeer, BasicCheck)
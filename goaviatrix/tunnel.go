@@ -3,7 +3,8 @@ package goaviatrix
 // Tunnel simple struct to hold tunnel details
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"fmt"
 )
 
 type Tunnel struct {
@@ -27,7 +28,6 @@ type TunnelListResp struct {
 
 func (c *Client) CreateTunnel(tunnel *Tunnel) error {
 	form := map[string]string{
-		"CID":        c.CID,
 		"action":     "peer_vpc_pair",
 		"vpc_name1":  tunnel.VpcName1,
 		"vpc_name2":  tunnel.VpcName2,
@@ -39,7 +39,6 @@ func (c *Client) CreateTunnel(tunnel *Tunnel) error {
 
 func (c *Client) GetTunnel(tunnel *Tunnel) (*Tunnel, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_peer_vpc_pairs",
 	}
 
@@ -53,11 +52,14 @@ func (c *Client) GetTunnel(tunnel *Tunnel) (*Tunnel, error) {
 	tunList := data.Results.PairList
 	for i := range tunList {
 		if tunList[i].VpcName1 == tunnel.VpcName1 && tunList[i].VpcName2 == tunnel.VpcName2 {
-			log.Debugf("Found %s~%s tunnel: %#v", tunnel.VpcName1, tunnel.VpcName2, tunList[i])
+			logDebug(c.logContext(context.Background()), "Found tunnel", map[string]interface{}{"tunnel": fmt.Sprintf("%#v", tunList[i])})
 			return &tunList[i], nil
 		}
 	}
-	log.Errorf("Tunnel with gateways %s and %s not found", tunnel.VpcName1, tunnel.VpcName2)
+	logError(c.logContext(context.Background()), "Tunnel not found", map[string]interface{}{
+		"gw_name1": tunnel.VpcName1,
+		"gw_name2": tunnel.VpcName2,
+	})
 	return nil, ErrNotFound
 }
 
@@ -67,7 +69,6 @@ func (c *Client) UpdateTunnel(tunnel *Tunnel) error {
 
 func (c *Client) DeleteTunnel(tunnel *Tunnel) error {
 	form := map[string]string{
-		"CID":       c.CID,
 		"action":    "unpeer_vpc_pair",
 		"vpc_name1": tunnel.VpcName1,
 		"vpc_name2": tunnel.VpcName2,
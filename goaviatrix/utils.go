@@ -20,6 +20,10 @@ func (d DuplicateError) Error() string {
 	return d.Err.Error()
 }
 
+func (d DuplicateError) Unwrap() error {
+	return d.Err
+}
+
 func ExpandStringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
 	for _, v := range configured {
@@ -5,7 +5,6 @@ import (
 )
 
 type VendorInfo struct {
-	CID            string `form:"CID,omitempty"`
 	Action         string `form:"action,omitempty"`
 	VpcID          string `form:"vpc_id,omitempty"`
 	InstanceID     string `form:"firewall_id,omitempty"`
@@ -22,7 +21,6 @@ type VendorInfo struct {
 }
 
 type FirewallManager struct {
-	CID           string
 	Action        string
 	VpcID         string
 	GatewayName   string
@@ -39,7 +37,6 @@ type FirewallManager struct {
 
 func (c *Client) EditFireNetFirewallVendorInfo(vendorInfo *VendorInfo) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "edit_firenet_firewall_vendor_info",
 		"vpc_id":          vendorInfo.VpcID,
 		"firewall_id":     vendorInfo.InstanceID,
@@ -57,7 +54,6 @@ func (c *Client) EditFireNetFirewallVendorInfo(vendorInfo *VendorInfo) error {
 
 func (c *Client) ShowFireNetFirewallVendorConfig(vendorInfo *VendorInfo) error {
 	form := map[string]string{
-		"CID":         c.CID,
 		"action":      "show_firenet_firewall_vendor_config",
 		"vpc_id":      vendorInfo.VpcID,
 		"firewall_id": vendorInfo.InstanceID,
@@ -70,7 +66,6 @@ func (c *Client) ShowFireNetFirewallVendorConfig(vendorInfo *VendorInfo) error {
 func (c *Client) EditFireNetFirewallManagerVendorInfo(ctx context.Context, firewallManager *FirewallManager) error {
 	params := map[string]string{
 		"action":          "edit_firenet_firewall_manager_vendor_info",
-		"CID":             c.CID,
 		"vpc_id":          firewallManager.VpcID,
 		"gw_name":         firewallManager.GatewayName,
 		"firewall_vendor": firewallManager.VendorType,
@@ -88,7 +83,6 @@ func (c *Client) EditFireNetFirewallManagerVendorInfo(ctx context.Context, firew
 func (c *Client) SyncFireNetFirewallManagerVendorConfig(ctx context.Context, firewallManager *FirewallManager) error {
 	params := map[string]string{
 		"action":  "show_firenet_firewall_vendor_config",
-		"CID":     c.CID,
 		"vpc_id":  firewallManager.VpcID,
 		"gw_name": firewallManager.GatewayName,
 		"sync":    "true",
@@ -100,7 +94,6 @@ func (c *Client) SyncFireNetFirewallManagerVendorConfig(ctx context.Context, fir
 func (c *Client) EditFireNetFirewallVendorInfoWithPrivateKey(vendorInfo *VendorInfo) error {
 	params := map[string]string{
 		"action":          "edit_firenet_firewall_vendor_info",
-		"CID":             c.CID,
 		"vpc_id":          vendorInfo.VpcID,
 		"firewall_id":     vendorInfo.InstanceID,
 		"firewall_name":   vendorInfo.FirewallName,
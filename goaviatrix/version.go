@@ -9,12 +9,9 @@ import (
 	"strconv"
 	"strings"
 	"time"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type Version struct {
-	CID           string `form:"CID,omitempty"`
 	Action        string `form:"action,omitempty"`
 	TargetVersion string `form:"version,omitempty"`
 	Version       string `json:"version,omitempty"`
@@ -87,7 +84,6 @@ func (av *AviatrixVersion) String(includeBuild bool) string {
 // AsyncUpgrade will upgrade controller asynchronously
 func (c *Client) AsyncUpgrade(version *Version, upgradeGateways bool) error {
 	form := map[string]string{
-		"CID":   c.CID,
 		"async": "true", // indicates an async command
 	}
 	if upgradeGateways {
@@ -125,7 +121,6 @@ func (c *Client) AsyncUpgrade(version *Version, upgradeGateways bool) error {
 
 	form1 := map[string]interface{}{
 		"action":        "platform_upgrade_status",
-		"CID":           c.CID,
 		"platform_only": true,
 	}
 
@@ -177,7 +172,6 @@ func (c *Client) AsyncUpgrade(version *Version, upgradeGateways bool) error {
 func (c *Client) UpgradeGateway(gateway *Gateway) error {
 	form := map[string]string{
 		"action":           "upgrade_selected_gateway",
-		"CID":              c.CID,
 		"gateway_list":     gateway.GwName,
 		"software_version": gateway.SoftwareVersion,
 		"image_version":    gateway.ImageVersion,
@@ -185,15 +179,14 @@ func (c *Client) UpgradeGateway(gateway *Gateway) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func (c *Client) GetCurrentVersion() (string, *AviatrixVersion, error) {
+func (c *Client) GetCurrentVersion(ctx context.Context) (string, *AviatrixVersion, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_version_info",
 	}
 
 	var data VersionInfoResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return "", nil, err
 	}
@@ -209,7 +202,6 @@ func (c *Client) GetCurrentVersion() (string, *AviatrixVersion, error) {
 func (c *Client) GetVersionInfo() (*VersionInfo, error) {
 	form := map[string]string{
 		"action": "list_version_info",
-		"CID":    c.CID,
 	}
 	var data struct {
 		Results struct {
@@ -237,7 +229,6 @@ func (c *Client) GetVersionInfo() (*VersionInfo, error) {
 
 func (c *Client) GetLatestVersion() (string, error) {
 	form := map[string]string{
-		"CID":            c.CID,
 		"action":         "list_version_info",
 		"latest_version": strconv.FormatBool(true),
 	}
@@ -259,7 +250,6 @@ func (c *Client) GetLatestVersion() (string, error) {
 func ParseVersion(version string) (string, *AviatrixVersion, error) {
 	version = strings.TrimPrefix(version, "UserConnect-")
 	if version == "" {
-		log.Infof("version is an empty string")
 		return "", &AviatrixVersion{}, nil
 	}
 
@@ -291,7 +281,6 @@ func ParseVersion(version string) (string, *AviatrixVersion, error) {
 func (c *Client) GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error) {
 	form := map[string]string{
 		"action":           "get_compatible_image_version",
-		"CID":              c.CID,
 		"software_version": softwareVersion,
 		"cloud_type":       strconv.Itoa(cloudType),
 	}
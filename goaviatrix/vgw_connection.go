@@ -12,7 +12,6 @@ type VGWConn struct {
 	BgpVGWId         string `form:"vgw_id,omitempty" json:"bgp_vgw_id,omitempty"`
 	BgpVGWAccount    string `form:"bgp_vgw_account_name,omitempty" json:"bgp_vgw_account,omitempty"`
 	BgpVGWRegion     string `form:"bgp_vgw_region,omitempty" json:"bgp_vgw_region,omitempty"`
-	CID              string `form:"CID,omitempty"`
 	ConnName         string `form:"connection_name,omitempty" json:"name,omitempty"`
 	GwName           string `form:"gw_name,omitempty" json:"gw_name,omitempty"`
 	VPCId            string `form:"vpc_id,omitempty" json:"vpc_id,omitempty"`
@@ -70,7 +69,6 @@ type VGWConnBgpManualSpokeAdvertisedNetworksResp struct {
 
 func (c *Client) CreateVGWConn(vgwConn *VGWConn) error {
 	form := map[string]string{
-		"CID":                  c.CID,
 		"action":               "connect_transit_gw_to_vgw",
 		"vpc_id":               vgwConn.VPCId,
 		"connection_name":      vgwConn.ConnName,
@@ -86,7 +84,6 @@ func (c *Client) CreateVGWConn(vgwConn *VGWConn) error {
 
 func (c *Client) GetVGWConn(vgwConn *VGWConn) (*VGWConn, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_vgw_connections",
 	}
 
@@ -116,7 +113,6 @@ func (c *Client) UpdateVGWConn(vgwConn *VGWConn) error {
 
 func (c *Client) DeleteVGWConn(vgwConn *VGWConn) error {
 	form := map[string]string{
-		"CID":             c.CID,
 		"action":          "disconnect_transit_gw_from_vgw",
 		"vpc_id":          vgwConn.VPCId,
 		"connection_name": vgwConn.ConnName,
@@ -126,14 +122,13 @@ func (c *Client) DeleteVGWConn(vgwConn *VGWConn) error {
 
 func (c *Client) GetVGWConnDetail(vgwConn *VGWConn) (*VGWConn, error) {
 	params := map[string]string{
-		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
 		"vpc_id":    vgwConn.VPCId,
 		"conn_name": vgwConn.ConnName,
 	}
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -163,13 +158,11 @@ func (c *Client) GetVGWConnDetail(vgwConn *VGWConn) (*VGWConn, error) {
 func (c *Client) EditVgwConnectionASPathPrepend(vgwConn *VGWConn, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
 	return c.PostAPI(action, struct {
-		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
 		ConnectionName string `form:"connection_name"`
 		PrependASPath  string `form:"connection_as_path_prepend"`
 	}{
-		CID:            c.CID,
 		Action:         action,
 		GatewayName:    vgwConn.GwName,
 		ConnectionName: vgwConn.ConnName,
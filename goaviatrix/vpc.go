@@ -71,7 +71,6 @@ type SubnetInfo struct {
 func (c *Client) CreateVpc(vpc *Vpc) error {
 	action := "create_custom_vpc"
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       action,
 		"cloud_type":   strconv.Itoa(vpc.CloudType),
 		"account_name": vpc.AccountName,
@@ -119,7 +118,6 @@ func (c *Client) CreateVpc(vpc *Vpc) error {
 // If the vpc does not exist, ErrNotFound is returned.
 func (c *Client) GetVpcCloudTypeById(ID string) (int, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_custom_vpcs",
 	}
 
@@ -144,7 +142,6 @@ func (c *Client) GetVpcCloudTypeById(ID string) (int, error) {
 func (c *Client) GetCloudTypeFromVpcID(vpcID string) (int, error) {
 	data := map[string]string{
 		"action": "list_custom_vpcs",
-		"CID":    c.CID,
 	}
 	var respData VpcResp
 	err := c.GetAPI(&respData, data["action"], data, BasicCheck)
@@ -164,13 +161,12 @@ func (c *Client) GetCloudTypeFromVpcID(vpcID string) (int, error) {
 func (c *Client) GetVpc(vpc *Vpc) (*Vpc, error) {
 	form := map[string]string{
 		"action":   "get_custom_vpc_by_name",
-		"CID":      c.CID,
 		"vpc_name": vpc.Name,
 	}
 	var data GetVpcByNameResp
 	check := func(action, method, reason string, ret bool) error {
 		if !ret {
-			if strings.Contains(reason, "does not exist") {
+			if IsNotFoundReason(reason) {
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
@@ -208,7 +204,6 @@ func (c *Client) GetVpc(vpc *Vpc) (*Vpc, error) {
 
 func (c *Client) GetVpcRouteTableIDs(vpc *Vpc) ([]string, error) {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "list_vpc_route_tables",
 		"vpc_id":       vpc.VpcID,
 		"account_name": vpc.AccountName,
@@ -249,7 +244,6 @@ func (c *Client) UpdateVpc(vpc *Vpc) error {
 
 func (c *Client) DeleteVpc(vpc *Vpc) error {
 	form := map[string]string{
-		"CID":          c.CID,
 		"action":       "delete_custom_vpc",
 		"account_name": vpc.AccountName,
 		"pool_name":    vpc.Name,
@@ -261,7 +255,6 @@ func (c *Client) DeleteVpc(vpc *Vpc) error {
 func (c *Client) EnableNativeAwsGwlbFirenet(vpc *Vpc) error {
 	data := map[string]string{
 		"action":       "enable_native_aws_gwlb_firenet",
-		"CID":          c.CID,
 		"account_name": vpc.AccountName,
 		"region":       vpc.Region,
 		"vpc_id":       vpc.VpcID,
@@ -272,7 +265,6 @@ func (c *Client) EnableNativeAwsGwlbFirenet(vpc *Vpc) error {
 func (c *Client) DisableNativeAwsGwlbFirenet(vpc *Vpc) error {
 	data := map[string]string{
 		"action": "disable_native_aws_gwlb_firenet",
-		"CID":    c.CID,
 		"vpc_id": vpc.VpcID,
 	}
 	return c.PostAPI(data["action"], data, BasicCheck)
@@ -281,7 +273,6 @@ func (c *Client) DisableNativeAwsGwlbFirenet(vpc *Vpc) error {
 func (c *Client) ListOciVpcAvailabilityDomains(vpc *Vpc) ([]string, error) {
 	params := map[string]string{
 		"action":       "list_oci_vpc_availability_domains",
-		"CID":          c.CID,
 		"account_name": vpc.AccountName,
 		"region":       vpc.Region,
 		"vpc_id":       vpc.VpcID,
@@ -306,7 +297,6 @@ func (c *Client) ListOciVpcAvailabilityDomains(vpc *Vpc) ([]string, error) {
 func (c *Client) ListOciVpcFaultDomains(vpc *Vpc) ([]string, error) {
 	params := map[string]string{
 		"action":       "list_oci_vpc_fault_domains",
-		"CID":          c.CID,
 		"account_name": vpc.AccountName,
 		"region":       vpc.Region,
 		"vpc_id":       vpc.VpcID,
@@ -1,9 +1,8 @@
 package goaviatrix
 
 import (
+	"context"
 	"math"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type VpcTracker struct {
@@ -44,7 +43,6 @@ type VpcTrackerResp struct {
 // GetVpcTracker retrieves the list of VPC's from the 'VPC Tracker' feature.
 func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "cloud_network_info",
 		"cache":    "no",
 		"show_all": "yes",
@@ -72,8 +70,15 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 			actualInstCount = int(instCount)
 		}
 
+		cloudType := vendorNameToCloudType(vpc.VendorName)
+		if cloudType == 0 {
+			logError(c.logContext(context.Background()), "Could not map vendor name to cloud type", map[string]interface{}{
+				"vendor_name": vpc.VendorName,
+			})
+		}
+
 		vpcList = append(vpcList, &VpcTracker{
-			CloudType:     vendorNameToCloudType(vpc.VendorName),
+			CloudType:     cloudType,
 			VpcID:         vpc.VpcID,
 			AccountName:   vpc.AccountName,
 			Region:        vpc.Region,
@@ -97,7 +102,6 @@ func vendorNameToCloudType(v string) int {
 	}
 	ct, ok := vendorToCloud[v]
 	if !ok {
-		log.Errorf("could not map vendor name to cloud type with vendor=%s", v)
 		return 0
 	}
 	return ct
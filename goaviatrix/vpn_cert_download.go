@@ -1,7 +1,6 @@
 package goaviatrix
 
 type VPNCertDownload struct {
-	CID          string `form:"CID,omitempty"`
 	Action       string `form:"action,omitempty"`
 	SAMLEndpoint string `form:"saml_endpoint,omitempty"`
 }
@@ -18,20 +17,18 @@ type GetVPNCertDownloadStatusResp struct {
 }
 
 func (c *Client) EnableVPNCertDownload(vpnCertDownload *VPNCertDownload) error {
-	vpnCertDownload.CID = c.CID
 	vpnCertDownload.Action = "enable_vpn_client_download"
 	return c.PostAPI(vpnCertDownload.Action, vpnCertDownload, BasicCheck)
 }
 
 func (c *Client) DisableVPNCertDownload() error {
 	var vpnCertDownload VPNCertDownload
-	vpnCertDownload.CID = c.CID
 	vpnCertDownload.Action = "disable_vpn_client_download"
 	return c.PostAPI(vpnCertDownload.Action, vpnCertDownload, BasicCheck)
 }
 
 func (c *Client) GetVPNCertDownloadStatus() (*GetVPNCertDownloadStatusResp, error) {
-	params := map[string]string{"CID": c.CID, "action": "get_allow_downloading_vpn_client_status"}
+	params := map[string]string{"action": "get_allow_downloading_vpn_client_status"}
 	var data GetVPNCertDownloadStatusResp
 	err := c.GetAPI(&data, "get_allow_downloading_vpn_client_status", params, BasicCheck)
 	if err != nil {
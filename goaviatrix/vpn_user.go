@@ -9,7 +9,6 @@ import (
 // VPNUser simple struct to hold vpn_user details
 type VPNUser struct {
 	Action       string   `form:"action,omitempty" json:"action,omitempty"`
-	CID          string   `form:"CID,omitempty" json:"CID,omitempty"`
 	SamlEndpoint string   `form:"saml_endpoint,omitempty" json:"saml_endpoint,omitempty"`
 	VpcID        string   `form:"vpc_id,omitempty" json:"vpc_id,omitempty"`
 	GwName       string   `form:"lb_name,omitempty" json:"lb_name,omitempty"`
@@ -32,7 +31,6 @@ type VPNUserInfo struct {
 
 func (c *Client) CreateVPNUser(vpnUser *VPNUser) error {
 	form := map[string]string{
-		"CID":           c.CID,
 		"action":        "add_vpn_user",
 		"username":      vpnUser.UserName,
 		"user_email":    vpnUser.UserEmail,
@@ -62,7 +60,6 @@ func (c *Client) CreateVPNUser(vpnUser *VPNUser) error {
 
 func (c *Client) GetVPNUser(vpnUser *VPNUser) (*VPNUser, error) {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "get_vpn_user_by_name",
 		"username": vpnUser.UserName,
 	}
@@ -97,7 +94,6 @@ func (c *Client) GetVPNUser(vpnUser *VPNUser) (*VPNUser, error) {
 
 func (c *Client) DeleteVPNUser(vpnUser *VPNUser) error {
 	form := map[string]string{
-		"CID":      c.CID,
 		"action":   "delete_vpn_user",
 		"username": vpnUser.UserName,
 	}
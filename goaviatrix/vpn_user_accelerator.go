@@ -2,7 +2,6 @@ package goaviatrix
 
 type VpnUserXlr struct {
 	Action         string `form:"action,omitempty"`
-	CID            string `form:"CID,omitempty"`
 	Endpoints      string `form:"endpoints,omitempty"`
 	AllEndpoints   string `json:"all,omitempty"`
 	FreeEndpoints  string `json:"free,omitempty"`
@@ -17,7 +16,6 @@ type VpnUserXlrAPIResp struct {
 
 func (c *Client) GetVpnUserAccelerator() ([]string, error) {
 	form := map[string]string{
-		"CID":    c.CID,
 		"action": "list_vpn_user_xlr",
 	}
 
@@ -32,7 +30,6 @@ func (c *Client) GetVpnUserAccelerator() ([]string, error) {
 }
 
 func (c *Client) UpdateVpnUserAccelerator(xlr *VpnUserXlr) error {
-	xlr.CID = c.CID
 	xlr.Action = "update_vpn_user_xlr"
 
 	return c.PostAPI(xlr.Action, xlr, BasicCheck)
@@ -0,0 +1,330 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redacted replaces the value of every secret in wire logs and transcripts.
+const Redacted = "REDACTED"
+
+// defaultRedactKeys are matched case-insensitively against parameter, JSON and header names. A name
+// containing any of them is considered a secret.
+var defaultRedactKeys = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"token",
+	"psk",
+	"pre_shared",
+	"preshared",
+	"pre-shared",
+	"md5_key",
+	"private_key",
+	"access_key",
+	"credential",
+	"authorization",
+	"cookie",
+}
+
+// redactExactKeys are names that are secrets only when they match exactly.
+var redactExactKeys = []string{
+	"cid",
+}
+
+// WireLogger is an http.RoundTripper that logs every request sent to the controller: method,
+// action, timing, status and response reason. Secrets are redacted. If a transcript writer is set,
+// every exchange is also written to it as a JSON line that can be attached to support tickets.
+type WireLogger struct {
+	// Transport is the underlying RoundTripper, http.DefaultTransport if nil
+	Transport http.RoundTripper
+	// RedactKeys are redacted in addition to the built-in list
+	RedactKeys []string
+
+	mu         sync.Mutex
+	transcript io.Writer
+	// file is the transcript file opened by NewWireLogger, closed by Close
+	file io.Closer
+}
+
+// WireLogEntry is one line of a wire log transcript.
+type WireLogEntry struct {
+	Time       time.Time         `json:"time"`
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	Action     string            `json:"action,omitempty"`
+	Request    interface{}       `json:"request,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	StatusCode int               `json:"status_code,omitempty"`
+	DurationMS int64             `json:"duration_ms"`
+	Reason     string            `json:"reason,omitempty"`
+	Response   interface{}       `json:"response,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// NewWireLogger returns a WireLogger wrapping transport. If transcriptPath is not empty, the
+// sanitized transcript is appended to that file, which is created readable by the owner only.
+// The file is closed by Close.
+func NewWireLogger(transport http.RoundTripper, transcriptPath string, redactKeys []string) (*WireLogger, error) {
+	w := &WireLogger{
+		Transport:  transport,
+		RedactKeys: redactKeys,
+	}
+	if transcriptPath != "" {
+		f, err := os.OpenFile(transcriptPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		w.transcript = f
+		w.file = f
+	}
+	return w, nil
+}
+
+// Close closes the transcript file opened by NewWireLogger. Requests are still logged afterwards,
+// without a transcript.
+func (w *WireLogger) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.transcript, w.file = nil, nil
+	return err
+}
+
+// SetTranscript sets the writer the sanitized transcript is written to.
+func (w *WireLogger) SetTranscript(transcript io.Writer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.transcript = transcript
+}
+
+// RoundTrip implements http.RoundTripper.
+func (w *WireLogger) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := w.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &WireLogEntry{
+		Time:    time.Now().UTC(),
+		Method:  req.Method,
+		URL:     w.redactURL(req.URL),
+		Request: w.redactBody(req.Header.Get("Content-Type"), reqBody),
+		Headers: w.redactHeaders(req.Header),
+	}
+	entry.Action = requestAction(req, reqBody)
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	entry.DurationMS = time.Since(start).Milliseconds()
+
+	logCtx := NewLogContext(req.Context())
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"action":      entry.Action,
+		"duration_ms": entry.DurationMS,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		fields["error"] = err.Error()
+		logDebug(logCtx, "HTTP request failed", fields)
+		w.write(logCtx, entry)
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		return resp, err
+	}
+
+	entry.StatusCode = resp.StatusCode
+	entry.Reason = responseReason(string(respBody))
+	entry.Response = w.redactBody(resp.Header.Get("Content-Type"), respBody)
+	fields["status"] = resp.StatusCode
+	if entry.Reason != "" {
+		fields["reason"] = entry.Reason
+	}
+	logDebug(logCtx, "HTTP request", fields)
+	w.write(logCtx, entry)
+
+	return resp, nil
+}
+
+func (w *WireLogger) write(ctx context.Context, entry *WireLogEntry) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.transcript == nil {
+		return
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		logWarn(ctx, "Could not encode wire log entry", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if _, err := w.transcript.Write(append(line, '\n')); err != nil {
+		logWarn(ctx, "Could not write wire log transcript", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+// peekRequestBody returns the request body without consuming it.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if strings.Contains(req.Header.Get("Content-Type"), "multipart") {
+		// File uploads are not logged
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// requestAction returns the v1/v2 action or the v2.5 path of the request.
+func requestAction(req *http.Request, body []byte) string {
+	if action := req.URL.Query().Get("action"); action != "" {
+		return action
+	}
+	if strings.Contains(req.Header.Get("Content-Type"), "json") {
+		var data struct {
+			Action string `json:"action"`
+		}
+		if json.Unmarshal(body, &data) == nil && data.Action != "" {
+			return data.Action
+		}
+	} else if values, err := url.ParseQuery(string(body)); err == nil && values.Get("action") != "" {
+		return values.Get("action")
+	}
+	if i := strings.Index(req.URL.Path, "/v2.5/api/"); i >= 0 {
+		return req.URL.Path[i+len("/v2.5/api/"):]
+	}
+	return ""
+}
+
+// IsSecretKey reports whether a parameter, JSON or header name holds a secret.
+func (w *WireLogger) IsSecretKey(name string) bool {
+	name = strings.ToLower(name)
+	for _, k := range redactExactKeys {
+		if name == k {
+			return true
+		}
+	}
+	for _, k := range defaultRedactKeys {
+		if strings.Contains(name, k) {
+			return true
+		}
+	}
+	for _, k := range w.RedactKeys {
+		if k != "" && strings.Contains(name, strings.ToLower(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *WireLogger) redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.RawQuery = w.redactValues(u.Query()).Encode()
+	return redacted.String()
+}
+
+func (w *WireLogger) redactValues(values url.Values) url.Values {
+	for k := range values {
+		if w.IsSecretKey(k) {
+			values[k] = []string{Redacted}
+		}
+	}
+	return values
+}
+
+func (w *WireLogger) redactHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string)
+	for k := range h {
+		if w.IsSecretKey(k) || strings.EqualFold(k, "X-Access-Key") {
+			headers[k] = Redacted
+		} else {
+			headers[k] = h.Get(k)
+		}
+	}
+	return headers
+}
+
+// redactBody returns a redacted copy of a form or JSON body.
+func (w *WireLogger) redactBody(contentType string, body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	if strings.Contains(contentType, "json") || json.Valid(body) {
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err == nil {
+			return w.redactJSON(v)
+		}
+	}
+	if strings.Contains(contentType, "x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return w.redactValues(values)
+		}
+	}
+	// Do not log unknown bodies, they might contain anything, e.g. a downloaded file
+	return nil
+}
+
+func (w *WireLogger) redactJSON(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			if w.IsSecretKey(k) {
+				val[k] = Redacted
+			} else {
+				val[k] = w.redactJSON(child)
+			}
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = w.redactJSON(child)
+		}
+		return val
+	}
+	return v
+}
+
+// redactForLog returns body with secrets redacted, for trace logging of request bodies.
+func redactForLog(contentType string, body []byte) string {
+	redacted := (&WireLogger{}).redactBody(contentType, body)
+	if redacted == nil {
+		return ""
+	}
+	if values, ok := redacted.(url.Values); ok {
+		return values.Encode()
+	}
+	b, _ := json.Marshal(redacted)
+	return string(b)
+}
@@ -0,0 +1,92 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWireLogger(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"return":false,"reason":"bad psk","results":{"CID":"secret-cid","api_token":"secret-token"}}`)
+	})
+	wireLogger, err := NewWireLogger(client.HTTPClient.Transport, "", []string{"custom_field"})
+	if err != nil {
+		t.Fatalf("NewWireLogger() error = %v", err)
+	}
+	transcript := new(bytes.Buffer)
+	wireLogger.SetTranscript(transcript)
+	client.HTTPClient.Transport = wireLogger
+
+	form := map[string]string{
+		"action":              "add_site2cloud",
+		"CID":                 "secret-cid",
+		"pre_shared_key":      "secret-psk",
+		"backup_presharedkey": "secret-backup-psk",
+		"bgp_md5_key":         "secret-md5",
+		"bgp_backup_md5_key":  "secret-backup-md5",
+		"password":            "secret-password",
+		"custom_field_id":     "secret-custom",
+		"gw_name":             "gw1",
+	}
+	_ = client.PostAPIContext(context.Background(), form["action"], form, BasicCheck)
+
+	if strings.Contains(transcript.String(), "secret") {
+		t.Errorf("transcript contains a secret: %s", transcript.String())
+	}
+
+	var entry WireLogEntry
+	if err := json.Unmarshal(transcript.Bytes(), &entry); err != nil {
+		t.Fatalf("could not decode transcript: %v", err)
+	}
+	if entry.Action != "add_site2cloud" || entry.StatusCode != http.StatusOK || entry.Reason != "bad psk" {
+		t.Errorf("WireLogEntry = %+v, want action add_site2cloud, status 200 and reason %q", entry, "bad psk")
+	}
+	if !strings.Contains(transcript.String(), `"gw_name":["gw1"]`) {
+		t.Errorf("transcript does not contain the non secret parameters: %s", transcript.String())
+	}
+}
+
+func TestWireLoggerHeaders(t *testing.T) {
+	w := &WireLogger{}
+	h := http.Header{}
+	h.Set("Cookie", "session=secret")
+	h.Set("Set-Cookie", "session=secret")
+	h.Set("Authorization", "Bearer secret")
+	h.Set("Content-Type", "application/json")
+	headers := w.redactHeaders(h)
+	for _, k := range []string{"Cookie", "Set-Cookie", "Authorization"} {
+		if headers[k] != Redacted {
+			t.Errorf("header %s = %q, want %q", k, headers[k], Redacted)
+		}
+	}
+	if headers["Content-Type"] != "application/json" {
+		t.Errorf("header Content-Type = %q, want it unchanged", headers["Content-Type"])
+	}
+}
+
+func TestWireLoggerClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.jsonl")
+	wireLogger, err := NewWireLogger(nil, path, nil)
+	if err != nil {
+		t.Fatalf("NewWireLogger() error = %v", err)
+	}
+	if err := wireLogger.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	// Closing again, or logging after Close, does not fail
+	if err := wireLogger.Close(); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+	wireLogger.write(context.Background(), &WireLogEntry{Action: "test_action"})
+	if b, err := os.ReadFile(path); err != nil || len(b) != 0 {
+		t.Errorf("transcript = %q, %v, want an empty file", b, err)
+	}
+}
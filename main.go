@@ -1,6 +1,8 @@
 package main
 
 import (
+	"log"
+
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/aviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
@@ -9,4 +11,7 @@ func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: aviatrix.Provider,
 	})
+	if err := aviatrix.CloseWireLogs(); err != nil {
+		log.Printf("[WARN] Could not close the wire log transcript: %v", err)
+	}
 }